@@ -0,0 +1,26 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/newt239/chat/internal/interfaces/handler/websocket"
+)
+
+func main() {
+	output := flag.String("o", "../openapi/asyncapi.yaml", "出力先のファイルパス")
+	flag.Parse()
+
+	doc, err := websocket.GenerateAsyncAPI()
+	if err != nil {
+		log.Fatalf("failed to generate asyncapi document: %v", err)
+	}
+
+	if err := os.WriteFile(*output, doc, 0o644); err != nil {
+		log.Fatalf("failed to write asyncapi document: %v", err)
+	}
+
+	fmt.Printf("✅ AsyncAPI document generated: %s\n", *output)
+}
@@ -28,6 +28,8 @@ type Channel struct {
 	IsPrivate bool `json:"is_private,omitempty"`
 	// ChannelType holds the value of the "channel_type" field.
 	ChannelType string `json:"channel_type,omitempty"`
+	// MessageRetentionDays holds the value of the "message_retention_days" field.
+	MessageRetentionDays *int `json:"message_retention_days,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// UpdatedAt holds the value of the "updated_at" field.
@@ -124,6 +126,8 @@ func (*Channel) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case channel.FieldIsPrivate:
 			values[i] = new(sql.NullBool)
+		case channel.FieldMessageRetentionDays:
+			values[i] = new(sql.NullInt64)
 		case channel.FieldName, channel.FieldDescription, channel.FieldChannelType:
 			values[i] = new(sql.NullString)
 		case channel.FieldCreatedAt, channel.FieldUpdatedAt:
@@ -179,6 +183,13 @@ func (_m *Channel) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.ChannelType = value.String
 			}
+		case channel.FieldMessageRetentionDays:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field message_retention_days", values[i])
+			} else if value.Valid {
+				_m.MessageRetentionDays = new(int)
+				*_m.MessageRetentionDays = int(value.Int64)
+			}
 		case channel.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
@@ -283,6 +294,11 @@ func (_m *Channel) String() string {
 	builder.WriteString("channel_type=")
 	builder.WriteString(_m.ChannelType)
 	builder.WriteString(", ")
+	if v := _m.MessageRetentionDays; v != nil {
+		builder.WriteString("message_retention_days=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
 	builder.WriteString(", ")
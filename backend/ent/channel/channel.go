@@ -23,6 +23,8 @@ const (
 	FieldIsPrivate = "is_private"
 	// FieldChannelType holds the string denoting the channel_type field in the database.
 	FieldChannelType = "channel_type"
+	// FieldMessageRetentionDays holds the string denoting the message_retention_days field in the database.
+	FieldMessageRetentionDays = "message_retention_days"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
 	FieldCreatedAt = "created_at"
 	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
@@ -92,6 +94,7 @@ var Columns = []string{
 	FieldDescription,
 	FieldIsPrivate,
 	FieldChannelType,
+	FieldMessageRetentionDays,
 	FieldCreatedAt,
 	FieldUpdatedAt,
 }
@@ -125,6 +128,8 @@ var (
 	DefaultIsPrivate bool
 	// DefaultChannelType holds the default value on creation for the "channel_type" field.
 	DefaultChannelType string
+	// MessageRetentionDaysValidator is a validator for the "message_retention_days" field. It is called by the builders before save.
+	MessageRetentionDaysValidator func(int) error
 	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
 	DefaultCreatedAt func() time.Time
 	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
@@ -163,6 +168,11 @@ func ByChannelType(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldChannelType, opts...).ToFunc()
 }
 
+// ByMessageRetentionDays orders the results by the message_retention_days field.
+func ByMessageRetentionDays(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMessageRetentionDays, opts...).ToFunc()
+}
+
 // ByCreatedAt orders the results by the created_at field.
 func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
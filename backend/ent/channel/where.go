@@ -76,6 +76,11 @@ func ChannelType(v string) predicate.Channel {
 	return predicate.Channel(sql.FieldEQ(FieldChannelType, v))
 }
 
+// MessageRetentionDays applies equality check predicate on the "message_retention_days" field. It's identical to MessageRetentionDaysEQ.
+func MessageRetentionDays(v int) predicate.Channel {
+	return predicate.Channel(sql.FieldEQ(FieldMessageRetentionDays, v))
+}
+
 // CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
 func CreatedAt(v time.Time) predicate.Channel {
 	return predicate.Channel(sql.FieldEQ(FieldCreatedAt, v))
@@ -311,6 +316,56 @@ func ChannelTypeContainsFold(v string) predicate.Channel {
 	return predicate.Channel(sql.FieldContainsFold(FieldChannelType, v))
 }
 
+// MessageRetentionDaysEQ applies the EQ predicate on the "message_retention_days" field.
+func MessageRetentionDaysEQ(v int) predicate.Channel {
+	return predicate.Channel(sql.FieldEQ(FieldMessageRetentionDays, v))
+}
+
+// MessageRetentionDaysNEQ applies the NEQ predicate on the "message_retention_days" field.
+func MessageRetentionDaysNEQ(v int) predicate.Channel {
+	return predicate.Channel(sql.FieldNEQ(FieldMessageRetentionDays, v))
+}
+
+// MessageRetentionDaysIn applies the In predicate on the "message_retention_days" field.
+func MessageRetentionDaysIn(vs ...int) predicate.Channel {
+	return predicate.Channel(sql.FieldIn(FieldMessageRetentionDays, vs...))
+}
+
+// MessageRetentionDaysNotIn applies the NotIn predicate on the "message_retention_days" field.
+func MessageRetentionDaysNotIn(vs ...int) predicate.Channel {
+	return predicate.Channel(sql.FieldNotIn(FieldMessageRetentionDays, vs...))
+}
+
+// MessageRetentionDaysGT applies the GT predicate on the "message_retention_days" field.
+func MessageRetentionDaysGT(v int) predicate.Channel {
+	return predicate.Channel(sql.FieldGT(FieldMessageRetentionDays, v))
+}
+
+// MessageRetentionDaysGTE applies the GTE predicate on the "message_retention_days" field.
+func MessageRetentionDaysGTE(v int) predicate.Channel {
+	return predicate.Channel(sql.FieldGTE(FieldMessageRetentionDays, v))
+}
+
+// MessageRetentionDaysLT applies the LT predicate on the "message_retention_days" field.
+func MessageRetentionDaysLT(v int) predicate.Channel {
+	return predicate.Channel(sql.FieldLT(FieldMessageRetentionDays, v))
+}
+
+// MessageRetentionDaysLTE applies the LTE predicate on the "message_retention_days" field.
+func MessageRetentionDaysLTE(v int) predicate.Channel {
+	return predicate.Channel(sql.FieldLTE(FieldMessageRetentionDays, v))
+}
+
+// MessageRetentionDaysIsNil applies the IsNil predicate on the "message_retention_days" field.
+func MessageRetentionDaysIsNil() predicate.Channel {
+	return predicate.Channel(sql.FieldIsNull(FieldMessageRetentionDays))
+}
+
+// MessageRetentionDaysNotNil applies the NotNil predicate on the "message_retention_days" field.
+func MessageRetentionDaysNotNil() predicate.Channel {
+	return predicate.Channel(sql.FieldNotNull(FieldMessageRetentionDays))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.Channel {
 	return predicate.Channel(sql.FieldEQ(FieldCreatedAt, v))
@@ -75,6 +75,20 @@ func (_c *ChannelCreate) SetNillableChannelType(v *string) *ChannelCreate {
 	return _c
 }
 
+// SetMessageRetentionDays sets the "message_retention_days" field.
+func (_c *ChannelCreate) SetMessageRetentionDays(v int) *ChannelCreate {
+	_c.mutation.SetMessageRetentionDays(v)
+	return _c
+}
+
+// SetNillableMessageRetentionDays sets the "message_retention_days" field if the given value is not nil.
+func (_c *ChannelCreate) SetNillableMessageRetentionDays(v *int) *ChannelCreate {
+	if v != nil {
+		_c.SetMessageRetentionDays(*v)
+	}
+	return _c
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (_c *ChannelCreate) SetCreatedAt(v time.Time) *ChannelCreate {
 	_c.mutation.SetCreatedAt(v)
@@ -269,6 +283,11 @@ func (_c *ChannelCreate) check() error {
 	if _, ok := _c.mutation.IsPrivate(); !ok {
 		return &ValidationError{Name: "is_private", err: errors.New(`ent: missing required field "Channel.is_private"`)}
 	}
+	if v, ok := _c.mutation.MessageRetentionDays(); ok {
+		if err := channel.MessageRetentionDaysValidator(v); err != nil {
+			return &ValidationError{Name: "message_retention_days", err: fmt.Errorf(`ent: validator failed for field "Channel.message_retention_days": %w`, err)}
+		}
+	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Channel.created_at"`)}
 	}
@@ -332,6 +351,10 @@ func (_c *ChannelCreate) createSpec() (*Channel, *sqlgraph.CreateSpec) {
 		_spec.SetField(channel.FieldChannelType, field.TypeString, value)
 		_node.ChannelType = value
 	}
+	if value, ok := _c.mutation.MessageRetentionDays(); ok {
+		_spec.SetField(channel.FieldMessageRetentionDays, field.TypeInt, value)
+		_node.MessageRetentionDays = &value
+	}
 	if value, ok := _c.mutation.CreatedAt(); ok {
 		_spec.SetField(channel.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
@@ -103,6 +103,33 @@ func (_u *ChannelUpdate) ClearChannelType() *ChannelUpdate {
 	return _u
 }
 
+// SetMessageRetentionDays sets the "message_retention_days" field.
+func (_u *ChannelUpdate) SetMessageRetentionDays(v int) *ChannelUpdate {
+	_u.mutation.ResetMessageRetentionDays()
+	_u.mutation.SetMessageRetentionDays(v)
+	return _u
+}
+
+// SetNillableMessageRetentionDays sets the "message_retention_days" field if the given value is not nil.
+func (_u *ChannelUpdate) SetNillableMessageRetentionDays(v *int) *ChannelUpdate {
+	if v != nil {
+		_u.SetMessageRetentionDays(*v)
+	}
+	return _u
+}
+
+// AddMessageRetentionDays adds value to the "message_retention_days" field.
+func (_u *ChannelUpdate) AddMessageRetentionDays(v int) *ChannelUpdate {
+	_u.mutation.AddMessageRetentionDays(v)
+	return _u
+}
+
+// ClearMessageRetentionDays clears the value of the "message_retention_days" field.
+func (_u *ChannelUpdate) ClearMessageRetentionDays() *ChannelUpdate {
+	_u.mutation.ClearMessageRetentionDays()
+	return _u
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (_u *ChannelUpdate) SetUpdatedAt(v time.Time) *ChannelUpdate {
 	_u.mutation.SetUpdatedAt(v)
@@ -335,6 +362,11 @@ func (_u *ChannelUpdate) check() error {
 			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Channel.name": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.MessageRetentionDays(); ok {
+		if err := channel.MessageRetentionDaysValidator(v); err != nil {
+			return &ValidationError{Name: "message_retention_days", err: fmt.Errorf(`ent: validator failed for field "Channel.message_retention_days": %w`, err)}
+		}
+	}
 	if _u.mutation.WorkspaceCleared() && len(_u.mutation.WorkspaceIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "Channel.workspace"`)
 	}
@@ -374,6 +406,15 @@ func (_u *ChannelUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if _u.mutation.ChannelTypeCleared() {
 		_spec.ClearField(channel.FieldChannelType, field.TypeString)
 	}
+	if value, ok := _u.mutation.MessageRetentionDays(); ok {
+		_spec.SetField(channel.FieldMessageRetentionDays, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMessageRetentionDays(); ok {
+		_spec.AddField(channel.FieldMessageRetentionDays, field.TypeInt, value)
+	}
+	if _u.mutation.MessageRetentionDaysCleared() {
+		_spec.ClearField(channel.FieldMessageRetentionDays, field.TypeInt)
+	}
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(channel.FieldUpdatedAt, field.TypeTime, value)
 	}
@@ -703,6 +744,33 @@ func (_u *ChannelUpdateOne) ClearChannelType() *ChannelUpdateOne {
 	return _u
 }
 
+// SetMessageRetentionDays sets the "message_retention_days" field.
+func (_u *ChannelUpdateOne) SetMessageRetentionDays(v int) *ChannelUpdateOne {
+	_u.mutation.ResetMessageRetentionDays()
+	_u.mutation.SetMessageRetentionDays(v)
+	return _u
+}
+
+// SetNillableMessageRetentionDays sets the "message_retention_days" field if the given value is not nil.
+func (_u *ChannelUpdateOne) SetNillableMessageRetentionDays(v *int) *ChannelUpdateOne {
+	if v != nil {
+		_u.SetMessageRetentionDays(*v)
+	}
+	return _u
+}
+
+// AddMessageRetentionDays adds value to the "message_retention_days" field.
+func (_u *ChannelUpdateOne) AddMessageRetentionDays(v int) *ChannelUpdateOne {
+	_u.mutation.AddMessageRetentionDays(v)
+	return _u
+}
+
+// ClearMessageRetentionDays clears the value of the "message_retention_days" field.
+func (_u *ChannelUpdateOne) ClearMessageRetentionDays() *ChannelUpdateOne {
+	_u.mutation.ClearMessageRetentionDays()
+	return _u
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (_u *ChannelUpdateOne) SetUpdatedAt(v time.Time) *ChannelUpdateOne {
 	_u.mutation.SetUpdatedAt(v)
@@ -948,6 +1016,11 @@ func (_u *ChannelUpdateOne) check() error {
 			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Channel.name": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.MessageRetentionDays(); ok {
+		if err := channel.MessageRetentionDaysValidator(v); err != nil {
+			return &ValidationError{Name: "message_retention_days", err: fmt.Errorf(`ent: validator failed for field "Channel.message_retention_days": %w`, err)}
+		}
+	}
 	if _u.mutation.WorkspaceCleared() && len(_u.mutation.WorkspaceIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "Channel.workspace"`)
 	}
@@ -1004,6 +1077,15 @@ func (_u *ChannelUpdateOne) sqlSave(ctx context.Context) (_node *Channel, err er
 	if _u.mutation.ChannelTypeCleared() {
 		_spec.ClearField(channel.FieldChannelType, field.TypeString)
 	}
+	if value, ok := _u.mutation.MessageRetentionDays(); ok {
+		_spec.SetField(channel.FieldMessageRetentionDays, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMessageRetentionDays(); ok {
+		_spec.AddField(channel.FieldMessageRetentionDays, field.TypeInt, value)
+	}
+	if _u.mutation.MessageRetentionDaysCleared() {
+		_spec.ClearField(channel.FieldMessageRetentionDays, field.TypeInt)
+	}
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(channel.FieldUpdatedAt, field.TypeTime, value)
 	}
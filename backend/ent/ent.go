@@ -16,13 +16,22 @@ import (
 	"github.com/newt239/chat/ent/channel"
 	"github.com/newt239/chat/ent/channelmember"
 	"github.com/newt239/chat/ent/channelreadstate"
+	"github.com/newt239/chat/ent/draft"
+	"github.com/newt239/chat/ent/ephemeralmessage"
 	"github.com/newt239/chat/ent/message"
 	"github.com/newt239/chat/ent/messagebookmark"
 	"github.com/newt239/chat/ent/messagegroupmention"
 	"github.com/newt239/chat/ent/messagelink"
 	"github.com/newt239/chat/ent/messagepin"
 	"github.com/newt239/chat/ent/messagereaction"
+	"github.com/newt239/chat/ent/messagerevision"
+	"github.com/newt239/chat/ent/messageshare"
 	"github.com/newt239/chat/ent/messageusermention"
+	"github.com/newt239/chat/ent/poll"
+	"github.com/newt239/chat/ent/polloption"
+	"github.com/newt239/chat/ent/pollvote"
+	"github.com/newt239/chat/ent/reminder"
+	"github.com/newt239/chat/ent/scheduledmessage"
 	"github.com/newt239/chat/ent/session"
 	"github.com/newt239/chat/ent/systemmessage"
 	"github.com/newt239/chat/ent/threadreadstate"
@@ -96,13 +105,22 @@ func checkColumn(t, c string) error {
 			channel.Table:             channel.ValidColumn,
 			channelmember.Table:       channelmember.ValidColumn,
 			channelreadstate.Table:    channelreadstate.ValidColumn,
+			draft.Table:               draft.ValidColumn,
+			ephemeralmessage.Table:    ephemeralmessage.ValidColumn,
 			message.Table:             message.ValidColumn,
 			messagebookmark.Table:     messagebookmark.ValidColumn,
 			messagegroupmention.Table: messagegroupmention.ValidColumn,
 			messagelink.Table:         messagelink.ValidColumn,
 			messagepin.Table:          messagepin.ValidColumn,
 			messagereaction.Table:     messagereaction.ValidColumn,
+			messagerevision.Table:     messagerevision.ValidColumn,
+			messageshare.Table:        messageshare.ValidColumn,
 			messageusermention.Table:  messageusermention.ValidColumn,
+			poll.Table:                poll.ValidColumn,
+			polloption.Table:          polloption.ValidColumn,
+			pollvote.Table:            pollvote.ValidColumn,
+			reminder.Table:            reminder.ValidColumn,
+			scheduledmessage.Table:    scheduledmessage.ValidColumn,
 			session.Table:             session.ValidColumn,
 			systemmessage.Table:       systemmessage.ValidColumn,
 			threadreadstate.Table:     threadreadstate.ValidColumn,
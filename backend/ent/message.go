@@ -22,6 +22,8 @@ type Message struct {
 	ID uuid.UUID `json:"id,omitempty"`
 	// Body holds the value of the "body" field.
 	Body string `json:"body,omitempty"`
+	// BodyAst holds the value of the "body_ast" field.
+	BodyAst string `json:"body_ast,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// EditedAt holds the value of the "edited_at" field.
@@ -30,6 +32,10 @@ type Message struct {
 	DeletedAt time.Time `json:"deleted_at,omitempty"`
 	// DeletedBy holds the value of the "deleted_by" field.
 	DeletedBy uuid.UUID `json:"deleted_by,omitempty"`
+	// ClientMsgID holds the value of the "client_msg_id" field.
+	ClientMsgID string `json:"client_msg_id,omitempty"`
+	// RevisionCount holds the value of the "revision_count" field.
+	RevisionCount int `json:"revision_count,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the MessageQuery when eager-loading is set.
 	Edges           MessageEdges `json:"edges"`
@@ -61,13 +67,19 @@ type MessageEdges struct {
 	Links []*MessageLink `json:"links,omitempty"`
 	// Attachments holds the value of the attachments edge.
 	Attachments []*Attachment `json:"attachments,omitempty"`
+	// Revisions holds the value of the revisions edge.
+	Revisions []*MessageRevision `json:"revisions,omitempty"`
+	// Shares holds the value of the shares edge.
+	Shares []*MessageShare `json:"shares,omitempty"`
+	// SharedAs holds the value of the shared_as edge.
+	SharedAs []*MessageShare `json:"shared_as,omitempty"`
 	// UserThreadFollows holds the value of the user_thread_follows edge.
 	UserThreadFollows []*UserThreadFollow `json:"user_thread_follows,omitempty"`
 	// ThreadReadStates holds the value of the thread_read_states edge.
 	ThreadReadStates []*ThreadReadState `json:"thread_read_states,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [12]bool
+	loadedTypes [15]bool
 }
 
 // ChannelOrErr returns the Channel value or an error if the edge
@@ -166,10 +178,37 @@ func (e MessageEdges) AttachmentsOrErr() ([]*Attachment, error) {
 	return nil, &NotLoadedError{edge: "attachments"}
 }
 
+// RevisionsOrErr returns the Revisions value or an error if the edge
+// was not loaded in eager-loading.
+func (e MessageEdges) RevisionsOrErr() ([]*MessageRevision, error) {
+	if e.loadedTypes[10] {
+		return e.Revisions, nil
+	}
+	return nil, &NotLoadedError{edge: "revisions"}
+}
+
+// SharesOrErr returns the Shares value or an error if the edge
+// was not loaded in eager-loading.
+func (e MessageEdges) SharesOrErr() ([]*MessageShare, error) {
+	if e.loadedTypes[11] {
+		return e.Shares, nil
+	}
+	return nil, &NotLoadedError{edge: "shares"}
+}
+
+// SharedAsOrErr returns the SharedAs value or an error if the edge
+// was not loaded in eager-loading.
+func (e MessageEdges) SharedAsOrErr() ([]*MessageShare, error) {
+	if e.loadedTypes[12] {
+		return e.SharedAs, nil
+	}
+	return nil, &NotLoadedError{edge: "shared_as"}
+}
+
 // UserThreadFollowsOrErr returns the UserThreadFollows value or an error if the edge
 // was not loaded in eager-loading.
 func (e MessageEdges) UserThreadFollowsOrErr() ([]*UserThreadFollow, error) {
-	if e.loadedTypes[10] {
+	if e.loadedTypes[13] {
 		return e.UserThreadFollows, nil
 	}
 	return nil, &NotLoadedError{edge: "user_thread_follows"}
@@ -178,7 +217,7 @@ func (e MessageEdges) UserThreadFollowsOrErr() ([]*UserThreadFollow, error) {
 // ThreadReadStatesOrErr returns the ThreadReadStates value or an error if the edge
 // was not loaded in eager-loading.
 func (e MessageEdges) ThreadReadStatesOrErr() ([]*ThreadReadState, error) {
-	if e.loadedTypes[11] {
+	if e.loadedTypes[14] {
 		return e.ThreadReadStates, nil
 	}
 	return nil, &NotLoadedError{edge: "thread_read_states"}
@@ -189,7 +228,9 @@ func (*Message) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case message.FieldBody:
+		case message.FieldRevisionCount:
+			values[i] = new(sql.NullInt64)
+		case message.FieldBody, message.FieldBodyAst, message.FieldClientMsgID:
 			values[i] = new(sql.NullString)
 		case message.FieldCreatedAt, message.FieldEditedAt, message.FieldDeletedAt:
 			values[i] = new(sql.NullTime)
@@ -228,6 +269,12 @@ func (_m *Message) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.Body = value.String
 			}
+		case message.FieldBodyAst:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field body_ast", values[i])
+			} else if value.Valid {
+				_m.BodyAst = value.String
+			}
 		case message.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
@@ -252,6 +299,18 @@ func (_m *Message) assignValues(columns []string, values []any) error {
 			} else if value != nil {
 				_m.DeletedBy = *value
 			}
+		case message.FieldClientMsgID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field client_msg_id", values[i])
+			} else if value.Valid {
+				_m.ClientMsgID = value.String
+			}
+		case message.FieldRevisionCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field revision_count", values[i])
+			} else if value.Valid {
+				_m.RevisionCount = int(value.Int64)
+			}
 		case message.ForeignKeys[0]:
 			if value, ok := values[i].(*sql.NullScanner); !ok {
 				return fmt.Errorf("unexpected type %T for field message_channel", values[i])
@@ -336,6 +395,21 @@ func (_m *Message) QueryAttachments() *AttachmentQuery {
 	return NewMessageClient(_m.config).QueryAttachments(_m)
 }
 
+// QueryRevisions queries the "revisions" edge of the Message entity.
+func (_m *Message) QueryRevisions() *MessageRevisionQuery {
+	return NewMessageClient(_m.config).QueryRevisions(_m)
+}
+
+// QueryShares queries the "shares" edge of the Message entity.
+func (_m *Message) QueryShares() *MessageShareQuery {
+	return NewMessageClient(_m.config).QueryShares(_m)
+}
+
+// QuerySharedAs queries the "shared_as" edge of the Message entity.
+func (_m *Message) QuerySharedAs() *MessageShareQuery {
+	return NewMessageClient(_m.config).QuerySharedAs(_m)
+}
+
 // QueryUserThreadFollows queries the "user_thread_follows" edge of the Message entity.
 func (_m *Message) QueryUserThreadFollows() *UserThreadFollowQuery {
 	return NewMessageClient(_m.config).QueryUserThreadFollows(_m)
@@ -372,6 +446,9 @@ func (_m *Message) String() string {
 	builder.WriteString("body=")
 	builder.WriteString(_m.Body)
 	builder.WriteString(", ")
+	builder.WriteString("body_ast=")
+	builder.WriteString(_m.BodyAst)
+	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
 	builder.WriteString(", ")
@@ -383,6 +460,12 @@ func (_m *Message) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("deleted_by=")
 	builder.WriteString(fmt.Sprintf("%v", _m.DeletedBy))
+	builder.WriteString(", ")
+	builder.WriteString("client_msg_id=")
+	builder.WriteString(_m.ClientMsgID)
+	builder.WriteString(", ")
+	builder.WriteString("revision_count=")
+	builder.WriteString(fmt.Sprintf("%v", _m.RevisionCount))
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -17,6 +17,8 @@ const (
 	FieldID = "id"
 	// FieldBody holds the string denoting the body field in the database.
 	FieldBody = "body"
+	// FieldBodyAst holds the string denoting the body_ast field in the database.
+	FieldBodyAst = "body_ast"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
 	FieldCreatedAt = "created_at"
 	// FieldEditedAt holds the string denoting the edited_at field in the database.
@@ -25,6 +27,10 @@ const (
 	FieldDeletedAt = "deleted_at"
 	// FieldDeletedBy holds the string denoting the deleted_by field in the database.
 	FieldDeletedBy = "deleted_by"
+	// FieldClientMsgID holds the string denoting the client_msg_id field in the database.
+	FieldClientMsgID = "client_msg_id"
+	// FieldRevisionCount holds the string denoting the revision_count field in the database.
+	FieldRevisionCount = "revision_count"
 	// EdgeChannel holds the string denoting the channel edge name in mutations.
 	EdgeChannel = "channel"
 	// EdgeUser holds the string denoting the user edge name in mutations.
@@ -45,6 +51,12 @@ const (
 	EdgeLinks = "links"
 	// EdgeAttachments holds the string denoting the attachments edge name in mutations.
 	EdgeAttachments = "attachments"
+	// EdgeRevisions holds the string denoting the revisions edge name in mutations.
+	EdgeRevisions = "revisions"
+	// EdgeShares holds the string denoting the shares edge name in mutations.
+	EdgeShares = "shares"
+	// EdgeSharedAs holds the string denoting the shared_as edge name in mutations.
+	EdgeSharedAs = "shared_as"
 	// EdgeUserThreadFollows holds the string denoting the user_thread_follows edge name in mutations.
 	EdgeUserThreadFollows = "user_thread_follows"
 	// EdgeThreadReadStates holds the string denoting the thread_read_states edge name in mutations.
@@ -115,6 +127,27 @@ const (
 	AttachmentsInverseTable = "attachments"
 	// AttachmentsColumn is the table column denoting the attachments relation/edge.
 	AttachmentsColumn = "attachment_message"
+	// RevisionsTable is the table that holds the revisions relation/edge.
+	RevisionsTable = "message_revisions"
+	// RevisionsInverseTable is the table name for the MessageRevision entity.
+	// It exists in this package in order to avoid circular dependency with the "messagerevision" package.
+	RevisionsInverseTable = "message_revisions"
+	// RevisionsColumn is the table column denoting the revisions relation/edge.
+	RevisionsColumn = "message_revision_message"
+	// SharesTable is the table that holds the shares relation/edge.
+	SharesTable = "message_shares"
+	// SharesInverseTable is the table name for the MessageShare entity.
+	// It exists in this package in order to avoid circular dependency with the "messageshare" package.
+	SharesInverseTable = "message_shares"
+	// SharesColumn is the table column denoting the shares relation/edge.
+	SharesColumn = "message_share_message"
+	// SharedAsTable is the table that holds the shared_as relation/edge.
+	SharedAsTable = "message_shares"
+	// SharedAsInverseTable is the table name for the MessageShare entity.
+	// It exists in this package in order to avoid circular dependency with the "messageshare" package.
+	SharedAsInverseTable = "message_shares"
+	// SharedAsColumn is the table column denoting the shared_as relation/edge.
+	SharedAsColumn = "message_share_source"
 	// UserThreadFollowsTable is the table that holds the user_thread_follows relation/edge.
 	UserThreadFollowsTable = "user_thread_follows"
 	// UserThreadFollowsInverseTable is the table name for the UserThreadFollow entity.
@@ -135,10 +168,13 @@ const (
 var Columns = []string{
 	FieldID,
 	FieldBody,
+	FieldBodyAst,
 	FieldCreatedAt,
 	FieldEditedAt,
 	FieldDeletedAt,
 	FieldDeletedBy,
+	FieldClientMsgID,
+	FieldRevisionCount,
 }
 
 // ForeignKeys holds the SQL foreign-keys that are owned by the "messages"
@@ -165,10 +201,14 @@ func ValidColumn(column string) bool {
 }
 
 var (
-	// BodyValidator is a validator for the "body" field. It is called by the builders before save.
-	BodyValidator func(string) error
 	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
 	DefaultCreatedAt func() time.Time
+	// ClientMsgIDValidator is a validator for the "client_msg_id" field. It is called by the builders before save.
+	ClientMsgIDValidator func(string) error
+	// DefaultRevisionCount holds the default value on creation for the "revision_count" field.
+	DefaultRevisionCount int
+	// RevisionCountValidator is a validator for the "revision_count" field. It is called by the builders before save.
+	RevisionCountValidator func(int) error
 	// DefaultID holds the default value on creation for the "id" field.
 	DefaultID func() uuid.UUID
 )
@@ -186,6 +226,11 @@ func ByBody(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldBody, opts...).ToFunc()
 }
 
+// ByBodyAst orders the results by the body_ast field.
+func ByBodyAst(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBodyAst, opts...).ToFunc()
+}
+
 // ByCreatedAt orders the results by the created_at field.
 func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
@@ -206,6 +251,16 @@ func ByDeletedBy(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldDeletedBy, opts...).ToFunc()
 }
 
+// ByClientMsgID orders the results by the client_msg_id field.
+func ByClientMsgID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldClientMsgID, opts...).ToFunc()
+}
+
+// ByRevisionCount orders the results by the revision_count field.
+func ByRevisionCount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRevisionCount, opts...).ToFunc()
+}
+
 // ByChannelField orders the results by channel field.
 func ByChannelField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -325,6 +380,48 @@ func ByAttachments(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 	}
 }
 
+// ByRevisionsCount orders the results by revisions count.
+func ByRevisionsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newRevisionsStep(), opts...)
+	}
+}
+
+// ByRevisions orders the results by revisions terms.
+func ByRevisions(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newRevisionsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// BySharesCount orders the results by shares count.
+func BySharesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newSharesStep(), opts...)
+	}
+}
+
+// ByShares orders the results by shares terms.
+func ByShares(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newSharesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// BySharedAsCount orders the results by shared_as count.
+func BySharedAsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newSharedAsStep(), opts...)
+	}
+}
+
+// BySharedAs orders the results by shared_as terms.
+func BySharedAs(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newSharedAsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
 // ByUserThreadFollowsCount orders the results by user_thread_follows count.
 func ByUserThreadFollowsCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -422,6 +519,27 @@ func newAttachmentsStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, true, AttachmentsTable, AttachmentsColumn),
 	)
 }
+func newRevisionsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(RevisionsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, true, RevisionsTable, RevisionsColumn),
+	)
+}
+func newSharesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(SharesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, true, SharesTable, SharesColumn),
+	)
+}
+func newSharedAsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(SharedAsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, true, SharedAsTable, SharedAsColumn),
+	)
+}
 func newUserThreadFollowsStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -61,6 +61,11 @@ func Body(v string) predicate.Message {
 	return predicate.Message(sql.FieldEQ(FieldBody, v))
 }
 
+// BodyAst applies equality check predicate on the "body_ast" field. It's identical to BodyAstEQ.
+func BodyAst(v string) predicate.Message {
+	return predicate.Message(sql.FieldEQ(FieldBodyAst, v))
+}
+
 // CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
 func CreatedAt(v time.Time) predicate.Message {
 	return predicate.Message(sql.FieldEQ(FieldCreatedAt, v))
@@ -81,6 +86,16 @@ func DeletedBy(v uuid.UUID) predicate.Message {
 	return predicate.Message(sql.FieldEQ(FieldDeletedBy, v))
 }
 
+// ClientMsgID applies equality check predicate on the "client_msg_id" field. It's identical to ClientMsgIDEQ.
+func ClientMsgID(v string) predicate.Message {
+	return predicate.Message(sql.FieldEQ(FieldClientMsgID, v))
+}
+
+// RevisionCount applies equality check predicate on the "revision_count" field. It's identical to RevisionCountEQ.
+func RevisionCount(v int) predicate.Message {
+	return predicate.Message(sql.FieldEQ(FieldRevisionCount, v))
+}
+
 // BodyEQ applies the EQ predicate on the "body" field.
 func BodyEQ(v string) predicate.Message {
 	return predicate.Message(sql.FieldEQ(FieldBody, v))
@@ -146,6 +161,81 @@ func BodyContainsFold(v string) predicate.Message {
 	return predicate.Message(sql.FieldContainsFold(FieldBody, v))
 }
 
+// BodyAstEQ applies the EQ predicate on the "body_ast" field.
+func BodyAstEQ(v string) predicate.Message {
+	return predicate.Message(sql.FieldEQ(FieldBodyAst, v))
+}
+
+// BodyAstNEQ applies the NEQ predicate on the "body_ast" field.
+func BodyAstNEQ(v string) predicate.Message {
+	return predicate.Message(sql.FieldNEQ(FieldBodyAst, v))
+}
+
+// BodyAstIn applies the In predicate on the "body_ast" field.
+func BodyAstIn(vs ...string) predicate.Message {
+	return predicate.Message(sql.FieldIn(FieldBodyAst, vs...))
+}
+
+// BodyAstNotIn applies the NotIn predicate on the "body_ast" field.
+func BodyAstNotIn(vs ...string) predicate.Message {
+	return predicate.Message(sql.FieldNotIn(FieldBodyAst, vs...))
+}
+
+// BodyAstGT applies the GT predicate on the "body_ast" field.
+func BodyAstGT(v string) predicate.Message {
+	return predicate.Message(sql.FieldGT(FieldBodyAst, v))
+}
+
+// BodyAstGTE applies the GTE predicate on the "body_ast" field.
+func BodyAstGTE(v string) predicate.Message {
+	return predicate.Message(sql.FieldGTE(FieldBodyAst, v))
+}
+
+// BodyAstLT applies the LT predicate on the "body_ast" field.
+func BodyAstLT(v string) predicate.Message {
+	return predicate.Message(sql.FieldLT(FieldBodyAst, v))
+}
+
+// BodyAstLTE applies the LTE predicate on the "body_ast" field.
+func BodyAstLTE(v string) predicate.Message {
+	return predicate.Message(sql.FieldLTE(FieldBodyAst, v))
+}
+
+// BodyAstContains applies the Contains predicate on the "body_ast" field.
+func BodyAstContains(v string) predicate.Message {
+	return predicate.Message(sql.FieldContains(FieldBodyAst, v))
+}
+
+// BodyAstHasPrefix applies the HasPrefix predicate on the "body_ast" field.
+func BodyAstHasPrefix(v string) predicate.Message {
+	return predicate.Message(sql.FieldHasPrefix(FieldBodyAst, v))
+}
+
+// BodyAstHasSuffix applies the HasSuffix predicate on the "body_ast" field.
+func BodyAstHasSuffix(v string) predicate.Message {
+	return predicate.Message(sql.FieldHasSuffix(FieldBodyAst, v))
+}
+
+// BodyAstIsNil applies the IsNil predicate on the "body_ast" field.
+func BodyAstIsNil() predicate.Message {
+	return predicate.Message(sql.FieldIsNull(FieldBodyAst))
+}
+
+// BodyAstNotNil applies the NotNil predicate on the "body_ast" field.
+func BodyAstNotNil() predicate.Message {
+	return predicate.Message(sql.FieldNotNull(FieldBodyAst))
+}
+
+// BodyAstEqualFold applies the EqualFold predicate on the "body_ast" field.
+func BodyAstEqualFold(v string) predicate.Message {
+	return predicate.Message(sql.FieldEqualFold(FieldBodyAst, v))
+}
+
+// BodyAstContainsFold applies the ContainsFold predicate on the "body_ast" field.
+func BodyAstContainsFold(v string) predicate.Message {
+	return predicate.Message(sql.FieldContainsFold(FieldBodyAst, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.Message {
 	return predicate.Message(sql.FieldEQ(FieldCreatedAt, v))
@@ -336,6 +426,121 @@ func DeletedByNotNil() predicate.Message {
 	return predicate.Message(sql.FieldNotNull(FieldDeletedBy))
 }
 
+// ClientMsgIDEQ applies the EQ predicate on the "client_msg_id" field.
+func ClientMsgIDEQ(v string) predicate.Message {
+	return predicate.Message(sql.FieldEQ(FieldClientMsgID, v))
+}
+
+// ClientMsgIDNEQ applies the NEQ predicate on the "client_msg_id" field.
+func ClientMsgIDNEQ(v string) predicate.Message {
+	return predicate.Message(sql.FieldNEQ(FieldClientMsgID, v))
+}
+
+// ClientMsgIDIn applies the In predicate on the "client_msg_id" field.
+func ClientMsgIDIn(vs ...string) predicate.Message {
+	return predicate.Message(sql.FieldIn(FieldClientMsgID, vs...))
+}
+
+// ClientMsgIDNotIn applies the NotIn predicate on the "client_msg_id" field.
+func ClientMsgIDNotIn(vs ...string) predicate.Message {
+	return predicate.Message(sql.FieldNotIn(FieldClientMsgID, vs...))
+}
+
+// ClientMsgIDGT applies the GT predicate on the "client_msg_id" field.
+func ClientMsgIDGT(v string) predicate.Message {
+	return predicate.Message(sql.FieldGT(FieldClientMsgID, v))
+}
+
+// ClientMsgIDGTE applies the GTE predicate on the "client_msg_id" field.
+func ClientMsgIDGTE(v string) predicate.Message {
+	return predicate.Message(sql.FieldGTE(FieldClientMsgID, v))
+}
+
+// ClientMsgIDLT applies the LT predicate on the "client_msg_id" field.
+func ClientMsgIDLT(v string) predicate.Message {
+	return predicate.Message(sql.FieldLT(FieldClientMsgID, v))
+}
+
+// ClientMsgIDLTE applies the LTE predicate on the "client_msg_id" field.
+func ClientMsgIDLTE(v string) predicate.Message {
+	return predicate.Message(sql.FieldLTE(FieldClientMsgID, v))
+}
+
+// ClientMsgIDContains applies the Contains predicate on the "client_msg_id" field.
+func ClientMsgIDContains(v string) predicate.Message {
+	return predicate.Message(sql.FieldContains(FieldClientMsgID, v))
+}
+
+// ClientMsgIDHasPrefix applies the HasPrefix predicate on the "client_msg_id" field.
+func ClientMsgIDHasPrefix(v string) predicate.Message {
+	return predicate.Message(sql.FieldHasPrefix(FieldClientMsgID, v))
+}
+
+// ClientMsgIDHasSuffix applies the HasSuffix predicate on the "client_msg_id" field.
+func ClientMsgIDHasSuffix(v string) predicate.Message {
+	return predicate.Message(sql.FieldHasSuffix(FieldClientMsgID, v))
+}
+
+// ClientMsgIDIsNil applies the IsNil predicate on the "client_msg_id" field.
+func ClientMsgIDIsNil() predicate.Message {
+	return predicate.Message(sql.FieldIsNull(FieldClientMsgID))
+}
+
+// ClientMsgIDNotNil applies the NotNil predicate on the "client_msg_id" field.
+func ClientMsgIDNotNil() predicate.Message {
+	return predicate.Message(sql.FieldNotNull(FieldClientMsgID))
+}
+
+// ClientMsgIDEqualFold applies the EqualFold predicate on the "client_msg_id" field.
+func ClientMsgIDEqualFold(v string) predicate.Message {
+	return predicate.Message(sql.FieldEqualFold(FieldClientMsgID, v))
+}
+
+// ClientMsgIDContainsFold applies the ContainsFold predicate on the "client_msg_id" field.
+func ClientMsgIDContainsFold(v string) predicate.Message {
+	return predicate.Message(sql.FieldContainsFold(FieldClientMsgID, v))
+}
+
+// RevisionCountEQ applies the EQ predicate on the "revision_count" field.
+func RevisionCountEQ(v int) predicate.Message {
+	return predicate.Message(sql.FieldEQ(FieldRevisionCount, v))
+}
+
+// RevisionCountNEQ applies the NEQ predicate on the "revision_count" field.
+func RevisionCountNEQ(v int) predicate.Message {
+	return predicate.Message(sql.FieldNEQ(FieldRevisionCount, v))
+}
+
+// RevisionCountIn applies the In predicate on the "revision_count" field.
+func RevisionCountIn(vs ...int) predicate.Message {
+	return predicate.Message(sql.FieldIn(FieldRevisionCount, vs...))
+}
+
+// RevisionCountNotIn applies the NotIn predicate on the "revision_count" field.
+func RevisionCountNotIn(vs ...int) predicate.Message {
+	return predicate.Message(sql.FieldNotIn(FieldRevisionCount, vs...))
+}
+
+// RevisionCountGT applies the GT predicate on the "revision_count" field.
+func RevisionCountGT(v int) predicate.Message {
+	return predicate.Message(sql.FieldGT(FieldRevisionCount, v))
+}
+
+// RevisionCountGTE applies the GTE predicate on the "revision_count" field.
+func RevisionCountGTE(v int) predicate.Message {
+	return predicate.Message(sql.FieldGTE(FieldRevisionCount, v))
+}
+
+// RevisionCountLT applies the LT predicate on the "revision_count" field.
+func RevisionCountLT(v int) predicate.Message {
+	return predicate.Message(sql.FieldLT(FieldRevisionCount, v))
+}
+
+// RevisionCountLTE applies the LTE predicate on the "revision_count" field.
+func RevisionCountLTE(v int) predicate.Message {
+	return predicate.Message(sql.FieldLTE(FieldRevisionCount, v))
+}
+
 // HasChannel applies the HasEdge predicate on the "channel" edge.
 func HasChannel() predicate.Message {
 	return predicate.Message(func(s *sql.Selector) {
@@ -566,6 +771,75 @@ func HasAttachmentsWith(preds ...predicate.Attachment) predicate.Message {
 	})
 }
 
+// HasRevisions applies the HasEdge predicate on the "revisions" edge.
+func HasRevisions() predicate.Message {
+	return predicate.Message(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, RevisionsTable, RevisionsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasRevisionsWith applies the HasEdge predicate on the "revisions" edge with a given conditions (other predicates).
+func HasRevisionsWith(preds ...predicate.MessageRevision) predicate.Message {
+	return predicate.Message(func(s *sql.Selector) {
+		step := newRevisionsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasShares applies the HasEdge predicate on the "shares" edge.
+func HasShares() predicate.Message {
+	return predicate.Message(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, SharesTable, SharesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasSharesWith applies the HasEdge predicate on the "shares" edge with a given conditions (other predicates).
+func HasSharesWith(preds ...predicate.MessageShare) predicate.Message {
+	return predicate.Message(func(s *sql.Selector) {
+		step := newSharesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasSharedAs applies the HasEdge predicate on the "shared_as" edge.
+func HasSharedAs() predicate.Message {
+	return predicate.Message(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, SharedAsTable, SharedAsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasSharedAsWith applies the HasEdge predicate on the "shared_as" edge with a given conditions (other predicates).
+func HasSharedAsWith(preds ...predicate.MessageShare) predicate.Message {
+	return predicate.Message(func(s *sql.Selector) {
+		step := newSharedAsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // HasUserThreadFollows applies the HasEdge predicate on the "user_thread_follows" edge.
 func HasUserThreadFollows() predicate.Message {
 	return predicate.Message(func(s *sql.Selector) {
@@ -18,6 +18,8 @@ import (
 	"github.com/newt239/chat/ent/messagegroupmention"
 	"github.com/newt239/chat/ent/messagelink"
 	"github.com/newt239/chat/ent/messagereaction"
+	"github.com/newt239/chat/ent/messagerevision"
+	"github.com/newt239/chat/ent/messageshare"
 	"github.com/newt239/chat/ent/messageusermention"
 	"github.com/newt239/chat/ent/threadreadstate"
 	"github.com/newt239/chat/ent/user"
@@ -37,6 +39,20 @@ func (_c *MessageCreate) SetBody(v string) *MessageCreate {
 	return _c
 }
 
+// SetBodyAst sets the "body_ast" field.
+func (_c *MessageCreate) SetBodyAst(v string) *MessageCreate {
+	_c.mutation.SetBodyAst(v)
+	return _c
+}
+
+// SetNillableBodyAst sets the "body_ast" field if the given value is not nil.
+func (_c *MessageCreate) SetNillableBodyAst(v *string) *MessageCreate {
+	if v != nil {
+		_c.SetBodyAst(*v)
+	}
+	return _c
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (_c *MessageCreate) SetCreatedAt(v time.Time) *MessageCreate {
 	_c.mutation.SetCreatedAt(v)
@@ -93,6 +109,34 @@ func (_c *MessageCreate) SetNillableDeletedBy(v *uuid.UUID) *MessageCreate {
 	return _c
 }
 
+// SetClientMsgID sets the "client_msg_id" field.
+func (_c *MessageCreate) SetClientMsgID(v string) *MessageCreate {
+	_c.mutation.SetClientMsgID(v)
+	return _c
+}
+
+// SetNillableClientMsgID sets the "client_msg_id" field if the given value is not nil.
+func (_c *MessageCreate) SetNillableClientMsgID(v *string) *MessageCreate {
+	if v != nil {
+		_c.SetClientMsgID(*v)
+	}
+	return _c
+}
+
+// SetRevisionCount sets the "revision_count" field.
+func (_c *MessageCreate) SetRevisionCount(v int) *MessageCreate {
+	_c.mutation.SetRevisionCount(v)
+	return _c
+}
+
+// SetNillableRevisionCount sets the "revision_count" field if the given value is not nil.
+func (_c *MessageCreate) SetNillableRevisionCount(v *int) *MessageCreate {
+	if v != nil {
+		_c.SetRevisionCount(*v)
+	}
+	return _c
+}
+
 // SetID sets the "id" field.
 func (_c *MessageCreate) SetID(v uuid.UUID) *MessageCreate {
 	_c.mutation.SetID(v)
@@ -253,6 +297,51 @@ func (_c *MessageCreate) AddAttachments(v ...*Attachment) *MessageCreate {
 	return _c.AddAttachmentIDs(ids...)
 }
 
+// AddRevisionIDs adds the "revisions" edge to the MessageRevision entity by IDs.
+func (_c *MessageCreate) AddRevisionIDs(ids ...uuid.UUID) *MessageCreate {
+	_c.mutation.AddRevisionIDs(ids...)
+	return _c
+}
+
+// AddRevisions adds the "revisions" edges to the MessageRevision entity.
+func (_c *MessageCreate) AddRevisions(v ...*MessageRevision) *MessageCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddRevisionIDs(ids...)
+}
+
+// AddShareIDs adds the "shares" edge to the MessageShare entity by IDs.
+func (_c *MessageCreate) AddShareIDs(ids ...uuid.UUID) *MessageCreate {
+	_c.mutation.AddShareIDs(ids...)
+	return _c
+}
+
+// AddShares adds the "shares" edges to the MessageShare entity.
+func (_c *MessageCreate) AddShares(v ...*MessageShare) *MessageCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddShareIDs(ids...)
+}
+
+// AddSharedAIDs adds the "shared_as" edge to the MessageShare entity by IDs.
+func (_c *MessageCreate) AddSharedAIDs(ids ...uuid.UUID) *MessageCreate {
+	_c.mutation.AddSharedAIDs(ids...)
+	return _c
+}
+
+// AddSharedAs adds the "shared_as" edges to the MessageShare entity.
+func (_c *MessageCreate) AddSharedAs(v ...*MessageShare) *MessageCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddSharedAIDs(ids...)
+}
+
 // AddUserThreadFollowIDs adds the "user_thread_follows" edge to the UserThreadFollow entity by IDs.
 func (_c *MessageCreate) AddUserThreadFollowIDs(ids ...uuid.UUID) *MessageCreate {
 	_c.mutation.AddUserThreadFollowIDs(ids...)
@@ -322,6 +411,10 @@ func (_c *MessageCreate) defaults() {
 		v := message.DefaultCreatedAt()
 		_c.mutation.SetCreatedAt(v)
 	}
+	if _, ok := _c.mutation.RevisionCount(); !ok {
+		v := message.DefaultRevisionCount
+		_c.mutation.SetRevisionCount(v)
+	}
 	if _, ok := _c.mutation.ID(); !ok {
 		v := message.DefaultID()
 		_c.mutation.SetID(v)
@@ -333,14 +426,22 @@ func (_c *MessageCreate) check() error {
 	if _, ok := _c.mutation.Body(); !ok {
 		return &ValidationError{Name: "body", err: errors.New(`ent: missing required field "Message.body"`)}
 	}
-	if v, ok := _c.mutation.Body(); ok {
-		if err := message.BodyValidator(v); err != nil {
-			return &ValidationError{Name: "body", err: fmt.Errorf(`ent: validator failed for field "Message.body": %w`, err)}
-		}
-	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Message.created_at"`)}
 	}
+	if v, ok := _c.mutation.ClientMsgID(); ok {
+		if err := message.ClientMsgIDValidator(v); err != nil {
+			return &ValidationError{Name: "client_msg_id", err: fmt.Errorf(`ent: validator failed for field "Message.client_msg_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.RevisionCount(); !ok {
+		return &ValidationError{Name: "revision_count", err: errors.New(`ent: missing required field "Message.revision_count"`)}
+	}
+	if v, ok := _c.mutation.RevisionCount(); ok {
+		if err := message.RevisionCountValidator(v); err != nil {
+			return &ValidationError{Name: "revision_count", err: fmt.Errorf(`ent: validator failed for field "Message.revision_count": %w`, err)}
+		}
+	}
 	if len(_c.mutation.ChannelIDs()) == 0 {
 		return &ValidationError{Name: "channel", err: errors.New(`ent: missing required edge "Message.channel"`)}
 	}
@@ -386,6 +487,10 @@ func (_c *MessageCreate) createSpec() (*Message, *sqlgraph.CreateSpec) {
 		_spec.SetField(message.FieldBody, field.TypeString, value)
 		_node.Body = value
 	}
+	if value, ok := _c.mutation.BodyAst(); ok {
+		_spec.SetField(message.FieldBodyAst, field.TypeString, value)
+		_node.BodyAst = value
+	}
 	if value, ok := _c.mutation.CreatedAt(); ok {
 		_spec.SetField(message.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
@@ -402,6 +507,14 @@ func (_c *MessageCreate) createSpec() (*Message, *sqlgraph.CreateSpec) {
 		_spec.SetField(message.FieldDeletedBy, field.TypeUUID, value)
 		_node.DeletedBy = value
 	}
+	if value, ok := _c.mutation.ClientMsgID(); ok {
+		_spec.SetField(message.FieldClientMsgID, field.TypeString, value)
+		_node.ClientMsgID = value
+	}
+	if value, ok := _c.mutation.RevisionCount(); ok {
+		_spec.SetField(message.FieldRevisionCount, field.TypeInt, value)
+		_node.RevisionCount = value
+	}
 	if nodes := _c.mutation.ChannelIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -565,6 +678,54 @@ func (_c *MessageCreate) createSpec() (*Message, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.RevisionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   message.RevisionsTable,
+			Columns: []string{message.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(messagerevision.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.SharesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   message.SharesTable,
+			Columns: []string{message.SharesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(messageshare.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.SharedAsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   message.SharedAsTable,
+			Columns: []string{message.SharedAsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(messageshare.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	if nodes := _c.mutation.UserThreadFollowsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
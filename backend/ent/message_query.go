@@ -20,6 +20,8 @@ import (
 	"github.com/newt239/chat/ent/messagegroupmention"
 	"github.com/newt239/chat/ent/messagelink"
 	"github.com/newt239/chat/ent/messagereaction"
+	"github.com/newt239/chat/ent/messagerevision"
+	"github.com/newt239/chat/ent/messageshare"
 	"github.com/newt239/chat/ent/messageusermention"
 	"github.com/newt239/chat/ent/predicate"
 	"github.com/newt239/chat/ent/threadreadstate"
@@ -44,6 +46,9 @@ type MessageQuery struct {
 	withGroupMentions     *MessageGroupMentionQuery
 	withLinks             *MessageLinkQuery
 	withAttachments       *AttachmentQuery
+	withRevisions         *MessageRevisionQuery
+	withShares            *MessageShareQuery
+	withSharedAs          *MessageShareQuery
 	withUserThreadFollows *UserThreadFollowQuery
 	withThreadReadStates  *ThreadReadStateQuery
 	withFKs               bool
@@ -303,6 +308,72 @@ func (_q *MessageQuery) QueryAttachments() *AttachmentQuery {
 	return query
 }
 
+// QueryRevisions chains the current query on the "revisions" edge.
+func (_q *MessageQuery) QueryRevisions() *MessageRevisionQuery {
+	query := (&MessageRevisionClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(message.Table, message.FieldID, selector),
+			sqlgraph.To(messagerevision.Table, messagerevision.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, message.RevisionsTable, message.RevisionsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryShares chains the current query on the "shares" edge.
+func (_q *MessageQuery) QueryShares() *MessageShareQuery {
+	query := (&MessageShareClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(message.Table, message.FieldID, selector),
+			sqlgraph.To(messageshare.Table, messageshare.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, message.SharesTable, message.SharesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QuerySharedAs chains the current query on the "shared_as" edge.
+func (_q *MessageQuery) QuerySharedAs() *MessageShareQuery {
+	query := (&MessageShareClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(message.Table, message.FieldID, selector),
+			sqlgraph.To(messageshare.Table, messageshare.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, true, message.SharedAsTable, message.SharedAsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // QueryUserThreadFollows chains the current query on the "user_thread_follows" edge.
 func (_q *MessageQuery) QueryUserThreadFollows() *UserThreadFollowQuery {
 	query := (&UserThreadFollowClient{config: _q.config}).Query()
@@ -549,6 +620,9 @@ func (_q *MessageQuery) Clone() *MessageQuery {
 		withGroupMentions:     _q.withGroupMentions.Clone(),
 		withLinks:             _q.withLinks.Clone(),
 		withAttachments:       _q.withAttachments.Clone(),
+		withRevisions:         _q.withRevisions.Clone(),
+		withShares:            _q.withShares.Clone(),
+		withSharedAs:          _q.withSharedAs.Clone(),
 		withUserThreadFollows: _q.withUserThreadFollows.Clone(),
 		withThreadReadStates:  _q.withThreadReadStates.Clone(),
 		// clone intermediate query.
@@ -667,6 +741,39 @@ func (_q *MessageQuery) WithAttachments(opts ...func(*AttachmentQuery)) *Message
 	return _q
 }
 
+// WithRevisions tells the query-builder to eager-load the nodes that are connected to
+// the "revisions" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *MessageQuery) WithRevisions(opts ...func(*MessageRevisionQuery)) *MessageQuery {
+	query := (&MessageRevisionClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withRevisions = query
+	return _q
+}
+
+// WithShares tells the query-builder to eager-load the nodes that are connected to
+// the "shares" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *MessageQuery) WithShares(opts ...func(*MessageShareQuery)) *MessageQuery {
+	query := (&MessageShareClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withShares = query
+	return _q
+}
+
+// WithSharedAs tells the query-builder to eager-load the nodes that are connected to
+// the "shared_as" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *MessageQuery) WithSharedAs(opts ...func(*MessageShareQuery)) *MessageQuery {
+	query := (&MessageShareClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withSharedAs = query
+	return _q
+}
+
 // WithUserThreadFollows tells the query-builder to eager-load the nodes that are connected to
 // the "user_thread_follows" edge. The optional arguments are used to configure the query builder of the edge.
 func (_q *MessageQuery) WithUserThreadFollows(opts ...func(*UserThreadFollowQuery)) *MessageQuery {
@@ -768,7 +875,7 @@ func (_q *MessageQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Mess
 		nodes       = []*Message{}
 		withFKs     = _q.withFKs
 		_spec       = _q.querySpec()
-		loadedTypes = [12]bool{
+		loadedTypes = [15]bool{
 			_q.withChannel != nil,
 			_q.withUser != nil,
 			_q.withParent != nil,
@@ -779,6 +886,9 @@ func (_q *MessageQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Mess
 			_q.withGroupMentions != nil,
 			_q.withLinks != nil,
 			_q.withAttachments != nil,
+			_q.withRevisions != nil,
+			_q.withShares != nil,
+			_q.withSharedAs != nil,
 			_q.withUserThreadFollows != nil,
 			_q.withThreadReadStates != nil,
 		}
@@ -874,6 +984,27 @@ func (_q *MessageQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Mess
 			return nil, err
 		}
 	}
+	if query := _q.withRevisions; query != nil {
+		if err := _q.loadRevisions(ctx, query, nodes,
+			func(n *Message) { n.Edges.Revisions = []*MessageRevision{} },
+			func(n *Message, e *MessageRevision) { n.Edges.Revisions = append(n.Edges.Revisions, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withShares; query != nil {
+		if err := _q.loadShares(ctx, query, nodes,
+			func(n *Message) { n.Edges.Shares = []*MessageShare{} },
+			func(n *Message, e *MessageShare) { n.Edges.Shares = append(n.Edges.Shares, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withSharedAs; query != nil {
+		if err := _q.loadSharedAs(ctx, query, nodes,
+			func(n *Message) { n.Edges.SharedAs = []*MessageShare{} },
+			func(n *Message, e *MessageShare) { n.Edges.SharedAs = append(n.Edges.SharedAs, e) }); err != nil {
+			return nil, err
+		}
+	}
 	if query := _q.withUserThreadFollows; query != nil {
 		if err := _q.loadUserThreadFollows(ctx, query, nodes,
 			func(n *Message) { n.Edges.UserThreadFollows = []*UserThreadFollow{} },
@@ -1206,6 +1337,99 @@ func (_q *MessageQuery) loadAttachments(ctx context.Context, query *AttachmentQu
 	}
 	return nil
 }
+func (_q *MessageQuery) loadRevisions(ctx context.Context, query *MessageRevisionQuery, nodes []*Message, init func(*Message), assign func(*Message, *MessageRevision)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Message)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.MessageRevision(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(message.RevisionsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.message_revision_message
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "message_revision_message" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "message_revision_message" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *MessageQuery) loadShares(ctx context.Context, query *MessageShareQuery, nodes []*Message, init func(*Message), assign func(*Message, *MessageShare)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Message)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.MessageShare(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(message.SharesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.message_share_message
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "message_share_message" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "message_share_message" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *MessageQuery) loadSharedAs(ctx context.Context, query *MessageShareQuery, nodes []*Message, init func(*Message), assign func(*Message, *MessageShare)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Message)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	query.withFKs = true
+	query.Where(predicate.MessageShare(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(message.SharedAsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.message_share_source
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "message_share_source" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "message_share_source" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 func (_q *MessageQuery) loadUserThreadFollows(ctx context.Context, query *UserThreadFollowQuery, nodes []*Message, init func(*Message), assign func(*Message, *UserThreadFollow)) error {
 	fks := make([]driver.Value, 0, len(nodes))
 	nodeids := make(map[uuid.UUID]*Message)
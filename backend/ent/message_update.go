@@ -19,6 +19,8 @@ import (
 	"github.com/newt239/chat/ent/messagegroupmention"
 	"github.com/newt239/chat/ent/messagelink"
 	"github.com/newt239/chat/ent/messagereaction"
+	"github.com/newt239/chat/ent/messagerevision"
+	"github.com/newt239/chat/ent/messageshare"
 	"github.com/newt239/chat/ent/messageusermention"
 	"github.com/newt239/chat/ent/predicate"
 	"github.com/newt239/chat/ent/threadreadstate"
@@ -53,6 +55,26 @@ func (_u *MessageUpdate) SetNillableBody(v *string) *MessageUpdate {
 	return _u
 }
 
+// SetBodyAst sets the "body_ast" field.
+func (_u *MessageUpdate) SetBodyAst(v string) *MessageUpdate {
+	_u.mutation.SetBodyAst(v)
+	return _u
+}
+
+// SetNillableBodyAst sets the "body_ast" field if the given value is not nil.
+func (_u *MessageUpdate) SetNillableBodyAst(v *string) *MessageUpdate {
+	if v != nil {
+		_u.SetBodyAst(*v)
+	}
+	return _u
+}
+
+// ClearBodyAst clears the value of the "body_ast" field.
+func (_u *MessageUpdate) ClearBodyAst() *MessageUpdate {
+	_u.mutation.ClearBodyAst()
+	return _u
+}
+
 // SetEditedAt sets the "edited_at" field.
 func (_u *MessageUpdate) SetEditedAt(v time.Time) *MessageUpdate {
 	_u.mutation.SetEditedAt(v)
@@ -113,6 +135,47 @@ func (_u *MessageUpdate) ClearDeletedBy() *MessageUpdate {
 	return _u
 }
 
+// SetClientMsgID sets the "client_msg_id" field.
+func (_u *MessageUpdate) SetClientMsgID(v string) *MessageUpdate {
+	_u.mutation.SetClientMsgID(v)
+	return _u
+}
+
+// SetNillableClientMsgID sets the "client_msg_id" field if the given value is not nil.
+func (_u *MessageUpdate) SetNillableClientMsgID(v *string) *MessageUpdate {
+	if v != nil {
+		_u.SetClientMsgID(*v)
+	}
+	return _u
+}
+
+// ClearClientMsgID clears the value of the "client_msg_id" field.
+func (_u *MessageUpdate) ClearClientMsgID() *MessageUpdate {
+	_u.mutation.ClearClientMsgID()
+	return _u
+}
+
+// SetRevisionCount sets the "revision_count" field.
+func (_u *MessageUpdate) SetRevisionCount(v int) *MessageUpdate {
+	_u.mutation.ResetRevisionCount()
+	_u.mutation.SetRevisionCount(v)
+	return _u
+}
+
+// SetNillableRevisionCount sets the "revision_count" field if the given value is not nil.
+func (_u *MessageUpdate) SetNillableRevisionCount(v *int) *MessageUpdate {
+	if v != nil {
+		_u.SetRevisionCount(*v)
+	}
+	return _u
+}
+
+// AddRevisionCount adds value to the "revision_count" field.
+func (_u *MessageUpdate) AddRevisionCount(v int) *MessageUpdate {
+	_u.mutation.AddRevisionCount(v)
+	return _u
+}
+
 // SetChannelID sets the "channel" edge to the Channel entity by ID.
 func (_u *MessageUpdate) SetChannelID(id uuid.UUID) *MessageUpdate {
 	_u.mutation.SetChannelID(id)
@@ -259,6 +322,51 @@ func (_u *MessageUpdate) AddAttachments(v ...*Attachment) *MessageUpdate {
 	return _u.AddAttachmentIDs(ids...)
 }
 
+// AddRevisionIDs adds the "revisions" edge to the MessageRevision entity by IDs.
+func (_u *MessageUpdate) AddRevisionIDs(ids ...uuid.UUID) *MessageUpdate {
+	_u.mutation.AddRevisionIDs(ids...)
+	return _u
+}
+
+// AddRevisions adds the "revisions" edges to the MessageRevision entity.
+func (_u *MessageUpdate) AddRevisions(v ...*MessageRevision) *MessageUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddRevisionIDs(ids...)
+}
+
+// AddShareIDs adds the "shares" edge to the MessageShare entity by IDs.
+func (_u *MessageUpdate) AddShareIDs(ids ...uuid.UUID) *MessageUpdate {
+	_u.mutation.AddShareIDs(ids...)
+	return _u
+}
+
+// AddShares adds the "shares" edges to the MessageShare entity.
+func (_u *MessageUpdate) AddShares(v ...*MessageShare) *MessageUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddShareIDs(ids...)
+}
+
+// AddSharedAIDs adds the "shared_as" edge to the MessageShare entity by IDs.
+func (_u *MessageUpdate) AddSharedAIDs(ids ...uuid.UUID) *MessageUpdate {
+	_u.mutation.AddSharedAIDs(ids...)
+	return _u
+}
+
+// AddSharedAs adds the "shared_as" edges to the MessageShare entity.
+func (_u *MessageUpdate) AddSharedAs(v ...*MessageShare) *MessageUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddSharedAIDs(ids...)
+}
+
 // AddUserThreadFollowIDs adds the "user_thread_follows" edge to the UserThreadFollow entity by IDs.
 func (_u *MessageUpdate) AddUserThreadFollowIDs(ids ...uuid.UUID) *MessageUpdate {
 	_u.mutation.AddUserThreadFollowIDs(ids...)
@@ -459,6 +567,69 @@ func (_u *MessageUpdate) RemoveAttachments(v ...*Attachment) *MessageUpdate {
 	return _u.RemoveAttachmentIDs(ids...)
 }
 
+// ClearRevisions clears all "revisions" edges to the MessageRevision entity.
+func (_u *MessageUpdate) ClearRevisions() *MessageUpdate {
+	_u.mutation.ClearRevisions()
+	return _u
+}
+
+// RemoveRevisionIDs removes the "revisions" edge to MessageRevision entities by IDs.
+func (_u *MessageUpdate) RemoveRevisionIDs(ids ...uuid.UUID) *MessageUpdate {
+	_u.mutation.RemoveRevisionIDs(ids...)
+	return _u
+}
+
+// RemoveRevisions removes "revisions" edges to MessageRevision entities.
+func (_u *MessageUpdate) RemoveRevisions(v ...*MessageRevision) *MessageUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveRevisionIDs(ids...)
+}
+
+// ClearShares clears all "shares" edges to the MessageShare entity.
+func (_u *MessageUpdate) ClearShares() *MessageUpdate {
+	_u.mutation.ClearShares()
+	return _u
+}
+
+// RemoveShareIDs removes the "shares" edge to MessageShare entities by IDs.
+func (_u *MessageUpdate) RemoveShareIDs(ids ...uuid.UUID) *MessageUpdate {
+	_u.mutation.RemoveShareIDs(ids...)
+	return _u
+}
+
+// RemoveShares removes "shares" edges to MessageShare entities.
+func (_u *MessageUpdate) RemoveShares(v ...*MessageShare) *MessageUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveShareIDs(ids...)
+}
+
+// ClearSharedAs clears all "shared_as" edges to the MessageShare entity.
+func (_u *MessageUpdate) ClearSharedAs() *MessageUpdate {
+	_u.mutation.ClearSharedAs()
+	return _u
+}
+
+// RemoveSharedAIDs removes the "shared_as" edge to MessageShare entities by IDs.
+func (_u *MessageUpdate) RemoveSharedAIDs(ids ...uuid.UUID) *MessageUpdate {
+	_u.mutation.RemoveSharedAIDs(ids...)
+	return _u
+}
+
+// RemoveSharedAs removes "shared_as" edges to MessageShare entities.
+func (_u *MessageUpdate) RemoveSharedAs(v ...*MessageShare) *MessageUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveSharedAIDs(ids...)
+}
+
 // ClearUserThreadFollows clears all "user_thread_follows" edges to the UserThreadFollow entity.
 func (_u *MessageUpdate) ClearUserThreadFollows() *MessageUpdate {
 	_u.mutation.ClearUserThreadFollows()
@@ -530,9 +701,14 @@ func (_u *MessageUpdate) ExecX(ctx context.Context) {
 
 // check runs all checks and user-defined validators on the builder.
 func (_u *MessageUpdate) check() error {
-	if v, ok := _u.mutation.Body(); ok {
-		if err := message.BodyValidator(v); err != nil {
-			return &ValidationError{Name: "body", err: fmt.Errorf(`ent: validator failed for field "Message.body": %w`, err)}
+	if v, ok := _u.mutation.ClientMsgID(); ok {
+		if err := message.ClientMsgIDValidator(v); err != nil {
+			return &ValidationError{Name: "client_msg_id", err: fmt.Errorf(`ent: validator failed for field "Message.client_msg_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.RevisionCount(); ok {
+		if err := message.RevisionCountValidator(v); err != nil {
+			return &ValidationError{Name: "revision_count", err: fmt.Errorf(`ent: validator failed for field "Message.revision_count": %w`, err)}
 		}
 	}
 	if _u.mutation.ChannelCleared() && len(_u.mutation.ChannelIDs()) > 0 {
@@ -559,6 +735,12 @@ func (_u *MessageUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if value, ok := _u.mutation.Body(); ok {
 		_spec.SetField(message.FieldBody, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.BodyAst(); ok {
+		_spec.SetField(message.FieldBodyAst, field.TypeString, value)
+	}
+	if _u.mutation.BodyAstCleared() {
+		_spec.ClearField(message.FieldBodyAst, field.TypeString)
+	}
 	if value, ok := _u.mutation.EditedAt(); ok {
 		_spec.SetField(message.FieldEditedAt, field.TypeTime, value)
 	}
@@ -577,6 +759,18 @@ func (_u *MessageUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if _u.mutation.DeletedByCleared() {
 		_spec.ClearField(message.FieldDeletedBy, field.TypeUUID)
 	}
+	if value, ok := _u.mutation.ClientMsgID(); ok {
+		_spec.SetField(message.FieldClientMsgID, field.TypeString, value)
+	}
+	if _u.mutation.ClientMsgIDCleared() {
+		_spec.ClearField(message.FieldClientMsgID, field.TypeString)
+	}
+	if value, ok := _u.mutation.RevisionCount(); ok {
+		_spec.SetField(message.FieldRevisionCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedRevisionCount(); ok {
+		_spec.AddField(message.FieldRevisionCount, field.TypeInt, value)
+	}
 	if _u.mutation.ChannelCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -979,6 +1173,141 @@ func (_u *MessageUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.RevisionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   message.RevisionsTable,
+			Columns: []string{message.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(messagerevision.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedRevisionsIDs(); len(nodes) > 0 && !_u.mutation.RevisionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   message.RevisionsTable,
+			Columns: []string{message.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(messagerevision.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RevisionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   message.RevisionsTable,
+			Columns: []string{message.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(messagerevision.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.SharesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   message.SharesTable,
+			Columns: []string{message.SharesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(messageshare.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedSharesIDs(); len(nodes) > 0 && !_u.mutation.SharesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   message.SharesTable,
+			Columns: []string{message.SharesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(messageshare.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.SharesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   message.SharesTable,
+			Columns: []string{message.SharesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(messageshare.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.SharedAsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   message.SharedAsTable,
+			Columns: []string{message.SharedAsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(messageshare.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedSharedAsIDs(); len(nodes) > 0 && !_u.mutation.SharedAsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   message.SharedAsTable,
+			Columns: []string{message.SharedAsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(messageshare.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.SharedAsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   message.SharedAsTable,
+			Columns: []string{message.SharedAsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(messageshare.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _u.mutation.UserThreadFollowsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -1103,6 +1432,26 @@ func (_u *MessageUpdateOne) SetNillableBody(v *string) *MessageUpdateOne {
 	return _u
 }
 
+// SetBodyAst sets the "body_ast" field.
+func (_u *MessageUpdateOne) SetBodyAst(v string) *MessageUpdateOne {
+	_u.mutation.SetBodyAst(v)
+	return _u
+}
+
+// SetNillableBodyAst sets the "body_ast" field if the given value is not nil.
+func (_u *MessageUpdateOne) SetNillableBodyAst(v *string) *MessageUpdateOne {
+	if v != nil {
+		_u.SetBodyAst(*v)
+	}
+	return _u
+}
+
+// ClearBodyAst clears the value of the "body_ast" field.
+func (_u *MessageUpdateOne) ClearBodyAst() *MessageUpdateOne {
+	_u.mutation.ClearBodyAst()
+	return _u
+}
+
 // SetEditedAt sets the "edited_at" field.
 func (_u *MessageUpdateOne) SetEditedAt(v time.Time) *MessageUpdateOne {
 	_u.mutation.SetEditedAt(v)
@@ -1163,6 +1512,47 @@ func (_u *MessageUpdateOne) ClearDeletedBy() *MessageUpdateOne {
 	return _u
 }
 
+// SetClientMsgID sets the "client_msg_id" field.
+func (_u *MessageUpdateOne) SetClientMsgID(v string) *MessageUpdateOne {
+	_u.mutation.SetClientMsgID(v)
+	return _u
+}
+
+// SetNillableClientMsgID sets the "client_msg_id" field if the given value is not nil.
+func (_u *MessageUpdateOne) SetNillableClientMsgID(v *string) *MessageUpdateOne {
+	if v != nil {
+		_u.SetClientMsgID(*v)
+	}
+	return _u
+}
+
+// ClearClientMsgID clears the value of the "client_msg_id" field.
+func (_u *MessageUpdateOne) ClearClientMsgID() *MessageUpdateOne {
+	_u.mutation.ClearClientMsgID()
+	return _u
+}
+
+// SetRevisionCount sets the "revision_count" field.
+func (_u *MessageUpdateOne) SetRevisionCount(v int) *MessageUpdateOne {
+	_u.mutation.ResetRevisionCount()
+	_u.mutation.SetRevisionCount(v)
+	return _u
+}
+
+// SetNillableRevisionCount sets the "revision_count" field if the given value is not nil.
+func (_u *MessageUpdateOne) SetNillableRevisionCount(v *int) *MessageUpdateOne {
+	if v != nil {
+		_u.SetRevisionCount(*v)
+	}
+	return _u
+}
+
+// AddRevisionCount adds value to the "revision_count" field.
+func (_u *MessageUpdateOne) AddRevisionCount(v int) *MessageUpdateOne {
+	_u.mutation.AddRevisionCount(v)
+	return _u
+}
+
 // SetChannelID sets the "channel" edge to the Channel entity by ID.
 func (_u *MessageUpdateOne) SetChannelID(id uuid.UUID) *MessageUpdateOne {
 	_u.mutation.SetChannelID(id)
@@ -1309,6 +1699,51 @@ func (_u *MessageUpdateOne) AddAttachments(v ...*Attachment) *MessageUpdateOne {
 	return _u.AddAttachmentIDs(ids...)
 }
 
+// AddRevisionIDs adds the "revisions" edge to the MessageRevision entity by IDs.
+func (_u *MessageUpdateOne) AddRevisionIDs(ids ...uuid.UUID) *MessageUpdateOne {
+	_u.mutation.AddRevisionIDs(ids...)
+	return _u
+}
+
+// AddRevisions adds the "revisions" edges to the MessageRevision entity.
+func (_u *MessageUpdateOne) AddRevisions(v ...*MessageRevision) *MessageUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddRevisionIDs(ids...)
+}
+
+// AddShareIDs adds the "shares" edge to the MessageShare entity by IDs.
+func (_u *MessageUpdateOne) AddShareIDs(ids ...uuid.UUID) *MessageUpdateOne {
+	_u.mutation.AddShareIDs(ids...)
+	return _u
+}
+
+// AddShares adds the "shares" edges to the MessageShare entity.
+func (_u *MessageUpdateOne) AddShares(v ...*MessageShare) *MessageUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddShareIDs(ids...)
+}
+
+// AddSharedAIDs adds the "shared_as" edge to the MessageShare entity by IDs.
+func (_u *MessageUpdateOne) AddSharedAIDs(ids ...uuid.UUID) *MessageUpdateOne {
+	_u.mutation.AddSharedAIDs(ids...)
+	return _u
+}
+
+// AddSharedAs adds the "shared_as" edges to the MessageShare entity.
+func (_u *MessageUpdateOne) AddSharedAs(v ...*MessageShare) *MessageUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddSharedAIDs(ids...)
+}
+
 // AddUserThreadFollowIDs adds the "user_thread_follows" edge to the UserThreadFollow entity by IDs.
 func (_u *MessageUpdateOne) AddUserThreadFollowIDs(ids ...uuid.UUID) *MessageUpdateOne {
 	_u.mutation.AddUserThreadFollowIDs(ids...)
@@ -1509,6 +1944,69 @@ func (_u *MessageUpdateOne) RemoveAttachments(v ...*Attachment) *MessageUpdateOn
 	return _u.RemoveAttachmentIDs(ids...)
 }
 
+// ClearRevisions clears all "revisions" edges to the MessageRevision entity.
+func (_u *MessageUpdateOne) ClearRevisions() *MessageUpdateOne {
+	_u.mutation.ClearRevisions()
+	return _u
+}
+
+// RemoveRevisionIDs removes the "revisions" edge to MessageRevision entities by IDs.
+func (_u *MessageUpdateOne) RemoveRevisionIDs(ids ...uuid.UUID) *MessageUpdateOne {
+	_u.mutation.RemoveRevisionIDs(ids...)
+	return _u
+}
+
+// RemoveRevisions removes "revisions" edges to MessageRevision entities.
+func (_u *MessageUpdateOne) RemoveRevisions(v ...*MessageRevision) *MessageUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveRevisionIDs(ids...)
+}
+
+// ClearShares clears all "shares" edges to the MessageShare entity.
+func (_u *MessageUpdateOne) ClearShares() *MessageUpdateOne {
+	_u.mutation.ClearShares()
+	return _u
+}
+
+// RemoveShareIDs removes the "shares" edge to MessageShare entities by IDs.
+func (_u *MessageUpdateOne) RemoveShareIDs(ids ...uuid.UUID) *MessageUpdateOne {
+	_u.mutation.RemoveShareIDs(ids...)
+	return _u
+}
+
+// RemoveShares removes "shares" edges to MessageShare entities.
+func (_u *MessageUpdateOne) RemoveShares(v ...*MessageShare) *MessageUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveShareIDs(ids...)
+}
+
+// ClearSharedAs clears all "shared_as" edges to the MessageShare entity.
+func (_u *MessageUpdateOne) ClearSharedAs() *MessageUpdateOne {
+	_u.mutation.ClearSharedAs()
+	return _u
+}
+
+// RemoveSharedAIDs removes the "shared_as" edge to MessageShare entities by IDs.
+func (_u *MessageUpdateOne) RemoveSharedAIDs(ids ...uuid.UUID) *MessageUpdateOne {
+	_u.mutation.RemoveSharedAIDs(ids...)
+	return _u
+}
+
+// RemoveSharedAs removes "shared_as" edges to MessageShare entities.
+func (_u *MessageUpdateOne) RemoveSharedAs(v ...*MessageShare) *MessageUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveSharedAIDs(ids...)
+}
+
 // ClearUserThreadFollows clears all "user_thread_follows" edges to the UserThreadFollow entity.
 func (_u *MessageUpdateOne) ClearUserThreadFollows() *MessageUpdateOne {
 	_u.mutation.ClearUserThreadFollows()
@@ -1593,9 +2091,14 @@ func (_u *MessageUpdateOne) ExecX(ctx context.Context) {
 
 // check runs all checks and user-defined validators on the builder.
 func (_u *MessageUpdateOne) check() error {
-	if v, ok := _u.mutation.Body(); ok {
-		if err := message.BodyValidator(v); err != nil {
-			return &ValidationError{Name: "body", err: fmt.Errorf(`ent: validator failed for field "Message.body": %w`, err)}
+	if v, ok := _u.mutation.ClientMsgID(); ok {
+		if err := message.ClientMsgIDValidator(v); err != nil {
+			return &ValidationError{Name: "client_msg_id", err: fmt.Errorf(`ent: validator failed for field "Message.client_msg_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.RevisionCount(); ok {
+		if err := message.RevisionCountValidator(v); err != nil {
+			return &ValidationError{Name: "revision_count", err: fmt.Errorf(`ent: validator failed for field "Message.revision_count": %w`, err)}
 		}
 	}
 	if _u.mutation.ChannelCleared() && len(_u.mutation.ChannelIDs()) > 0 {
@@ -1639,6 +2142,12 @@ func (_u *MessageUpdateOne) sqlSave(ctx context.Context) (_node *Message, err er
 	if value, ok := _u.mutation.Body(); ok {
 		_spec.SetField(message.FieldBody, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.BodyAst(); ok {
+		_spec.SetField(message.FieldBodyAst, field.TypeString, value)
+	}
+	if _u.mutation.BodyAstCleared() {
+		_spec.ClearField(message.FieldBodyAst, field.TypeString)
+	}
 	if value, ok := _u.mutation.EditedAt(); ok {
 		_spec.SetField(message.FieldEditedAt, field.TypeTime, value)
 	}
@@ -1657,6 +2166,18 @@ func (_u *MessageUpdateOne) sqlSave(ctx context.Context) (_node *Message, err er
 	if _u.mutation.DeletedByCleared() {
 		_spec.ClearField(message.FieldDeletedBy, field.TypeUUID)
 	}
+	if value, ok := _u.mutation.ClientMsgID(); ok {
+		_spec.SetField(message.FieldClientMsgID, field.TypeString, value)
+	}
+	if _u.mutation.ClientMsgIDCleared() {
+		_spec.ClearField(message.FieldClientMsgID, field.TypeString)
+	}
+	if value, ok := _u.mutation.RevisionCount(); ok {
+		_spec.SetField(message.FieldRevisionCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedRevisionCount(); ok {
+		_spec.AddField(message.FieldRevisionCount, field.TypeInt, value)
+	}
 	if _u.mutation.ChannelCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -2059,6 +2580,141 @@ func (_u *MessageUpdateOne) sqlSave(ctx context.Context) (_node *Message, err er
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.RevisionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   message.RevisionsTable,
+			Columns: []string{message.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(messagerevision.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedRevisionsIDs(); len(nodes) > 0 && !_u.mutation.RevisionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   message.RevisionsTable,
+			Columns: []string{message.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(messagerevision.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RevisionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   message.RevisionsTable,
+			Columns: []string{message.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(messagerevision.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.SharesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   message.SharesTable,
+			Columns: []string{message.SharesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(messageshare.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedSharesIDs(); len(nodes) > 0 && !_u.mutation.SharesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   message.SharesTable,
+			Columns: []string{message.SharesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(messageshare.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.SharesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   message.SharesTable,
+			Columns: []string{message.SharesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(messageshare.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.SharedAsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   message.SharedAsTable,
+			Columns: []string{message.SharedAsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(messageshare.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedSharedAsIDs(); len(nodes) > 0 && !_u.mutation.SharedAsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   message.SharedAsTable,
+			Columns: []string{message.SharedAsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(messageshare.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.SharedAsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: true,
+			Table:   message.SharedAsTable,
+			Columns: []string{message.SharedAsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(messageshare.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _u.mutation.UserThreadFollowsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
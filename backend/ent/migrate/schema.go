@@ -3,6 +3,7 @@
 package migrate
 
 import (
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/dialect/sql/schema"
 	"entgo.io/ent/schema/field"
 )
@@ -63,6 +64,7 @@ var (
 		{Name: "description", Type: field.TypeString, Nullable: true},
 		{Name: "is_private", Type: field.TypeBool, Default: false},
 		{Name: "channel_type", Type: field.TypeString, Nullable: true, Default: "public"},
+		{Name: "message_retention_days", Type: field.TypeInt, Nullable: true},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
 		{Name: "channel_workspace", Type: field.TypeString, Size: 12},
@@ -76,13 +78,13 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "channels_workspaces_workspace",
-				Columns:    []*schema.Column{ChannelsColumns[7]},
+				Columns:    []*schema.Column{ChannelsColumns[8]},
 				RefColumns: []*schema.Column{WorkspacesColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 			{
 				Symbol:     "channels_users_created_by",
-				Columns:    []*schema.Column{ChannelsColumns[8]},
+				Columns:    []*schema.Column{ChannelsColumns[9]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
@@ -169,14 +171,107 @@ var (
 			},
 		},
 	}
+	// DraftsColumns holds the columns for the "drafts" table.
+	DraftsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "body", Type: field.TypeString, Size: 2147483647, Default: ""},
+		{Name: "attachment_ids", Type: field.TypeJSON, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "draft_user", Type: field.TypeUUID},
+		{Name: "draft_channel", Type: field.TypeUUID},
+		{Name: "draft_parent", Type: field.TypeUUID, Nullable: true},
+	}
+	// DraftsTable holds the schema information for the "drafts" table.
+	DraftsTable = &schema.Table{
+		Name:       "drafts",
+		Columns:    DraftsColumns,
+		PrimaryKey: []*schema.Column{DraftsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "drafts_users_user",
+				Columns:    []*schema.Column{DraftsColumns[5]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "drafts_channels_channel",
+				Columns:    []*schema.Column{DraftsColumns[6]},
+				RefColumns: []*schema.Column{ChannelsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "drafts_messages_parent",
+				Columns:    []*schema.Column{DraftsColumns[7]},
+				RefColumns: []*schema.Column{MessagesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "draft_draft_user_draft_channel_draft_parent",
+				Unique:  true,
+				Columns: []*schema.Column{DraftsColumns[5], DraftsColumns[6], DraftsColumns[7]},
+			},
+			{
+				Name:    "draft_draft_user_draft_channel",
+				Unique:  true,
+				Columns: []*schema.Column{DraftsColumns[5], DraftsColumns[6]},
+				Annotation: &entsql.IndexAnnotation{
+					Where: "draft_parent IS NULL",
+				},
+			},
+		},
+	}
+	// EphemeralMessagesColumns holds the columns for the "ephemeral_messages" table.
+	EphemeralMessagesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "kind", Type: field.TypeString},
+		{Name: "body", Type: field.TypeString, Size: 2147483647},
+		{Name: "payload", Type: field.TypeJSON, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "expires_at", Type: field.TypeTime},
+		{Name: "ephemeral_message_channel", Type: field.TypeUUID},
+		{Name: "ephemeral_message_recipient", Type: field.TypeUUID},
+	}
+	// EphemeralMessagesTable holds the schema information for the "ephemeral_messages" table.
+	EphemeralMessagesTable = &schema.Table{
+		Name:       "ephemeral_messages",
+		Columns:    EphemeralMessagesColumns,
+		PrimaryKey: []*schema.Column{EphemeralMessagesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "ephemeral_messages_channels_channel",
+				Columns:    []*schema.Column{EphemeralMessagesColumns[6]},
+				RefColumns: []*schema.Column{ChannelsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "ephemeral_messages_users_recipient",
+				Columns:    []*schema.Column{EphemeralMessagesColumns[7]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "ephemeralmessage_expires_at",
+				Unique:  false,
+				Columns: []*schema.Column{EphemeralMessagesColumns[5]},
+			},
+		},
+	}
 	// MessagesColumns holds the columns for the "messages" table.
 	MessagesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID},
 		{Name: "body", Type: field.TypeString, Size: 2147483647},
+		{Name: "body_ast", Type: field.TypeString, Nullable: true, Size: 2147483647},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "edited_at", Type: field.TypeTime, Nullable: true},
 		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
 		{Name: "deleted_by", Type: field.TypeUUID, Nullable: true},
+		{Name: "client_msg_id", Type: field.TypeString, Nullable: true, Size: 64},
+		{Name: "revision_count", Type: field.TypeInt, Default: 0},
 		{Name: "message_channel", Type: field.TypeUUID},
 		{Name: "message_user", Type: field.TypeUUID},
 		{Name: "message_parent", Type: field.TypeUUID, Nullable: true},
@@ -189,19 +284,19 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "messages_channels_channel",
-				Columns:    []*schema.Column{MessagesColumns[6]},
+				Columns:    []*schema.Column{MessagesColumns[9]},
 				RefColumns: []*schema.Column{ChannelsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 			{
 				Symbol:     "messages_users_user",
-				Columns:    []*schema.Column{MessagesColumns[7]},
+				Columns:    []*schema.Column{MessagesColumns[10]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 			{
 				Symbol:     "messages_messages_parent",
-				Columns:    []*schema.Column{MessagesColumns[8]},
+				Columns:    []*schema.Column{MessagesColumns[11]},
 				RefColumns: []*schema.Column{MessagesColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
@@ -210,7 +305,12 @@ var (
 			{
 				Name:    "message_created_at",
 				Unique:  false,
-				Columns: []*schema.Column{MessagesColumns[2]},
+				Columns: []*schema.Column{MessagesColumns[3]},
+			},
+			{
+				Name:    "message_client_msg_id_message_user",
+				Unique:  true,
+				Columns: []*schema.Column{MessagesColumns[7], MessagesColumns[10]},
 			},
 		},
 	}
@@ -375,6 +475,80 @@ var (
 			},
 		},
 	}
+	// MessageRevisionsColumns holds the columns for the "message_revisions" table.
+	MessageRevisionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "body", Type: field.TypeString, Size: 2147483647},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "message_revision_message", Type: field.TypeUUID},
+		{Name: "message_revision_editor", Type: field.TypeUUID},
+	}
+	// MessageRevisionsTable holds the schema information for the "message_revisions" table.
+	MessageRevisionsTable = &schema.Table{
+		Name:       "message_revisions",
+		Columns:    MessageRevisionsColumns,
+		PrimaryKey: []*schema.Column{MessageRevisionsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "message_revisions_messages_message",
+				Columns:    []*schema.Column{MessageRevisionsColumns[3]},
+				RefColumns: []*schema.Column{MessagesColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "message_revisions_users_editor",
+				Columns:    []*schema.Column{MessageRevisionsColumns[4]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "messagerevision_created_at_message_revision_message",
+				Unique:  false,
+				Columns: []*schema.Column{MessageRevisionsColumns[2], MessageRevisionsColumns[3]},
+			},
+		},
+	}
+	// MessageSharesColumns holds the columns for the "message_shares" table.
+	MessageSharesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "message_share_message", Type: field.TypeUUID},
+		{Name: "message_share_source", Type: field.TypeUUID},
+	}
+	// MessageSharesTable holds the schema information for the "message_shares" table.
+	MessageSharesTable = &schema.Table{
+		Name:       "message_shares",
+		Columns:    MessageSharesColumns,
+		PrimaryKey: []*schema.Column{MessageSharesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "message_shares_messages_message",
+				Columns:    []*schema.Column{MessageSharesColumns[2]},
+				RefColumns: []*schema.Column{MessagesColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "message_shares_messages_source",
+				Columns:    []*schema.Column{MessageSharesColumns[3]},
+				RefColumns: []*schema.Column{MessagesColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "messageshare_message_share_message",
+				Unique:  true,
+				Columns: []*schema.Column{MessageSharesColumns[2]},
+			},
+			{
+				Name:    "messageshare_message_share_source",
+				Unique:  false,
+				Columns: []*schema.Column{MessageSharesColumns[3]},
+			},
+		},
+	}
 	// MessageUserMentionsColumns holds the columns for the "message_user_mentions" table.
 	MessageUserMentionsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID},
@@ -402,6 +576,201 @@ var (
 			},
 		},
 	}
+	// PollsColumns holds the columns for the "polls" table.
+	PollsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "multiple_choice", Type: field.TypeBool, Default: false},
+		{Name: "anonymous", Type: field.TypeBool, Default: false},
+		{Name: "closes_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "poll_message", Type: field.TypeUUID},
+	}
+	// PollsTable holds the schema information for the "polls" table.
+	PollsTable = &schema.Table{
+		Name:       "polls",
+		Columns:    PollsColumns,
+		PrimaryKey: []*schema.Column{PollsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "polls_messages_message",
+				Columns:    []*schema.Column{PollsColumns[5]},
+				RefColumns: []*schema.Column{MessagesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "poll_poll_message",
+				Unique:  true,
+				Columns: []*schema.Column{PollsColumns[5]},
+			},
+		},
+	}
+	// PollOptionsColumns holds the columns for the "poll_options" table.
+	PollOptionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "text", Type: field.TypeString, Size: 200},
+		{Name: "position", Type: field.TypeInt},
+		{Name: "poll_option_poll", Type: field.TypeUUID},
+	}
+	// PollOptionsTable holds the schema information for the "poll_options" table.
+	PollOptionsTable = &schema.Table{
+		Name:       "poll_options",
+		Columns:    PollOptionsColumns,
+		PrimaryKey: []*schema.Column{PollOptionsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "poll_options_polls_poll",
+				Columns:    []*schema.Column{PollOptionsColumns[3]},
+				RefColumns: []*schema.Column{PollsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "polloption_position_poll_option_poll",
+				Unique:  false,
+				Columns: []*schema.Column{PollOptionsColumns[2], PollOptionsColumns[3]},
+			},
+		},
+	}
+	// PollVotesColumns holds the columns for the "poll_votes" table.
+	PollVotesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "poll_vote_poll", Type: field.TypeUUID},
+		{Name: "poll_vote_option", Type: field.TypeUUID},
+		{Name: "poll_vote_user", Type: field.TypeUUID},
+	}
+	// PollVotesTable holds the schema information for the "poll_votes" table.
+	PollVotesTable = &schema.Table{
+		Name:       "poll_votes",
+		Columns:    PollVotesColumns,
+		PrimaryKey: []*schema.Column{PollVotesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "poll_votes_polls_poll",
+				Columns:    []*schema.Column{PollVotesColumns[2]},
+				RefColumns: []*schema.Column{PollsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "poll_votes_poll_options_option",
+				Columns:    []*schema.Column{PollVotesColumns[3]},
+				RefColumns: []*schema.Column{PollOptionsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+			{
+				Symbol:     "poll_votes_users_user",
+				Columns:    []*schema.Column{PollVotesColumns[4]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "pollvote_poll_vote_option_poll_vote_user",
+				Unique:  true,
+				Columns: []*schema.Column{PollVotesColumns[3], PollVotesColumns[4]},
+			},
+			{
+				Name:    "pollvote_poll_vote_poll_poll_vote_user",
+				Unique:  false,
+				Columns: []*schema.Column{PollVotesColumns[2], PollVotesColumns[4]},
+			},
+		},
+	}
+	// RemindersColumns holds the columns for the "reminders" table.
+	RemindersColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "remind_at", Type: field.TypeTime},
+		{Name: "status", Type: field.TypeString, Default: "pending"},
+		{Name: "claimed_at", Type: field.TypeTime, Nullable: true},
+		{Name: "fired_at", Type: field.TypeTime, Nullable: true},
+		{Name: "completed_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "reminder_user", Type: field.TypeUUID},
+		{Name: "reminder_message", Type: field.TypeUUID},
+	}
+	// RemindersTable holds the schema information for the "reminders" table.
+	RemindersTable = &schema.Table{
+		Name:       "reminders",
+		Columns:    RemindersColumns,
+		PrimaryKey: []*schema.Column{RemindersColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "reminders_users_user",
+				Columns:    []*schema.Column{RemindersColumns[8]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "reminders_messages_message",
+				Columns:    []*schema.Column{RemindersColumns[9]},
+				RefColumns: []*schema.Column{MessagesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "reminder_status_remind_at",
+				Unique:  false,
+				Columns: []*schema.Column{RemindersColumns[2], RemindersColumns[1]},
+			},
+		},
+	}
+	// ScheduledMessagesColumns holds the columns for the "scheduled_messages" table.
+	ScheduledMessagesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "body", Type: field.TypeString, Size: 2147483647},
+		{Name: "attachment_ids", Type: field.TypeJSON, Nullable: true},
+		{Name: "send_at", Type: field.TypeTime},
+		{Name: "recurrence", Type: field.TypeString, Nullable: true},
+		{Name: "timezone", Type: field.TypeString, Default: "UTC"},
+		{Name: "status", Type: field.TypeString, Default: "pending"},
+		{Name: "claimed_at", Type: field.TypeTime, Nullable: true},
+		{Name: "attempts", Type: field.TypeInt, Default: 0},
+		{Name: "last_error", Type: field.TypeString, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "scheduled_message_channel", Type: field.TypeUUID},
+		{Name: "scheduled_message_user", Type: field.TypeUUID},
+		{Name: "scheduled_message_parent", Type: field.TypeUUID, Nullable: true},
+	}
+	// ScheduledMessagesTable holds the schema information for the "scheduled_messages" table.
+	ScheduledMessagesTable = &schema.Table{
+		Name:       "scheduled_messages",
+		Columns:    ScheduledMessagesColumns,
+		PrimaryKey: []*schema.Column{ScheduledMessagesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "scheduled_messages_channels_channel",
+				Columns:    []*schema.Column{ScheduledMessagesColumns[12]},
+				RefColumns: []*schema.Column{ChannelsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "scheduled_messages_users_user",
+				Columns:    []*schema.Column{ScheduledMessagesColumns[13]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "scheduled_messages_messages_parent",
+				Columns:    []*schema.Column{ScheduledMessagesColumns[14]},
+				RefColumns: []*schema.Column{MessagesColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "scheduledmessage_status_send_at",
+				Unique:  false,
+				Columns: []*schema.Column{ScheduledMessagesColumns[6], ScheduledMessagesColumns[3]},
+			},
+		},
+	}
 	// SessionsColumns holds the columns for the "sessions" table.
 	SessionsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID},
@@ -440,6 +809,7 @@ var (
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "system_message_channel", Type: field.TypeUUID},
 		{Name: "system_message_actor", Type: field.TypeUUID, Nullable: true},
+		{Name: "system_message_recipient", Type: field.TypeUUID, Nullable: true},
 	}
 	// SystemMessagesTable holds the schema information for the "system_messages" table.
 	SystemMessagesTable = &schema.Table{
@@ -459,6 +829,12 @@ var (
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
+			{
+				Symbol:     "system_messages_users_recipient",
+				Columns:    []*schema.Column{SystemMessagesColumns[6]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
 		},
 		Indexes: []*schema.Index{
 			{
@@ -517,6 +893,8 @@ var (
 		{Name: "display_name", Type: field.TypeString},
 		{Name: "bio", Type: field.TypeString, Nullable: true},
 		{Name: "avatar_url", Type: field.TypeString, Nullable: true},
+		{Name: "last_seen_at", Type: field.TypeTime, Nullable: true},
+		{Name: "read_receipts_enabled", Type: field.TypeBool, Default: true},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
 	}
@@ -643,6 +1021,8 @@ var (
 		{Name: "description", Type: field.TypeString, Nullable: true},
 		{Name: "icon_url", Type: field.TypeString, Nullable: true},
 		{Name: "is_public", Type: field.TypeBool, Default: false},
+		{Name: "message_history_enabled", Type: field.TypeBool, Default: true},
+		{Name: "message_retention_days", Type: field.TypeInt, Default: 0},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
 		{Name: "workspace_created_by", Type: field.TypeUUID},
@@ -655,7 +1035,7 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "workspaces_users_created_by",
-				Columns:    []*schema.Column{WorkspacesColumns[7]},
+				Columns:    []*schema.Column{WorkspacesColumns[9]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
@@ -714,13 +1094,22 @@ var (
 		ChannelsTable,
 		ChannelMembersTable,
 		ChannelReadStatesTable,
+		DraftsTable,
+		EphemeralMessagesTable,
 		MessagesTable,
 		MessageBookmarksTable,
 		MessageGroupMentionsTable,
 		MessageLinksTable,
 		MessagePinsTable,
 		MessageReactionsTable,
+		MessageRevisionsTable,
+		MessageSharesTable,
 		MessageUserMentionsTable,
+		PollsTable,
+		PollOptionsTable,
+		PollVotesTable,
+		RemindersTable,
+		ScheduledMessagesTable,
 		SessionsTable,
 		SystemMessagesTable,
 		ThreadReadStatesTable,
@@ -743,6 +1132,11 @@ func init() {
 	ChannelMembersTable.ForeignKeys[1].RefTable = UsersTable
 	ChannelReadStatesTable.ForeignKeys[0].RefTable = ChannelsTable
 	ChannelReadStatesTable.ForeignKeys[1].RefTable = UsersTable
+	DraftsTable.ForeignKeys[0].RefTable = UsersTable
+	DraftsTable.ForeignKeys[1].RefTable = ChannelsTable
+	DraftsTable.ForeignKeys[2].RefTable = MessagesTable
+	EphemeralMessagesTable.ForeignKeys[0].RefTable = ChannelsTable
+	EphemeralMessagesTable.ForeignKeys[1].RefTable = UsersTable
 	MessagesTable.ForeignKeys[0].RefTable = ChannelsTable
 	MessagesTable.ForeignKeys[1].RefTable = UsersTable
 	MessagesTable.ForeignKeys[2].RefTable = MessagesTable
@@ -756,11 +1150,26 @@ func init() {
 	MessagePinsTable.ForeignKeys[2].RefTable = UsersTable
 	MessageReactionsTable.ForeignKeys[0].RefTable = MessagesTable
 	MessageReactionsTable.ForeignKeys[1].RefTable = UsersTable
+	MessageRevisionsTable.ForeignKeys[0].RefTable = MessagesTable
+	MessageRevisionsTable.ForeignKeys[1].RefTable = UsersTable
+	MessageSharesTable.ForeignKeys[0].RefTable = MessagesTable
+	MessageSharesTable.ForeignKeys[1].RefTable = MessagesTable
 	MessageUserMentionsTable.ForeignKeys[0].RefTable = MessagesTable
 	MessageUserMentionsTable.ForeignKeys[1].RefTable = UsersTable
+	PollsTable.ForeignKeys[0].RefTable = MessagesTable
+	PollOptionsTable.ForeignKeys[0].RefTable = PollsTable
+	PollVotesTable.ForeignKeys[0].RefTable = PollsTable
+	PollVotesTable.ForeignKeys[1].RefTable = PollOptionsTable
+	PollVotesTable.ForeignKeys[2].RefTable = UsersTable
+	RemindersTable.ForeignKeys[0].RefTable = UsersTable
+	RemindersTable.ForeignKeys[1].RefTable = MessagesTable
+	ScheduledMessagesTable.ForeignKeys[0].RefTable = ChannelsTable
+	ScheduledMessagesTable.ForeignKeys[1].RefTable = UsersTable
+	ScheduledMessagesTable.ForeignKeys[2].RefTable = MessagesTable
 	SessionsTable.ForeignKeys[0].RefTable = UsersTable
 	SystemMessagesTable.ForeignKeys[0].RefTable = ChannelsTable
 	SystemMessagesTable.ForeignKeys[1].RefTable = UsersTable
+	SystemMessagesTable.ForeignKeys[2].RefTable = UsersTable
 	ThreadReadStatesTable.ForeignKeys[0].RefTable = UsersTable
 	ThreadReadStatesTable.ForeignKeys[1].RefTable = MessagesTable
 	UserGroupsTable.ForeignKeys[0].RefTable = WorkspacesTable
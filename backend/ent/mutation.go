@@ -16,14 +16,23 @@ import (
 	"github.com/newt239/chat/ent/channel"
 	"github.com/newt239/chat/ent/channelmember"
 	"github.com/newt239/chat/ent/channelreadstate"
+	"github.com/newt239/chat/ent/draft"
+	"github.com/newt239/chat/ent/ephemeralmessage"
 	"github.com/newt239/chat/ent/message"
 	"github.com/newt239/chat/ent/messagebookmark"
 	"github.com/newt239/chat/ent/messagegroupmention"
 	"github.com/newt239/chat/ent/messagelink"
 	"github.com/newt239/chat/ent/messagepin"
 	"github.com/newt239/chat/ent/messagereaction"
+	"github.com/newt239/chat/ent/messagerevision"
+	"github.com/newt239/chat/ent/messageshare"
 	"github.com/newt239/chat/ent/messageusermention"
+	"github.com/newt239/chat/ent/poll"
+	"github.com/newt239/chat/ent/polloption"
+	"github.com/newt239/chat/ent/pollvote"
 	"github.com/newt239/chat/ent/predicate"
+	"github.com/newt239/chat/ent/reminder"
+	"github.com/newt239/chat/ent/scheduledmessage"
 	"github.com/newt239/chat/ent/session"
 	"github.com/newt239/chat/ent/systemmessage"
 	"github.com/newt239/chat/ent/threadreadstate"
@@ -48,13 +57,22 @@ const (
 	TypeChannel             = "Channel"
 	TypeChannelMember       = "ChannelMember"
 	TypeChannelReadState    = "ChannelReadState"
+	TypeDraft               = "Draft"
+	TypeEphemeralMessage    = "EphemeralMessage"
 	TypeMessage             = "Message"
 	TypeMessageBookmark     = "MessageBookmark"
 	TypeMessageGroupMention = "MessageGroupMention"
 	TypeMessageLink         = "MessageLink"
 	TypeMessagePin          = "MessagePin"
 	TypeMessageReaction     = "MessageReaction"
+	TypeMessageRevision     = "MessageRevision"
+	TypeMessageShare        = "MessageShare"
 	TypeMessageUserMention  = "MessageUserMention"
+	TypePoll                = "Poll"
+	TypePollOption          = "PollOption"
+	TypePollVote            = "PollVote"
+	TypeReminder            = "Reminder"
+	TypeScheduledMessage    = "ScheduledMessage"
 	TypeSession             = "Session"
 	TypeSystemMessage       = "SystemMessage"
 	TypeThreadReadState     = "ThreadReadState"
@@ -1041,35 +1059,37 @@ func (m *AttachmentMutation) ResetEdge(name string) error {
 // ChannelMutation represents an operation that mutates the Channel nodes in the graph.
 type ChannelMutation struct {
 	config
-	op                 Op
-	typ                string
-	id                 *uuid.UUID
-	name               *string
-	description        *string
-	is_private         *bool
-	channel_type       *string
-	created_at         *time.Time
-	updated_at         *time.Time
-	clearedFields      map[string]struct{}
-	workspace          *string
-	clearedworkspace   bool
-	created_by         *uuid.UUID
-	clearedcreated_by  bool
-	members            map[uuid.UUID]struct{}
-	removedmembers     map[uuid.UUID]struct{}
-	clearedmembers     bool
-	messages           map[uuid.UUID]struct{}
-	removedmessages    map[uuid.UUID]struct{}
-	clearedmessages    bool
-	attachments        map[uuid.UUID]struct{}
-	removedattachments map[uuid.UUID]struct{}
-	clearedattachments bool
-	read_states        map[uuid.UUID]struct{}
-	removedread_states map[uuid.UUID]struct{}
-	clearedread_states bool
-	done               bool
-	oldValue           func(context.Context) (*Channel, error)
-	predicates         []predicate.Channel
+	op                        Op
+	typ                       string
+	id                        *uuid.UUID
+	name                      *string
+	description               *string
+	is_private                *bool
+	channel_type              *string
+	message_retention_days    *int
+	addmessage_retention_days *int
+	created_at                *time.Time
+	updated_at                *time.Time
+	clearedFields             map[string]struct{}
+	workspace                 *string
+	clearedworkspace          bool
+	created_by                *uuid.UUID
+	clearedcreated_by         bool
+	members                   map[uuid.UUID]struct{}
+	removedmembers            map[uuid.UUID]struct{}
+	clearedmembers            bool
+	messages                  map[uuid.UUID]struct{}
+	removedmessages           map[uuid.UUID]struct{}
+	clearedmessages           bool
+	attachments               map[uuid.UUID]struct{}
+	removedattachments        map[uuid.UUID]struct{}
+	clearedattachments        bool
+	read_states               map[uuid.UUID]struct{}
+	removedread_states        map[uuid.UUID]struct{}
+	clearedread_states        bool
+	done                      bool
+	oldValue                  func(context.Context) (*Channel, error)
+	predicates                []predicate.Channel
 }
 
 var _ ent.Mutation = (*ChannelMutation)(nil)
@@ -1346,6 +1366,76 @@ func (m *ChannelMutation) ResetChannelType() {
 	delete(m.clearedFields, channel.FieldChannelType)
 }
 
+// SetMessageRetentionDays sets the "message_retention_days" field.
+func (m *ChannelMutation) SetMessageRetentionDays(i int) {
+	m.message_retention_days = &i
+	m.addmessage_retention_days = nil
+}
+
+// MessageRetentionDays returns the value of the "message_retention_days" field in the mutation.
+func (m *ChannelMutation) MessageRetentionDays() (r int, exists bool) {
+	v := m.message_retention_days
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMessageRetentionDays returns the old "message_retention_days" field's value of the Channel entity.
+// If the Channel object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ChannelMutation) OldMessageRetentionDays(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMessageRetentionDays is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMessageRetentionDays requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMessageRetentionDays: %w", err)
+	}
+	return oldValue.MessageRetentionDays, nil
+}
+
+// AddMessageRetentionDays adds i to the "message_retention_days" field.
+func (m *ChannelMutation) AddMessageRetentionDays(i int) {
+	if m.addmessage_retention_days != nil {
+		*m.addmessage_retention_days += i
+	} else {
+		m.addmessage_retention_days = &i
+	}
+}
+
+// AddedMessageRetentionDays returns the value that was added to the "message_retention_days" field in this mutation.
+func (m *ChannelMutation) AddedMessageRetentionDays() (r int, exists bool) {
+	v := m.addmessage_retention_days
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearMessageRetentionDays clears the value of the "message_retention_days" field.
+func (m *ChannelMutation) ClearMessageRetentionDays() {
+	m.message_retention_days = nil
+	m.addmessage_retention_days = nil
+	m.clearedFields[channel.FieldMessageRetentionDays] = struct{}{}
+}
+
+// MessageRetentionDaysCleared returns if the "message_retention_days" field was cleared in this mutation.
+func (m *ChannelMutation) MessageRetentionDaysCleared() bool {
+	_, ok := m.clearedFields[channel.FieldMessageRetentionDays]
+	return ok
+}
+
+// ResetMessageRetentionDays resets all changes to the "message_retention_days" field.
+func (m *ChannelMutation) ResetMessageRetentionDays() {
+	m.message_retention_days = nil
+	m.addmessage_retention_days = nil
+	delete(m.clearedFields, channel.FieldMessageRetentionDays)
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (m *ChannelMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
@@ -1746,7 +1836,7 @@ func (m *ChannelMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *ChannelMutation) Fields() []string {
-	fields := make([]string, 0, 6)
+	fields := make([]string, 0, 7)
 	if m.name != nil {
 		fields = append(fields, channel.FieldName)
 	}
@@ -1759,6 +1849,9 @@ func (m *ChannelMutation) Fields() []string {
 	if m.channel_type != nil {
 		fields = append(fields, channel.FieldChannelType)
 	}
+	if m.message_retention_days != nil {
+		fields = append(fields, channel.FieldMessageRetentionDays)
+	}
 	if m.created_at != nil {
 		fields = append(fields, channel.FieldCreatedAt)
 	}
@@ -1781,6 +1874,8 @@ func (m *ChannelMutation) Field(name string) (ent.Value, bool) {
 		return m.IsPrivate()
 	case channel.FieldChannelType:
 		return m.ChannelType()
+	case channel.FieldMessageRetentionDays:
+		return m.MessageRetentionDays()
 	case channel.FieldCreatedAt:
 		return m.CreatedAt()
 	case channel.FieldUpdatedAt:
@@ -1802,6 +1897,8 @@ func (m *ChannelMutation) OldField(ctx context.Context, name string) (ent.Value,
 		return m.OldIsPrivate(ctx)
 	case channel.FieldChannelType:
 		return m.OldChannelType(ctx)
+	case channel.FieldMessageRetentionDays:
+		return m.OldMessageRetentionDays(ctx)
 	case channel.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
 	case channel.FieldUpdatedAt:
@@ -1843,6 +1940,13 @@ func (m *ChannelMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetChannelType(v)
 		return nil
+	case channel.FieldMessageRetentionDays:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMessageRetentionDays(v)
+		return nil
 	case channel.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
@@ -1864,13 +1968,21 @@ func (m *ChannelMutation) SetField(name string, value ent.Value) error {
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
 func (m *ChannelMutation) AddedFields() []string {
-	return nil
+	var fields []string
+	if m.addmessage_retention_days != nil {
+		fields = append(fields, channel.FieldMessageRetentionDays)
+	}
+	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
 func (m *ChannelMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case channel.FieldMessageRetentionDays:
+		return m.AddedMessageRetentionDays()
+	}
 	return nil, false
 }
 
@@ -1879,6 +1991,13 @@ func (m *ChannelMutation) AddedField(name string) (ent.Value, bool) {
 // type.
 func (m *ChannelMutation) AddField(name string, value ent.Value) error {
 	switch name {
+	case channel.FieldMessageRetentionDays:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMessageRetentionDays(v)
+		return nil
 	}
 	return fmt.Errorf("unknown Channel numeric field %s", name)
 }
@@ -1893,6 +2012,9 @@ func (m *ChannelMutation) ClearedFields() []string {
 	if m.FieldCleared(channel.FieldChannelType) {
 		fields = append(fields, channel.FieldChannelType)
 	}
+	if m.FieldCleared(channel.FieldMessageRetentionDays) {
+		fields = append(fields, channel.FieldMessageRetentionDays)
+	}
 	return fields
 }
 
@@ -1913,6 +2035,9 @@ func (m *ChannelMutation) ClearField(name string) error {
 	case channel.FieldChannelType:
 		m.ClearChannelType()
 		return nil
+	case channel.FieldMessageRetentionDays:
+		m.ClearMessageRetentionDays()
+		return nil
 	}
 	return fmt.Errorf("unknown Channel nullable field %s", name)
 }
@@ -1933,6 +2058,9 @@ func (m *ChannelMutation) ResetField(name string) error {
 	case channel.FieldChannelType:
 		m.ResetChannelType()
 		return nil
+	case channel.FieldMessageRetentionDays:
+		m.ResetMessageRetentionDays()
+		return nil
 	case channel.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
@@ -3111,67 +3239,40 @@ func (m *ChannelReadStateMutation) ResetEdge(name string) error {
 	return fmt.Errorf("unknown ChannelReadState edge %s", name)
 }
 
-// MessageMutation represents an operation that mutates the Message nodes in the graph.
-type MessageMutation struct {
+// DraftMutation represents an operation that mutates the Draft nodes in the graph.
+type DraftMutation struct {
 	config
-	op                         Op
-	typ                        string
-	id                         *uuid.UUID
-	body                       *string
-	created_at                 *time.Time
-	edited_at                  *time.Time
-	deleted_at                 *time.Time
-	deleted_by                 *uuid.UUID
-	clearedFields              map[string]struct{}
-	channel                    *uuid.UUID
-	clearedchannel             bool
-	user                       *uuid.UUID
-	cleareduser                bool
-	parent                     *uuid.UUID
-	clearedparent              bool
-	replies                    map[uuid.UUID]struct{}
-	removedreplies             map[uuid.UUID]struct{}
-	clearedreplies             bool
-	reactions                  map[uuid.UUID]struct{}
-	removedreactions           map[uuid.UUID]struct{}
-	clearedreactions           bool
-	bookmarks                  map[uuid.UUID]struct{}
-	removedbookmarks           map[uuid.UUID]struct{}
-	clearedbookmarks           bool
-	user_mentions              map[uuid.UUID]struct{}
-	removeduser_mentions       map[uuid.UUID]struct{}
-	cleareduser_mentions       bool
-	group_mentions             map[uuid.UUID]struct{}
-	removedgroup_mentions      map[uuid.UUID]struct{}
-	clearedgroup_mentions      bool
-	links                      map[uuid.UUID]struct{}
-	removedlinks               map[uuid.UUID]struct{}
-	clearedlinks               bool
-	attachments                map[uuid.UUID]struct{}
-	removedattachments         map[uuid.UUID]struct{}
-	clearedattachments         bool
-	user_thread_follows        map[uuid.UUID]struct{}
-	removeduser_thread_follows map[uuid.UUID]struct{}
-	cleareduser_thread_follows bool
-	thread_read_states         map[uuid.UUID]struct{}
-	removedthread_read_states  map[uuid.UUID]struct{}
-	clearedthread_read_states  bool
-	done                       bool
-	oldValue                   func(context.Context) (*Message, error)
-	predicates                 []predicate.Message
-}
-
-var _ ent.Mutation = (*MessageMutation)(nil)
-
-// messageOption allows management of the mutation configuration using functional options.
-type messageOption func(*MessageMutation)
-
-// newMessageMutation creates new mutation for the Message entity.
-func newMessageMutation(c config, op Op, opts ...messageOption) *MessageMutation {
-	m := &MessageMutation{
+	op                   Op
+	typ                  string
+	id                   *uuid.UUID
+	body                 *string
+	attachment_ids       *[]string
+	appendattachment_ids []string
+	created_at           *time.Time
+	updated_at           *time.Time
+	clearedFields        map[string]struct{}
+	user                 *uuid.UUID
+	cleareduser          bool
+	channel              *uuid.UUID
+	clearedchannel       bool
+	parent               *uuid.UUID
+	clearedparent        bool
+	done                 bool
+	oldValue             func(context.Context) (*Draft, error)
+	predicates           []predicate.Draft
+}
+
+var _ ent.Mutation = (*DraftMutation)(nil)
+
+// draftOption allows management of the mutation configuration using functional options.
+type draftOption func(*DraftMutation)
+
+// newDraftMutation creates new mutation for the Draft entity.
+func newDraftMutation(c config, op Op, opts ...draftOption) *DraftMutation {
+	m := &DraftMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeMessage,
+		typ:           TypeDraft,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -3180,20 +3281,20 @@ func newMessageMutation(c config, op Op, opts ...messageOption) *MessageMutation
 	return m
 }
 
-// withMessageID sets the ID field of the mutation.
-func withMessageID(id uuid.UUID) messageOption {
-	return func(m *MessageMutation) {
+// withDraftID sets the ID field of the mutation.
+func withDraftID(id uuid.UUID) draftOption {
+	return func(m *DraftMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Message
+			value *Draft
 		)
-		m.oldValue = func(ctx context.Context) (*Message, error) {
+		m.oldValue = func(ctx context.Context) (*Draft, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Message.Get(ctx, id)
+					value, err = m.Client().Draft.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -3202,10 +3303,10 @@ func withMessageID(id uuid.UUID) messageOption {
 	}
 }
 
-// withMessage sets the old Message of the mutation.
-func withMessage(node *Message) messageOption {
-	return func(m *MessageMutation) {
-		m.oldValue = func(context.Context) (*Message, error) {
+// withDraft sets the old Draft of the mutation.
+func withDraft(node *Draft) draftOption {
+	return func(m *DraftMutation) {
+		m.oldValue = func(context.Context) (*Draft, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -3214,7 +3315,7 @@ func withMessage(node *Message) messageOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m MessageMutation) Client() *Client {
+func (m DraftMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -3222,7 +3323,7 @@ func (m MessageMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m MessageMutation) Tx() (*Tx, error) {
+func (m DraftMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -3232,14 +3333,14 @@ func (m MessageMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Message entities.
-func (m *MessageMutation) SetID(id uuid.UUID) {
+// operation is only accepted on creation of Draft entities.
+func (m *DraftMutation) SetID(id uuid.UUID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *MessageMutation) ID() (id uuid.UUID, exists bool) {
+func (m *DraftMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -3250,7 +3351,7 @@ func (m *MessageMutation) ID() (id uuid.UUID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *MessageMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+func (m *DraftMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -3259,19 +3360,19 @@ func (m *MessageMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Message.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Draft.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetBody sets the "body" field.
-func (m *MessageMutation) SetBody(s string) {
+func (m *DraftMutation) SetBody(s string) {
 	m.body = &s
 }
 
 // Body returns the value of the "body" field in the mutation.
-func (m *MessageMutation) Body() (r string, exists bool) {
+func (m *DraftMutation) Body() (r string, exists bool) {
 	v := m.body
 	if v == nil {
 		return
@@ -3279,10 +3380,10 @@ func (m *MessageMutation) Body() (r string, exists bool) {
 	return *v, true
 }
 
-// OldBody returns the old "body" field's value of the Message entity.
-// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// OldBody returns the old "body" field's value of the Draft entity.
+// If the Draft object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MessageMutation) OldBody(ctx context.Context) (v string, err error) {
+func (m *DraftMutation) OldBody(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldBody is only allowed on UpdateOne operations")
 	}
@@ -3297,210 +3398,203 @@ func (m *MessageMutation) OldBody(ctx context.Context) (v string, err error) {
 }
 
 // ResetBody resets all changes to the "body" field.
-func (m *MessageMutation) ResetBody() {
+func (m *DraftMutation) ResetBody() {
 	m.body = nil
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *MessageMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// SetAttachmentIds sets the "attachment_ids" field.
+func (m *DraftMutation) SetAttachmentIds(s []string) {
+	m.attachment_ids = &s
+	m.appendattachment_ids = nil
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *MessageMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
+// AttachmentIds returns the value of the "attachment_ids" field in the mutation.
+func (m *DraftMutation) AttachmentIds() (r []string, exists bool) {
+	v := m.attachment_ids
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Message entity.
-// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// OldAttachmentIds returns the old "attachment_ids" field's value of the Draft entity.
+// If the Draft object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MessageMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *DraftMutation) OldAttachmentIds(ctx context.Context) (v []string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldAttachmentIds is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+		return v, errors.New("OldAttachmentIds requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldAttachmentIds: %w", err)
 	}
-	return oldValue.CreatedAt, nil
-}
-
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *MessageMutation) ResetCreatedAt() {
-	m.created_at = nil
+	return oldValue.AttachmentIds, nil
 }
 
-// SetEditedAt sets the "edited_at" field.
-func (m *MessageMutation) SetEditedAt(t time.Time) {
-	m.edited_at = &t
-}
-
-// EditedAt returns the value of the "edited_at" field in the mutation.
-func (m *MessageMutation) EditedAt() (r time.Time, exists bool) {
-	v := m.edited_at
-	if v == nil {
-		return
-	}
-	return *v, true
+// AppendAttachmentIds adds s to the "attachment_ids" field.
+func (m *DraftMutation) AppendAttachmentIds(s []string) {
+	m.appendattachment_ids = append(m.appendattachment_ids, s...)
 }
 
-// OldEditedAt returns the old "edited_at" field's value of the Message entity.
-// If the Message object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MessageMutation) OldEditedAt(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldEditedAt is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldEditedAt requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldEditedAt: %w", err)
+// AppendedAttachmentIds returns the list of values that were appended to the "attachment_ids" field in this mutation.
+func (m *DraftMutation) AppendedAttachmentIds() ([]string, bool) {
+	if len(m.appendattachment_ids) == 0 {
+		return nil, false
 	}
-	return oldValue.EditedAt, nil
+	return m.appendattachment_ids, true
 }
 
-// ClearEditedAt clears the value of the "edited_at" field.
-func (m *MessageMutation) ClearEditedAt() {
-	m.edited_at = nil
-	m.clearedFields[message.FieldEditedAt] = struct{}{}
+// ClearAttachmentIds clears the value of the "attachment_ids" field.
+func (m *DraftMutation) ClearAttachmentIds() {
+	m.attachment_ids = nil
+	m.appendattachment_ids = nil
+	m.clearedFields[draft.FieldAttachmentIds] = struct{}{}
 }
 
-// EditedAtCleared returns if the "edited_at" field was cleared in this mutation.
-func (m *MessageMutation) EditedAtCleared() bool {
-	_, ok := m.clearedFields[message.FieldEditedAt]
+// AttachmentIdsCleared returns if the "attachment_ids" field was cleared in this mutation.
+func (m *DraftMutation) AttachmentIdsCleared() bool {
+	_, ok := m.clearedFields[draft.FieldAttachmentIds]
 	return ok
 }
 
-// ResetEditedAt resets all changes to the "edited_at" field.
-func (m *MessageMutation) ResetEditedAt() {
-	m.edited_at = nil
-	delete(m.clearedFields, message.FieldEditedAt)
+// ResetAttachmentIds resets all changes to the "attachment_ids" field.
+func (m *DraftMutation) ResetAttachmentIds() {
+	m.attachment_ids = nil
+	m.appendattachment_ids = nil
+	delete(m.clearedFields, draft.FieldAttachmentIds)
 }
 
-// SetDeletedAt sets the "deleted_at" field.
-func (m *MessageMutation) SetDeletedAt(t time.Time) {
-	m.deleted_at = &t
+// SetCreatedAt sets the "created_at" field.
+func (m *DraftMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
 }
 
-// DeletedAt returns the value of the "deleted_at" field in the mutation.
-func (m *MessageMutation) DeletedAt() (r time.Time, exists bool) {
-	v := m.deleted_at
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *DraftMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDeletedAt returns the old "deleted_at" field's value of the Message entity.
-// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the Draft entity.
+// If the Draft object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MessageMutation) OldDeletedAt(ctx context.Context) (v time.Time, err error) {
+func (m *DraftMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDeletedAt requires an ID field in the mutation")
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDeletedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
-	return oldValue.DeletedAt, nil
-}
-
-// ClearDeletedAt clears the value of the "deleted_at" field.
-func (m *MessageMutation) ClearDeletedAt() {
-	m.deleted_at = nil
-	m.clearedFields[message.FieldDeletedAt] = struct{}{}
-}
-
-// DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
-func (m *MessageMutation) DeletedAtCleared() bool {
-	_, ok := m.clearedFields[message.FieldDeletedAt]
-	return ok
+	return oldValue.CreatedAt, nil
 }
 
-// ResetDeletedAt resets all changes to the "deleted_at" field.
-func (m *MessageMutation) ResetDeletedAt() {
-	m.deleted_at = nil
-	delete(m.clearedFields, message.FieldDeletedAt)
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *DraftMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// SetDeletedBy sets the "deleted_by" field.
-func (m *MessageMutation) SetDeletedBy(u uuid.UUID) {
-	m.deleted_by = &u
+// SetUpdatedAt sets the "updated_at" field.
+func (m *DraftMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
 }
 
-// DeletedBy returns the value of the "deleted_by" field in the mutation.
-func (m *MessageMutation) DeletedBy() (r uuid.UUID, exists bool) {
-	v := m.deleted_by
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *DraftMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDeletedBy returns the old "deleted_by" field's value of the Message entity.
-// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the Draft entity.
+// If the Draft object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MessageMutation) OldDeletedBy(ctx context.Context) (v uuid.UUID, err error) {
+func (m *DraftMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDeletedBy is only allowed on UpdateOne operations")
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDeletedBy requires an ID field in the mutation")
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDeletedBy: %w", err)
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
 	}
-	return oldValue.DeletedBy, nil
+	return oldValue.UpdatedAt, nil
 }
 
-// ClearDeletedBy clears the value of the "deleted_by" field.
-func (m *MessageMutation) ClearDeletedBy() {
-	m.deleted_by = nil
-	m.clearedFields[message.FieldDeletedBy] = struct{}{}
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *DraftMutation) ResetUpdatedAt() {
+	m.updated_at = nil
 }
 
-// DeletedByCleared returns if the "deleted_by" field was cleared in this mutation.
-func (m *MessageMutation) DeletedByCleared() bool {
-	_, ok := m.clearedFields[message.FieldDeletedBy]
-	return ok
+// SetUserID sets the "user" edge to the User entity by id.
+func (m *DraftMutation) SetUserID(id uuid.UUID) {
+	m.user = &id
 }
 
-// ResetDeletedBy resets all changes to the "deleted_by" field.
-func (m *MessageMutation) ResetDeletedBy() {
-	m.deleted_by = nil
-	delete(m.clearedFields, message.FieldDeletedBy)
+// ClearUser clears the "user" edge to the User entity.
+func (m *DraftMutation) ClearUser() {
+	m.cleareduser = true
+}
+
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *DraftMutation) UserCleared() bool {
+	return m.cleareduser
+}
+
+// UserID returns the "user" edge ID in the mutation.
+func (m *DraftMutation) UserID() (id uuid.UUID, exists bool) {
+	if m.user != nil {
+		return *m.user, true
+	}
+	return
+}
+
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *DraftMutation) UserIDs() (ids []uuid.UUID) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetUser resets all changes to the "user" edge.
+func (m *DraftMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
 }
 
 // SetChannelID sets the "channel" edge to the Channel entity by id.
-func (m *MessageMutation) SetChannelID(id uuid.UUID) {
+func (m *DraftMutation) SetChannelID(id uuid.UUID) {
 	m.channel = &id
 }
 
 // ClearChannel clears the "channel" edge to the Channel entity.
-func (m *MessageMutation) ClearChannel() {
+func (m *DraftMutation) ClearChannel() {
 	m.clearedchannel = true
 }
 
 // ChannelCleared reports if the "channel" edge to the Channel entity was cleared.
-func (m *MessageMutation) ChannelCleared() bool {
+func (m *DraftMutation) ChannelCleared() bool {
 	return m.clearedchannel
 }
 
 // ChannelID returns the "channel" edge ID in the mutation.
-func (m *MessageMutation) ChannelID() (id uuid.UUID, exists bool) {
+func (m *DraftMutation) ChannelID() (id uuid.UUID, exists bool) {
 	if m.channel != nil {
 		return *m.channel, true
 	}
@@ -3510,7 +3604,7 @@ func (m *MessageMutation) ChannelID() (id uuid.UUID, exists bool) {
 // ChannelIDs returns the "channel" edge IDs in the mutation.
 // Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
 // ChannelID instead. It exists only for internal usage by the builders.
-func (m *MessageMutation) ChannelIDs() (ids []uuid.UUID) {
+func (m *DraftMutation) ChannelIDs() (ids []uuid.UUID) {
 	if id := m.channel; id != nil {
 		ids = append(ids, *id)
 	}
@@ -3518,67 +3612,28 @@ func (m *MessageMutation) ChannelIDs() (ids []uuid.UUID) {
 }
 
 // ResetChannel resets all changes to the "channel" edge.
-func (m *MessageMutation) ResetChannel() {
+func (m *DraftMutation) ResetChannel() {
 	m.channel = nil
 	m.clearedchannel = false
 }
 
-// SetUserID sets the "user" edge to the User entity by id.
-func (m *MessageMutation) SetUserID(id uuid.UUID) {
-	m.user = &id
-}
-
-// ClearUser clears the "user" edge to the User entity.
-func (m *MessageMutation) ClearUser() {
-	m.cleareduser = true
-}
-
-// UserCleared reports if the "user" edge to the User entity was cleared.
-func (m *MessageMutation) UserCleared() bool {
-	return m.cleareduser
-}
-
-// UserID returns the "user" edge ID in the mutation.
-func (m *MessageMutation) UserID() (id uuid.UUID, exists bool) {
-	if m.user != nil {
-		return *m.user, true
-	}
-	return
-}
-
-// UserIDs returns the "user" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// UserID instead. It exists only for internal usage by the builders.
-func (m *MessageMutation) UserIDs() (ids []uuid.UUID) {
-	if id := m.user; id != nil {
-		ids = append(ids, *id)
-	}
-	return
-}
-
-// ResetUser resets all changes to the "user" edge.
-func (m *MessageMutation) ResetUser() {
-	m.user = nil
-	m.cleareduser = false
-}
-
 // SetParentID sets the "parent" edge to the Message entity by id.
-func (m *MessageMutation) SetParentID(id uuid.UUID) {
+func (m *DraftMutation) SetParentID(id uuid.UUID) {
 	m.parent = &id
 }
 
 // ClearParent clears the "parent" edge to the Message entity.
-func (m *MessageMutation) ClearParent() {
+func (m *DraftMutation) ClearParent() {
 	m.clearedparent = true
 }
 
 // ParentCleared reports if the "parent" edge to the Message entity was cleared.
-func (m *MessageMutation) ParentCleared() bool {
+func (m *DraftMutation) ParentCleared() bool {
 	return m.clearedparent
 }
 
 // ParentID returns the "parent" edge ID in the mutation.
-func (m *MessageMutation) ParentID() (id uuid.UUID, exists bool) {
+func (m *DraftMutation) ParentID() (id uuid.UUID, exists bool) {
 	if m.parent != nil {
 		return *m.parent, true
 	}
@@ -3588,7 +3643,7 @@ func (m *MessageMutation) ParentID() (id uuid.UUID, exists bool) {
 // ParentIDs returns the "parent" edge IDs in the mutation.
 // Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
 // ParentID instead. It exists only for internal usage by the builders.
-func (m *MessageMutation) ParentIDs() (ids []uuid.UUID) {
+func (m *DraftMutation) ParentIDs() (ids []uuid.UUID) {
 	if id := m.parent; id != nil {
 		ids = append(ids, *id)
 	}
@@ -3596,506 +3651,717 @@ func (m *MessageMutation) ParentIDs() (ids []uuid.UUID) {
 }
 
 // ResetParent resets all changes to the "parent" edge.
-func (m *MessageMutation) ResetParent() {
+func (m *DraftMutation) ResetParent() {
 	m.parent = nil
 	m.clearedparent = false
 }
 
-// AddReplyIDs adds the "replies" edge to the Message entity by ids.
-func (m *MessageMutation) AddReplyIDs(ids ...uuid.UUID) {
-	if m.replies == nil {
-		m.replies = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		m.replies[ids[i]] = struct{}{}
+// Where appends a list predicates to the DraftMutation builder.
+func (m *DraftMutation) Where(ps ...predicate.Draft) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the DraftMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *DraftMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Draft, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
 	}
+	m.Where(p...)
 }
 
-// ClearReplies clears the "replies" edge to the Message entity.
-func (m *MessageMutation) ClearReplies() {
-	m.clearedreplies = true
+// Op returns the operation name.
+func (m *DraftMutation) Op() Op {
+	return m.op
 }
 
-// RepliesCleared reports if the "replies" edge to the Message entity was cleared.
-func (m *MessageMutation) RepliesCleared() bool {
-	return m.clearedreplies
+// SetOp allows setting the mutation operation.
+func (m *DraftMutation) SetOp(op Op) {
+	m.op = op
 }
 
-// RemoveReplyIDs removes the "replies" edge to the Message entity by IDs.
-func (m *MessageMutation) RemoveReplyIDs(ids ...uuid.UUID) {
-	if m.removedreplies == nil {
-		m.removedreplies = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		delete(m.replies, ids[i])
-		m.removedreplies[ids[i]] = struct{}{}
-	}
+// Type returns the node type of this mutation (Draft).
+func (m *DraftMutation) Type() string {
+	return m.typ
 }
 
-// RemovedReplies returns the removed IDs of the "replies" edge to the Message entity.
-func (m *MessageMutation) RemovedRepliesIDs() (ids []uuid.UUID) {
-	for id := range m.removedreplies {
-		ids = append(ids, id)
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *DraftMutation) Fields() []string {
+	fields := make([]string, 0, 4)
+	if m.body != nil {
+		fields = append(fields, draft.FieldBody)
 	}
-	return
+	if m.attachment_ids != nil {
+		fields = append(fields, draft.FieldAttachmentIds)
+	}
+	if m.created_at != nil {
+		fields = append(fields, draft.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, draft.FieldUpdatedAt)
+	}
+	return fields
 }
 
-// RepliesIDs returns the "replies" edge IDs in the mutation.
-func (m *MessageMutation) RepliesIDs() (ids []uuid.UUID) {
-	for id := range m.replies {
-		ids = append(ids, id)
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *DraftMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case draft.FieldBody:
+		return m.Body()
+	case draft.FieldAttachmentIds:
+		return m.AttachmentIds()
+	case draft.FieldCreatedAt:
+		return m.CreatedAt()
+	case draft.FieldUpdatedAt:
+		return m.UpdatedAt()
 	}
-	return
+	return nil, false
 }
 
-// ResetReplies resets all changes to the "replies" edge.
-func (m *MessageMutation) ResetReplies() {
-	m.replies = nil
-	m.clearedreplies = false
-	m.removedreplies = nil
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *DraftMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case draft.FieldBody:
+		return m.OldBody(ctx)
+	case draft.FieldAttachmentIds:
+		return m.OldAttachmentIds(ctx)
+	case draft.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case draft.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Draft field %s", name)
 }
 
-// AddReactionIDs adds the "reactions" edge to the MessageReaction entity by ids.
-func (m *MessageMutation) AddReactionIDs(ids ...uuid.UUID) {
-	if m.reactions == nil {
-		m.reactions = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		m.reactions[ids[i]] = struct{}{}
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *DraftMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case draft.FieldBody:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBody(v)
+		return nil
+	case draft.FieldAttachmentIds:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAttachmentIds(v)
+		return nil
+	case draft.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case draft.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
 	}
+	return fmt.Errorf("unknown Draft field %s", name)
 }
 
-// ClearReactions clears the "reactions" edge to the MessageReaction entity.
-func (m *MessageMutation) ClearReactions() {
-	m.clearedreactions = true
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *DraftMutation) AddedFields() []string {
+	return nil
 }
 
-// ReactionsCleared reports if the "reactions" edge to the MessageReaction entity was cleared.
-func (m *MessageMutation) ReactionsCleared() bool {
-	return m.clearedreactions
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *DraftMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
 }
 
-// RemoveReactionIDs removes the "reactions" edge to the MessageReaction entity by IDs.
-func (m *MessageMutation) RemoveReactionIDs(ids ...uuid.UUID) {
-	if m.removedreactions == nil {
-		m.removedreactions = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		delete(m.reactions, ids[i])
-		m.removedreactions[ids[i]] = struct{}{}
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *DraftMutation) AddField(name string, value ent.Value) error {
+	switch name {
 	}
+	return fmt.Errorf("unknown Draft numeric field %s", name)
 }
 
-// RemovedReactions returns the removed IDs of the "reactions" edge to the MessageReaction entity.
-func (m *MessageMutation) RemovedReactionsIDs() (ids []uuid.UUID) {
-	for id := range m.removedreactions {
-		ids = append(ids, id)
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *DraftMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(draft.FieldAttachmentIds) {
+		fields = append(fields, draft.FieldAttachmentIds)
 	}
-	return
+	return fields
 }
 
-// ReactionsIDs returns the "reactions" edge IDs in the mutation.
-func (m *MessageMutation) ReactionsIDs() (ids []uuid.UUID) {
-	for id := range m.reactions {
-		ids = append(ids, id)
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *DraftMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *DraftMutation) ClearField(name string) error {
+	switch name {
+	case draft.FieldAttachmentIds:
+		m.ClearAttachmentIds()
+		return nil
 	}
-	return
+	return fmt.Errorf("unknown Draft nullable field %s", name)
 }
 
-// ResetReactions resets all changes to the "reactions" edge.
-func (m *MessageMutation) ResetReactions() {
-	m.reactions = nil
-	m.clearedreactions = false
-	m.removedreactions = nil
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *DraftMutation) ResetField(name string) error {
+	switch name {
+	case draft.FieldBody:
+		m.ResetBody()
+		return nil
+	case draft.FieldAttachmentIds:
+		m.ResetAttachmentIds()
+		return nil
+	case draft.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case draft.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Draft field %s", name)
 }
 
-// AddBookmarkIDs adds the "bookmarks" edge to the MessageBookmark entity by ids.
-func (m *MessageMutation) AddBookmarkIDs(ids ...uuid.UUID) {
-	if m.bookmarks == nil {
-		m.bookmarks = make(map[uuid.UUID]struct{})
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *DraftMutation) AddedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.user != nil {
+		edges = append(edges, draft.EdgeUser)
 	}
-	for i := range ids {
-		m.bookmarks[ids[i]] = struct{}{}
+	if m.channel != nil {
+		edges = append(edges, draft.EdgeChannel)
+	}
+	if m.parent != nil {
+		edges = append(edges, draft.EdgeParent)
 	}
+	return edges
 }
 
-// ClearBookmarks clears the "bookmarks" edge to the MessageBookmark entity.
-func (m *MessageMutation) ClearBookmarks() {
-	m.clearedbookmarks = true
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *DraftMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case draft.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
+		}
+	case draft.EdgeChannel:
+		if id := m.channel; id != nil {
+			return []ent.Value{*id}
+		}
+	case draft.EdgeParent:
+		if id := m.parent; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
 }
 
-// BookmarksCleared reports if the "bookmarks" edge to the MessageBookmark entity was cleared.
-func (m *MessageMutation) BookmarksCleared() bool {
-	return m.clearedbookmarks
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *DraftMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 3)
+	return edges
 }
 
-// RemoveBookmarkIDs removes the "bookmarks" edge to the MessageBookmark entity by IDs.
-func (m *MessageMutation) RemoveBookmarkIDs(ids ...uuid.UUID) {
-	if m.removedbookmarks == nil {
-		m.removedbookmarks = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		delete(m.bookmarks, ids[i])
-		m.removedbookmarks[ids[i]] = struct{}{}
-	}
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *DraftMutation) RemovedIDs(name string) []ent.Value {
+	return nil
 }
 
-// RemovedBookmarks returns the removed IDs of the "bookmarks" edge to the MessageBookmark entity.
-func (m *MessageMutation) RemovedBookmarksIDs() (ids []uuid.UUID) {
-	for id := range m.removedbookmarks {
-		ids = append(ids, id)
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *DraftMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.cleareduser {
+		edges = append(edges, draft.EdgeUser)
 	}
-	return
+	if m.clearedchannel {
+		edges = append(edges, draft.EdgeChannel)
+	}
+	if m.clearedparent {
+		edges = append(edges, draft.EdgeParent)
+	}
+	return edges
 }
 
-// BookmarksIDs returns the "bookmarks" edge IDs in the mutation.
-func (m *MessageMutation) BookmarksIDs() (ids []uuid.UUID) {
-	for id := range m.bookmarks {
-		ids = append(ids, id)
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *DraftMutation) EdgeCleared(name string) bool {
+	switch name {
+	case draft.EdgeUser:
+		return m.cleareduser
+	case draft.EdgeChannel:
+		return m.clearedchannel
+	case draft.EdgeParent:
+		return m.clearedparent
 	}
-	return
+	return false
 }
 
-// ResetBookmarks resets all changes to the "bookmarks" edge.
-func (m *MessageMutation) ResetBookmarks() {
-	m.bookmarks = nil
-	m.clearedbookmarks = false
-	m.removedbookmarks = nil
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *DraftMutation) ClearEdge(name string) error {
+	switch name {
+	case draft.EdgeUser:
+		m.ClearUser()
+		return nil
+	case draft.EdgeChannel:
+		m.ClearChannel()
+		return nil
+	case draft.EdgeParent:
+		m.ClearParent()
+		return nil
+	}
+	return fmt.Errorf("unknown Draft unique edge %s", name)
 }
 
-// AddUserMentionIDs adds the "user_mentions" edge to the MessageUserMention entity by ids.
-func (m *MessageMutation) AddUserMentionIDs(ids ...uuid.UUID) {
-	if m.user_mentions == nil {
-		m.user_mentions = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		m.user_mentions[ids[i]] = struct{}{}
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *DraftMutation) ResetEdge(name string) error {
+	switch name {
+	case draft.EdgeUser:
+		m.ResetUser()
+		return nil
+	case draft.EdgeChannel:
+		m.ResetChannel()
+		return nil
+	case draft.EdgeParent:
+		m.ResetParent()
+		return nil
 	}
+	return fmt.Errorf("unknown Draft edge %s", name)
 }
 
-// ClearUserMentions clears the "user_mentions" edge to the MessageUserMention entity.
-func (m *MessageMutation) ClearUserMentions() {
-	m.cleareduser_mentions = true
+// EphemeralMessageMutation represents an operation that mutates the EphemeralMessage nodes in the graph.
+type EphemeralMessageMutation struct {
+	config
+	op               Op
+	typ              string
+	id               *uuid.UUID
+	kind             *string
+	body             *string
+	payload          *map[string]interface{}
+	created_at       *time.Time
+	expires_at       *time.Time
+	clearedFields    map[string]struct{}
+	channel          *uuid.UUID
+	clearedchannel   bool
+	recipient        *uuid.UUID
+	clearedrecipient bool
+	done             bool
+	oldValue         func(context.Context) (*EphemeralMessage, error)
+	predicates       []predicate.EphemeralMessage
 }
 
-// UserMentionsCleared reports if the "user_mentions" edge to the MessageUserMention entity was cleared.
-func (m *MessageMutation) UserMentionsCleared() bool {
-	return m.cleareduser_mentions
-}
+var _ ent.Mutation = (*EphemeralMessageMutation)(nil)
 
-// RemoveUserMentionIDs removes the "user_mentions" edge to the MessageUserMention entity by IDs.
-func (m *MessageMutation) RemoveUserMentionIDs(ids ...uuid.UUID) {
-	if m.removeduser_mentions == nil {
-		m.removeduser_mentions = make(map[uuid.UUID]struct{})
+// ephemeralmessageOption allows management of the mutation configuration using functional options.
+type ephemeralmessageOption func(*EphemeralMessageMutation)
+
+// newEphemeralMessageMutation creates new mutation for the EphemeralMessage entity.
+func newEphemeralMessageMutation(c config, op Op, opts ...ephemeralmessageOption) *EphemeralMessageMutation {
+	m := &EphemeralMessageMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeEphemeralMessage,
+		clearedFields: make(map[string]struct{}),
 	}
-	for i := range ids {
-		delete(m.user_mentions, ids[i])
-		m.removeduser_mentions[ids[i]] = struct{}{}
+	for _, opt := range opts {
+		opt(m)
 	}
+	return m
 }
 
-// RemovedUserMentions returns the removed IDs of the "user_mentions" edge to the MessageUserMention entity.
-func (m *MessageMutation) RemovedUserMentionsIDs() (ids []uuid.UUID) {
-	for id := range m.removeduser_mentions {
-		ids = append(ids, id)
+// withEphemeralMessageID sets the ID field of the mutation.
+func withEphemeralMessageID(id uuid.UUID) ephemeralmessageOption {
+	return func(m *EphemeralMessageMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *EphemeralMessage
+		)
+		m.oldValue = func(ctx context.Context) (*EphemeralMessage, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().EphemeralMessage.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
 	}
-	return
 }
 
-// UserMentionsIDs returns the "user_mentions" edge IDs in the mutation.
-func (m *MessageMutation) UserMentionsIDs() (ids []uuid.UUID) {
-	for id := range m.user_mentions {
-		ids = append(ids, id)
+// withEphemeralMessage sets the old EphemeralMessage of the mutation.
+func withEphemeralMessage(node *EphemeralMessage) ephemeralmessageOption {
+	return func(m *EphemeralMessageMutation) {
+		m.oldValue = func(context.Context) (*EphemeralMessage, error) {
+			return node, nil
+		}
+		m.id = &node.ID
 	}
-	return
 }
 
-// ResetUserMentions resets all changes to the "user_mentions" edge.
-func (m *MessageMutation) ResetUserMentions() {
-	m.user_mentions = nil
-	m.cleareduser_mentions = false
-	m.removeduser_mentions = nil
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m EphemeralMessageMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
 }
 
-// AddGroupMentionIDs adds the "group_mentions" edge to the MessageGroupMention entity by ids.
-func (m *MessageMutation) AddGroupMentionIDs(ids ...uuid.UUID) {
-	if m.group_mentions == nil {
-		m.group_mentions = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		m.group_mentions[ids[i]] = struct{}{}
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m EphemeralMessageMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
 }
 
-// ClearGroupMentions clears the "group_mentions" edge to the MessageGroupMention entity.
-func (m *MessageMutation) ClearGroupMentions() {
-	m.clearedgroup_mentions = true
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of EphemeralMessage entities.
+func (m *EphemeralMessageMutation) SetID(id uuid.UUID) {
+	m.id = &id
 }
 
-// GroupMentionsCleared reports if the "group_mentions" edge to the MessageGroupMention entity was cleared.
-func (m *MessageMutation) GroupMentionsCleared() bool {
-	return m.clearedgroup_mentions
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *EphemeralMessageMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
 }
 
-// RemoveGroupMentionIDs removes the "group_mentions" edge to the MessageGroupMention entity by IDs.
-func (m *MessageMutation) RemoveGroupMentionIDs(ids ...uuid.UUID) {
-	if m.removedgroup_mentions == nil {
-		m.removedgroup_mentions = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		delete(m.group_mentions, ids[i])
-		m.removedgroup_mentions[ids[i]] = struct{}{}
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *EphemeralMessageMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().EphemeralMessage.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// RemovedGroupMentions returns the removed IDs of the "group_mentions" edge to the MessageGroupMention entity.
-func (m *MessageMutation) RemovedGroupMentionsIDs() (ids []uuid.UUID) {
-	for id := range m.removedgroup_mentions {
-		ids = append(ids, id)
-	}
-	return
+// SetKind sets the "kind" field.
+func (m *EphemeralMessageMutation) SetKind(s string) {
+	m.kind = &s
 }
 
-// GroupMentionsIDs returns the "group_mentions" edge IDs in the mutation.
-func (m *MessageMutation) GroupMentionsIDs() (ids []uuid.UUID) {
-	for id := range m.group_mentions {
-		ids = append(ids, id)
+// Kind returns the value of the "kind" field in the mutation.
+func (m *EphemeralMessageMutation) Kind() (r string, exists bool) {
+	v := m.kind
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// ResetGroupMentions resets all changes to the "group_mentions" edge.
-func (m *MessageMutation) ResetGroupMentions() {
-	m.group_mentions = nil
-	m.clearedgroup_mentions = false
-	m.removedgroup_mentions = nil
-}
-
-// AddLinkIDs adds the "links" edge to the MessageLink entity by ids.
-func (m *MessageMutation) AddLinkIDs(ids ...uuid.UUID) {
-	if m.links == nil {
-		m.links = make(map[uuid.UUID]struct{})
+// OldKind returns the old "kind" field's value of the EphemeralMessage entity.
+// If the EphemeralMessage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EphemeralMessageMutation) OldKind(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldKind is only allowed on UpdateOne operations")
 	}
-	for i := range ids {
-		m.links[ids[i]] = struct{}{}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldKind requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldKind: %w", err)
 	}
+	return oldValue.Kind, nil
 }
 
-// ClearLinks clears the "links" edge to the MessageLink entity.
-func (m *MessageMutation) ClearLinks() {
-	m.clearedlinks = true
+// ResetKind resets all changes to the "kind" field.
+func (m *EphemeralMessageMutation) ResetKind() {
+	m.kind = nil
 }
 
-// LinksCleared reports if the "links" edge to the MessageLink entity was cleared.
-func (m *MessageMutation) LinksCleared() bool {
-	return m.clearedlinks
+// SetBody sets the "body" field.
+func (m *EphemeralMessageMutation) SetBody(s string) {
+	m.body = &s
 }
 
-// RemoveLinkIDs removes the "links" edge to the MessageLink entity by IDs.
-func (m *MessageMutation) RemoveLinkIDs(ids ...uuid.UUID) {
-	if m.removedlinks == nil {
-		m.removedlinks = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		delete(m.links, ids[i])
-		m.removedlinks[ids[i]] = struct{}{}
+// Body returns the value of the "body" field in the mutation.
+func (m *EphemeralMessageMutation) Body() (r string, exists bool) {
+	v := m.body
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// RemovedLinks returns the removed IDs of the "links" edge to the MessageLink entity.
-func (m *MessageMutation) RemovedLinksIDs() (ids []uuid.UUID) {
-	for id := range m.removedlinks {
-		ids = append(ids, id)
+// OldBody returns the old "body" field's value of the EphemeralMessage entity.
+// If the EphemeralMessage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EphemeralMessageMutation) OldBody(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBody is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBody requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBody: %w", err)
+	}
+	return oldValue.Body, nil
 }
 
-// LinksIDs returns the "links" edge IDs in the mutation.
-func (m *MessageMutation) LinksIDs() (ids []uuid.UUID) {
-	for id := range m.links {
-		ids = append(ids, id)
-	}
-	return
+// ResetBody resets all changes to the "body" field.
+func (m *EphemeralMessageMutation) ResetBody() {
+	m.body = nil
 }
 
-// ResetLinks resets all changes to the "links" edge.
-func (m *MessageMutation) ResetLinks() {
-	m.links = nil
-	m.clearedlinks = false
-	m.removedlinks = nil
+// SetPayload sets the "payload" field.
+func (m *EphemeralMessageMutation) SetPayload(value map[string]interface{}) {
+	m.payload = &value
 }
 
-// AddAttachmentIDs adds the "attachments" edge to the Attachment entity by ids.
-func (m *MessageMutation) AddAttachmentIDs(ids ...uuid.UUID) {
-	if m.attachments == nil {
-		m.attachments = make(map[uuid.UUID]struct{})
+// Payload returns the value of the "payload" field in the mutation.
+func (m *EphemeralMessageMutation) Payload() (r map[string]interface{}, exists bool) {
+	v := m.payload
+	if v == nil {
+		return
 	}
-	for i := range ids {
-		m.attachments[ids[i]] = struct{}{}
+	return *v, true
+}
+
+// OldPayload returns the old "payload" field's value of the EphemeralMessage entity.
+// If the EphemeralMessage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EphemeralMessageMutation) OldPayload(ctx context.Context) (v map[string]interface{}, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPayload is only allowed on UpdateOne operations")
 	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPayload requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPayload: %w", err)
+	}
+	return oldValue.Payload, nil
 }
 
-// ClearAttachments clears the "attachments" edge to the Attachment entity.
-func (m *MessageMutation) ClearAttachments() {
-	m.clearedattachments = true
+// ClearPayload clears the value of the "payload" field.
+func (m *EphemeralMessageMutation) ClearPayload() {
+	m.payload = nil
+	m.clearedFields[ephemeralmessage.FieldPayload] = struct{}{}
 }
 
-// AttachmentsCleared reports if the "attachments" edge to the Attachment entity was cleared.
-func (m *MessageMutation) AttachmentsCleared() bool {
-	return m.clearedattachments
+// PayloadCleared returns if the "payload" field was cleared in this mutation.
+func (m *EphemeralMessageMutation) PayloadCleared() bool {
+	_, ok := m.clearedFields[ephemeralmessage.FieldPayload]
+	return ok
 }
 
-// RemoveAttachmentIDs removes the "attachments" edge to the Attachment entity by IDs.
-func (m *MessageMutation) RemoveAttachmentIDs(ids ...uuid.UUID) {
-	if m.removedattachments == nil {
-		m.removedattachments = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		delete(m.attachments, ids[i])
-		m.removedattachments[ids[i]] = struct{}{}
-	}
+// ResetPayload resets all changes to the "payload" field.
+func (m *EphemeralMessageMutation) ResetPayload() {
+	m.payload = nil
+	delete(m.clearedFields, ephemeralmessage.FieldPayload)
 }
 
-// RemovedAttachments returns the removed IDs of the "attachments" edge to the Attachment entity.
-func (m *MessageMutation) RemovedAttachmentsIDs() (ids []uuid.UUID) {
-	for id := range m.removedattachments {
-		ids = append(ids, id)
+// SetCreatedAt sets the "created_at" field.
+func (m *EphemeralMessageMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *EphemeralMessageMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// AttachmentsIDs returns the "attachments" edge IDs in the mutation.
-func (m *MessageMutation) AttachmentsIDs() (ids []uuid.UUID) {
-	for id := range m.attachments {
-		ids = append(ids, id)
+// OldCreatedAt returns the old "created_at" field's value of the EphemeralMessage entity.
+// If the EphemeralMessage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EphemeralMessageMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
 }
 
-// ResetAttachments resets all changes to the "attachments" edge.
-func (m *MessageMutation) ResetAttachments() {
-	m.attachments = nil
-	m.clearedattachments = false
-	m.removedattachments = nil
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *EphemeralMessageMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// AddUserThreadFollowIDs adds the "user_thread_follows" edge to the UserThreadFollow entity by ids.
-func (m *MessageMutation) AddUserThreadFollowIDs(ids ...uuid.UUID) {
-	if m.user_thread_follows == nil {
-		m.user_thread_follows = make(map[uuid.UUID]struct{})
+// SetExpiresAt sets the "expires_at" field.
+func (m *EphemeralMessageMutation) SetExpiresAt(t time.Time) {
+	m.expires_at = &t
+}
+
+// ExpiresAt returns the value of the "expires_at" field in the mutation.
+func (m *EphemeralMessageMutation) ExpiresAt() (r time.Time, exists bool) {
+	v := m.expires_at
+	if v == nil {
+		return
 	}
-	for i := range ids {
-		m.user_thread_follows[ids[i]] = struct{}{}
+	return *v, true
+}
+
+// OldExpiresAt returns the old "expires_at" field's value of the EphemeralMessage entity.
+// If the EphemeralMessage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EphemeralMessageMutation) OldExpiresAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExpiresAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExpiresAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExpiresAt: %w", err)
 	}
+	return oldValue.ExpiresAt, nil
 }
 
-// ClearUserThreadFollows clears the "user_thread_follows" edge to the UserThreadFollow entity.
-func (m *MessageMutation) ClearUserThreadFollows() {
-	m.cleareduser_thread_follows = true
+// ResetExpiresAt resets all changes to the "expires_at" field.
+func (m *EphemeralMessageMutation) ResetExpiresAt() {
+	m.expires_at = nil
 }
 
-// UserThreadFollowsCleared reports if the "user_thread_follows" edge to the UserThreadFollow entity was cleared.
-func (m *MessageMutation) UserThreadFollowsCleared() bool {
-	return m.cleareduser_thread_follows
+// SetChannelID sets the "channel" edge to the Channel entity by id.
+func (m *EphemeralMessageMutation) SetChannelID(id uuid.UUID) {
+	m.channel = &id
 }
 
-// RemoveUserThreadFollowIDs removes the "user_thread_follows" edge to the UserThreadFollow entity by IDs.
-func (m *MessageMutation) RemoveUserThreadFollowIDs(ids ...uuid.UUID) {
-	if m.removeduser_thread_follows == nil {
-		m.removeduser_thread_follows = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		delete(m.user_thread_follows, ids[i])
-		m.removeduser_thread_follows[ids[i]] = struct{}{}
-	}
+// ClearChannel clears the "channel" edge to the Channel entity.
+func (m *EphemeralMessageMutation) ClearChannel() {
+	m.clearedchannel = true
 }
 
-// RemovedUserThreadFollows returns the removed IDs of the "user_thread_follows" edge to the UserThreadFollow entity.
-func (m *MessageMutation) RemovedUserThreadFollowsIDs() (ids []uuid.UUID) {
-	for id := range m.removeduser_thread_follows {
-		ids = append(ids, id)
+// ChannelCleared reports if the "channel" edge to the Channel entity was cleared.
+func (m *EphemeralMessageMutation) ChannelCleared() bool {
+	return m.clearedchannel
+}
+
+// ChannelID returns the "channel" edge ID in the mutation.
+func (m *EphemeralMessageMutation) ChannelID() (id uuid.UUID, exists bool) {
+	if m.channel != nil {
+		return *m.channel, true
 	}
 	return
 }
 
-// UserThreadFollowsIDs returns the "user_thread_follows" edge IDs in the mutation.
-func (m *MessageMutation) UserThreadFollowsIDs() (ids []uuid.UUID) {
-	for id := range m.user_thread_follows {
-		ids = append(ids, id)
+// ChannelIDs returns the "channel" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ChannelID instead. It exists only for internal usage by the builders.
+func (m *EphemeralMessageMutation) ChannelIDs() (ids []uuid.UUID) {
+	if id := m.channel; id != nil {
+		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetUserThreadFollows resets all changes to the "user_thread_follows" edge.
-func (m *MessageMutation) ResetUserThreadFollows() {
-	m.user_thread_follows = nil
-	m.cleareduser_thread_follows = false
-	m.removeduser_thread_follows = nil
+// ResetChannel resets all changes to the "channel" edge.
+func (m *EphemeralMessageMutation) ResetChannel() {
+	m.channel = nil
+	m.clearedchannel = false
 }
 
-// AddThreadReadStateIDs adds the "thread_read_states" edge to the ThreadReadState entity by ids.
-func (m *MessageMutation) AddThreadReadStateIDs(ids ...uuid.UUID) {
-	if m.thread_read_states == nil {
-		m.thread_read_states = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		m.thread_read_states[ids[i]] = struct{}{}
-	}
+// SetRecipientID sets the "recipient" edge to the User entity by id.
+func (m *EphemeralMessageMutation) SetRecipientID(id uuid.UUID) {
+	m.recipient = &id
 }
 
-// ClearThreadReadStates clears the "thread_read_states" edge to the ThreadReadState entity.
-func (m *MessageMutation) ClearThreadReadStates() {
-	m.clearedthread_read_states = true
+// ClearRecipient clears the "recipient" edge to the User entity.
+func (m *EphemeralMessageMutation) ClearRecipient() {
+	m.clearedrecipient = true
 }
 
-// ThreadReadStatesCleared reports if the "thread_read_states" edge to the ThreadReadState entity was cleared.
-func (m *MessageMutation) ThreadReadStatesCleared() bool {
-	return m.clearedthread_read_states
+// RecipientCleared reports if the "recipient" edge to the User entity was cleared.
+func (m *EphemeralMessageMutation) RecipientCleared() bool {
+	return m.clearedrecipient
 }
 
-// RemoveThreadReadStateIDs removes the "thread_read_states" edge to the ThreadReadState entity by IDs.
-func (m *MessageMutation) RemoveThreadReadStateIDs(ids ...uuid.UUID) {
-	if m.removedthread_read_states == nil {
-		m.removedthread_read_states = make(map[uuid.UUID]struct{})
+// RecipientID returns the "recipient" edge ID in the mutation.
+func (m *EphemeralMessageMutation) RecipientID() (id uuid.UUID, exists bool) {
+	if m.recipient != nil {
+		return *m.recipient, true
 	}
-	for i := range ids {
-		delete(m.thread_read_states, ids[i])
-		m.removedthread_read_states[ids[i]] = struct{}{}
+	return
+}
+
+// RecipientIDs returns the "recipient" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// RecipientID instead. It exists only for internal usage by the builders.
+func (m *EphemeralMessageMutation) RecipientIDs() (ids []uuid.UUID) {
+	if id := m.recipient; id != nil {
+		ids = append(ids, *id)
 	}
+	return
 }
 
-// RemovedThreadReadStates returns the removed IDs of the "thread_read_states" edge to the ThreadReadState entity.
-func (m *MessageMutation) RemovedThreadReadStatesIDs() (ids []uuid.UUID) {
-	for id := range m.removedthread_read_states {
-		ids = append(ids, id)
-	}
-	return
-}
-
-// ThreadReadStatesIDs returns the "thread_read_states" edge IDs in the mutation.
-func (m *MessageMutation) ThreadReadStatesIDs() (ids []uuid.UUID) {
-	for id := range m.thread_read_states {
-		ids = append(ids, id)
-	}
-	return
-}
-
-// ResetThreadReadStates resets all changes to the "thread_read_states" edge.
-func (m *MessageMutation) ResetThreadReadStates() {
-	m.thread_read_states = nil
-	m.clearedthread_read_states = false
-	m.removedthread_read_states = nil
+// ResetRecipient resets all changes to the "recipient" edge.
+func (m *EphemeralMessageMutation) ResetRecipient() {
+	m.recipient = nil
+	m.clearedrecipient = false
 }
 
-// Where appends a list predicates to the MessageMutation builder.
-func (m *MessageMutation) Where(ps ...predicate.Message) {
+// Where appends a list predicates to the EphemeralMessageMutation builder.
+func (m *EphemeralMessageMutation) Where(ps ...predicate.EphemeralMessage) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the MessageMutation builder. Using this method,
+// WhereP appends storage-level predicates to the EphemeralMessageMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *MessageMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Message, len(ps))
+func (m *EphemeralMessageMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.EphemeralMessage, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -4103,39 +4369,39 @@ func (m *MessageMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *MessageMutation) Op() Op {
+func (m *EphemeralMessageMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *MessageMutation) SetOp(op Op) {
+func (m *EphemeralMessageMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Message).
-func (m *MessageMutation) Type() string {
+// Type returns the node type of this mutation (EphemeralMessage).
+func (m *EphemeralMessageMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *MessageMutation) Fields() []string {
+func (m *EphemeralMessageMutation) Fields() []string {
 	fields := make([]string, 0, 5)
-	if m.body != nil {
-		fields = append(fields, message.FieldBody)
+	if m.kind != nil {
+		fields = append(fields, ephemeralmessage.FieldKind)
 	}
-	if m.created_at != nil {
-		fields = append(fields, message.FieldCreatedAt)
+	if m.body != nil {
+		fields = append(fields, ephemeralmessage.FieldBody)
 	}
-	if m.edited_at != nil {
-		fields = append(fields, message.FieldEditedAt)
+	if m.payload != nil {
+		fields = append(fields, ephemeralmessage.FieldPayload)
 	}
-	if m.deleted_at != nil {
-		fields = append(fields, message.FieldDeletedAt)
+	if m.created_at != nil {
+		fields = append(fields, ephemeralmessage.FieldCreatedAt)
 	}
-	if m.deleted_by != nil {
-		fields = append(fields, message.FieldDeletedBy)
+	if m.expires_at != nil {
+		fields = append(fields, ephemeralmessage.FieldExpiresAt)
 	}
 	return fields
 }
@@ -4143,18 +4409,18 @@ func (m *MessageMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *MessageMutation) Field(name string) (ent.Value, bool) {
+func (m *EphemeralMessageMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case message.FieldBody:
+	case ephemeralmessage.FieldKind:
+		return m.Kind()
+	case ephemeralmessage.FieldBody:
 		return m.Body()
-	case message.FieldCreatedAt:
+	case ephemeralmessage.FieldPayload:
+		return m.Payload()
+	case ephemeralmessage.FieldCreatedAt:
 		return m.CreatedAt()
-	case message.FieldEditedAt:
-		return m.EditedAt()
-	case message.FieldDeletedAt:
-		return m.DeletedAt()
-	case message.FieldDeletedBy:
-		return m.DeletedBy()
+	case ephemeralmessage.FieldExpiresAt:
+		return m.ExpiresAt()
 	}
 	return nil, false
 }
@@ -4162,525 +4428,6022 @@ func (m *MessageMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *MessageMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *EphemeralMessageMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case message.FieldBody:
+	case ephemeralmessage.FieldKind:
+		return m.OldKind(ctx)
+	case ephemeralmessage.FieldBody:
 		return m.OldBody(ctx)
-	case message.FieldCreatedAt:
+	case ephemeralmessage.FieldPayload:
+		return m.OldPayload(ctx)
+	case ephemeralmessage.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case message.FieldEditedAt:
-		return m.OldEditedAt(ctx)
-	case message.FieldDeletedAt:
-		return m.OldDeletedAt(ctx)
-	case message.FieldDeletedBy:
-		return m.OldDeletedBy(ctx)
+	case ephemeralmessage.FieldExpiresAt:
+		return m.OldExpiresAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown Message field %s", name)
+	return nil, fmt.Errorf("unknown EphemeralMessage field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *MessageMutation) SetField(name string, value ent.Value) error {
+func (m *EphemeralMessageMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case message.FieldBody:
+	case ephemeralmessage.FieldKind:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetBody(v)
+		m.SetKind(v)
 		return nil
-	case message.FieldCreatedAt:
-		v, ok := value.(time.Time)
+	case ephemeralmessage.FieldBody:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCreatedAt(v)
+		m.SetBody(v)
 		return nil
-	case message.FieldEditedAt:
-		v, ok := value.(time.Time)
+	case ephemeralmessage.FieldPayload:
+		v, ok := value.(map[string]interface{})
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetEditedAt(v)
+		m.SetPayload(v)
 		return nil
-	case message.FieldDeletedAt:
+	case ephemeralmessage.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDeletedAt(v)
+		m.SetCreatedAt(v)
 		return nil
-	case message.FieldDeletedBy:
-		v, ok := value.(uuid.UUID)
+	case ephemeralmessage.FieldExpiresAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDeletedBy(v)
+		m.SetExpiresAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Message field %s", name)
+	return fmt.Errorf("unknown EphemeralMessage field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *MessageMutation) AddedFields() []string {
+func (m *EphemeralMessageMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *MessageMutation) AddedField(name string) (ent.Value, bool) {
+func (m *EphemeralMessageMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *MessageMutation) AddField(name string, value ent.Value) error {
+func (m *EphemeralMessageMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown Message numeric field %s", name)
+	return fmt.Errorf("unknown EphemeralMessage numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *MessageMutation) ClearedFields() []string {
+func (m *EphemeralMessageMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(message.FieldEditedAt) {
-		fields = append(fields, message.FieldEditedAt)
-	}
-	if m.FieldCleared(message.FieldDeletedAt) {
-		fields = append(fields, message.FieldDeletedAt)
-	}
-	if m.FieldCleared(message.FieldDeletedBy) {
-		fields = append(fields, message.FieldDeletedBy)
+	if m.FieldCleared(ephemeralmessage.FieldPayload) {
+		fields = append(fields, ephemeralmessage.FieldPayload)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *MessageMutation) FieldCleared(name string) bool {
+func (m *EphemeralMessageMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *MessageMutation) ClearField(name string) error {
+func (m *EphemeralMessageMutation) ClearField(name string) error {
 	switch name {
-	case message.FieldEditedAt:
-		m.ClearEditedAt()
-		return nil
-	case message.FieldDeletedAt:
-		m.ClearDeletedAt()
-		return nil
-	case message.FieldDeletedBy:
-		m.ClearDeletedBy()
+	case ephemeralmessage.FieldPayload:
+		m.ClearPayload()
 		return nil
 	}
-	return fmt.Errorf("unknown Message nullable field %s", name)
+	return fmt.Errorf("unknown EphemeralMessage nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *MessageMutation) ResetField(name string) error {
+func (m *EphemeralMessageMutation) ResetField(name string) error {
 	switch name {
-	case message.FieldBody:
-		m.ResetBody()
+	case ephemeralmessage.FieldKind:
+		m.ResetKind()
 		return nil
-	case message.FieldCreatedAt:
-		m.ResetCreatedAt()
+	case ephemeralmessage.FieldBody:
+		m.ResetBody()
 		return nil
-	case message.FieldEditedAt:
-		m.ResetEditedAt()
+	case ephemeralmessage.FieldPayload:
+		m.ResetPayload()
 		return nil
-	case message.FieldDeletedAt:
-		m.ResetDeletedAt()
+	case ephemeralmessage.FieldCreatedAt:
+		m.ResetCreatedAt()
 		return nil
-	case message.FieldDeletedBy:
-		m.ResetDeletedBy()
+	case ephemeralmessage.FieldExpiresAt:
+		m.ResetExpiresAt()
 		return nil
 	}
-	return fmt.Errorf("unknown Message field %s", name)
+	return fmt.Errorf("unknown EphemeralMessage field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *MessageMutation) AddedEdges() []string {
-	edges := make([]string, 0, 12)
+func (m *EphemeralMessageMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
 	if m.channel != nil {
-		edges = append(edges, message.EdgeChannel)
-	}
-	if m.user != nil {
-		edges = append(edges, message.EdgeUser)
-	}
-	if m.parent != nil {
-		edges = append(edges, message.EdgeParent)
-	}
-	if m.replies != nil {
-		edges = append(edges, message.EdgeReplies)
-	}
-	if m.reactions != nil {
-		edges = append(edges, message.EdgeReactions)
-	}
-	if m.bookmarks != nil {
-		edges = append(edges, message.EdgeBookmarks)
-	}
-	if m.user_mentions != nil {
-		edges = append(edges, message.EdgeUserMentions)
-	}
-	if m.group_mentions != nil {
-		edges = append(edges, message.EdgeGroupMentions)
-	}
-	if m.links != nil {
-		edges = append(edges, message.EdgeLinks)
-	}
-	if m.attachments != nil {
-		edges = append(edges, message.EdgeAttachments)
-	}
-	if m.user_thread_follows != nil {
-		edges = append(edges, message.EdgeUserThreadFollows)
+		edges = append(edges, ephemeralmessage.EdgeChannel)
 	}
-	if m.thread_read_states != nil {
-		edges = append(edges, message.EdgeThreadReadStates)
+	if m.recipient != nil {
+		edges = append(edges, ephemeralmessage.EdgeRecipient)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *MessageMutation) AddedIDs(name string) []ent.Value {
+func (m *EphemeralMessageMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case message.EdgeChannel:
+	case ephemeralmessage.EdgeChannel:
 		if id := m.channel; id != nil {
 			return []ent.Value{*id}
 		}
-	case message.EdgeUser:
-		if id := m.user; id != nil {
-			return []ent.Value{*id}
-		}
-	case message.EdgeParent:
-		if id := m.parent; id != nil {
+	case ephemeralmessage.EdgeRecipient:
+		if id := m.recipient; id != nil {
 			return []ent.Value{*id}
 		}
-	case message.EdgeReplies:
-		ids := make([]ent.Value, 0, len(m.replies))
-		for id := range m.replies {
-			ids = append(ids, id)
-		}
-		return ids
-	case message.EdgeReactions:
-		ids := make([]ent.Value, 0, len(m.reactions))
-		for id := range m.reactions {
-			ids = append(ids, id)
-		}
-		return ids
-	case message.EdgeBookmarks:
-		ids := make([]ent.Value, 0, len(m.bookmarks))
-		for id := range m.bookmarks {
-			ids = append(ids, id)
-		}
-		return ids
-	case message.EdgeUserMentions:
-		ids := make([]ent.Value, 0, len(m.user_mentions))
-		for id := range m.user_mentions {
-			ids = append(ids, id)
-		}
-		return ids
-	case message.EdgeGroupMentions:
-		ids := make([]ent.Value, 0, len(m.group_mentions))
-		for id := range m.group_mentions {
-			ids = append(ids, id)
-		}
-		return ids
-	case message.EdgeLinks:
-		ids := make([]ent.Value, 0, len(m.links))
-		for id := range m.links {
-			ids = append(ids, id)
-		}
-		return ids
-	case message.EdgeAttachments:
-		ids := make([]ent.Value, 0, len(m.attachments))
-		for id := range m.attachments {
-			ids = append(ids, id)
-		}
-		return ids
-	case message.EdgeUserThreadFollows:
-		ids := make([]ent.Value, 0, len(m.user_thread_follows))
-		for id := range m.user_thread_follows {
-			ids = append(ids, id)
-		}
-		return ids
-	case message.EdgeThreadReadStates:
-		ids := make([]ent.Value, 0, len(m.thread_read_states))
-		for id := range m.thread_read_states {
-			ids = append(ids, id)
-		}
-		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *MessageMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 12)
-	if m.removedreplies != nil {
-		edges = append(edges, message.EdgeReplies)
-	}
-	if m.removedreactions != nil {
-		edges = append(edges, message.EdgeReactions)
-	}
-	if m.removedbookmarks != nil {
-		edges = append(edges, message.EdgeBookmarks)
+func (m *EphemeralMessageMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *EphemeralMessageMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *EphemeralMessageMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedchannel {
+		edges = append(edges, ephemeralmessage.EdgeChannel)
 	}
-	if m.removeduser_mentions != nil {
-		edges = append(edges, message.EdgeUserMentions)
+	if m.clearedrecipient {
+		edges = append(edges, ephemeralmessage.EdgeRecipient)
 	}
-	if m.removedgroup_mentions != nil {
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *EphemeralMessageMutation) EdgeCleared(name string) bool {
+	switch name {
+	case ephemeralmessage.EdgeChannel:
+		return m.clearedchannel
+	case ephemeralmessage.EdgeRecipient:
+		return m.clearedrecipient
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *EphemeralMessageMutation) ClearEdge(name string) error {
+	switch name {
+	case ephemeralmessage.EdgeChannel:
+		m.ClearChannel()
+		return nil
+	case ephemeralmessage.EdgeRecipient:
+		m.ClearRecipient()
+		return nil
+	}
+	return fmt.Errorf("unknown EphemeralMessage unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *EphemeralMessageMutation) ResetEdge(name string) error {
+	switch name {
+	case ephemeralmessage.EdgeChannel:
+		m.ResetChannel()
+		return nil
+	case ephemeralmessage.EdgeRecipient:
+		m.ResetRecipient()
+		return nil
+	}
+	return fmt.Errorf("unknown EphemeralMessage edge %s", name)
+}
+
+// MessageMutation represents an operation that mutates the Message nodes in the graph.
+type MessageMutation struct {
+	config
+	op                         Op
+	typ                        string
+	id                         *uuid.UUID
+	body                       *string
+	body_ast                   *string
+	created_at                 *time.Time
+	edited_at                  *time.Time
+	deleted_at                 *time.Time
+	deleted_by                 *uuid.UUID
+	client_msg_id              *string
+	revision_count             *int
+	addrevision_count          *int
+	clearedFields              map[string]struct{}
+	channel                    *uuid.UUID
+	clearedchannel             bool
+	user                       *uuid.UUID
+	cleareduser                bool
+	parent                     *uuid.UUID
+	clearedparent              bool
+	replies                    map[uuid.UUID]struct{}
+	removedreplies             map[uuid.UUID]struct{}
+	clearedreplies             bool
+	reactions                  map[uuid.UUID]struct{}
+	removedreactions           map[uuid.UUID]struct{}
+	clearedreactions           bool
+	bookmarks                  map[uuid.UUID]struct{}
+	removedbookmarks           map[uuid.UUID]struct{}
+	clearedbookmarks           bool
+	user_mentions              map[uuid.UUID]struct{}
+	removeduser_mentions       map[uuid.UUID]struct{}
+	cleareduser_mentions       bool
+	group_mentions             map[uuid.UUID]struct{}
+	removedgroup_mentions      map[uuid.UUID]struct{}
+	clearedgroup_mentions      bool
+	links                      map[uuid.UUID]struct{}
+	removedlinks               map[uuid.UUID]struct{}
+	clearedlinks               bool
+	attachments                map[uuid.UUID]struct{}
+	removedattachments         map[uuid.UUID]struct{}
+	clearedattachments         bool
+	revisions                  map[uuid.UUID]struct{}
+	removedrevisions           map[uuid.UUID]struct{}
+	clearedrevisions           bool
+	shares                     map[uuid.UUID]struct{}
+	removedshares              map[uuid.UUID]struct{}
+	clearedshares              bool
+	shared_as                  map[uuid.UUID]struct{}
+	removedshared_as           map[uuid.UUID]struct{}
+	clearedshared_as           bool
+	user_thread_follows        map[uuid.UUID]struct{}
+	removeduser_thread_follows map[uuid.UUID]struct{}
+	cleareduser_thread_follows bool
+	thread_read_states         map[uuid.UUID]struct{}
+	removedthread_read_states  map[uuid.UUID]struct{}
+	clearedthread_read_states  bool
+	done                       bool
+	oldValue                   func(context.Context) (*Message, error)
+	predicates                 []predicate.Message
+}
+
+var _ ent.Mutation = (*MessageMutation)(nil)
+
+// messageOption allows management of the mutation configuration using functional options.
+type messageOption func(*MessageMutation)
+
+// newMessageMutation creates new mutation for the Message entity.
+func newMessageMutation(c config, op Op, opts ...messageOption) *MessageMutation {
+	m := &MessageMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeMessage,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withMessageID sets the ID field of the mutation.
+func withMessageID(id uuid.UUID) messageOption {
+	return func(m *MessageMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Message
+		)
+		m.oldValue = func(ctx context.Context) (*Message, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Message.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withMessage sets the old Message of the mutation.
+func withMessage(node *Message) messageOption {
+	return func(m *MessageMutation) {
+		m.oldValue = func(context.Context) (*Message, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m MessageMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m MessageMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Message entities.
+func (m *MessageMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *MessageMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *MessageMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Message.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetBody sets the "body" field.
+func (m *MessageMutation) SetBody(s string) {
+	m.body = &s
+}
+
+// Body returns the value of the "body" field in the mutation.
+func (m *MessageMutation) Body() (r string, exists bool) {
+	v := m.body
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBody returns the old "body" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageMutation) OldBody(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBody is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBody requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBody: %w", err)
+	}
+	return oldValue.Body, nil
+}
+
+// ResetBody resets all changes to the "body" field.
+func (m *MessageMutation) ResetBody() {
+	m.body = nil
+}
+
+// SetBodyAst sets the "body_ast" field.
+func (m *MessageMutation) SetBodyAst(s string) {
+	m.body_ast = &s
+}
+
+// BodyAst returns the value of the "body_ast" field in the mutation.
+func (m *MessageMutation) BodyAst() (r string, exists bool) {
+	v := m.body_ast
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBodyAst returns the old "body_ast" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageMutation) OldBodyAst(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBodyAst is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBodyAst requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBodyAst: %w", err)
+	}
+	return oldValue.BodyAst, nil
+}
+
+// ClearBodyAst clears the value of the "body_ast" field.
+func (m *MessageMutation) ClearBodyAst() {
+	m.body_ast = nil
+	m.clearedFields[message.FieldBodyAst] = struct{}{}
+}
+
+// BodyAstCleared returns if the "body_ast" field was cleared in this mutation.
+func (m *MessageMutation) BodyAstCleared() bool {
+	_, ok := m.clearedFields[message.FieldBodyAst]
+	return ok
+}
+
+// ResetBodyAst resets all changes to the "body_ast" field.
+func (m *MessageMutation) ResetBodyAst() {
+	m.body_ast = nil
+	delete(m.clearedFields, message.FieldBodyAst)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *MessageMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *MessageMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *MessageMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetEditedAt sets the "edited_at" field.
+func (m *MessageMutation) SetEditedAt(t time.Time) {
+	m.edited_at = &t
+}
+
+// EditedAt returns the value of the "edited_at" field in the mutation.
+func (m *MessageMutation) EditedAt() (r time.Time, exists bool) {
+	v := m.edited_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEditedAt returns the old "edited_at" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageMutation) OldEditedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEditedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEditedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEditedAt: %w", err)
+	}
+	return oldValue.EditedAt, nil
+}
+
+// ClearEditedAt clears the value of the "edited_at" field.
+func (m *MessageMutation) ClearEditedAt() {
+	m.edited_at = nil
+	m.clearedFields[message.FieldEditedAt] = struct{}{}
+}
+
+// EditedAtCleared returns if the "edited_at" field was cleared in this mutation.
+func (m *MessageMutation) EditedAtCleared() bool {
+	_, ok := m.clearedFields[message.FieldEditedAt]
+	return ok
+}
+
+// ResetEditedAt resets all changes to the "edited_at" field.
+func (m *MessageMutation) ResetEditedAt() {
+	m.edited_at = nil
+	delete(m.clearedFields, message.FieldEditedAt)
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (m *MessageMutation) SetDeletedAt(t time.Time) {
+	m.deleted_at = &t
+}
+
+// DeletedAt returns the value of the "deleted_at" field in the mutation.
+func (m *MessageMutation) DeletedAt() (r time.Time, exists bool) {
+	v := m.deleted_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeletedAt returns the old "deleted_at" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageMutation) OldDeletedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeletedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeletedAt: %w", err)
+	}
+	return oldValue.DeletedAt, nil
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (m *MessageMutation) ClearDeletedAt() {
+	m.deleted_at = nil
+	m.clearedFields[message.FieldDeletedAt] = struct{}{}
+}
+
+// DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
+func (m *MessageMutation) DeletedAtCleared() bool {
+	_, ok := m.clearedFields[message.FieldDeletedAt]
+	return ok
+}
+
+// ResetDeletedAt resets all changes to the "deleted_at" field.
+func (m *MessageMutation) ResetDeletedAt() {
+	m.deleted_at = nil
+	delete(m.clearedFields, message.FieldDeletedAt)
+}
+
+// SetDeletedBy sets the "deleted_by" field.
+func (m *MessageMutation) SetDeletedBy(u uuid.UUID) {
+	m.deleted_by = &u
+}
+
+// DeletedBy returns the value of the "deleted_by" field in the mutation.
+func (m *MessageMutation) DeletedBy() (r uuid.UUID, exists bool) {
+	v := m.deleted_by
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeletedBy returns the old "deleted_by" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageMutation) OldDeletedBy(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeletedBy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeletedBy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeletedBy: %w", err)
+	}
+	return oldValue.DeletedBy, nil
+}
+
+// ClearDeletedBy clears the value of the "deleted_by" field.
+func (m *MessageMutation) ClearDeletedBy() {
+	m.deleted_by = nil
+	m.clearedFields[message.FieldDeletedBy] = struct{}{}
+}
+
+// DeletedByCleared returns if the "deleted_by" field was cleared in this mutation.
+func (m *MessageMutation) DeletedByCleared() bool {
+	_, ok := m.clearedFields[message.FieldDeletedBy]
+	return ok
+}
+
+// ResetDeletedBy resets all changes to the "deleted_by" field.
+func (m *MessageMutation) ResetDeletedBy() {
+	m.deleted_by = nil
+	delete(m.clearedFields, message.FieldDeletedBy)
+}
+
+// SetClientMsgID sets the "client_msg_id" field.
+func (m *MessageMutation) SetClientMsgID(s string) {
+	m.client_msg_id = &s
+}
+
+// ClientMsgID returns the value of the "client_msg_id" field in the mutation.
+func (m *MessageMutation) ClientMsgID() (r string, exists bool) {
+	v := m.client_msg_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldClientMsgID returns the old "client_msg_id" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageMutation) OldClientMsgID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldClientMsgID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldClientMsgID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldClientMsgID: %w", err)
+	}
+	return oldValue.ClientMsgID, nil
+}
+
+// ClearClientMsgID clears the value of the "client_msg_id" field.
+func (m *MessageMutation) ClearClientMsgID() {
+	m.client_msg_id = nil
+	m.clearedFields[message.FieldClientMsgID] = struct{}{}
+}
+
+// ClientMsgIDCleared returns if the "client_msg_id" field was cleared in this mutation.
+func (m *MessageMutation) ClientMsgIDCleared() bool {
+	_, ok := m.clearedFields[message.FieldClientMsgID]
+	return ok
+}
+
+// ResetClientMsgID resets all changes to the "client_msg_id" field.
+func (m *MessageMutation) ResetClientMsgID() {
+	m.client_msg_id = nil
+	delete(m.clearedFields, message.FieldClientMsgID)
+}
+
+// SetRevisionCount sets the "revision_count" field.
+func (m *MessageMutation) SetRevisionCount(i int) {
+	m.revision_count = &i
+	m.addrevision_count = nil
+}
+
+// RevisionCount returns the value of the "revision_count" field in the mutation.
+func (m *MessageMutation) RevisionCount() (r int, exists bool) {
+	v := m.revision_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRevisionCount returns the old "revision_count" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageMutation) OldRevisionCount(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRevisionCount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRevisionCount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRevisionCount: %w", err)
+	}
+	return oldValue.RevisionCount, nil
+}
+
+// AddRevisionCount adds i to the "revision_count" field.
+func (m *MessageMutation) AddRevisionCount(i int) {
+	if m.addrevision_count != nil {
+		*m.addrevision_count += i
+	} else {
+		m.addrevision_count = &i
+	}
+}
+
+// AddedRevisionCount returns the value that was added to the "revision_count" field in this mutation.
+func (m *MessageMutation) AddedRevisionCount() (r int, exists bool) {
+	v := m.addrevision_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetRevisionCount resets all changes to the "revision_count" field.
+func (m *MessageMutation) ResetRevisionCount() {
+	m.revision_count = nil
+	m.addrevision_count = nil
+}
+
+// SetChannelID sets the "channel" edge to the Channel entity by id.
+func (m *MessageMutation) SetChannelID(id uuid.UUID) {
+	m.channel = &id
+}
+
+// ClearChannel clears the "channel" edge to the Channel entity.
+func (m *MessageMutation) ClearChannel() {
+	m.clearedchannel = true
+}
+
+// ChannelCleared reports if the "channel" edge to the Channel entity was cleared.
+func (m *MessageMutation) ChannelCleared() bool {
+	return m.clearedchannel
+}
+
+// ChannelID returns the "channel" edge ID in the mutation.
+func (m *MessageMutation) ChannelID() (id uuid.UUID, exists bool) {
+	if m.channel != nil {
+		return *m.channel, true
+	}
+	return
+}
+
+// ChannelIDs returns the "channel" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ChannelID instead. It exists only for internal usage by the builders.
+func (m *MessageMutation) ChannelIDs() (ids []uuid.UUID) {
+	if id := m.channel; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetChannel resets all changes to the "channel" edge.
+func (m *MessageMutation) ResetChannel() {
+	m.channel = nil
+	m.clearedchannel = false
+}
+
+// SetUserID sets the "user" edge to the User entity by id.
+func (m *MessageMutation) SetUserID(id uuid.UUID) {
+	m.user = &id
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (m *MessageMutation) ClearUser() {
+	m.cleareduser = true
+}
+
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *MessageMutation) UserCleared() bool {
+	return m.cleareduser
+}
+
+// UserID returns the "user" edge ID in the mutation.
+func (m *MessageMutation) UserID() (id uuid.UUID, exists bool) {
+	if m.user != nil {
+		return *m.user, true
+	}
+	return
+}
+
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *MessageMutation) UserIDs() (ids []uuid.UUID) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetUser resets all changes to the "user" edge.
+func (m *MessageMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
+}
+
+// SetParentID sets the "parent" edge to the Message entity by id.
+func (m *MessageMutation) SetParentID(id uuid.UUID) {
+	m.parent = &id
+}
+
+// ClearParent clears the "parent" edge to the Message entity.
+func (m *MessageMutation) ClearParent() {
+	m.clearedparent = true
+}
+
+// ParentCleared reports if the "parent" edge to the Message entity was cleared.
+func (m *MessageMutation) ParentCleared() bool {
+	return m.clearedparent
+}
+
+// ParentID returns the "parent" edge ID in the mutation.
+func (m *MessageMutation) ParentID() (id uuid.UUID, exists bool) {
+	if m.parent != nil {
+		return *m.parent, true
+	}
+	return
+}
+
+// ParentIDs returns the "parent" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ParentID instead. It exists only for internal usage by the builders.
+func (m *MessageMutation) ParentIDs() (ids []uuid.UUID) {
+	if id := m.parent; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetParent resets all changes to the "parent" edge.
+func (m *MessageMutation) ResetParent() {
+	m.parent = nil
+	m.clearedparent = false
+}
+
+// AddReplyIDs adds the "replies" edge to the Message entity by ids.
+func (m *MessageMutation) AddReplyIDs(ids ...uuid.UUID) {
+	if m.replies == nil {
+		m.replies = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.replies[ids[i]] = struct{}{}
+	}
+}
+
+// ClearReplies clears the "replies" edge to the Message entity.
+func (m *MessageMutation) ClearReplies() {
+	m.clearedreplies = true
+}
+
+// RepliesCleared reports if the "replies" edge to the Message entity was cleared.
+func (m *MessageMutation) RepliesCleared() bool {
+	return m.clearedreplies
+}
+
+// RemoveReplyIDs removes the "replies" edge to the Message entity by IDs.
+func (m *MessageMutation) RemoveReplyIDs(ids ...uuid.UUID) {
+	if m.removedreplies == nil {
+		m.removedreplies = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.replies, ids[i])
+		m.removedreplies[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedReplies returns the removed IDs of the "replies" edge to the Message entity.
+func (m *MessageMutation) RemovedRepliesIDs() (ids []uuid.UUID) {
+	for id := range m.removedreplies {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// RepliesIDs returns the "replies" edge IDs in the mutation.
+func (m *MessageMutation) RepliesIDs() (ids []uuid.UUID) {
+	for id := range m.replies {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetReplies resets all changes to the "replies" edge.
+func (m *MessageMutation) ResetReplies() {
+	m.replies = nil
+	m.clearedreplies = false
+	m.removedreplies = nil
+}
+
+// AddReactionIDs adds the "reactions" edge to the MessageReaction entity by ids.
+func (m *MessageMutation) AddReactionIDs(ids ...uuid.UUID) {
+	if m.reactions == nil {
+		m.reactions = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.reactions[ids[i]] = struct{}{}
+	}
+}
+
+// ClearReactions clears the "reactions" edge to the MessageReaction entity.
+func (m *MessageMutation) ClearReactions() {
+	m.clearedreactions = true
+}
+
+// ReactionsCleared reports if the "reactions" edge to the MessageReaction entity was cleared.
+func (m *MessageMutation) ReactionsCleared() bool {
+	return m.clearedreactions
+}
+
+// RemoveReactionIDs removes the "reactions" edge to the MessageReaction entity by IDs.
+func (m *MessageMutation) RemoveReactionIDs(ids ...uuid.UUID) {
+	if m.removedreactions == nil {
+		m.removedreactions = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.reactions, ids[i])
+		m.removedreactions[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedReactions returns the removed IDs of the "reactions" edge to the MessageReaction entity.
+func (m *MessageMutation) RemovedReactionsIDs() (ids []uuid.UUID) {
+	for id := range m.removedreactions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ReactionsIDs returns the "reactions" edge IDs in the mutation.
+func (m *MessageMutation) ReactionsIDs() (ids []uuid.UUID) {
+	for id := range m.reactions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetReactions resets all changes to the "reactions" edge.
+func (m *MessageMutation) ResetReactions() {
+	m.reactions = nil
+	m.clearedreactions = false
+	m.removedreactions = nil
+}
+
+// AddBookmarkIDs adds the "bookmarks" edge to the MessageBookmark entity by ids.
+func (m *MessageMutation) AddBookmarkIDs(ids ...uuid.UUID) {
+	if m.bookmarks == nil {
+		m.bookmarks = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.bookmarks[ids[i]] = struct{}{}
+	}
+}
+
+// ClearBookmarks clears the "bookmarks" edge to the MessageBookmark entity.
+func (m *MessageMutation) ClearBookmarks() {
+	m.clearedbookmarks = true
+}
+
+// BookmarksCleared reports if the "bookmarks" edge to the MessageBookmark entity was cleared.
+func (m *MessageMutation) BookmarksCleared() bool {
+	return m.clearedbookmarks
+}
+
+// RemoveBookmarkIDs removes the "bookmarks" edge to the MessageBookmark entity by IDs.
+func (m *MessageMutation) RemoveBookmarkIDs(ids ...uuid.UUID) {
+	if m.removedbookmarks == nil {
+		m.removedbookmarks = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.bookmarks, ids[i])
+		m.removedbookmarks[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedBookmarks returns the removed IDs of the "bookmarks" edge to the MessageBookmark entity.
+func (m *MessageMutation) RemovedBookmarksIDs() (ids []uuid.UUID) {
+	for id := range m.removedbookmarks {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// BookmarksIDs returns the "bookmarks" edge IDs in the mutation.
+func (m *MessageMutation) BookmarksIDs() (ids []uuid.UUID) {
+	for id := range m.bookmarks {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetBookmarks resets all changes to the "bookmarks" edge.
+func (m *MessageMutation) ResetBookmarks() {
+	m.bookmarks = nil
+	m.clearedbookmarks = false
+	m.removedbookmarks = nil
+}
+
+// AddUserMentionIDs adds the "user_mentions" edge to the MessageUserMention entity by ids.
+func (m *MessageMutation) AddUserMentionIDs(ids ...uuid.UUID) {
+	if m.user_mentions == nil {
+		m.user_mentions = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.user_mentions[ids[i]] = struct{}{}
+	}
+}
+
+// ClearUserMentions clears the "user_mentions" edge to the MessageUserMention entity.
+func (m *MessageMutation) ClearUserMentions() {
+	m.cleareduser_mentions = true
+}
+
+// UserMentionsCleared reports if the "user_mentions" edge to the MessageUserMention entity was cleared.
+func (m *MessageMutation) UserMentionsCleared() bool {
+	return m.cleareduser_mentions
+}
+
+// RemoveUserMentionIDs removes the "user_mentions" edge to the MessageUserMention entity by IDs.
+func (m *MessageMutation) RemoveUserMentionIDs(ids ...uuid.UUID) {
+	if m.removeduser_mentions == nil {
+		m.removeduser_mentions = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.user_mentions, ids[i])
+		m.removeduser_mentions[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedUserMentions returns the removed IDs of the "user_mentions" edge to the MessageUserMention entity.
+func (m *MessageMutation) RemovedUserMentionsIDs() (ids []uuid.UUID) {
+	for id := range m.removeduser_mentions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// UserMentionsIDs returns the "user_mentions" edge IDs in the mutation.
+func (m *MessageMutation) UserMentionsIDs() (ids []uuid.UUID) {
+	for id := range m.user_mentions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetUserMentions resets all changes to the "user_mentions" edge.
+func (m *MessageMutation) ResetUserMentions() {
+	m.user_mentions = nil
+	m.cleareduser_mentions = false
+	m.removeduser_mentions = nil
+}
+
+// AddGroupMentionIDs adds the "group_mentions" edge to the MessageGroupMention entity by ids.
+func (m *MessageMutation) AddGroupMentionIDs(ids ...uuid.UUID) {
+	if m.group_mentions == nil {
+		m.group_mentions = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.group_mentions[ids[i]] = struct{}{}
+	}
+}
+
+// ClearGroupMentions clears the "group_mentions" edge to the MessageGroupMention entity.
+func (m *MessageMutation) ClearGroupMentions() {
+	m.clearedgroup_mentions = true
+}
+
+// GroupMentionsCleared reports if the "group_mentions" edge to the MessageGroupMention entity was cleared.
+func (m *MessageMutation) GroupMentionsCleared() bool {
+	return m.clearedgroup_mentions
+}
+
+// RemoveGroupMentionIDs removes the "group_mentions" edge to the MessageGroupMention entity by IDs.
+func (m *MessageMutation) RemoveGroupMentionIDs(ids ...uuid.UUID) {
+	if m.removedgroup_mentions == nil {
+		m.removedgroup_mentions = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.group_mentions, ids[i])
+		m.removedgroup_mentions[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedGroupMentions returns the removed IDs of the "group_mentions" edge to the MessageGroupMention entity.
+func (m *MessageMutation) RemovedGroupMentionsIDs() (ids []uuid.UUID) {
+	for id := range m.removedgroup_mentions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// GroupMentionsIDs returns the "group_mentions" edge IDs in the mutation.
+func (m *MessageMutation) GroupMentionsIDs() (ids []uuid.UUID) {
+	for id := range m.group_mentions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetGroupMentions resets all changes to the "group_mentions" edge.
+func (m *MessageMutation) ResetGroupMentions() {
+	m.group_mentions = nil
+	m.clearedgroup_mentions = false
+	m.removedgroup_mentions = nil
+}
+
+// AddLinkIDs adds the "links" edge to the MessageLink entity by ids.
+func (m *MessageMutation) AddLinkIDs(ids ...uuid.UUID) {
+	if m.links == nil {
+		m.links = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.links[ids[i]] = struct{}{}
+	}
+}
+
+// ClearLinks clears the "links" edge to the MessageLink entity.
+func (m *MessageMutation) ClearLinks() {
+	m.clearedlinks = true
+}
+
+// LinksCleared reports if the "links" edge to the MessageLink entity was cleared.
+func (m *MessageMutation) LinksCleared() bool {
+	return m.clearedlinks
+}
+
+// RemoveLinkIDs removes the "links" edge to the MessageLink entity by IDs.
+func (m *MessageMutation) RemoveLinkIDs(ids ...uuid.UUID) {
+	if m.removedlinks == nil {
+		m.removedlinks = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.links, ids[i])
+		m.removedlinks[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedLinks returns the removed IDs of the "links" edge to the MessageLink entity.
+func (m *MessageMutation) RemovedLinksIDs() (ids []uuid.UUID) {
+	for id := range m.removedlinks {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// LinksIDs returns the "links" edge IDs in the mutation.
+func (m *MessageMutation) LinksIDs() (ids []uuid.UUID) {
+	for id := range m.links {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetLinks resets all changes to the "links" edge.
+func (m *MessageMutation) ResetLinks() {
+	m.links = nil
+	m.clearedlinks = false
+	m.removedlinks = nil
+}
+
+// AddAttachmentIDs adds the "attachments" edge to the Attachment entity by ids.
+func (m *MessageMutation) AddAttachmentIDs(ids ...uuid.UUID) {
+	if m.attachments == nil {
+		m.attachments = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.attachments[ids[i]] = struct{}{}
+	}
+}
+
+// ClearAttachments clears the "attachments" edge to the Attachment entity.
+func (m *MessageMutation) ClearAttachments() {
+	m.clearedattachments = true
+}
+
+// AttachmentsCleared reports if the "attachments" edge to the Attachment entity was cleared.
+func (m *MessageMutation) AttachmentsCleared() bool {
+	return m.clearedattachments
+}
+
+// RemoveAttachmentIDs removes the "attachments" edge to the Attachment entity by IDs.
+func (m *MessageMutation) RemoveAttachmentIDs(ids ...uuid.UUID) {
+	if m.removedattachments == nil {
+		m.removedattachments = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.attachments, ids[i])
+		m.removedattachments[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedAttachments returns the removed IDs of the "attachments" edge to the Attachment entity.
+func (m *MessageMutation) RemovedAttachmentsIDs() (ids []uuid.UUID) {
+	for id := range m.removedattachments {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// AttachmentsIDs returns the "attachments" edge IDs in the mutation.
+func (m *MessageMutation) AttachmentsIDs() (ids []uuid.UUID) {
+	for id := range m.attachments {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetAttachments resets all changes to the "attachments" edge.
+func (m *MessageMutation) ResetAttachments() {
+	m.attachments = nil
+	m.clearedattachments = false
+	m.removedattachments = nil
+}
+
+// AddRevisionIDs adds the "revisions" edge to the MessageRevision entity by ids.
+func (m *MessageMutation) AddRevisionIDs(ids ...uuid.UUID) {
+	if m.revisions == nil {
+		m.revisions = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.revisions[ids[i]] = struct{}{}
+	}
+}
+
+// ClearRevisions clears the "revisions" edge to the MessageRevision entity.
+func (m *MessageMutation) ClearRevisions() {
+	m.clearedrevisions = true
+}
+
+// RevisionsCleared reports if the "revisions" edge to the MessageRevision entity was cleared.
+func (m *MessageMutation) RevisionsCleared() bool {
+	return m.clearedrevisions
+}
+
+// RemoveRevisionIDs removes the "revisions" edge to the MessageRevision entity by IDs.
+func (m *MessageMutation) RemoveRevisionIDs(ids ...uuid.UUID) {
+	if m.removedrevisions == nil {
+		m.removedrevisions = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.revisions, ids[i])
+		m.removedrevisions[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedRevisions returns the removed IDs of the "revisions" edge to the MessageRevision entity.
+func (m *MessageMutation) RemovedRevisionsIDs() (ids []uuid.UUID) {
+	for id := range m.removedrevisions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// RevisionsIDs returns the "revisions" edge IDs in the mutation.
+func (m *MessageMutation) RevisionsIDs() (ids []uuid.UUID) {
+	for id := range m.revisions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetRevisions resets all changes to the "revisions" edge.
+func (m *MessageMutation) ResetRevisions() {
+	m.revisions = nil
+	m.clearedrevisions = false
+	m.removedrevisions = nil
+}
+
+// AddShareIDs adds the "shares" edge to the MessageShare entity by ids.
+func (m *MessageMutation) AddShareIDs(ids ...uuid.UUID) {
+	if m.shares == nil {
+		m.shares = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.shares[ids[i]] = struct{}{}
+	}
+}
+
+// ClearShares clears the "shares" edge to the MessageShare entity.
+func (m *MessageMutation) ClearShares() {
+	m.clearedshares = true
+}
+
+// SharesCleared reports if the "shares" edge to the MessageShare entity was cleared.
+func (m *MessageMutation) SharesCleared() bool {
+	return m.clearedshares
+}
+
+// RemoveShareIDs removes the "shares" edge to the MessageShare entity by IDs.
+func (m *MessageMutation) RemoveShareIDs(ids ...uuid.UUID) {
+	if m.removedshares == nil {
+		m.removedshares = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.shares, ids[i])
+		m.removedshares[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedShares returns the removed IDs of the "shares" edge to the MessageShare entity.
+func (m *MessageMutation) RemovedSharesIDs() (ids []uuid.UUID) {
+	for id := range m.removedshares {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// SharesIDs returns the "shares" edge IDs in the mutation.
+func (m *MessageMutation) SharesIDs() (ids []uuid.UUID) {
+	for id := range m.shares {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetShares resets all changes to the "shares" edge.
+func (m *MessageMutation) ResetShares() {
+	m.shares = nil
+	m.clearedshares = false
+	m.removedshares = nil
+}
+
+// AddSharedAIDs adds the "shared_as" edge to the MessageShare entity by ids.
+func (m *MessageMutation) AddSharedAIDs(ids ...uuid.UUID) {
+	if m.shared_as == nil {
+		m.shared_as = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.shared_as[ids[i]] = struct{}{}
+	}
+}
+
+// ClearSharedAs clears the "shared_as" edge to the MessageShare entity.
+func (m *MessageMutation) ClearSharedAs() {
+	m.clearedshared_as = true
+}
+
+// SharedAsCleared reports if the "shared_as" edge to the MessageShare entity was cleared.
+func (m *MessageMutation) SharedAsCleared() bool {
+	return m.clearedshared_as
+}
+
+// RemoveSharedAIDs removes the "shared_as" edge to the MessageShare entity by IDs.
+func (m *MessageMutation) RemoveSharedAIDs(ids ...uuid.UUID) {
+	if m.removedshared_as == nil {
+		m.removedshared_as = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.shared_as, ids[i])
+		m.removedshared_as[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedSharedAs returns the removed IDs of the "shared_as" edge to the MessageShare entity.
+func (m *MessageMutation) RemovedSharedAsIDs() (ids []uuid.UUID) {
+	for id := range m.removedshared_as {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// SharedAsIDs returns the "shared_as" edge IDs in the mutation.
+func (m *MessageMutation) SharedAsIDs() (ids []uuid.UUID) {
+	for id := range m.shared_as {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetSharedAs resets all changes to the "shared_as" edge.
+func (m *MessageMutation) ResetSharedAs() {
+	m.shared_as = nil
+	m.clearedshared_as = false
+	m.removedshared_as = nil
+}
+
+// AddUserThreadFollowIDs adds the "user_thread_follows" edge to the UserThreadFollow entity by ids.
+func (m *MessageMutation) AddUserThreadFollowIDs(ids ...uuid.UUID) {
+	if m.user_thread_follows == nil {
+		m.user_thread_follows = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.user_thread_follows[ids[i]] = struct{}{}
+	}
+}
+
+// ClearUserThreadFollows clears the "user_thread_follows" edge to the UserThreadFollow entity.
+func (m *MessageMutation) ClearUserThreadFollows() {
+	m.cleareduser_thread_follows = true
+}
+
+// UserThreadFollowsCleared reports if the "user_thread_follows" edge to the UserThreadFollow entity was cleared.
+func (m *MessageMutation) UserThreadFollowsCleared() bool {
+	return m.cleareduser_thread_follows
+}
+
+// RemoveUserThreadFollowIDs removes the "user_thread_follows" edge to the UserThreadFollow entity by IDs.
+func (m *MessageMutation) RemoveUserThreadFollowIDs(ids ...uuid.UUID) {
+	if m.removeduser_thread_follows == nil {
+		m.removeduser_thread_follows = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.user_thread_follows, ids[i])
+		m.removeduser_thread_follows[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedUserThreadFollows returns the removed IDs of the "user_thread_follows" edge to the UserThreadFollow entity.
+func (m *MessageMutation) RemovedUserThreadFollowsIDs() (ids []uuid.UUID) {
+	for id := range m.removeduser_thread_follows {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// UserThreadFollowsIDs returns the "user_thread_follows" edge IDs in the mutation.
+func (m *MessageMutation) UserThreadFollowsIDs() (ids []uuid.UUID) {
+	for id := range m.user_thread_follows {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetUserThreadFollows resets all changes to the "user_thread_follows" edge.
+func (m *MessageMutation) ResetUserThreadFollows() {
+	m.user_thread_follows = nil
+	m.cleareduser_thread_follows = false
+	m.removeduser_thread_follows = nil
+}
+
+// AddThreadReadStateIDs adds the "thread_read_states" edge to the ThreadReadState entity by ids.
+func (m *MessageMutation) AddThreadReadStateIDs(ids ...uuid.UUID) {
+	if m.thread_read_states == nil {
+		m.thread_read_states = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.thread_read_states[ids[i]] = struct{}{}
+	}
+}
+
+// ClearThreadReadStates clears the "thread_read_states" edge to the ThreadReadState entity.
+func (m *MessageMutation) ClearThreadReadStates() {
+	m.clearedthread_read_states = true
+}
+
+// ThreadReadStatesCleared reports if the "thread_read_states" edge to the ThreadReadState entity was cleared.
+func (m *MessageMutation) ThreadReadStatesCleared() bool {
+	return m.clearedthread_read_states
+}
+
+// RemoveThreadReadStateIDs removes the "thread_read_states" edge to the ThreadReadState entity by IDs.
+func (m *MessageMutation) RemoveThreadReadStateIDs(ids ...uuid.UUID) {
+	if m.removedthread_read_states == nil {
+		m.removedthread_read_states = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.thread_read_states, ids[i])
+		m.removedthread_read_states[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedThreadReadStates returns the removed IDs of the "thread_read_states" edge to the ThreadReadState entity.
+func (m *MessageMutation) RemovedThreadReadStatesIDs() (ids []uuid.UUID) {
+	for id := range m.removedthread_read_states {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ThreadReadStatesIDs returns the "thread_read_states" edge IDs in the mutation.
+func (m *MessageMutation) ThreadReadStatesIDs() (ids []uuid.UUID) {
+	for id := range m.thread_read_states {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetThreadReadStates resets all changes to the "thread_read_states" edge.
+func (m *MessageMutation) ResetThreadReadStates() {
+	m.thread_read_states = nil
+	m.clearedthread_read_states = false
+	m.removedthread_read_states = nil
+}
+
+// Where appends a list predicates to the MessageMutation builder.
+func (m *MessageMutation) Where(ps ...predicate.Message) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the MessageMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *MessageMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Message, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *MessageMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *MessageMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Message).
+func (m *MessageMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *MessageMutation) Fields() []string {
+	fields := make([]string, 0, 8)
+	if m.body != nil {
+		fields = append(fields, message.FieldBody)
+	}
+	if m.body_ast != nil {
+		fields = append(fields, message.FieldBodyAst)
+	}
+	if m.created_at != nil {
+		fields = append(fields, message.FieldCreatedAt)
+	}
+	if m.edited_at != nil {
+		fields = append(fields, message.FieldEditedAt)
+	}
+	if m.deleted_at != nil {
+		fields = append(fields, message.FieldDeletedAt)
+	}
+	if m.deleted_by != nil {
+		fields = append(fields, message.FieldDeletedBy)
+	}
+	if m.client_msg_id != nil {
+		fields = append(fields, message.FieldClientMsgID)
+	}
+	if m.revision_count != nil {
+		fields = append(fields, message.FieldRevisionCount)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *MessageMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case message.FieldBody:
+		return m.Body()
+	case message.FieldBodyAst:
+		return m.BodyAst()
+	case message.FieldCreatedAt:
+		return m.CreatedAt()
+	case message.FieldEditedAt:
+		return m.EditedAt()
+	case message.FieldDeletedAt:
+		return m.DeletedAt()
+	case message.FieldDeletedBy:
+		return m.DeletedBy()
+	case message.FieldClientMsgID:
+		return m.ClientMsgID()
+	case message.FieldRevisionCount:
+		return m.RevisionCount()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *MessageMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case message.FieldBody:
+		return m.OldBody(ctx)
+	case message.FieldBodyAst:
+		return m.OldBodyAst(ctx)
+	case message.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case message.FieldEditedAt:
+		return m.OldEditedAt(ctx)
+	case message.FieldDeletedAt:
+		return m.OldDeletedAt(ctx)
+	case message.FieldDeletedBy:
+		return m.OldDeletedBy(ctx)
+	case message.FieldClientMsgID:
+		return m.OldClientMsgID(ctx)
+	case message.FieldRevisionCount:
+		return m.OldRevisionCount(ctx)
+	}
+	return nil, fmt.Errorf("unknown Message field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MessageMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case message.FieldBody:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBody(v)
+		return nil
+	case message.FieldBodyAst:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBodyAst(v)
+		return nil
+	case message.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case message.FieldEditedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEditedAt(v)
+		return nil
+	case message.FieldDeletedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeletedAt(v)
+		return nil
+	case message.FieldDeletedBy:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeletedBy(v)
+		return nil
+	case message.FieldClientMsgID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetClientMsgID(v)
+		return nil
+	case message.FieldRevisionCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRevisionCount(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Message field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *MessageMutation) AddedFields() []string {
+	var fields []string
+	if m.addrevision_count != nil {
+		fields = append(fields, message.FieldRevisionCount)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *MessageMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case message.FieldRevisionCount:
+		return m.AddedRevisionCount()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MessageMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case message.FieldRevisionCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddRevisionCount(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Message numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *MessageMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(message.FieldBodyAst) {
+		fields = append(fields, message.FieldBodyAst)
+	}
+	if m.FieldCleared(message.FieldEditedAt) {
+		fields = append(fields, message.FieldEditedAt)
+	}
+	if m.FieldCleared(message.FieldDeletedAt) {
+		fields = append(fields, message.FieldDeletedAt)
+	}
+	if m.FieldCleared(message.FieldDeletedBy) {
+		fields = append(fields, message.FieldDeletedBy)
+	}
+	if m.FieldCleared(message.FieldClientMsgID) {
+		fields = append(fields, message.FieldClientMsgID)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *MessageMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *MessageMutation) ClearField(name string) error {
+	switch name {
+	case message.FieldBodyAst:
+		m.ClearBodyAst()
+		return nil
+	case message.FieldEditedAt:
+		m.ClearEditedAt()
+		return nil
+	case message.FieldDeletedAt:
+		m.ClearDeletedAt()
+		return nil
+	case message.FieldDeletedBy:
+		m.ClearDeletedBy()
+		return nil
+	case message.FieldClientMsgID:
+		m.ClearClientMsgID()
+		return nil
+	}
+	return fmt.Errorf("unknown Message nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *MessageMutation) ResetField(name string) error {
+	switch name {
+	case message.FieldBody:
+		m.ResetBody()
+		return nil
+	case message.FieldBodyAst:
+		m.ResetBodyAst()
+		return nil
+	case message.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case message.FieldEditedAt:
+		m.ResetEditedAt()
+		return nil
+	case message.FieldDeletedAt:
+		m.ResetDeletedAt()
+		return nil
+	case message.FieldDeletedBy:
+		m.ResetDeletedBy()
+		return nil
+	case message.FieldClientMsgID:
+		m.ResetClientMsgID()
+		return nil
+	case message.FieldRevisionCount:
+		m.ResetRevisionCount()
+		return nil
+	}
+	return fmt.Errorf("unknown Message field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *MessageMutation) AddedEdges() []string {
+	edges := make([]string, 0, 15)
+	if m.channel != nil {
+		edges = append(edges, message.EdgeChannel)
+	}
+	if m.user != nil {
+		edges = append(edges, message.EdgeUser)
+	}
+	if m.parent != nil {
+		edges = append(edges, message.EdgeParent)
+	}
+	if m.replies != nil {
+		edges = append(edges, message.EdgeReplies)
+	}
+	if m.reactions != nil {
+		edges = append(edges, message.EdgeReactions)
+	}
+	if m.bookmarks != nil {
+		edges = append(edges, message.EdgeBookmarks)
+	}
+	if m.user_mentions != nil {
+		edges = append(edges, message.EdgeUserMentions)
+	}
+	if m.group_mentions != nil {
+		edges = append(edges, message.EdgeGroupMentions)
+	}
+	if m.links != nil {
+		edges = append(edges, message.EdgeLinks)
+	}
+	if m.attachments != nil {
+		edges = append(edges, message.EdgeAttachments)
+	}
+	if m.revisions != nil {
+		edges = append(edges, message.EdgeRevisions)
+	}
+	if m.shares != nil {
+		edges = append(edges, message.EdgeShares)
+	}
+	if m.shared_as != nil {
+		edges = append(edges, message.EdgeSharedAs)
+	}
+	if m.user_thread_follows != nil {
+		edges = append(edges, message.EdgeUserThreadFollows)
+	}
+	if m.thread_read_states != nil {
+		edges = append(edges, message.EdgeThreadReadStates)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *MessageMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case message.EdgeChannel:
+		if id := m.channel; id != nil {
+			return []ent.Value{*id}
+		}
+	case message.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
+		}
+	case message.EdgeParent:
+		if id := m.parent; id != nil {
+			return []ent.Value{*id}
+		}
+	case message.EdgeReplies:
+		ids := make([]ent.Value, 0, len(m.replies))
+		for id := range m.replies {
+			ids = append(ids, id)
+		}
+		return ids
+	case message.EdgeReactions:
+		ids := make([]ent.Value, 0, len(m.reactions))
+		for id := range m.reactions {
+			ids = append(ids, id)
+		}
+		return ids
+	case message.EdgeBookmarks:
+		ids := make([]ent.Value, 0, len(m.bookmarks))
+		for id := range m.bookmarks {
+			ids = append(ids, id)
+		}
+		return ids
+	case message.EdgeUserMentions:
+		ids := make([]ent.Value, 0, len(m.user_mentions))
+		for id := range m.user_mentions {
+			ids = append(ids, id)
+		}
+		return ids
+	case message.EdgeGroupMentions:
+		ids := make([]ent.Value, 0, len(m.group_mentions))
+		for id := range m.group_mentions {
+			ids = append(ids, id)
+		}
+		return ids
+	case message.EdgeLinks:
+		ids := make([]ent.Value, 0, len(m.links))
+		for id := range m.links {
+			ids = append(ids, id)
+		}
+		return ids
+	case message.EdgeAttachments:
+		ids := make([]ent.Value, 0, len(m.attachments))
+		for id := range m.attachments {
+			ids = append(ids, id)
+		}
+		return ids
+	case message.EdgeRevisions:
+		ids := make([]ent.Value, 0, len(m.revisions))
+		for id := range m.revisions {
+			ids = append(ids, id)
+		}
+		return ids
+	case message.EdgeShares:
+		ids := make([]ent.Value, 0, len(m.shares))
+		for id := range m.shares {
+			ids = append(ids, id)
+		}
+		return ids
+	case message.EdgeSharedAs:
+		ids := make([]ent.Value, 0, len(m.shared_as))
+		for id := range m.shared_as {
+			ids = append(ids, id)
+		}
+		return ids
+	case message.EdgeUserThreadFollows:
+		ids := make([]ent.Value, 0, len(m.user_thread_follows))
+		for id := range m.user_thread_follows {
+			ids = append(ids, id)
+		}
+		return ids
+	case message.EdgeThreadReadStates:
+		ids := make([]ent.Value, 0, len(m.thread_read_states))
+		for id := range m.thread_read_states {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *MessageMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 15)
+	if m.removedreplies != nil {
+		edges = append(edges, message.EdgeReplies)
+	}
+	if m.removedreactions != nil {
+		edges = append(edges, message.EdgeReactions)
+	}
+	if m.removedbookmarks != nil {
+		edges = append(edges, message.EdgeBookmarks)
+	}
+	if m.removeduser_mentions != nil {
+		edges = append(edges, message.EdgeUserMentions)
+	}
+	if m.removedgroup_mentions != nil {
+		edges = append(edges, message.EdgeGroupMentions)
+	}
+	if m.removedlinks != nil {
+		edges = append(edges, message.EdgeLinks)
+	}
+	if m.removedattachments != nil {
+		edges = append(edges, message.EdgeAttachments)
+	}
+	if m.removedrevisions != nil {
+		edges = append(edges, message.EdgeRevisions)
+	}
+	if m.removedshares != nil {
+		edges = append(edges, message.EdgeShares)
+	}
+	if m.removedshared_as != nil {
+		edges = append(edges, message.EdgeSharedAs)
+	}
+	if m.removeduser_thread_follows != nil {
+		edges = append(edges, message.EdgeUserThreadFollows)
+	}
+	if m.removedthread_read_states != nil {
+		edges = append(edges, message.EdgeThreadReadStates)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *MessageMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case message.EdgeReplies:
+		ids := make([]ent.Value, 0, len(m.removedreplies))
+		for id := range m.removedreplies {
+			ids = append(ids, id)
+		}
+		return ids
+	case message.EdgeReactions:
+		ids := make([]ent.Value, 0, len(m.removedreactions))
+		for id := range m.removedreactions {
+			ids = append(ids, id)
+		}
+		return ids
+	case message.EdgeBookmarks:
+		ids := make([]ent.Value, 0, len(m.removedbookmarks))
+		for id := range m.removedbookmarks {
+			ids = append(ids, id)
+		}
+		return ids
+	case message.EdgeUserMentions:
+		ids := make([]ent.Value, 0, len(m.removeduser_mentions))
+		for id := range m.removeduser_mentions {
+			ids = append(ids, id)
+		}
+		return ids
+	case message.EdgeGroupMentions:
+		ids := make([]ent.Value, 0, len(m.removedgroup_mentions))
+		for id := range m.removedgroup_mentions {
+			ids = append(ids, id)
+		}
+		return ids
+	case message.EdgeLinks:
+		ids := make([]ent.Value, 0, len(m.removedlinks))
+		for id := range m.removedlinks {
+			ids = append(ids, id)
+		}
+		return ids
+	case message.EdgeAttachments:
+		ids := make([]ent.Value, 0, len(m.removedattachments))
+		for id := range m.removedattachments {
+			ids = append(ids, id)
+		}
+		return ids
+	case message.EdgeRevisions:
+		ids := make([]ent.Value, 0, len(m.removedrevisions))
+		for id := range m.removedrevisions {
+			ids = append(ids, id)
+		}
+		return ids
+	case message.EdgeShares:
+		ids := make([]ent.Value, 0, len(m.removedshares))
+		for id := range m.removedshares {
+			ids = append(ids, id)
+		}
+		return ids
+	case message.EdgeSharedAs:
+		ids := make([]ent.Value, 0, len(m.removedshared_as))
+		for id := range m.removedshared_as {
+			ids = append(ids, id)
+		}
+		return ids
+	case message.EdgeUserThreadFollows:
+		ids := make([]ent.Value, 0, len(m.removeduser_thread_follows))
+		for id := range m.removeduser_thread_follows {
+			ids = append(ids, id)
+		}
+		return ids
+	case message.EdgeThreadReadStates:
+		ids := make([]ent.Value, 0, len(m.removedthread_read_states))
+		for id := range m.removedthread_read_states {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *MessageMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 15)
+	if m.clearedchannel {
+		edges = append(edges, message.EdgeChannel)
+	}
+	if m.cleareduser {
+		edges = append(edges, message.EdgeUser)
+	}
+	if m.clearedparent {
+		edges = append(edges, message.EdgeParent)
+	}
+	if m.clearedreplies {
+		edges = append(edges, message.EdgeReplies)
+	}
+	if m.clearedreactions {
+		edges = append(edges, message.EdgeReactions)
+	}
+	if m.clearedbookmarks {
+		edges = append(edges, message.EdgeBookmarks)
+	}
+	if m.cleareduser_mentions {
+		edges = append(edges, message.EdgeUserMentions)
+	}
+	if m.clearedgroup_mentions {
 		edges = append(edges, message.EdgeGroupMentions)
 	}
-	if m.removedlinks != nil {
-		edges = append(edges, message.EdgeLinks)
+	if m.clearedlinks {
+		edges = append(edges, message.EdgeLinks)
+	}
+	if m.clearedattachments {
+		edges = append(edges, message.EdgeAttachments)
+	}
+	if m.clearedrevisions {
+		edges = append(edges, message.EdgeRevisions)
+	}
+	if m.clearedshares {
+		edges = append(edges, message.EdgeShares)
+	}
+	if m.clearedshared_as {
+		edges = append(edges, message.EdgeSharedAs)
+	}
+	if m.cleareduser_thread_follows {
+		edges = append(edges, message.EdgeUserThreadFollows)
+	}
+	if m.clearedthread_read_states {
+		edges = append(edges, message.EdgeThreadReadStates)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *MessageMutation) EdgeCleared(name string) bool {
+	switch name {
+	case message.EdgeChannel:
+		return m.clearedchannel
+	case message.EdgeUser:
+		return m.cleareduser
+	case message.EdgeParent:
+		return m.clearedparent
+	case message.EdgeReplies:
+		return m.clearedreplies
+	case message.EdgeReactions:
+		return m.clearedreactions
+	case message.EdgeBookmarks:
+		return m.clearedbookmarks
+	case message.EdgeUserMentions:
+		return m.cleareduser_mentions
+	case message.EdgeGroupMentions:
+		return m.clearedgroup_mentions
+	case message.EdgeLinks:
+		return m.clearedlinks
+	case message.EdgeAttachments:
+		return m.clearedattachments
+	case message.EdgeRevisions:
+		return m.clearedrevisions
+	case message.EdgeShares:
+		return m.clearedshares
+	case message.EdgeSharedAs:
+		return m.clearedshared_as
+	case message.EdgeUserThreadFollows:
+		return m.cleareduser_thread_follows
+	case message.EdgeThreadReadStates:
+		return m.clearedthread_read_states
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *MessageMutation) ClearEdge(name string) error {
+	switch name {
+	case message.EdgeChannel:
+		m.ClearChannel()
+		return nil
+	case message.EdgeUser:
+		m.ClearUser()
+		return nil
+	case message.EdgeParent:
+		m.ClearParent()
+		return nil
+	}
+	return fmt.Errorf("unknown Message unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *MessageMutation) ResetEdge(name string) error {
+	switch name {
+	case message.EdgeChannel:
+		m.ResetChannel()
+		return nil
+	case message.EdgeUser:
+		m.ResetUser()
+		return nil
+	case message.EdgeParent:
+		m.ResetParent()
+		return nil
+	case message.EdgeReplies:
+		m.ResetReplies()
+		return nil
+	case message.EdgeReactions:
+		m.ResetReactions()
+		return nil
+	case message.EdgeBookmarks:
+		m.ResetBookmarks()
+		return nil
+	case message.EdgeUserMentions:
+		m.ResetUserMentions()
+		return nil
+	case message.EdgeGroupMentions:
+		m.ResetGroupMentions()
+		return nil
+	case message.EdgeLinks:
+		m.ResetLinks()
+		return nil
+	case message.EdgeAttachments:
+		m.ResetAttachments()
+		return nil
+	case message.EdgeRevisions:
+		m.ResetRevisions()
+		return nil
+	case message.EdgeShares:
+		m.ResetShares()
+		return nil
+	case message.EdgeSharedAs:
+		m.ResetSharedAs()
+		return nil
+	case message.EdgeUserThreadFollows:
+		m.ResetUserThreadFollows()
+		return nil
+	case message.EdgeThreadReadStates:
+		m.ResetThreadReadStates()
+		return nil
+	}
+	return fmt.Errorf("unknown Message edge %s", name)
+}
+
+// MessageBookmarkMutation represents an operation that mutates the MessageBookmark nodes in the graph.
+type MessageBookmarkMutation struct {
+	config
+	op             Op
+	typ            string
+	id             *uuid.UUID
+	created_at     *time.Time
+	clearedFields  map[string]struct{}
+	user           *uuid.UUID
+	cleareduser    bool
+	message        *uuid.UUID
+	clearedmessage bool
+	done           bool
+	oldValue       func(context.Context) (*MessageBookmark, error)
+	predicates     []predicate.MessageBookmark
+}
+
+var _ ent.Mutation = (*MessageBookmarkMutation)(nil)
+
+// messagebookmarkOption allows management of the mutation configuration using functional options.
+type messagebookmarkOption func(*MessageBookmarkMutation)
+
+// newMessageBookmarkMutation creates new mutation for the MessageBookmark entity.
+func newMessageBookmarkMutation(c config, op Op, opts ...messagebookmarkOption) *MessageBookmarkMutation {
+	m := &MessageBookmarkMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeMessageBookmark,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withMessageBookmarkID sets the ID field of the mutation.
+func withMessageBookmarkID(id uuid.UUID) messagebookmarkOption {
+	return func(m *MessageBookmarkMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *MessageBookmark
+		)
+		m.oldValue = func(ctx context.Context) (*MessageBookmark, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().MessageBookmark.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withMessageBookmark sets the old MessageBookmark of the mutation.
+func withMessageBookmark(node *MessageBookmark) messagebookmarkOption {
+	return func(m *MessageBookmarkMutation) {
+		m.oldValue = func(context.Context) (*MessageBookmark, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m MessageBookmarkMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m MessageBookmarkMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of MessageBookmark entities.
+func (m *MessageBookmarkMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *MessageBookmarkMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *MessageBookmarkMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().MessageBookmark.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *MessageBookmarkMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *MessageBookmarkMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the MessageBookmark entity.
+// If the MessageBookmark object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageBookmarkMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *MessageBookmarkMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUserID sets the "user" edge to the User entity by id.
+func (m *MessageBookmarkMutation) SetUserID(id uuid.UUID) {
+	m.user = &id
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (m *MessageBookmarkMutation) ClearUser() {
+	m.cleareduser = true
+}
+
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *MessageBookmarkMutation) UserCleared() bool {
+	return m.cleareduser
+}
+
+// UserID returns the "user" edge ID in the mutation.
+func (m *MessageBookmarkMutation) UserID() (id uuid.UUID, exists bool) {
+	if m.user != nil {
+		return *m.user, true
+	}
+	return
+}
+
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *MessageBookmarkMutation) UserIDs() (ids []uuid.UUID) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetUser resets all changes to the "user" edge.
+func (m *MessageBookmarkMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
+}
+
+// SetMessageID sets the "message" edge to the Message entity by id.
+func (m *MessageBookmarkMutation) SetMessageID(id uuid.UUID) {
+	m.message = &id
+}
+
+// ClearMessage clears the "message" edge to the Message entity.
+func (m *MessageBookmarkMutation) ClearMessage() {
+	m.clearedmessage = true
+}
+
+// MessageCleared reports if the "message" edge to the Message entity was cleared.
+func (m *MessageBookmarkMutation) MessageCleared() bool {
+	return m.clearedmessage
+}
+
+// MessageID returns the "message" edge ID in the mutation.
+func (m *MessageBookmarkMutation) MessageID() (id uuid.UUID, exists bool) {
+	if m.message != nil {
+		return *m.message, true
+	}
+	return
+}
+
+// MessageIDs returns the "message" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// MessageID instead. It exists only for internal usage by the builders.
+func (m *MessageBookmarkMutation) MessageIDs() (ids []uuid.UUID) {
+	if id := m.message; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetMessage resets all changes to the "message" edge.
+func (m *MessageBookmarkMutation) ResetMessage() {
+	m.message = nil
+	m.clearedmessage = false
+}
+
+// Where appends a list predicates to the MessageBookmarkMutation builder.
+func (m *MessageBookmarkMutation) Where(ps ...predicate.MessageBookmark) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the MessageBookmarkMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *MessageBookmarkMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.MessageBookmark, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *MessageBookmarkMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *MessageBookmarkMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (MessageBookmark).
+func (m *MessageBookmarkMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *MessageBookmarkMutation) Fields() []string {
+	fields := make([]string, 0, 1)
+	if m.created_at != nil {
+		fields = append(fields, messagebookmark.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *MessageBookmarkMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case messagebookmark.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *MessageBookmarkMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case messagebookmark.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown MessageBookmark field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MessageBookmarkMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case messagebookmark.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown MessageBookmark field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *MessageBookmarkMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *MessageBookmarkMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MessageBookmarkMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown MessageBookmark numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *MessageBookmarkMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *MessageBookmarkMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *MessageBookmarkMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown MessageBookmark nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *MessageBookmarkMutation) ResetField(name string) error {
+	switch name {
+	case messagebookmark.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown MessageBookmark field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *MessageBookmarkMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.user != nil {
+		edges = append(edges, messagebookmark.EdgeUser)
+	}
+	if m.message != nil {
+		edges = append(edges, messagebookmark.EdgeMessage)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *MessageBookmarkMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case messagebookmark.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
+		}
+	case messagebookmark.EdgeMessage:
+		if id := m.message; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *MessageBookmarkMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *MessageBookmarkMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *MessageBookmarkMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.cleareduser {
+		edges = append(edges, messagebookmark.EdgeUser)
+	}
+	if m.clearedmessage {
+		edges = append(edges, messagebookmark.EdgeMessage)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *MessageBookmarkMutation) EdgeCleared(name string) bool {
+	switch name {
+	case messagebookmark.EdgeUser:
+		return m.cleareduser
+	case messagebookmark.EdgeMessage:
+		return m.clearedmessage
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *MessageBookmarkMutation) ClearEdge(name string) error {
+	switch name {
+	case messagebookmark.EdgeUser:
+		m.ClearUser()
+		return nil
+	case messagebookmark.EdgeMessage:
+		m.ClearMessage()
+		return nil
+	}
+	return fmt.Errorf("unknown MessageBookmark unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *MessageBookmarkMutation) ResetEdge(name string) error {
+	switch name {
+	case messagebookmark.EdgeUser:
+		m.ResetUser()
+		return nil
+	case messagebookmark.EdgeMessage:
+		m.ResetMessage()
+		return nil
+	}
+	return fmt.Errorf("unknown MessageBookmark edge %s", name)
+}
+
+// MessageGroupMentionMutation represents an operation that mutates the MessageGroupMention nodes in the graph.
+type MessageGroupMentionMutation struct {
+	config
+	op             Op
+	typ            string
+	id             *uuid.UUID
+	created_at     *time.Time
+	clearedFields  map[string]struct{}
+	message        *uuid.UUID
+	clearedmessage bool
+	group          *uuid.UUID
+	clearedgroup   bool
+	done           bool
+	oldValue       func(context.Context) (*MessageGroupMention, error)
+	predicates     []predicate.MessageGroupMention
+}
+
+var _ ent.Mutation = (*MessageGroupMentionMutation)(nil)
+
+// messagegroupmentionOption allows management of the mutation configuration using functional options.
+type messagegroupmentionOption func(*MessageGroupMentionMutation)
+
+// newMessageGroupMentionMutation creates new mutation for the MessageGroupMention entity.
+func newMessageGroupMentionMutation(c config, op Op, opts ...messagegroupmentionOption) *MessageGroupMentionMutation {
+	m := &MessageGroupMentionMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeMessageGroupMention,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withMessageGroupMentionID sets the ID field of the mutation.
+func withMessageGroupMentionID(id uuid.UUID) messagegroupmentionOption {
+	return func(m *MessageGroupMentionMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *MessageGroupMention
+		)
+		m.oldValue = func(ctx context.Context) (*MessageGroupMention, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().MessageGroupMention.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withMessageGroupMention sets the old MessageGroupMention of the mutation.
+func withMessageGroupMention(node *MessageGroupMention) messagegroupmentionOption {
+	return func(m *MessageGroupMentionMutation) {
+		m.oldValue = func(context.Context) (*MessageGroupMention, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m MessageGroupMentionMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m MessageGroupMentionMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of MessageGroupMention entities.
+func (m *MessageGroupMentionMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *MessageGroupMentionMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *MessageGroupMentionMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().MessageGroupMention.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *MessageGroupMentionMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *MessageGroupMentionMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the MessageGroupMention entity.
+// If the MessageGroupMention object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageGroupMentionMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *MessageGroupMentionMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetMessageID sets the "message" edge to the Message entity by id.
+func (m *MessageGroupMentionMutation) SetMessageID(id uuid.UUID) {
+	m.message = &id
+}
+
+// ClearMessage clears the "message" edge to the Message entity.
+func (m *MessageGroupMentionMutation) ClearMessage() {
+	m.clearedmessage = true
+}
+
+// MessageCleared reports if the "message" edge to the Message entity was cleared.
+func (m *MessageGroupMentionMutation) MessageCleared() bool {
+	return m.clearedmessage
+}
+
+// MessageID returns the "message" edge ID in the mutation.
+func (m *MessageGroupMentionMutation) MessageID() (id uuid.UUID, exists bool) {
+	if m.message != nil {
+		return *m.message, true
+	}
+	return
+}
+
+// MessageIDs returns the "message" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// MessageID instead. It exists only for internal usage by the builders.
+func (m *MessageGroupMentionMutation) MessageIDs() (ids []uuid.UUID) {
+	if id := m.message; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetMessage resets all changes to the "message" edge.
+func (m *MessageGroupMentionMutation) ResetMessage() {
+	m.message = nil
+	m.clearedmessage = false
+}
+
+// SetGroupID sets the "group" edge to the UserGroup entity by id.
+func (m *MessageGroupMentionMutation) SetGroupID(id uuid.UUID) {
+	m.group = &id
+}
+
+// ClearGroup clears the "group" edge to the UserGroup entity.
+func (m *MessageGroupMentionMutation) ClearGroup() {
+	m.clearedgroup = true
+}
+
+// GroupCleared reports if the "group" edge to the UserGroup entity was cleared.
+func (m *MessageGroupMentionMutation) GroupCleared() bool {
+	return m.clearedgroup
+}
+
+// GroupID returns the "group" edge ID in the mutation.
+func (m *MessageGroupMentionMutation) GroupID() (id uuid.UUID, exists bool) {
+	if m.group != nil {
+		return *m.group, true
+	}
+	return
+}
+
+// GroupIDs returns the "group" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// GroupID instead. It exists only for internal usage by the builders.
+func (m *MessageGroupMentionMutation) GroupIDs() (ids []uuid.UUID) {
+	if id := m.group; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetGroup resets all changes to the "group" edge.
+func (m *MessageGroupMentionMutation) ResetGroup() {
+	m.group = nil
+	m.clearedgroup = false
+}
+
+// Where appends a list predicates to the MessageGroupMentionMutation builder.
+func (m *MessageGroupMentionMutation) Where(ps ...predicate.MessageGroupMention) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the MessageGroupMentionMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *MessageGroupMentionMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.MessageGroupMention, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *MessageGroupMentionMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *MessageGroupMentionMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (MessageGroupMention).
+func (m *MessageGroupMentionMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *MessageGroupMentionMutation) Fields() []string {
+	fields := make([]string, 0, 1)
+	if m.created_at != nil {
+		fields = append(fields, messagegroupmention.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *MessageGroupMentionMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case messagegroupmention.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *MessageGroupMentionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case messagegroupmention.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown MessageGroupMention field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MessageGroupMentionMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case messagegroupmention.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown MessageGroupMention field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *MessageGroupMentionMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *MessageGroupMentionMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MessageGroupMentionMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown MessageGroupMention numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *MessageGroupMentionMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *MessageGroupMentionMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *MessageGroupMentionMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown MessageGroupMention nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *MessageGroupMentionMutation) ResetField(name string) error {
+	switch name {
+	case messagegroupmention.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown MessageGroupMention field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *MessageGroupMentionMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.message != nil {
+		edges = append(edges, messagegroupmention.EdgeMessage)
+	}
+	if m.group != nil {
+		edges = append(edges, messagegroupmention.EdgeGroup)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *MessageGroupMentionMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case messagegroupmention.EdgeMessage:
+		if id := m.message; id != nil {
+			return []ent.Value{*id}
+		}
+	case messagegroupmention.EdgeGroup:
+		if id := m.group; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *MessageGroupMentionMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *MessageGroupMentionMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *MessageGroupMentionMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedmessage {
+		edges = append(edges, messagegroupmention.EdgeMessage)
+	}
+	if m.clearedgroup {
+		edges = append(edges, messagegroupmention.EdgeGroup)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *MessageGroupMentionMutation) EdgeCleared(name string) bool {
+	switch name {
+	case messagegroupmention.EdgeMessage:
+		return m.clearedmessage
+	case messagegroupmention.EdgeGroup:
+		return m.clearedgroup
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *MessageGroupMentionMutation) ClearEdge(name string) error {
+	switch name {
+	case messagegroupmention.EdgeMessage:
+		m.ClearMessage()
+		return nil
+	case messagegroupmention.EdgeGroup:
+		m.ClearGroup()
+		return nil
+	}
+	return fmt.Errorf("unknown MessageGroupMention unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *MessageGroupMentionMutation) ResetEdge(name string) error {
+	switch name {
+	case messagegroupmention.EdgeMessage:
+		m.ResetMessage()
+		return nil
+	case messagegroupmention.EdgeGroup:
+		m.ResetGroup()
+		return nil
+	}
+	return fmt.Errorf("unknown MessageGroupMention edge %s", name)
+}
+
+// MessageLinkMutation represents an operation that mutates the MessageLink nodes in the graph.
+type MessageLinkMutation struct {
+	config
+	op             Op
+	typ            string
+	id             *uuid.UUID
+	url            *string
+	title          *string
+	description    *string
+	image_url      *string
+	site_name      *string
+	card_type      *string
+	created_at     *time.Time
+	clearedFields  map[string]struct{}
+	message        *uuid.UUID
+	clearedmessage bool
+	done           bool
+	oldValue       func(context.Context) (*MessageLink, error)
+	predicates     []predicate.MessageLink
+}
+
+var _ ent.Mutation = (*MessageLinkMutation)(nil)
+
+// messagelinkOption allows management of the mutation configuration using functional options.
+type messagelinkOption func(*MessageLinkMutation)
+
+// newMessageLinkMutation creates new mutation for the MessageLink entity.
+func newMessageLinkMutation(c config, op Op, opts ...messagelinkOption) *MessageLinkMutation {
+	m := &MessageLinkMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeMessageLink,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withMessageLinkID sets the ID field of the mutation.
+func withMessageLinkID(id uuid.UUID) messagelinkOption {
+	return func(m *MessageLinkMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *MessageLink
+		)
+		m.oldValue = func(ctx context.Context) (*MessageLink, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().MessageLink.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withMessageLink sets the old MessageLink of the mutation.
+func withMessageLink(node *MessageLink) messagelinkOption {
+	return func(m *MessageLinkMutation) {
+		m.oldValue = func(context.Context) (*MessageLink, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m MessageLinkMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m MessageLinkMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of MessageLink entities.
+func (m *MessageLinkMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *MessageLinkMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *MessageLinkMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().MessageLink.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetURL sets the "url" field.
+func (m *MessageLinkMutation) SetURL(s string) {
+	m.url = &s
+}
+
+// URL returns the value of the "url" field in the mutation.
+func (m *MessageLinkMutation) URL() (r string, exists bool) {
+	v := m.url
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldURL returns the old "url" field's value of the MessageLink entity.
+// If the MessageLink object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageLinkMutation) OldURL(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldURL is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldURL requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldURL: %w", err)
+	}
+	return oldValue.URL, nil
+}
+
+// ResetURL resets all changes to the "url" field.
+func (m *MessageLinkMutation) ResetURL() {
+	m.url = nil
+}
+
+// SetTitle sets the "title" field.
+func (m *MessageLinkMutation) SetTitle(s string) {
+	m.title = &s
+}
+
+// Title returns the value of the "title" field in the mutation.
+func (m *MessageLinkMutation) Title() (r string, exists bool) {
+	v := m.title
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTitle returns the old "title" field's value of the MessageLink entity.
+// If the MessageLink object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageLinkMutation) OldTitle(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTitle is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTitle requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTitle: %w", err)
+	}
+	return oldValue.Title, nil
+}
+
+// ClearTitle clears the value of the "title" field.
+func (m *MessageLinkMutation) ClearTitle() {
+	m.title = nil
+	m.clearedFields[messagelink.FieldTitle] = struct{}{}
+}
+
+// TitleCleared returns if the "title" field was cleared in this mutation.
+func (m *MessageLinkMutation) TitleCleared() bool {
+	_, ok := m.clearedFields[messagelink.FieldTitle]
+	return ok
+}
+
+// ResetTitle resets all changes to the "title" field.
+func (m *MessageLinkMutation) ResetTitle() {
+	m.title = nil
+	delete(m.clearedFields, messagelink.FieldTitle)
+}
+
+// SetDescription sets the "description" field.
+func (m *MessageLinkMutation) SetDescription(s string) {
+	m.description = &s
+}
+
+// Description returns the value of the "description" field in the mutation.
+func (m *MessageLinkMutation) Description() (r string, exists bool) {
+	v := m.description
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDescription returns the old "description" field's value of the MessageLink entity.
+// If the MessageLink object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageLinkMutation) OldDescription(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDescription requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+	}
+	return oldValue.Description, nil
+}
+
+// ClearDescription clears the value of the "description" field.
+func (m *MessageLinkMutation) ClearDescription() {
+	m.description = nil
+	m.clearedFields[messagelink.FieldDescription] = struct{}{}
+}
+
+// DescriptionCleared returns if the "description" field was cleared in this mutation.
+func (m *MessageLinkMutation) DescriptionCleared() bool {
+	_, ok := m.clearedFields[messagelink.FieldDescription]
+	return ok
+}
+
+// ResetDescription resets all changes to the "description" field.
+func (m *MessageLinkMutation) ResetDescription() {
+	m.description = nil
+	delete(m.clearedFields, messagelink.FieldDescription)
+}
+
+// SetImageURL sets the "image_url" field.
+func (m *MessageLinkMutation) SetImageURL(s string) {
+	m.image_url = &s
+}
+
+// ImageURL returns the value of the "image_url" field in the mutation.
+func (m *MessageLinkMutation) ImageURL() (r string, exists bool) {
+	v := m.image_url
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldImageURL returns the old "image_url" field's value of the MessageLink entity.
+// If the MessageLink object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageLinkMutation) OldImageURL(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldImageURL is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldImageURL requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldImageURL: %w", err)
+	}
+	return oldValue.ImageURL, nil
+}
+
+// ClearImageURL clears the value of the "image_url" field.
+func (m *MessageLinkMutation) ClearImageURL() {
+	m.image_url = nil
+	m.clearedFields[messagelink.FieldImageURL] = struct{}{}
+}
+
+// ImageURLCleared returns if the "image_url" field was cleared in this mutation.
+func (m *MessageLinkMutation) ImageURLCleared() bool {
+	_, ok := m.clearedFields[messagelink.FieldImageURL]
+	return ok
+}
+
+// ResetImageURL resets all changes to the "image_url" field.
+func (m *MessageLinkMutation) ResetImageURL() {
+	m.image_url = nil
+	delete(m.clearedFields, messagelink.FieldImageURL)
+}
+
+// SetSiteName sets the "site_name" field.
+func (m *MessageLinkMutation) SetSiteName(s string) {
+	m.site_name = &s
+}
+
+// SiteName returns the value of the "site_name" field in the mutation.
+func (m *MessageLinkMutation) SiteName() (r string, exists bool) {
+	v := m.site_name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSiteName returns the old "site_name" field's value of the MessageLink entity.
+// If the MessageLink object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageLinkMutation) OldSiteName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSiteName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSiteName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSiteName: %w", err)
+	}
+	return oldValue.SiteName, nil
+}
+
+// ClearSiteName clears the value of the "site_name" field.
+func (m *MessageLinkMutation) ClearSiteName() {
+	m.site_name = nil
+	m.clearedFields[messagelink.FieldSiteName] = struct{}{}
+}
+
+// SiteNameCleared returns if the "site_name" field was cleared in this mutation.
+func (m *MessageLinkMutation) SiteNameCleared() bool {
+	_, ok := m.clearedFields[messagelink.FieldSiteName]
+	return ok
+}
+
+// ResetSiteName resets all changes to the "site_name" field.
+func (m *MessageLinkMutation) ResetSiteName() {
+	m.site_name = nil
+	delete(m.clearedFields, messagelink.FieldSiteName)
+}
+
+// SetCardType sets the "card_type" field.
+func (m *MessageLinkMutation) SetCardType(s string) {
+	m.card_type = &s
+}
+
+// CardType returns the value of the "card_type" field in the mutation.
+func (m *MessageLinkMutation) CardType() (r string, exists bool) {
+	v := m.card_type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCardType returns the old "card_type" field's value of the MessageLink entity.
+// If the MessageLink object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageLinkMutation) OldCardType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCardType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCardType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCardType: %w", err)
+	}
+	return oldValue.CardType, nil
+}
+
+// ClearCardType clears the value of the "card_type" field.
+func (m *MessageLinkMutation) ClearCardType() {
+	m.card_type = nil
+	m.clearedFields[messagelink.FieldCardType] = struct{}{}
+}
+
+// CardTypeCleared returns if the "card_type" field was cleared in this mutation.
+func (m *MessageLinkMutation) CardTypeCleared() bool {
+	_, ok := m.clearedFields[messagelink.FieldCardType]
+	return ok
+}
+
+// ResetCardType resets all changes to the "card_type" field.
+func (m *MessageLinkMutation) ResetCardType() {
+	m.card_type = nil
+	delete(m.clearedFields, messagelink.FieldCardType)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *MessageLinkMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *MessageLinkMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the MessageLink entity.
+// If the MessageLink object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageLinkMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *MessageLinkMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetMessageID sets the "message" edge to the Message entity by id.
+func (m *MessageLinkMutation) SetMessageID(id uuid.UUID) {
+	m.message = &id
+}
+
+// ClearMessage clears the "message" edge to the Message entity.
+func (m *MessageLinkMutation) ClearMessage() {
+	m.clearedmessage = true
+}
+
+// MessageCleared reports if the "message" edge to the Message entity was cleared.
+func (m *MessageLinkMutation) MessageCleared() bool {
+	return m.clearedmessage
+}
+
+// MessageID returns the "message" edge ID in the mutation.
+func (m *MessageLinkMutation) MessageID() (id uuid.UUID, exists bool) {
+	if m.message != nil {
+		return *m.message, true
+	}
+	return
+}
+
+// MessageIDs returns the "message" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// MessageID instead. It exists only for internal usage by the builders.
+func (m *MessageLinkMutation) MessageIDs() (ids []uuid.UUID) {
+	if id := m.message; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetMessage resets all changes to the "message" edge.
+func (m *MessageLinkMutation) ResetMessage() {
+	m.message = nil
+	m.clearedmessage = false
+}
+
+// Where appends a list predicates to the MessageLinkMutation builder.
+func (m *MessageLinkMutation) Where(ps ...predicate.MessageLink) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the MessageLinkMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *MessageLinkMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.MessageLink, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *MessageLinkMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *MessageLinkMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (MessageLink).
+func (m *MessageLinkMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *MessageLinkMutation) Fields() []string {
+	fields := make([]string, 0, 7)
+	if m.url != nil {
+		fields = append(fields, messagelink.FieldURL)
+	}
+	if m.title != nil {
+		fields = append(fields, messagelink.FieldTitle)
+	}
+	if m.description != nil {
+		fields = append(fields, messagelink.FieldDescription)
+	}
+	if m.image_url != nil {
+		fields = append(fields, messagelink.FieldImageURL)
+	}
+	if m.site_name != nil {
+		fields = append(fields, messagelink.FieldSiteName)
+	}
+	if m.card_type != nil {
+		fields = append(fields, messagelink.FieldCardType)
+	}
+	if m.created_at != nil {
+		fields = append(fields, messagelink.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *MessageLinkMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case messagelink.FieldURL:
+		return m.URL()
+	case messagelink.FieldTitle:
+		return m.Title()
+	case messagelink.FieldDescription:
+		return m.Description()
+	case messagelink.FieldImageURL:
+		return m.ImageURL()
+	case messagelink.FieldSiteName:
+		return m.SiteName()
+	case messagelink.FieldCardType:
+		return m.CardType()
+	case messagelink.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *MessageLinkMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case messagelink.FieldURL:
+		return m.OldURL(ctx)
+	case messagelink.FieldTitle:
+		return m.OldTitle(ctx)
+	case messagelink.FieldDescription:
+		return m.OldDescription(ctx)
+	case messagelink.FieldImageURL:
+		return m.OldImageURL(ctx)
+	case messagelink.FieldSiteName:
+		return m.OldSiteName(ctx)
+	case messagelink.FieldCardType:
+		return m.OldCardType(ctx)
+	case messagelink.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown MessageLink field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MessageLinkMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case messagelink.FieldURL:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetURL(v)
+		return nil
+	case messagelink.FieldTitle:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTitle(v)
+		return nil
+	case messagelink.FieldDescription:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDescription(v)
+		return nil
+	case messagelink.FieldImageURL:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetImageURL(v)
+		return nil
+	case messagelink.FieldSiteName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSiteName(v)
+		return nil
+	case messagelink.FieldCardType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCardType(v)
+		return nil
+	case messagelink.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown MessageLink field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *MessageLinkMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *MessageLinkMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MessageLinkMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown MessageLink numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *MessageLinkMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(messagelink.FieldTitle) {
+		fields = append(fields, messagelink.FieldTitle)
+	}
+	if m.FieldCleared(messagelink.FieldDescription) {
+		fields = append(fields, messagelink.FieldDescription)
+	}
+	if m.FieldCleared(messagelink.FieldImageURL) {
+		fields = append(fields, messagelink.FieldImageURL)
+	}
+	if m.FieldCleared(messagelink.FieldSiteName) {
+		fields = append(fields, messagelink.FieldSiteName)
+	}
+	if m.FieldCleared(messagelink.FieldCardType) {
+		fields = append(fields, messagelink.FieldCardType)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *MessageLinkMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *MessageLinkMutation) ClearField(name string) error {
+	switch name {
+	case messagelink.FieldTitle:
+		m.ClearTitle()
+		return nil
+	case messagelink.FieldDescription:
+		m.ClearDescription()
+		return nil
+	case messagelink.FieldImageURL:
+		m.ClearImageURL()
+		return nil
+	case messagelink.FieldSiteName:
+		m.ClearSiteName()
+		return nil
+	case messagelink.FieldCardType:
+		m.ClearCardType()
+		return nil
+	}
+	return fmt.Errorf("unknown MessageLink nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *MessageLinkMutation) ResetField(name string) error {
+	switch name {
+	case messagelink.FieldURL:
+		m.ResetURL()
+		return nil
+	case messagelink.FieldTitle:
+		m.ResetTitle()
+		return nil
+	case messagelink.FieldDescription:
+		m.ResetDescription()
+		return nil
+	case messagelink.FieldImageURL:
+		m.ResetImageURL()
+		return nil
+	case messagelink.FieldSiteName:
+		m.ResetSiteName()
+		return nil
+	case messagelink.FieldCardType:
+		m.ResetCardType()
+		return nil
+	case messagelink.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown MessageLink field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *MessageLinkMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.message != nil {
+		edges = append(edges, messagelink.EdgeMessage)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *MessageLinkMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case messagelink.EdgeMessage:
+		if id := m.message; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *MessageLinkMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *MessageLinkMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *MessageLinkMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedmessage {
+		edges = append(edges, messagelink.EdgeMessage)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *MessageLinkMutation) EdgeCleared(name string) bool {
+	switch name {
+	case messagelink.EdgeMessage:
+		return m.clearedmessage
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *MessageLinkMutation) ClearEdge(name string) error {
+	switch name {
+	case messagelink.EdgeMessage:
+		m.ClearMessage()
+		return nil
+	}
+	return fmt.Errorf("unknown MessageLink unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *MessageLinkMutation) ResetEdge(name string) error {
+	switch name {
+	case messagelink.EdgeMessage:
+		m.ResetMessage()
+		return nil
+	}
+	return fmt.Errorf("unknown MessageLink edge %s", name)
+}
+
+// MessagePinMutation represents an operation that mutates the MessagePin nodes in the graph.
+type MessagePinMutation struct {
+	config
+	op               Op
+	typ              string
+	id               *uuid.UUID
+	created_at       *time.Time
+	clearedFields    map[string]struct{}
+	channel          *uuid.UUID
+	clearedchannel   bool
+	message          *uuid.UUID
+	clearedmessage   bool
+	pinned_by        *uuid.UUID
+	clearedpinned_by bool
+	done             bool
+	oldValue         func(context.Context) (*MessagePin, error)
+	predicates       []predicate.MessagePin
+}
+
+var _ ent.Mutation = (*MessagePinMutation)(nil)
+
+// messagepinOption allows management of the mutation configuration using functional options.
+type messagepinOption func(*MessagePinMutation)
+
+// newMessagePinMutation creates new mutation for the MessagePin entity.
+func newMessagePinMutation(c config, op Op, opts ...messagepinOption) *MessagePinMutation {
+	m := &MessagePinMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeMessagePin,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withMessagePinID sets the ID field of the mutation.
+func withMessagePinID(id uuid.UUID) messagepinOption {
+	return func(m *MessagePinMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *MessagePin
+		)
+		m.oldValue = func(ctx context.Context) (*MessagePin, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().MessagePin.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withMessagePin sets the old MessagePin of the mutation.
+func withMessagePin(node *MessagePin) messagepinOption {
+	return func(m *MessagePinMutation) {
+		m.oldValue = func(context.Context) (*MessagePin, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m MessagePinMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m MessagePinMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of MessagePin entities.
+func (m *MessagePinMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *MessagePinMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *MessagePinMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().MessagePin.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *MessagePinMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *MessagePinMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the MessagePin entity.
+// If the MessagePin object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessagePinMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *MessagePinMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetChannelID sets the "channel" edge to the Channel entity by id.
+func (m *MessagePinMutation) SetChannelID(id uuid.UUID) {
+	m.channel = &id
+}
+
+// ClearChannel clears the "channel" edge to the Channel entity.
+func (m *MessagePinMutation) ClearChannel() {
+	m.clearedchannel = true
+}
+
+// ChannelCleared reports if the "channel" edge to the Channel entity was cleared.
+func (m *MessagePinMutation) ChannelCleared() bool {
+	return m.clearedchannel
+}
+
+// ChannelID returns the "channel" edge ID in the mutation.
+func (m *MessagePinMutation) ChannelID() (id uuid.UUID, exists bool) {
+	if m.channel != nil {
+		return *m.channel, true
+	}
+	return
+}
+
+// ChannelIDs returns the "channel" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ChannelID instead. It exists only for internal usage by the builders.
+func (m *MessagePinMutation) ChannelIDs() (ids []uuid.UUID) {
+	if id := m.channel; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetChannel resets all changes to the "channel" edge.
+func (m *MessagePinMutation) ResetChannel() {
+	m.channel = nil
+	m.clearedchannel = false
+}
+
+// SetMessageID sets the "message" edge to the Message entity by id.
+func (m *MessagePinMutation) SetMessageID(id uuid.UUID) {
+	m.message = &id
+}
+
+// ClearMessage clears the "message" edge to the Message entity.
+func (m *MessagePinMutation) ClearMessage() {
+	m.clearedmessage = true
+}
+
+// MessageCleared reports if the "message" edge to the Message entity was cleared.
+func (m *MessagePinMutation) MessageCleared() bool {
+	return m.clearedmessage
+}
+
+// MessageID returns the "message" edge ID in the mutation.
+func (m *MessagePinMutation) MessageID() (id uuid.UUID, exists bool) {
+	if m.message != nil {
+		return *m.message, true
+	}
+	return
+}
+
+// MessageIDs returns the "message" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// MessageID instead. It exists only for internal usage by the builders.
+func (m *MessagePinMutation) MessageIDs() (ids []uuid.UUID) {
+	if id := m.message; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetMessage resets all changes to the "message" edge.
+func (m *MessagePinMutation) ResetMessage() {
+	m.message = nil
+	m.clearedmessage = false
+}
+
+// SetPinnedByID sets the "pinned_by" edge to the User entity by id.
+func (m *MessagePinMutation) SetPinnedByID(id uuid.UUID) {
+	m.pinned_by = &id
+}
+
+// ClearPinnedBy clears the "pinned_by" edge to the User entity.
+func (m *MessagePinMutation) ClearPinnedBy() {
+	m.clearedpinned_by = true
+}
+
+// PinnedByCleared reports if the "pinned_by" edge to the User entity was cleared.
+func (m *MessagePinMutation) PinnedByCleared() bool {
+	return m.clearedpinned_by
+}
+
+// PinnedByID returns the "pinned_by" edge ID in the mutation.
+func (m *MessagePinMutation) PinnedByID() (id uuid.UUID, exists bool) {
+	if m.pinned_by != nil {
+		return *m.pinned_by, true
+	}
+	return
+}
+
+// PinnedByIDs returns the "pinned_by" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// PinnedByID instead. It exists only for internal usage by the builders.
+func (m *MessagePinMutation) PinnedByIDs() (ids []uuid.UUID) {
+	if id := m.pinned_by; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetPinnedBy resets all changes to the "pinned_by" edge.
+func (m *MessagePinMutation) ResetPinnedBy() {
+	m.pinned_by = nil
+	m.clearedpinned_by = false
+}
+
+// Where appends a list predicates to the MessagePinMutation builder.
+func (m *MessagePinMutation) Where(ps ...predicate.MessagePin) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the MessagePinMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *MessagePinMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.MessagePin, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *MessagePinMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *MessagePinMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (MessagePin).
+func (m *MessagePinMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *MessagePinMutation) Fields() []string {
+	fields := make([]string, 0, 1)
+	if m.created_at != nil {
+		fields = append(fields, messagepin.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *MessagePinMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case messagepin.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *MessagePinMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case messagepin.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown MessagePin field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MessagePinMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case messagepin.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown MessagePin field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *MessagePinMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *MessagePinMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MessagePinMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown MessagePin numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *MessagePinMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *MessagePinMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *MessagePinMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown MessagePin nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *MessagePinMutation) ResetField(name string) error {
+	switch name {
+	case messagepin.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown MessagePin field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *MessagePinMutation) AddedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.channel != nil {
+		edges = append(edges, messagepin.EdgeChannel)
+	}
+	if m.message != nil {
+		edges = append(edges, messagepin.EdgeMessage)
+	}
+	if m.pinned_by != nil {
+		edges = append(edges, messagepin.EdgePinnedBy)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *MessagePinMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case messagepin.EdgeChannel:
+		if id := m.channel; id != nil {
+			return []ent.Value{*id}
+		}
+	case messagepin.EdgeMessage:
+		if id := m.message; id != nil {
+			return []ent.Value{*id}
+		}
+	case messagepin.EdgePinnedBy:
+		if id := m.pinned_by; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *MessagePinMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 3)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *MessagePinMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *MessagePinMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.clearedchannel {
+		edges = append(edges, messagepin.EdgeChannel)
+	}
+	if m.clearedmessage {
+		edges = append(edges, messagepin.EdgeMessage)
+	}
+	if m.clearedpinned_by {
+		edges = append(edges, messagepin.EdgePinnedBy)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *MessagePinMutation) EdgeCleared(name string) bool {
+	switch name {
+	case messagepin.EdgeChannel:
+		return m.clearedchannel
+	case messagepin.EdgeMessage:
+		return m.clearedmessage
+	case messagepin.EdgePinnedBy:
+		return m.clearedpinned_by
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *MessagePinMutation) ClearEdge(name string) error {
+	switch name {
+	case messagepin.EdgeChannel:
+		m.ClearChannel()
+		return nil
+	case messagepin.EdgeMessage:
+		m.ClearMessage()
+		return nil
+	case messagepin.EdgePinnedBy:
+		m.ClearPinnedBy()
+		return nil
+	}
+	return fmt.Errorf("unknown MessagePin unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *MessagePinMutation) ResetEdge(name string) error {
+	switch name {
+	case messagepin.EdgeChannel:
+		m.ResetChannel()
+		return nil
+	case messagepin.EdgeMessage:
+		m.ResetMessage()
+		return nil
+	case messagepin.EdgePinnedBy:
+		m.ResetPinnedBy()
+		return nil
+	}
+	return fmt.Errorf("unknown MessagePin edge %s", name)
+}
+
+// MessageReactionMutation represents an operation that mutates the MessageReaction nodes in the graph.
+type MessageReactionMutation struct {
+	config
+	op             Op
+	typ            string
+	id             *uuid.UUID
+	emoji          *string
+	created_at     *time.Time
+	clearedFields  map[string]struct{}
+	message        *uuid.UUID
+	clearedmessage bool
+	user           *uuid.UUID
+	cleareduser    bool
+	done           bool
+	oldValue       func(context.Context) (*MessageReaction, error)
+	predicates     []predicate.MessageReaction
+}
+
+var _ ent.Mutation = (*MessageReactionMutation)(nil)
+
+// messagereactionOption allows management of the mutation configuration using functional options.
+type messagereactionOption func(*MessageReactionMutation)
+
+// newMessageReactionMutation creates new mutation for the MessageReaction entity.
+func newMessageReactionMutation(c config, op Op, opts ...messagereactionOption) *MessageReactionMutation {
+	m := &MessageReactionMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeMessageReaction,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withMessageReactionID sets the ID field of the mutation.
+func withMessageReactionID(id uuid.UUID) messagereactionOption {
+	return func(m *MessageReactionMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *MessageReaction
+		)
+		m.oldValue = func(ctx context.Context) (*MessageReaction, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().MessageReaction.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withMessageReaction sets the old MessageReaction of the mutation.
+func withMessageReaction(node *MessageReaction) messagereactionOption {
+	return func(m *MessageReactionMutation) {
+		m.oldValue = func(context.Context) (*MessageReaction, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m MessageReactionMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m MessageReactionMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of MessageReaction entities.
+func (m *MessageReactionMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *MessageReactionMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *MessageReactionMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().MessageReaction.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetEmoji sets the "emoji" field.
+func (m *MessageReactionMutation) SetEmoji(s string) {
+	m.emoji = &s
+}
+
+// Emoji returns the value of the "emoji" field in the mutation.
+func (m *MessageReactionMutation) Emoji() (r string, exists bool) {
+	v := m.emoji
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEmoji returns the old "emoji" field's value of the MessageReaction entity.
+// If the MessageReaction object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageReactionMutation) OldEmoji(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEmoji is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEmoji requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEmoji: %w", err)
+	}
+	return oldValue.Emoji, nil
+}
+
+// ResetEmoji resets all changes to the "emoji" field.
+func (m *MessageReactionMutation) ResetEmoji() {
+	m.emoji = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *MessageReactionMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *MessageReactionMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the MessageReaction entity.
+// If the MessageReaction object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageReactionMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *MessageReactionMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetMessageID sets the "message" edge to the Message entity by id.
+func (m *MessageReactionMutation) SetMessageID(id uuid.UUID) {
+	m.message = &id
+}
+
+// ClearMessage clears the "message" edge to the Message entity.
+func (m *MessageReactionMutation) ClearMessage() {
+	m.clearedmessage = true
+}
+
+// MessageCleared reports if the "message" edge to the Message entity was cleared.
+func (m *MessageReactionMutation) MessageCleared() bool {
+	return m.clearedmessage
+}
+
+// MessageID returns the "message" edge ID in the mutation.
+func (m *MessageReactionMutation) MessageID() (id uuid.UUID, exists bool) {
+	if m.message != nil {
+		return *m.message, true
+	}
+	return
+}
+
+// MessageIDs returns the "message" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// MessageID instead. It exists only for internal usage by the builders.
+func (m *MessageReactionMutation) MessageIDs() (ids []uuid.UUID) {
+	if id := m.message; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetMessage resets all changes to the "message" edge.
+func (m *MessageReactionMutation) ResetMessage() {
+	m.message = nil
+	m.clearedmessage = false
+}
+
+// SetUserID sets the "user" edge to the User entity by id.
+func (m *MessageReactionMutation) SetUserID(id uuid.UUID) {
+	m.user = &id
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (m *MessageReactionMutation) ClearUser() {
+	m.cleareduser = true
+}
+
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *MessageReactionMutation) UserCleared() bool {
+	return m.cleareduser
+}
+
+// UserID returns the "user" edge ID in the mutation.
+func (m *MessageReactionMutation) UserID() (id uuid.UUID, exists bool) {
+	if m.user != nil {
+		return *m.user, true
+	}
+	return
+}
+
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *MessageReactionMutation) UserIDs() (ids []uuid.UUID) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetUser resets all changes to the "user" edge.
+func (m *MessageReactionMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
+}
+
+// Where appends a list predicates to the MessageReactionMutation builder.
+func (m *MessageReactionMutation) Where(ps ...predicate.MessageReaction) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the MessageReactionMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *MessageReactionMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.MessageReaction, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *MessageReactionMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *MessageReactionMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (MessageReaction).
+func (m *MessageReactionMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *MessageReactionMutation) Fields() []string {
+	fields := make([]string, 0, 2)
+	if m.emoji != nil {
+		fields = append(fields, messagereaction.FieldEmoji)
+	}
+	if m.created_at != nil {
+		fields = append(fields, messagereaction.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *MessageReactionMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case messagereaction.FieldEmoji:
+		return m.Emoji()
+	case messagereaction.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *MessageReactionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case messagereaction.FieldEmoji:
+		return m.OldEmoji(ctx)
+	case messagereaction.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown MessageReaction field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MessageReactionMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case messagereaction.FieldEmoji:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEmoji(v)
+		return nil
+	case messagereaction.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown MessageReaction field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *MessageReactionMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *MessageReactionMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MessageReactionMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown MessageReaction numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *MessageReactionMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *MessageReactionMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *MessageReactionMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown MessageReaction nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *MessageReactionMutation) ResetField(name string) error {
+	switch name {
+	case messagereaction.FieldEmoji:
+		m.ResetEmoji()
+		return nil
+	case messagereaction.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown MessageReaction field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *MessageReactionMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.message != nil {
+		edges = append(edges, messagereaction.EdgeMessage)
+	}
+	if m.user != nil {
+		edges = append(edges, messagereaction.EdgeUser)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *MessageReactionMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case messagereaction.EdgeMessage:
+		if id := m.message; id != nil {
+			return []ent.Value{*id}
+		}
+	case messagereaction.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *MessageReactionMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *MessageReactionMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *MessageReactionMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedmessage {
+		edges = append(edges, messagereaction.EdgeMessage)
+	}
+	if m.cleareduser {
+		edges = append(edges, messagereaction.EdgeUser)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *MessageReactionMutation) EdgeCleared(name string) bool {
+	switch name {
+	case messagereaction.EdgeMessage:
+		return m.clearedmessage
+	case messagereaction.EdgeUser:
+		return m.cleareduser
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *MessageReactionMutation) ClearEdge(name string) error {
+	switch name {
+	case messagereaction.EdgeMessage:
+		m.ClearMessage()
+		return nil
+	case messagereaction.EdgeUser:
+		m.ClearUser()
+		return nil
+	}
+	return fmt.Errorf("unknown MessageReaction unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *MessageReactionMutation) ResetEdge(name string) error {
+	switch name {
+	case messagereaction.EdgeMessage:
+		m.ResetMessage()
+		return nil
+	case messagereaction.EdgeUser:
+		m.ResetUser()
+		return nil
+	}
+	return fmt.Errorf("unknown MessageReaction edge %s", name)
+}
+
+// MessageRevisionMutation represents an operation that mutates the MessageRevision nodes in the graph.
+type MessageRevisionMutation struct {
+	config
+	op             Op
+	typ            string
+	id             *uuid.UUID
+	body           *string
+	created_at     *time.Time
+	clearedFields  map[string]struct{}
+	message        *uuid.UUID
+	clearedmessage bool
+	editor         *uuid.UUID
+	clearededitor  bool
+	done           bool
+	oldValue       func(context.Context) (*MessageRevision, error)
+	predicates     []predicate.MessageRevision
+}
+
+var _ ent.Mutation = (*MessageRevisionMutation)(nil)
+
+// messagerevisionOption allows management of the mutation configuration using functional options.
+type messagerevisionOption func(*MessageRevisionMutation)
+
+// newMessageRevisionMutation creates new mutation for the MessageRevision entity.
+func newMessageRevisionMutation(c config, op Op, opts ...messagerevisionOption) *MessageRevisionMutation {
+	m := &MessageRevisionMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeMessageRevision,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withMessageRevisionID sets the ID field of the mutation.
+func withMessageRevisionID(id uuid.UUID) messagerevisionOption {
+	return func(m *MessageRevisionMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *MessageRevision
+		)
+		m.oldValue = func(ctx context.Context) (*MessageRevision, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().MessageRevision.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withMessageRevision sets the old MessageRevision of the mutation.
+func withMessageRevision(node *MessageRevision) messagerevisionOption {
+	return func(m *MessageRevisionMutation) {
+		m.oldValue = func(context.Context) (*MessageRevision, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m MessageRevisionMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m MessageRevisionMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of MessageRevision entities.
+func (m *MessageRevisionMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *MessageRevisionMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *MessageRevisionMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().MessageRevision.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetBody sets the "body" field.
+func (m *MessageRevisionMutation) SetBody(s string) {
+	m.body = &s
+}
+
+// Body returns the value of the "body" field in the mutation.
+func (m *MessageRevisionMutation) Body() (r string, exists bool) {
+	v := m.body
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBody returns the old "body" field's value of the MessageRevision entity.
+// If the MessageRevision object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageRevisionMutation) OldBody(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBody is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBody requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBody: %w", err)
+	}
+	return oldValue.Body, nil
+}
+
+// ResetBody resets all changes to the "body" field.
+func (m *MessageRevisionMutation) ResetBody() {
+	m.body = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *MessageRevisionMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *MessageRevisionMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the MessageRevision entity.
+// If the MessageRevision object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageRevisionMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *MessageRevisionMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetMessageID sets the "message" edge to the Message entity by id.
+func (m *MessageRevisionMutation) SetMessageID(id uuid.UUID) {
+	m.message = &id
+}
+
+// ClearMessage clears the "message" edge to the Message entity.
+func (m *MessageRevisionMutation) ClearMessage() {
+	m.clearedmessage = true
+}
+
+// MessageCleared reports if the "message" edge to the Message entity was cleared.
+func (m *MessageRevisionMutation) MessageCleared() bool {
+	return m.clearedmessage
+}
+
+// MessageID returns the "message" edge ID in the mutation.
+func (m *MessageRevisionMutation) MessageID() (id uuid.UUID, exists bool) {
+	if m.message != nil {
+		return *m.message, true
+	}
+	return
+}
+
+// MessageIDs returns the "message" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// MessageID instead. It exists only for internal usage by the builders.
+func (m *MessageRevisionMutation) MessageIDs() (ids []uuid.UUID) {
+	if id := m.message; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetMessage resets all changes to the "message" edge.
+func (m *MessageRevisionMutation) ResetMessage() {
+	m.message = nil
+	m.clearedmessage = false
+}
+
+// SetEditorID sets the "editor" edge to the User entity by id.
+func (m *MessageRevisionMutation) SetEditorID(id uuid.UUID) {
+	m.editor = &id
+}
+
+// ClearEditor clears the "editor" edge to the User entity.
+func (m *MessageRevisionMutation) ClearEditor() {
+	m.clearededitor = true
+}
+
+// EditorCleared reports if the "editor" edge to the User entity was cleared.
+func (m *MessageRevisionMutation) EditorCleared() bool {
+	return m.clearededitor
+}
+
+// EditorID returns the "editor" edge ID in the mutation.
+func (m *MessageRevisionMutation) EditorID() (id uuid.UUID, exists bool) {
+	if m.editor != nil {
+		return *m.editor, true
+	}
+	return
+}
+
+// EditorIDs returns the "editor" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// EditorID instead. It exists only for internal usage by the builders.
+func (m *MessageRevisionMutation) EditorIDs() (ids []uuid.UUID) {
+	if id := m.editor; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetEditor resets all changes to the "editor" edge.
+func (m *MessageRevisionMutation) ResetEditor() {
+	m.editor = nil
+	m.clearededitor = false
+}
+
+// Where appends a list predicates to the MessageRevisionMutation builder.
+func (m *MessageRevisionMutation) Where(ps ...predicate.MessageRevision) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the MessageRevisionMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *MessageRevisionMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.MessageRevision, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *MessageRevisionMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *MessageRevisionMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (MessageRevision).
+func (m *MessageRevisionMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *MessageRevisionMutation) Fields() []string {
+	fields := make([]string, 0, 2)
+	if m.body != nil {
+		fields = append(fields, messagerevision.FieldBody)
+	}
+	if m.created_at != nil {
+		fields = append(fields, messagerevision.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *MessageRevisionMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case messagerevision.FieldBody:
+		return m.Body()
+	case messagerevision.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *MessageRevisionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case messagerevision.FieldBody:
+		return m.OldBody(ctx)
+	case messagerevision.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown MessageRevision field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MessageRevisionMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case messagerevision.FieldBody:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBody(v)
+		return nil
+	case messagerevision.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown MessageRevision field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *MessageRevisionMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *MessageRevisionMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MessageRevisionMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown MessageRevision numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *MessageRevisionMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *MessageRevisionMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *MessageRevisionMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown MessageRevision nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *MessageRevisionMutation) ResetField(name string) error {
+	switch name {
+	case messagerevision.FieldBody:
+		m.ResetBody()
+		return nil
+	case messagerevision.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown MessageRevision field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *MessageRevisionMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.message != nil {
+		edges = append(edges, messagerevision.EdgeMessage)
+	}
+	if m.editor != nil {
+		edges = append(edges, messagerevision.EdgeEditor)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *MessageRevisionMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case messagerevision.EdgeMessage:
+		if id := m.message; id != nil {
+			return []ent.Value{*id}
+		}
+	case messagerevision.EdgeEditor:
+		if id := m.editor; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *MessageRevisionMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *MessageRevisionMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *MessageRevisionMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedmessage {
+		edges = append(edges, messagerevision.EdgeMessage)
+	}
+	if m.clearededitor {
+		edges = append(edges, messagerevision.EdgeEditor)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *MessageRevisionMutation) EdgeCleared(name string) bool {
+	switch name {
+	case messagerevision.EdgeMessage:
+		return m.clearedmessage
+	case messagerevision.EdgeEditor:
+		return m.clearededitor
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *MessageRevisionMutation) ClearEdge(name string) error {
+	switch name {
+	case messagerevision.EdgeMessage:
+		m.ClearMessage()
+		return nil
+	case messagerevision.EdgeEditor:
+		m.ClearEditor()
+		return nil
+	}
+	return fmt.Errorf("unknown MessageRevision unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *MessageRevisionMutation) ResetEdge(name string) error {
+	switch name {
+	case messagerevision.EdgeMessage:
+		m.ResetMessage()
+		return nil
+	case messagerevision.EdgeEditor:
+		m.ResetEditor()
+		return nil
+	}
+	return fmt.Errorf("unknown MessageRevision edge %s", name)
+}
+
+// MessageShareMutation represents an operation that mutates the MessageShare nodes in the graph.
+type MessageShareMutation struct {
+	config
+	op             Op
+	typ            string
+	id             *uuid.UUID
+	created_at     *time.Time
+	clearedFields  map[string]struct{}
+	message        *uuid.UUID
+	clearedmessage bool
+	source         *uuid.UUID
+	clearedsource  bool
+	done           bool
+	oldValue       func(context.Context) (*MessageShare, error)
+	predicates     []predicate.MessageShare
+}
+
+var _ ent.Mutation = (*MessageShareMutation)(nil)
+
+// messageshareOption allows management of the mutation configuration using functional options.
+type messageshareOption func(*MessageShareMutation)
+
+// newMessageShareMutation creates new mutation for the MessageShare entity.
+func newMessageShareMutation(c config, op Op, opts ...messageshareOption) *MessageShareMutation {
+	m := &MessageShareMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeMessageShare,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withMessageShareID sets the ID field of the mutation.
+func withMessageShareID(id uuid.UUID) messageshareOption {
+	return func(m *MessageShareMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *MessageShare
+		)
+		m.oldValue = func(ctx context.Context) (*MessageShare, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().MessageShare.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withMessageShare sets the old MessageShare of the mutation.
+func withMessageShare(node *MessageShare) messageshareOption {
+	return func(m *MessageShareMutation) {
+		m.oldValue = func(context.Context) (*MessageShare, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m MessageShareMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m MessageShareMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of MessageShare entities.
+func (m *MessageShareMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *MessageShareMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *MessageShareMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().MessageShare.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *MessageShareMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *MessageShareMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the MessageShare entity.
+// If the MessageShare object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageShareMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *MessageShareMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetMessageID sets the "message" edge to the Message entity by id.
+func (m *MessageShareMutation) SetMessageID(id uuid.UUID) {
+	m.message = &id
+}
+
+// ClearMessage clears the "message" edge to the Message entity.
+func (m *MessageShareMutation) ClearMessage() {
+	m.clearedmessage = true
+}
+
+// MessageCleared reports if the "message" edge to the Message entity was cleared.
+func (m *MessageShareMutation) MessageCleared() bool {
+	return m.clearedmessage
+}
+
+// MessageID returns the "message" edge ID in the mutation.
+func (m *MessageShareMutation) MessageID() (id uuid.UUID, exists bool) {
+	if m.message != nil {
+		return *m.message, true
+	}
+	return
+}
+
+// MessageIDs returns the "message" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// MessageID instead. It exists only for internal usage by the builders.
+func (m *MessageShareMutation) MessageIDs() (ids []uuid.UUID) {
+	if id := m.message; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetMessage resets all changes to the "message" edge.
+func (m *MessageShareMutation) ResetMessage() {
+	m.message = nil
+	m.clearedmessage = false
+}
+
+// SetSourceID sets the "source" edge to the Message entity by id.
+func (m *MessageShareMutation) SetSourceID(id uuid.UUID) {
+	m.source = &id
+}
+
+// ClearSource clears the "source" edge to the Message entity.
+func (m *MessageShareMutation) ClearSource() {
+	m.clearedsource = true
+}
+
+// SourceCleared reports if the "source" edge to the Message entity was cleared.
+func (m *MessageShareMutation) SourceCleared() bool {
+	return m.clearedsource
+}
+
+// SourceID returns the "source" edge ID in the mutation.
+func (m *MessageShareMutation) SourceID() (id uuid.UUID, exists bool) {
+	if m.source != nil {
+		return *m.source, true
+	}
+	return
+}
+
+// SourceIDs returns the "source" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// SourceID instead. It exists only for internal usage by the builders.
+func (m *MessageShareMutation) SourceIDs() (ids []uuid.UUID) {
+	if id := m.source; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetSource resets all changes to the "source" edge.
+func (m *MessageShareMutation) ResetSource() {
+	m.source = nil
+	m.clearedsource = false
+}
+
+// Where appends a list predicates to the MessageShareMutation builder.
+func (m *MessageShareMutation) Where(ps ...predicate.MessageShare) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the MessageShareMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *MessageShareMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.MessageShare, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *MessageShareMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *MessageShareMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (MessageShare).
+func (m *MessageShareMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *MessageShareMutation) Fields() []string {
+	fields := make([]string, 0, 1)
+	if m.created_at != nil {
+		fields = append(fields, messageshare.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *MessageShareMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case messageshare.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *MessageShareMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case messageshare.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown MessageShare field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MessageShareMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case messageshare.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown MessageShare field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *MessageShareMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *MessageShareMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MessageShareMutation) AddField(name string, value ent.Value) error {
+	switch name {
 	}
-	if m.removedattachments != nil {
-		edges = append(edges, message.EdgeAttachments)
+	return fmt.Errorf("unknown MessageShare numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *MessageShareMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *MessageShareMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *MessageShareMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown MessageShare nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *MessageShareMutation) ResetField(name string) error {
+	switch name {
+	case messageshare.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
 	}
-	if m.removeduser_thread_follows != nil {
-		edges = append(edges, message.EdgeUserThreadFollows)
+	return fmt.Errorf("unknown MessageShare field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *MessageShareMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.message != nil {
+		edges = append(edges, messageshare.EdgeMessage)
 	}
-	if m.removedthread_read_states != nil {
-		edges = append(edges, message.EdgeThreadReadStates)
+	if m.source != nil {
+		edges = append(edges, messageshare.EdgeSource)
 	}
 	return edges
 }
 
-// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
-// the given name in this mutation.
-func (m *MessageMutation) RemovedIDs(name string) []ent.Value {
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *MessageShareMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case message.EdgeReplies:
-		ids := make([]ent.Value, 0, len(m.removedreplies))
-		for id := range m.removedreplies {
-			ids = append(ids, id)
-		}
-		return ids
-	case message.EdgeReactions:
-		ids := make([]ent.Value, 0, len(m.removedreactions))
-		for id := range m.removedreactions {
-			ids = append(ids, id)
-		}
-		return ids
-	case message.EdgeBookmarks:
-		ids := make([]ent.Value, 0, len(m.removedbookmarks))
-		for id := range m.removedbookmarks {
-			ids = append(ids, id)
-		}
-		return ids
-	case message.EdgeUserMentions:
-		ids := make([]ent.Value, 0, len(m.removeduser_mentions))
-		for id := range m.removeduser_mentions {
-			ids = append(ids, id)
-		}
-		return ids
-	case message.EdgeGroupMentions:
-		ids := make([]ent.Value, 0, len(m.removedgroup_mentions))
-		for id := range m.removedgroup_mentions {
-			ids = append(ids, id)
-		}
-		return ids
-	case message.EdgeLinks:
-		ids := make([]ent.Value, 0, len(m.removedlinks))
-		for id := range m.removedlinks {
-			ids = append(ids, id)
-		}
-		return ids
-	case message.EdgeAttachments:
-		ids := make([]ent.Value, 0, len(m.removedattachments))
-		for id := range m.removedattachments {
-			ids = append(ids, id)
-		}
-		return ids
-	case message.EdgeUserThreadFollows:
-		ids := make([]ent.Value, 0, len(m.removeduser_thread_follows))
-		for id := range m.removeduser_thread_follows {
-			ids = append(ids, id)
+	case messageshare.EdgeMessage:
+		if id := m.message; id != nil {
+			return []ent.Value{*id}
 		}
-		return ids
-	case message.EdgeThreadReadStates:
-		ids := make([]ent.Value, 0, len(m.removedthread_read_states))
-		for id := range m.removedthread_read_states {
-			ids = append(ids, id)
+	case messageshare.EdgeSource:
+		if id := m.source; id != nil {
+			return []ent.Value{*id}
 		}
-		return ids
 	}
 	return nil
 }
 
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *MessageShareMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *MessageShareMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *MessageMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 12)
-	if m.clearedchannel {
-		edges = append(edges, message.EdgeChannel)
-	}
-	if m.cleareduser {
-		edges = append(edges, message.EdgeUser)
-	}
-	if m.clearedparent {
-		edges = append(edges, message.EdgeParent)
-	}
-	if m.clearedreplies {
-		edges = append(edges, message.EdgeReplies)
-	}
-	if m.clearedreactions {
-		edges = append(edges, message.EdgeReactions)
-	}
-	if m.clearedbookmarks {
-		edges = append(edges, message.EdgeBookmarks)
-	}
-	if m.cleareduser_mentions {
-		edges = append(edges, message.EdgeUserMentions)
-	}
-	if m.clearedgroup_mentions {
-		edges = append(edges, message.EdgeGroupMentions)
-	}
-	if m.clearedlinks {
-		edges = append(edges, message.EdgeLinks)
-	}
-	if m.clearedattachments {
-		edges = append(edges, message.EdgeAttachments)
-	}
-	if m.cleareduser_thread_follows {
-		edges = append(edges, message.EdgeUserThreadFollows)
+func (m *MessageShareMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedmessage {
+		edges = append(edges, messageshare.EdgeMessage)
 	}
-	if m.clearedthread_read_states {
-		edges = append(edges, message.EdgeThreadReadStates)
+	if m.clearedsource {
+		edges = append(edges, messageshare.EdgeSource)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *MessageMutation) EdgeCleared(name string) bool {
+func (m *MessageShareMutation) EdgeCleared(name string) bool {
 	switch name {
-	case message.EdgeChannel:
-		return m.clearedchannel
-	case message.EdgeUser:
-		return m.cleareduser
-	case message.EdgeParent:
-		return m.clearedparent
-	case message.EdgeReplies:
-		return m.clearedreplies
-	case message.EdgeReactions:
-		return m.clearedreactions
-	case message.EdgeBookmarks:
-		return m.clearedbookmarks
-	case message.EdgeUserMentions:
-		return m.cleareduser_mentions
-	case message.EdgeGroupMentions:
-		return m.clearedgroup_mentions
-	case message.EdgeLinks:
-		return m.clearedlinks
-	case message.EdgeAttachments:
-		return m.clearedattachments
-	case message.EdgeUserThreadFollows:
-		return m.cleareduser_thread_follows
-	case message.EdgeThreadReadStates:
-		return m.clearedthread_read_states
+	case messageshare.EdgeMessage:
+		return m.clearedmessage
+	case messageshare.EdgeSource:
+		return m.clearedsource
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *MessageMutation) ClearEdge(name string) error {
+func (m *MessageShareMutation) ClearEdge(name string) error {
 	switch name {
-	case message.EdgeChannel:
-		m.ClearChannel()
-		return nil
-	case message.EdgeUser:
-		m.ClearUser()
+	case messageshare.EdgeMessage:
+		m.ClearMessage()
 		return nil
-	case message.EdgeParent:
-		m.ClearParent()
+	case messageshare.EdgeSource:
+		m.ClearSource()
 		return nil
 	}
-	return fmt.Errorf("unknown Message unique edge %s", name)
+	return fmt.Errorf("unknown MessageShare unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *MessageMutation) ResetEdge(name string) error {
+func (m *MessageShareMutation) ResetEdge(name string) error {
 	switch name {
-	case message.EdgeChannel:
-		m.ResetChannel()
-		return nil
-	case message.EdgeUser:
-		m.ResetUser()
-		return nil
-	case message.EdgeParent:
-		m.ResetParent()
-		return nil
-	case message.EdgeReplies:
-		m.ResetReplies()
-		return nil
-	case message.EdgeReactions:
-		m.ResetReactions()
-		return nil
-	case message.EdgeBookmarks:
-		m.ResetBookmarks()
-		return nil
-	case message.EdgeUserMentions:
-		m.ResetUserMentions()
-		return nil
-	case message.EdgeGroupMentions:
-		m.ResetGroupMentions()
-		return nil
-	case message.EdgeLinks:
-		m.ResetLinks()
-		return nil
-	case message.EdgeAttachments:
-		m.ResetAttachments()
-		return nil
-	case message.EdgeUserThreadFollows:
-		m.ResetUserThreadFollows()
+	case messageshare.EdgeMessage:
+		m.ResetMessage()
 		return nil
-	case message.EdgeThreadReadStates:
-		m.ResetThreadReadStates()
+	case messageshare.EdgeSource:
+		m.ResetSource()
 		return nil
 	}
-	return fmt.Errorf("unknown Message edge %s", name)
+	return fmt.Errorf("unknown MessageShare edge %s", name)
 }
 
-// MessageBookmarkMutation represents an operation that mutates the MessageBookmark nodes in the graph.
-type MessageBookmarkMutation struct {
+// MessageUserMentionMutation represents an operation that mutates the MessageUserMention nodes in the graph.
+type MessageUserMentionMutation struct {
 	config
 	op             Op
 	typ            string
 	id             *uuid.UUID
 	created_at     *time.Time
 	clearedFields  map[string]struct{}
-	user           *uuid.UUID
-	cleareduser    bool
 	message        *uuid.UUID
 	clearedmessage bool
+	user           *uuid.UUID
+	cleareduser    bool
 	done           bool
-	oldValue       func(context.Context) (*MessageBookmark, error)
-	predicates     []predicate.MessageBookmark
+	oldValue       func(context.Context) (*MessageUserMention, error)
+	predicates     []predicate.MessageUserMention
 }
 
-var _ ent.Mutation = (*MessageBookmarkMutation)(nil)
+var _ ent.Mutation = (*MessageUserMentionMutation)(nil)
 
-// messagebookmarkOption allows management of the mutation configuration using functional options.
-type messagebookmarkOption func(*MessageBookmarkMutation)
+// messageusermentionOption allows management of the mutation configuration using functional options.
+type messageusermentionOption func(*MessageUserMentionMutation)
 
-// newMessageBookmarkMutation creates new mutation for the MessageBookmark entity.
-func newMessageBookmarkMutation(c config, op Op, opts ...messagebookmarkOption) *MessageBookmarkMutation {
-	m := &MessageBookmarkMutation{
+// newMessageUserMentionMutation creates new mutation for the MessageUserMention entity.
+func newMessageUserMentionMutation(c config, op Op, opts ...messageusermentionOption) *MessageUserMentionMutation {
+	m := &MessageUserMentionMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeMessageBookmark,
+		typ:           TypeMessageUserMention,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -4689,20 +10452,20 @@ func newMessageBookmarkMutation(c config, op Op, opts ...messagebookmarkOption)
 	return m
 }
 
-// withMessageBookmarkID sets the ID field of the mutation.
-func withMessageBookmarkID(id uuid.UUID) messagebookmarkOption {
-	return func(m *MessageBookmarkMutation) {
+// withMessageUserMentionID sets the ID field of the mutation.
+func withMessageUserMentionID(id uuid.UUID) messageusermentionOption {
+	return func(m *MessageUserMentionMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *MessageBookmark
+			value *MessageUserMention
 		)
-		m.oldValue = func(ctx context.Context) (*MessageBookmark, error) {
+		m.oldValue = func(ctx context.Context) (*MessageUserMention, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().MessageBookmark.Get(ctx, id)
+					value, err = m.Client().MessageUserMention.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -4711,10 +10474,10 @@ func withMessageBookmarkID(id uuid.UUID) messagebookmarkOption {
 	}
 }
 
-// withMessageBookmark sets the old MessageBookmark of the mutation.
-func withMessageBookmark(node *MessageBookmark) messagebookmarkOption {
-	return func(m *MessageBookmarkMutation) {
-		m.oldValue = func(context.Context) (*MessageBookmark, error) {
+// withMessageUserMention sets the old MessageUserMention of the mutation.
+func withMessageUserMention(node *MessageUserMention) messageusermentionOption {
+	return func(m *MessageUserMentionMutation) {
+		m.oldValue = func(context.Context) (*MessageUserMention, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -4723,7 +10486,7 @@ func withMessageBookmark(node *MessageBookmark) messagebookmarkOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m MessageBookmarkMutation) Client() *Client {
+func (m MessageUserMentionMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -4731,7 +10494,7 @@ func (m MessageBookmarkMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m MessageBookmarkMutation) Tx() (*Tx, error) {
+func (m MessageUserMentionMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -4741,14 +10504,14 @@ func (m MessageBookmarkMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of MessageBookmark entities.
-func (m *MessageBookmarkMutation) SetID(id uuid.UUID) {
+// operation is only accepted on creation of MessageUserMention entities.
+func (m *MessageUserMentionMutation) SetID(id uuid.UUID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *MessageBookmarkMutation) ID() (id uuid.UUID, exists bool) {
+func (m *MessageUserMentionMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -4759,7 +10522,7 @@ func (m *MessageBookmarkMutation) ID() (id uuid.UUID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *MessageBookmarkMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+func (m *MessageUserMentionMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -4768,19 +10531,19 @@ func (m *MessageBookmarkMutation) IDs(ctx context.Context) ([]uuid.UUID, error)
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().MessageBookmark.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().MessageUserMention.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *MessageBookmarkMutation) SetCreatedAt(t time.Time) {
+func (m *MessageUserMentionMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *MessageBookmarkMutation) CreatedAt() (r time.Time, exists bool) {
+func (m *MessageUserMentionMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -4788,10 +10551,10 @@ func (m *MessageBookmarkMutation) CreatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the MessageBookmark entity.
-// If the MessageBookmark object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the MessageUserMention entity.
+// If the MessageUserMention object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MessageBookmarkMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *MessageUserMentionMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -4806,97 +10569,97 @@ func (m *MessageBookmarkMutation) OldCreatedAt(ctx context.Context) (v time.Time
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *MessageBookmarkMutation) ResetCreatedAt() {
+func (m *MessageUserMentionMutation) ResetCreatedAt() {
 	m.created_at = nil
 }
 
-// SetUserID sets the "user" edge to the User entity by id.
-func (m *MessageBookmarkMutation) SetUserID(id uuid.UUID) {
-	m.user = &id
+// SetMessageID sets the "message" edge to the Message entity by id.
+func (m *MessageUserMentionMutation) SetMessageID(id uuid.UUID) {
+	m.message = &id
 }
 
-// ClearUser clears the "user" edge to the User entity.
-func (m *MessageBookmarkMutation) ClearUser() {
-	m.cleareduser = true
+// ClearMessage clears the "message" edge to the Message entity.
+func (m *MessageUserMentionMutation) ClearMessage() {
+	m.clearedmessage = true
 }
 
-// UserCleared reports if the "user" edge to the User entity was cleared.
-func (m *MessageBookmarkMutation) UserCleared() bool {
-	return m.cleareduser
+// MessageCleared reports if the "message" edge to the Message entity was cleared.
+func (m *MessageUserMentionMutation) MessageCleared() bool {
+	return m.clearedmessage
 }
 
-// UserID returns the "user" edge ID in the mutation.
-func (m *MessageBookmarkMutation) UserID() (id uuid.UUID, exists bool) {
-	if m.user != nil {
-		return *m.user, true
+// MessageID returns the "message" edge ID in the mutation.
+func (m *MessageUserMentionMutation) MessageID() (id uuid.UUID, exists bool) {
+	if m.message != nil {
+		return *m.message, true
 	}
 	return
 }
 
-// UserIDs returns the "user" edge IDs in the mutation.
+// MessageIDs returns the "message" edge IDs in the mutation.
 // Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// UserID instead. It exists only for internal usage by the builders.
-func (m *MessageBookmarkMutation) UserIDs() (ids []uuid.UUID) {
-	if id := m.user; id != nil {
+// MessageID instead. It exists only for internal usage by the builders.
+func (m *MessageUserMentionMutation) MessageIDs() (ids []uuid.UUID) {
+	if id := m.message; id != nil {
 		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetUser resets all changes to the "user" edge.
-func (m *MessageBookmarkMutation) ResetUser() {
-	m.user = nil
-	m.cleareduser = false
+// ResetMessage resets all changes to the "message" edge.
+func (m *MessageUserMentionMutation) ResetMessage() {
+	m.message = nil
+	m.clearedmessage = false
 }
 
-// SetMessageID sets the "message" edge to the Message entity by id.
-func (m *MessageBookmarkMutation) SetMessageID(id uuid.UUID) {
-	m.message = &id
+// SetUserID sets the "user" edge to the User entity by id.
+func (m *MessageUserMentionMutation) SetUserID(id uuid.UUID) {
+	m.user = &id
 }
 
-// ClearMessage clears the "message" edge to the Message entity.
-func (m *MessageBookmarkMutation) ClearMessage() {
-	m.clearedmessage = true
+// ClearUser clears the "user" edge to the User entity.
+func (m *MessageUserMentionMutation) ClearUser() {
+	m.cleareduser = true
 }
 
-// MessageCleared reports if the "message" edge to the Message entity was cleared.
-func (m *MessageBookmarkMutation) MessageCleared() bool {
-	return m.clearedmessage
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *MessageUserMentionMutation) UserCleared() bool {
+	return m.cleareduser
 }
 
-// MessageID returns the "message" edge ID in the mutation.
-func (m *MessageBookmarkMutation) MessageID() (id uuid.UUID, exists bool) {
-	if m.message != nil {
-		return *m.message, true
+// UserID returns the "user" edge ID in the mutation.
+func (m *MessageUserMentionMutation) UserID() (id uuid.UUID, exists bool) {
+	if m.user != nil {
+		return *m.user, true
 	}
 	return
 }
 
-// MessageIDs returns the "message" edge IDs in the mutation.
+// UserIDs returns the "user" edge IDs in the mutation.
 // Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// MessageID instead. It exists only for internal usage by the builders.
-func (m *MessageBookmarkMutation) MessageIDs() (ids []uuid.UUID) {
-	if id := m.message; id != nil {
+// UserID instead. It exists only for internal usage by the builders.
+func (m *MessageUserMentionMutation) UserIDs() (ids []uuid.UUID) {
+	if id := m.user; id != nil {
 		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetMessage resets all changes to the "message" edge.
-func (m *MessageBookmarkMutation) ResetMessage() {
-	m.message = nil
-	m.clearedmessage = false
+// ResetUser resets all changes to the "user" edge.
+func (m *MessageUserMentionMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
 }
 
-// Where appends a list predicates to the MessageBookmarkMutation builder.
-func (m *MessageBookmarkMutation) Where(ps ...predicate.MessageBookmark) {
+// Where appends a list predicates to the MessageUserMentionMutation builder.
+func (m *MessageUserMentionMutation) Where(ps ...predicate.MessageUserMention) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the MessageBookmarkMutation builder. Using this method,
+// WhereP appends storage-level predicates to the MessageUserMentionMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *MessageBookmarkMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.MessageBookmark, len(ps))
+func (m *MessageUserMentionMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.MessageUserMention, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -4904,27 +10667,27 @@ func (m *MessageBookmarkMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *MessageBookmarkMutation) Op() Op {
+func (m *MessageUserMentionMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *MessageBookmarkMutation) SetOp(op Op) {
+func (m *MessageUserMentionMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (MessageBookmark).
-func (m *MessageBookmarkMutation) Type() string {
+// Type returns the node type of this mutation (MessageUserMention).
+func (m *MessageUserMentionMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *MessageBookmarkMutation) Fields() []string {
+func (m *MessageUserMentionMutation) Fields() []string {
 	fields := make([]string, 0, 1)
 	if m.created_at != nil {
-		fields = append(fields, messagebookmark.FieldCreatedAt)
+		fields = append(fields, messageusermention.FieldCreatedAt)
 	}
 	return fields
 }
@@ -4932,9 +10695,9 @@ func (m *MessageBookmarkMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *MessageBookmarkMutation) Field(name string) (ent.Value, bool) {
+func (m *MessageUserMentionMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case messagebookmark.FieldCreatedAt:
+	case messageusermention.FieldCreatedAt:
 		return m.CreatedAt()
 	}
 	return nil, false
@@ -4943,20 +10706,20 @@ func (m *MessageBookmarkMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *MessageBookmarkMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *MessageUserMentionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case messagebookmark.FieldCreatedAt:
+	case messageusermention.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown MessageBookmark field %s", name)
+	return nil, fmt.Errorf("unknown MessageUserMention field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *MessageBookmarkMutation) SetField(name string, value ent.Value) error {
+func (m *MessageUserMentionMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case messagebookmark.FieldCreatedAt:
+	case messageusermention.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
@@ -4964,83 +10727,83 @@ func (m *MessageBookmarkMutation) SetField(name string, value ent.Value) error {
 		m.SetCreatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown MessageBookmark field %s", name)
+	return fmt.Errorf("unknown MessageUserMention field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *MessageBookmarkMutation) AddedFields() []string {
+func (m *MessageUserMentionMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *MessageBookmarkMutation) AddedField(name string) (ent.Value, bool) {
+func (m *MessageUserMentionMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *MessageBookmarkMutation) AddField(name string, value ent.Value) error {
+func (m *MessageUserMentionMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown MessageBookmark numeric field %s", name)
+	return fmt.Errorf("unknown MessageUserMention numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *MessageBookmarkMutation) ClearedFields() []string {
+func (m *MessageUserMentionMutation) ClearedFields() []string {
 	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *MessageBookmarkMutation) FieldCleared(name string) bool {
+func (m *MessageUserMentionMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *MessageBookmarkMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown MessageBookmark nullable field %s", name)
+func (m *MessageUserMentionMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown MessageUserMention nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *MessageBookmarkMutation) ResetField(name string) error {
+func (m *MessageUserMentionMutation) ResetField(name string) error {
 	switch name {
-	case messagebookmark.FieldCreatedAt:
+	case messageusermention.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown MessageBookmark field %s", name)
+	return fmt.Errorf("unknown MessageUserMention field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *MessageBookmarkMutation) AddedEdges() []string {
+func (m *MessageUserMentionMutation) AddedEdges() []string {
 	edges := make([]string, 0, 2)
-	if m.user != nil {
-		edges = append(edges, messagebookmark.EdgeUser)
-	}
 	if m.message != nil {
-		edges = append(edges, messagebookmark.EdgeMessage)
+		edges = append(edges, messageusermention.EdgeMessage)
+	}
+	if m.user != nil {
+		edges = append(edges, messageusermention.EdgeUser)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *MessageBookmarkMutation) AddedIDs(name string) []ent.Value {
+func (m *MessageUserMentionMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case messagebookmark.EdgeUser:
-		if id := m.user; id != nil {
+	case messageusermention.EdgeMessage:
+		if id := m.message; id != nil {
 			return []ent.Value{*id}
 		}
-	case messagebookmark.EdgeMessage:
-		if id := m.message; id != nil {
+	case messageusermention.EdgeUser:
+		if id := m.user; id != nil {
 			return []ent.Value{*id}
 		}
 	}
@@ -5048,97 +10811,104 @@ func (m *MessageBookmarkMutation) AddedIDs(name string) []ent.Value {
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *MessageBookmarkMutation) RemovedEdges() []string {
+func (m *MessageUserMentionMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 2)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *MessageBookmarkMutation) RemovedIDs(name string) []ent.Value {
+func (m *MessageUserMentionMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *MessageBookmarkMutation) ClearedEdges() []string {
+func (m *MessageUserMentionMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 2)
-	if m.cleareduser {
-		edges = append(edges, messagebookmark.EdgeUser)
-	}
 	if m.clearedmessage {
-		edges = append(edges, messagebookmark.EdgeMessage)
+		edges = append(edges, messageusermention.EdgeMessage)
+	}
+	if m.cleareduser {
+		edges = append(edges, messageusermention.EdgeUser)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *MessageBookmarkMutation) EdgeCleared(name string) bool {
+func (m *MessageUserMentionMutation) EdgeCleared(name string) bool {
 	switch name {
-	case messagebookmark.EdgeUser:
-		return m.cleareduser
-	case messagebookmark.EdgeMessage:
+	case messageusermention.EdgeMessage:
 		return m.clearedmessage
+	case messageusermention.EdgeUser:
+		return m.cleareduser
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *MessageBookmarkMutation) ClearEdge(name string) error {
+func (m *MessageUserMentionMutation) ClearEdge(name string) error {
 	switch name {
-	case messagebookmark.EdgeUser:
-		m.ClearUser()
-		return nil
-	case messagebookmark.EdgeMessage:
+	case messageusermention.EdgeMessage:
 		m.ClearMessage()
 		return nil
+	case messageusermention.EdgeUser:
+		m.ClearUser()
+		return nil
 	}
-	return fmt.Errorf("unknown MessageBookmark unique edge %s", name)
+	return fmt.Errorf("unknown MessageUserMention unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *MessageBookmarkMutation) ResetEdge(name string) error {
+func (m *MessageUserMentionMutation) ResetEdge(name string) error {
 	switch name {
-	case messagebookmark.EdgeUser:
-		m.ResetUser()
-		return nil
-	case messagebookmark.EdgeMessage:
+	case messageusermention.EdgeMessage:
 		m.ResetMessage()
 		return nil
-	}
-	return fmt.Errorf("unknown MessageBookmark edge %s", name)
-}
-
-// MessageGroupMentionMutation represents an operation that mutates the MessageGroupMention nodes in the graph.
-type MessageGroupMentionMutation struct {
-	config
-	op             Op
-	typ            string
-	id             *uuid.UUID
-	created_at     *time.Time
-	clearedFields  map[string]struct{}
-	message        *uuid.UUID
-	clearedmessage bool
-	group          *uuid.UUID
-	clearedgroup   bool
-	done           bool
-	oldValue       func(context.Context) (*MessageGroupMention, error)
-	predicates     []predicate.MessageGroupMention
+	case messageusermention.EdgeUser:
+		m.ResetUser()
+		return nil
+	}
+	return fmt.Errorf("unknown MessageUserMention edge %s", name)
 }
 
-var _ ent.Mutation = (*MessageGroupMentionMutation)(nil)
+// PollMutation represents an operation that mutates the Poll nodes in the graph.
+type PollMutation struct {
+	config
+	op              Op
+	typ             string
+	id              *uuid.UUID
+	multiple_choice *bool
+	anonymous       *bool
+	closes_at       *time.Time
+	created_at      *time.Time
+	clearedFields   map[string]struct{}
+	message         *uuid.UUID
+	clearedmessage  bool
+	options         map[uuid.UUID]struct{}
+	removedoptions  map[uuid.UUID]struct{}
+	clearedoptions  bool
+	votes           map[uuid.UUID]struct{}
+	removedvotes    map[uuid.UUID]struct{}
+	clearedvotes    bool
+	done            bool
+	oldValue        func(context.Context) (*Poll, error)
+	predicates      []predicate.Poll
+}
 
-// messagegroupmentionOption allows management of the mutation configuration using functional options.
-type messagegroupmentionOption func(*MessageGroupMentionMutation)
+var _ ent.Mutation = (*PollMutation)(nil)
 
-// newMessageGroupMentionMutation creates new mutation for the MessageGroupMention entity.
-func newMessageGroupMentionMutation(c config, op Op, opts ...messagegroupmentionOption) *MessageGroupMentionMutation {
-	m := &MessageGroupMentionMutation{
+// pollOption allows management of the mutation configuration using functional options.
+type pollOption func(*PollMutation)
+
+// newPollMutation creates new mutation for the Poll entity.
+func newPollMutation(c config, op Op, opts ...pollOption) *PollMutation {
+	m := &PollMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeMessageGroupMention,
+		typ:           TypePoll,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -5147,20 +10917,20 @@ func newMessageGroupMentionMutation(c config, op Op, opts ...messagegroupmention
 	return m
 }
 
-// withMessageGroupMentionID sets the ID field of the mutation.
-func withMessageGroupMentionID(id uuid.UUID) messagegroupmentionOption {
-	return func(m *MessageGroupMentionMutation) {
+// withPollID sets the ID field of the mutation.
+func withPollID(id uuid.UUID) pollOption {
+	return func(m *PollMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *MessageGroupMention
+			value *Poll
 		)
-		m.oldValue = func(ctx context.Context) (*MessageGroupMention, error) {
+		m.oldValue = func(ctx context.Context) (*Poll, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().MessageGroupMention.Get(ctx, id)
+					value, err = m.Client().Poll.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -5169,10 +10939,10 @@ func withMessageGroupMentionID(id uuid.UUID) messagegroupmentionOption {
 	}
 }
 
-// withMessageGroupMention sets the old MessageGroupMention of the mutation.
-func withMessageGroupMention(node *MessageGroupMention) messagegroupmentionOption {
-	return func(m *MessageGroupMentionMutation) {
-		m.oldValue = func(context.Context) (*MessageGroupMention, error) {
+// withPoll sets the old Poll of the mutation.
+func withPoll(node *Poll) pollOption {
+	return func(m *PollMutation) {
+		m.oldValue = func(context.Context) (*Poll, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -5181,7 +10951,7 @@ func withMessageGroupMention(node *MessageGroupMention) messagegroupmentionOptio
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m MessageGroupMentionMutation) Client() *Client {
+func (m PollMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -5189,7 +10959,7 @@ func (m MessageGroupMentionMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m MessageGroupMentionMutation) Tx() (*Tx, error) {
+func (m PollMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -5199,14 +10969,14 @@ func (m MessageGroupMentionMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of MessageGroupMention entities.
-func (m *MessageGroupMentionMutation) SetID(id uuid.UUID) {
+// operation is only accepted on creation of Poll entities.
+func (m *PollMutation) SetID(id uuid.UUID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *MessageGroupMentionMutation) ID() (id uuid.UUID, exists bool) {
+func (m *PollMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -5217,7 +10987,7 @@ func (m *MessageGroupMentionMutation) ID() (id uuid.UUID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *MessageGroupMentionMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+func (m *PollMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -5226,19 +10996,140 @@ func (m *MessageGroupMentionMutation) IDs(ctx context.Context) ([]uuid.UUID, err
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().MessageGroupMention.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Poll.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
+// SetMultipleChoice sets the "multiple_choice" field.
+func (m *PollMutation) SetMultipleChoice(b bool) {
+	m.multiple_choice = &b
+}
+
+// MultipleChoice returns the value of the "multiple_choice" field in the mutation.
+func (m *PollMutation) MultipleChoice() (r bool, exists bool) {
+	v := m.multiple_choice
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMultipleChoice returns the old "multiple_choice" field's value of the Poll entity.
+// If the Poll object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PollMutation) OldMultipleChoice(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMultipleChoice is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMultipleChoice requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMultipleChoice: %w", err)
+	}
+	return oldValue.MultipleChoice, nil
+}
+
+// ResetMultipleChoice resets all changes to the "multiple_choice" field.
+func (m *PollMutation) ResetMultipleChoice() {
+	m.multiple_choice = nil
+}
+
+// SetAnonymous sets the "anonymous" field.
+func (m *PollMutation) SetAnonymous(b bool) {
+	m.anonymous = &b
+}
+
+// Anonymous returns the value of the "anonymous" field in the mutation.
+func (m *PollMutation) Anonymous() (r bool, exists bool) {
+	v := m.anonymous
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAnonymous returns the old "anonymous" field's value of the Poll entity.
+// If the Poll object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PollMutation) OldAnonymous(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAnonymous is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAnonymous requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAnonymous: %w", err)
+	}
+	return oldValue.Anonymous, nil
+}
+
+// ResetAnonymous resets all changes to the "anonymous" field.
+func (m *PollMutation) ResetAnonymous() {
+	m.anonymous = nil
+}
+
+// SetClosesAt sets the "closes_at" field.
+func (m *PollMutation) SetClosesAt(t time.Time) {
+	m.closes_at = &t
+}
+
+// ClosesAt returns the value of the "closes_at" field in the mutation.
+func (m *PollMutation) ClosesAt() (r time.Time, exists bool) {
+	v := m.closes_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldClosesAt returns the old "closes_at" field's value of the Poll entity.
+// If the Poll object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PollMutation) OldClosesAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldClosesAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldClosesAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldClosesAt: %w", err)
+	}
+	return oldValue.ClosesAt, nil
+}
+
+// ClearClosesAt clears the value of the "closes_at" field.
+func (m *PollMutation) ClearClosesAt() {
+	m.closes_at = nil
+	m.clearedFields[poll.FieldClosesAt] = struct{}{}
+}
+
+// ClosesAtCleared returns if the "closes_at" field was cleared in this mutation.
+func (m *PollMutation) ClosesAtCleared() bool {
+	_, ok := m.clearedFields[poll.FieldClosesAt]
+	return ok
+}
+
+// ResetClosesAt resets all changes to the "closes_at" field.
+func (m *PollMutation) ResetClosesAt() {
+	m.closes_at = nil
+	delete(m.clearedFields, poll.FieldClosesAt)
+}
+
 // SetCreatedAt sets the "created_at" field.
-func (m *MessageGroupMentionMutation) SetCreatedAt(t time.Time) {
+func (m *PollMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *MessageGroupMentionMutation) CreatedAt() (r time.Time, exists bool) {
+func (m *PollMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -5246,10 +11137,10 @@ func (m *MessageGroupMentionMutation) CreatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the MessageGroupMention entity.
-// If the MessageGroupMention object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the Poll entity.
+// If the Poll object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MessageGroupMentionMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *PollMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -5264,27 +11155,27 @@ func (m *MessageGroupMentionMutation) OldCreatedAt(ctx context.Context) (v time.
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *MessageGroupMentionMutation) ResetCreatedAt() {
+func (m *PollMutation) ResetCreatedAt() {
 	m.created_at = nil
 }
 
 // SetMessageID sets the "message" edge to the Message entity by id.
-func (m *MessageGroupMentionMutation) SetMessageID(id uuid.UUID) {
+func (m *PollMutation) SetMessageID(id uuid.UUID) {
 	m.message = &id
 }
 
 // ClearMessage clears the "message" edge to the Message entity.
-func (m *MessageGroupMentionMutation) ClearMessage() {
+func (m *PollMutation) ClearMessage() {
 	m.clearedmessage = true
 }
 
 // MessageCleared reports if the "message" edge to the Message entity was cleared.
-func (m *MessageGroupMentionMutation) MessageCleared() bool {
+func (m *PollMutation) MessageCleared() bool {
 	return m.clearedmessage
 }
 
 // MessageID returns the "message" edge ID in the mutation.
-func (m *MessageGroupMentionMutation) MessageID() (id uuid.UUID, exists bool) {
+func (m *PollMutation) MessageID() (id uuid.UUID, exists bool) {
 	if m.message != nil {
 		return *m.message, true
 	}
@@ -5294,7 +11185,7 @@ func (m *MessageGroupMentionMutation) MessageID() (id uuid.UUID, exists bool) {
 // MessageIDs returns the "message" edge IDs in the mutation.
 // Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
 // MessageID instead. It exists only for internal usage by the builders.
-func (m *MessageGroupMentionMutation) MessageIDs() (ids []uuid.UUID) {
+func (m *PollMutation) MessageIDs() (ids []uuid.UUID) {
 	if id := m.message; id != nil {
 		ids = append(ids, *id)
 	}
@@ -5302,59 +11193,128 @@ func (m *MessageGroupMentionMutation) MessageIDs() (ids []uuid.UUID) {
 }
 
 // ResetMessage resets all changes to the "message" edge.
-func (m *MessageGroupMentionMutation) ResetMessage() {
+func (m *PollMutation) ResetMessage() {
 	m.message = nil
 	m.clearedmessage = false
 }
 
-// SetGroupID sets the "group" edge to the UserGroup entity by id.
-func (m *MessageGroupMentionMutation) SetGroupID(id uuid.UUID) {
-	m.group = &id
+// AddOptionIDs adds the "options" edge to the PollOption entity by ids.
+func (m *PollMutation) AddOptionIDs(ids ...uuid.UUID) {
+	if m.options == nil {
+		m.options = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.options[ids[i]] = struct{}{}
+	}
 }
 
-// ClearGroup clears the "group" edge to the UserGroup entity.
-func (m *MessageGroupMentionMutation) ClearGroup() {
-	m.clearedgroup = true
+// ClearOptions clears the "options" edge to the PollOption entity.
+func (m *PollMutation) ClearOptions() {
+	m.clearedoptions = true
 }
 
-// GroupCleared reports if the "group" edge to the UserGroup entity was cleared.
-func (m *MessageGroupMentionMutation) GroupCleared() bool {
-	return m.clearedgroup
+// OptionsCleared reports if the "options" edge to the PollOption entity was cleared.
+func (m *PollMutation) OptionsCleared() bool {
+	return m.clearedoptions
 }
 
-// GroupID returns the "group" edge ID in the mutation.
-func (m *MessageGroupMentionMutation) GroupID() (id uuid.UUID, exists bool) {
-	if m.group != nil {
-		return *m.group, true
+// RemoveOptionIDs removes the "options" edge to the PollOption entity by IDs.
+func (m *PollMutation) RemoveOptionIDs(ids ...uuid.UUID) {
+	if m.removedoptions == nil {
+		m.removedoptions = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.options, ids[i])
+		m.removedoptions[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedOptions returns the removed IDs of the "options" edge to the PollOption entity.
+func (m *PollMutation) RemovedOptionsIDs() (ids []uuid.UUID) {
+	for id := range m.removedoptions {
+		ids = append(ids, id)
 	}
 	return
 }
 
-// GroupIDs returns the "group" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// GroupID instead. It exists only for internal usage by the builders.
-func (m *MessageGroupMentionMutation) GroupIDs() (ids []uuid.UUID) {
-	if id := m.group; id != nil {
-		ids = append(ids, *id)
+// OptionsIDs returns the "options" edge IDs in the mutation.
+func (m *PollMutation) OptionsIDs() (ids []uuid.UUID) {
+	for id := range m.options {
+		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetGroup resets all changes to the "group" edge.
-func (m *MessageGroupMentionMutation) ResetGroup() {
-	m.group = nil
-	m.clearedgroup = false
+// ResetOptions resets all changes to the "options" edge.
+func (m *PollMutation) ResetOptions() {
+	m.options = nil
+	m.clearedoptions = false
+	m.removedoptions = nil
 }
 
-// Where appends a list predicates to the MessageGroupMentionMutation builder.
-func (m *MessageGroupMentionMutation) Where(ps ...predicate.MessageGroupMention) {
+// AddVoteIDs adds the "votes" edge to the PollVote entity by ids.
+func (m *PollMutation) AddVoteIDs(ids ...uuid.UUID) {
+	if m.votes == nil {
+		m.votes = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.votes[ids[i]] = struct{}{}
+	}
+}
+
+// ClearVotes clears the "votes" edge to the PollVote entity.
+func (m *PollMutation) ClearVotes() {
+	m.clearedvotes = true
+}
+
+// VotesCleared reports if the "votes" edge to the PollVote entity was cleared.
+func (m *PollMutation) VotesCleared() bool {
+	return m.clearedvotes
+}
+
+// RemoveVoteIDs removes the "votes" edge to the PollVote entity by IDs.
+func (m *PollMutation) RemoveVoteIDs(ids ...uuid.UUID) {
+	if m.removedvotes == nil {
+		m.removedvotes = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.votes, ids[i])
+		m.removedvotes[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedVotes returns the removed IDs of the "votes" edge to the PollVote entity.
+func (m *PollMutation) RemovedVotesIDs() (ids []uuid.UUID) {
+	for id := range m.removedvotes {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// VotesIDs returns the "votes" edge IDs in the mutation.
+func (m *PollMutation) VotesIDs() (ids []uuid.UUID) {
+	for id := range m.votes {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetVotes resets all changes to the "votes" edge.
+func (m *PollMutation) ResetVotes() {
+	m.votes = nil
+	m.clearedvotes = false
+	m.removedvotes = nil
+}
+
+// Where appends a list predicates to the PollMutation builder.
+func (m *PollMutation) Where(ps ...predicate.Poll) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the MessageGroupMentionMutation builder. Using this method,
+// WhereP appends storage-level predicates to the PollMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *MessageGroupMentionMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.MessageGroupMention, len(ps))
+func (m *PollMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Poll, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -5362,27 +11322,36 @@ func (m *MessageGroupMentionMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *MessageGroupMentionMutation) Op() Op {
+func (m *PollMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *MessageGroupMentionMutation) SetOp(op Op) {
+func (m *PollMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (MessageGroupMention).
-func (m *MessageGroupMentionMutation) Type() string {
+// Type returns the node type of this mutation (Poll).
+func (m *PollMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *MessageGroupMentionMutation) Fields() []string {
-	fields := make([]string, 0, 1)
+func (m *PollMutation) Fields() []string {
+	fields := make([]string, 0, 4)
+	if m.multiple_choice != nil {
+		fields = append(fields, poll.FieldMultipleChoice)
+	}
+	if m.anonymous != nil {
+		fields = append(fields, poll.FieldAnonymous)
+	}
+	if m.closes_at != nil {
+		fields = append(fields, poll.FieldClosesAt)
+	}
 	if m.created_at != nil {
-		fields = append(fields, messagegroupmention.FieldCreatedAt)
+		fields = append(fields, poll.FieldCreatedAt)
 	}
 	return fields
 }
@@ -5390,9 +11359,15 @@ func (m *MessageGroupMentionMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *MessageGroupMentionMutation) Field(name string) (ent.Value, bool) {
+func (m *PollMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case messagegroupmention.FieldCreatedAt:
+	case poll.FieldMultipleChoice:
+		return m.MultipleChoice()
+	case poll.FieldAnonymous:
+		return m.Anonymous()
+	case poll.FieldClosesAt:
+		return m.ClosesAt()
+	case poll.FieldCreatedAt:
 		return m.CreatedAt()
 	}
 	return nil, false
@@ -5401,20 +11376,47 @@ func (m *MessageGroupMentionMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *MessageGroupMentionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *PollMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case messagegroupmention.FieldCreatedAt:
+	case poll.FieldMultipleChoice:
+		return m.OldMultipleChoice(ctx)
+	case poll.FieldAnonymous:
+		return m.OldAnonymous(ctx)
+	case poll.FieldClosesAt:
+		return m.OldClosesAt(ctx)
+	case poll.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown MessageGroupMention field %s", name)
+	return nil, fmt.Errorf("unknown Poll field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *MessageGroupMentionMutation) SetField(name string, value ent.Value) error {
+func (m *PollMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case messagegroupmention.FieldCreatedAt:
+	case poll.FieldMultipleChoice:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMultipleChoice(v)
+		return nil
+	case poll.FieldAnonymous:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAnonymous(v)
+		return nil
+	case poll.FieldClosesAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetClosesAt(v)
+		return nil
+	case poll.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
@@ -5422,185 +11424,238 @@ func (m *MessageGroupMentionMutation) SetField(name string, value ent.Value) err
 		m.SetCreatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown MessageGroupMention field %s", name)
+	return fmt.Errorf("unknown Poll field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *MessageGroupMentionMutation) AddedFields() []string {
+func (m *PollMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *MessageGroupMentionMutation) AddedField(name string) (ent.Value, bool) {
+func (m *PollMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *MessageGroupMentionMutation) AddField(name string, value ent.Value) error {
+func (m *PollMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown MessageGroupMention numeric field %s", name)
+	return fmt.Errorf("unknown Poll numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *MessageGroupMentionMutation) ClearedFields() []string {
-	return nil
+func (m *PollMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(poll.FieldClosesAt) {
+		fields = append(fields, poll.FieldClosesAt)
+	}
+	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *MessageGroupMentionMutation) FieldCleared(name string) bool {
+func (m *PollMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *MessageGroupMentionMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown MessageGroupMention nullable field %s", name)
+func (m *PollMutation) ClearField(name string) error {
+	switch name {
+	case poll.FieldClosesAt:
+		m.ClearClosesAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Poll nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *MessageGroupMentionMutation) ResetField(name string) error {
+func (m *PollMutation) ResetField(name string) error {
 	switch name {
-	case messagegroupmention.FieldCreatedAt:
+	case poll.FieldMultipleChoice:
+		m.ResetMultipleChoice()
+		return nil
+	case poll.FieldAnonymous:
+		m.ResetAnonymous()
+		return nil
+	case poll.FieldClosesAt:
+		m.ResetClosesAt()
+		return nil
+	case poll.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown MessageGroupMention field %s", name)
+	return fmt.Errorf("unknown Poll field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *MessageGroupMentionMutation) AddedEdges() []string {
-	edges := make([]string, 0, 2)
+func (m *PollMutation) AddedEdges() []string {
+	edges := make([]string, 0, 3)
 	if m.message != nil {
-		edges = append(edges, messagegroupmention.EdgeMessage)
+		edges = append(edges, poll.EdgeMessage)
 	}
-	if m.group != nil {
-		edges = append(edges, messagegroupmention.EdgeGroup)
+	if m.options != nil {
+		edges = append(edges, poll.EdgeOptions)
+	}
+	if m.votes != nil {
+		edges = append(edges, poll.EdgeVotes)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *MessageGroupMentionMutation) AddedIDs(name string) []ent.Value {
+func (m *PollMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case messagegroupmention.EdgeMessage:
+	case poll.EdgeMessage:
 		if id := m.message; id != nil {
 			return []ent.Value{*id}
 		}
-	case messagegroupmention.EdgeGroup:
-		if id := m.group; id != nil {
-			return []ent.Value{*id}
+	case poll.EdgeOptions:
+		ids := make([]ent.Value, 0, len(m.options))
+		for id := range m.options {
+			ids = append(ids, id)
+		}
+		return ids
+	case poll.EdgeVotes:
+		ids := make([]ent.Value, 0, len(m.votes))
+		for id := range m.votes {
+			ids = append(ids, id)
 		}
+		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *MessageGroupMentionMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 2)
+func (m *PollMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.removedoptions != nil {
+		edges = append(edges, poll.EdgeOptions)
+	}
+	if m.removedvotes != nil {
+		edges = append(edges, poll.EdgeVotes)
+	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *MessageGroupMentionMutation) RemovedIDs(name string) []ent.Value {
+func (m *PollMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case poll.EdgeOptions:
+		ids := make([]ent.Value, 0, len(m.removedoptions))
+		for id := range m.removedoptions {
+			ids = append(ids, id)
+		}
+		return ids
+	case poll.EdgeVotes:
+		ids := make([]ent.Value, 0, len(m.removedvotes))
+		for id := range m.removedvotes {
+			ids = append(ids, id)
+		}
+		return ids
+	}
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *MessageGroupMentionMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 2)
+func (m *PollMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 3)
 	if m.clearedmessage {
-		edges = append(edges, messagegroupmention.EdgeMessage)
+		edges = append(edges, poll.EdgeMessage)
 	}
-	if m.clearedgroup {
-		edges = append(edges, messagegroupmention.EdgeGroup)
+	if m.clearedoptions {
+		edges = append(edges, poll.EdgeOptions)
+	}
+	if m.clearedvotes {
+		edges = append(edges, poll.EdgeVotes)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *MessageGroupMentionMutation) EdgeCleared(name string) bool {
+func (m *PollMutation) EdgeCleared(name string) bool {
 	switch name {
-	case messagegroupmention.EdgeMessage:
+	case poll.EdgeMessage:
 		return m.clearedmessage
-	case messagegroupmention.EdgeGroup:
-		return m.clearedgroup
+	case poll.EdgeOptions:
+		return m.clearedoptions
+	case poll.EdgeVotes:
+		return m.clearedvotes
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *MessageGroupMentionMutation) ClearEdge(name string) error {
+func (m *PollMutation) ClearEdge(name string) error {
 	switch name {
-	case messagegroupmention.EdgeMessage:
+	case poll.EdgeMessage:
 		m.ClearMessage()
 		return nil
-	case messagegroupmention.EdgeGroup:
-		m.ClearGroup()
-		return nil
 	}
-	return fmt.Errorf("unknown MessageGroupMention unique edge %s", name)
+	return fmt.Errorf("unknown Poll unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *MessageGroupMentionMutation) ResetEdge(name string) error {
+func (m *PollMutation) ResetEdge(name string) error {
 	switch name {
-	case messagegroupmention.EdgeMessage:
+	case poll.EdgeMessage:
 		m.ResetMessage()
 		return nil
-	case messagegroupmention.EdgeGroup:
-		m.ResetGroup()
+	case poll.EdgeOptions:
+		m.ResetOptions()
+		return nil
+	case poll.EdgeVotes:
+		m.ResetVotes()
 		return nil
 	}
-	return fmt.Errorf("unknown MessageGroupMention edge %s", name)
+	return fmt.Errorf("unknown Poll edge %s", name)
 }
 
-// MessageLinkMutation represents an operation that mutates the MessageLink nodes in the graph.
-type MessageLinkMutation struct {
+// PollOptionMutation represents an operation that mutates the PollOption nodes in the graph.
+type PollOptionMutation struct {
 	config
-	op             Op
-	typ            string
-	id             *uuid.UUID
-	url            *string
-	title          *string
-	description    *string
-	image_url      *string
-	site_name      *string
-	card_type      *string
-	created_at     *time.Time
-	clearedFields  map[string]struct{}
-	message        *uuid.UUID
-	clearedmessage bool
-	done           bool
-	oldValue       func(context.Context) (*MessageLink, error)
-	predicates     []predicate.MessageLink
+	op            Op
+	typ           string
+	id            *uuid.UUID
+	text          *string
+	position      *int
+	addposition   *int
+	clearedFields map[string]struct{}
+	poll          *uuid.UUID
+	clearedpoll   bool
+	votes         map[uuid.UUID]struct{}
+	removedvotes  map[uuid.UUID]struct{}
+	clearedvotes  bool
+	done          bool
+	oldValue      func(context.Context) (*PollOption, error)
+	predicates    []predicate.PollOption
 }
 
-var _ ent.Mutation = (*MessageLinkMutation)(nil)
+var _ ent.Mutation = (*PollOptionMutation)(nil)
 
-// messagelinkOption allows management of the mutation configuration using functional options.
-type messagelinkOption func(*MessageLinkMutation)
+// polloptionOption allows management of the mutation configuration using functional options.
+type polloptionOption func(*PollOptionMutation)
 
-// newMessageLinkMutation creates new mutation for the MessageLink entity.
-func newMessageLinkMutation(c config, op Op, opts ...messagelinkOption) *MessageLinkMutation {
-	m := &MessageLinkMutation{
+// newPollOptionMutation creates new mutation for the PollOption entity.
+func newPollOptionMutation(c config, op Op, opts ...polloptionOption) *PollOptionMutation {
+	m := &PollOptionMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeMessageLink,
+		typ:           TypePollOption,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -5609,20 +11664,20 @@ func newMessageLinkMutation(c config, op Op, opts ...messagelinkOption) *Message
 	return m
 }
 
-// withMessageLinkID sets the ID field of the mutation.
-func withMessageLinkID(id uuid.UUID) messagelinkOption {
-	return func(m *MessageLinkMutation) {
+// withPollOptionID sets the ID field of the mutation.
+func withPollOptionID(id uuid.UUID) polloptionOption {
+	return func(m *PollOptionMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *MessageLink
+			value *PollOption
 		)
-		m.oldValue = func(ctx context.Context) (*MessageLink, error) {
+		m.oldValue = func(ctx context.Context) (*PollOption, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().MessageLink.Get(ctx, id)
+					value, err = m.Client().PollOption.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -5631,10 +11686,10 @@ func withMessageLinkID(id uuid.UUID) messagelinkOption {
 	}
 }
 
-// withMessageLink sets the old MessageLink of the mutation.
-func withMessageLink(node *MessageLink) messagelinkOption {
-	return func(m *MessageLinkMutation) {
-		m.oldValue = func(context.Context) (*MessageLink, error) {
+// withPollOption sets the old PollOption of the mutation.
+func withPollOption(node *PollOption) polloptionOption {
+	return func(m *PollOptionMutation) {
+		m.oldValue = func(context.Context) (*PollOption, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -5643,7 +11698,7 @@ func withMessageLink(node *MessageLink) messagelinkOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m MessageLinkMutation) Client() *Client {
+func (m PollOptionMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -5651,7 +11706,7 @@ func (m MessageLinkMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m MessageLinkMutation) Tx() (*Tx, error) {
+func (m PollOptionMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -5661,14 +11716,14 @@ func (m MessageLinkMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of MessageLink entities.
-func (m *MessageLinkMutation) SetID(id uuid.UUID) {
+// operation is only accepted on creation of PollOption entities.
+func (m *PollOptionMutation) SetID(id uuid.UUID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *MessageLinkMutation) ID() (id uuid.UUID, exists bool) {
+func (m *PollOptionMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -5679,7 +11734,7 @@ func (m *MessageLinkMutation) ID() (id uuid.UUID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *MessageLinkMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+func (m *PollOptionMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -5688,300 +11743,592 @@ func (m *MessageLinkMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().MessageLink.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().PollOption.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetURL sets the "url" field.
-func (m *MessageLinkMutation) SetURL(s string) {
-	m.url = &s
+// SetText sets the "text" field.
+func (m *PollOptionMutation) SetText(s string) {
+	m.text = &s
 }
 
-// URL returns the value of the "url" field in the mutation.
-func (m *MessageLinkMutation) URL() (r string, exists bool) {
-	v := m.url
+// Text returns the value of the "text" field in the mutation.
+func (m *PollOptionMutation) Text() (r string, exists bool) {
+	v := m.text
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldURL returns the old "url" field's value of the MessageLink entity.
-// If the MessageLink object wasn't provided to the builder, the object is fetched from the database.
+// OldText returns the old "text" field's value of the PollOption entity.
+// If the PollOption object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MessageLinkMutation) OldURL(ctx context.Context) (v string, err error) {
+func (m *PollOptionMutation) OldText(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldURL is only allowed on UpdateOne operations")
+		return v, errors.New("OldText is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldURL requires an ID field in the mutation")
+		return v, errors.New("OldText requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldURL: %w", err)
+		return v, fmt.Errorf("querying old value for OldText: %w", err)
 	}
-	return oldValue.URL, nil
+	return oldValue.Text, nil
 }
 
-// ResetURL resets all changes to the "url" field.
-func (m *MessageLinkMutation) ResetURL() {
-	m.url = nil
+// ResetText resets all changes to the "text" field.
+func (m *PollOptionMutation) ResetText() {
+	m.text = nil
 }
 
-// SetTitle sets the "title" field.
-func (m *MessageLinkMutation) SetTitle(s string) {
-	m.title = &s
+// SetPosition sets the "position" field.
+func (m *PollOptionMutation) SetPosition(i int) {
+	m.position = &i
+	m.addposition = nil
 }
 
-// Title returns the value of the "title" field in the mutation.
-func (m *MessageLinkMutation) Title() (r string, exists bool) {
-	v := m.title
+// Position returns the value of the "position" field in the mutation.
+func (m *PollOptionMutation) Position() (r int, exists bool) {
+	v := m.position
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTitle returns the old "title" field's value of the MessageLink entity.
-// If the MessageLink object wasn't provided to the builder, the object is fetched from the database.
+// OldPosition returns the old "position" field's value of the PollOption entity.
+// If the PollOption object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MessageLinkMutation) OldTitle(ctx context.Context) (v string, err error) {
+func (m *PollOptionMutation) OldPosition(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTitle is only allowed on UpdateOne operations")
+		return v, errors.New("OldPosition is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTitle requires an ID field in the mutation")
+		return v, errors.New("OldPosition requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTitle: %w", err)
+		return v, fmt.Errorf("querying old value for OldPosition: %w", err)
 	}
-	return oldValue.Title, nil
+	return oldValue.Position, nil
 }
 
-// ClearTitle clears the value of the "title" field.
-func (m *MessageLinkMutation) ClearTitle() {
-	m.title = nil
-	m.clearedFields[messagelink.FieldTitle] = struct{}{}
+// AddPosition adds i to the "position" field.
+func (m *PollOptionMutation) AddPosition(i int) {
+	if m.addposition != nil {
+		*m.addposition += i
+	} else {
+		m.addposition = &i
+	}
 }
 
-// TitleCleared returns if the "title" field was cleared in this mutation.
-func (m *MessageLinkMutation) TitleCleared() bool {
-	_, ok := m.clearedFields[messagelink.FieldTitle]
-	return ok
+// AddedPosition returns the value that was added to the "position" field in this mutation.
+func (m *PollOptionMutation) AddedPosition() (r int, exists bool) {
+	v := m.addposition
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ResetTitle resets all changes to the "title" field.
-func (m *MessageLinkMutation) ResetTitle() {
-	m.title = nil
-	delete(m.clearedFields, messagelink.FieldTitle)
+// ResetPosition resets all changes to the "position" field.
+func (m *PollOptionMutation) ResetPosition() {
+	m.position = nil
+	m.addposition = nil
 }
 
-// SetDescription sets the "description" field.
-func (m *MessageLinkMutation) SetDescription(s string) {
-	m.description = &s
+// SetPollID sets the "poll" edge to the Poll entity by id.
+func (m *PollOptionMutation) SetPollID(id uuid.UUID) {
+	m.poll = &id
 }
 
-// Description returns the value of the "description" field in the mutation.
-func (m *MessageLinkMutation) Description() (r string, exists bool) {
-	v := m.description
-	if v == nil {
-		return
+// ClearPoll clears the "poll" edge to the Poll entity.
+func (m *PollOptionMutation) ClearPoll() {
+	m.clearedpoll = true
+}
+
+// PollCleared reports if the "poll" edge to the Poll entity was cleared.
+func (m *PollOptionMutation) PollCleared() bool {
+	return m.clearedpoll
+}
+
+// PollID returns the "poll" edge ID in the mutation.
+func (m *PollOptionMutation) PollID() (id uuid.UUID, exists bool) {
+	if m.poll != nil {
+		return *m.poll, true
 	}
-	return *v, true
+	return
 }
 
-// OldDescription returns the old "description" field's value of the MessageLink entity.
-// If the MessageLink object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MessageLinkMutation) OldDescription(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+// PollIDs returns the "poll" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// PollID instead. It exists only for internal usage by the builders.
+func (m *PollOptionMutation) PollIDs() (ids []uuid.UUID) {
+	if id := m.poll; id != nil {
+		ids = append(ids, *id)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDescription requires an ID field in the mutation")
+	return
+}
+
+// ResetPoll resets all changes to the "poll" edge.
+func (m *PollOptionMutation) ResetPoll() {
+	m.poll = nil
+	m.clearedpoll = false
+}
+
+// AddVoteIDs adds the "votes" edge to the PollVote entity by ids.
+func (m *PollOptionMutation) AddVoteIDs(ids ...uuid.UUID) {
+	if m.votes == nil {
+		m.votes = make(map[uuid.UUID]struct{})
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+	for i := range ids {
+		m.votes[ids[i]] = struct{}{}
 	}
-	return oldValue.Description, nil
 }
 
-// ClearDescription clears the value of the "description" field.
-func (m *MessageLinkMutation) ClearDescription() {
-	m.description = nil
-	m.clearedFields[messagelink.FieldDescription] = struct{}{}
+// ClearVotes clears the "votes" edge to the PollVote entity.
+func (m *PollOptionMutation) ClearVotes() {
+	m.clearedvotes = true
+}
+
+// VotesCleared reports if the "votes" edge to the PollVote entity was cleared.
+func (m *PollOptionMutation) VotesCleared() bool {
+	return m.clearedvotes
+}
+
+// RemoveVoteIDs removes the "votes" edge to the PollVote entity by IDs.
+func (m *PollOptionMutation) RemoveVoteIDs(ids ...uuid.UUID) {
+	if m.removedvotes == nil {
+		m.removedvotes = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.votes, ids[i])
+		m.removedvotes[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedVotes returns the removed IDs of the "votes" edge to the PollVote entity.
+func (m *PollOptionMutation) RemovedVotesIDs() (ids []uuid.UUID) {
+	for id := range m.removedvotes {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// VotesIDs returns the "votes" edge IDs in the mutation.
+func (m *PollOptionMutation) VotesIDs() (ids []uuid.UUID) {
+	for id := range m.votes {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetVotes resets all changes to the "votes" edge.
+func (m *PollOptionMutation) ResetVotes() {
+	m.votes = nil
+	m.clearedvotes = false
+	m.removedvotes = nil
+}
+
+// Where appends a list predicates to the PollOptionMutation builder.
+func (m *PollOptionMutation) Where(ps ...predicate.PollOption) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the PollOptionMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *PollOptionMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.PollOption, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *PollOptionMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *PollOptionMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (PollOption).
+func (m *PollOptionMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *PollOptionMutation) Fields() []string {
+	fields := make([]string, 0, 2)
+	if m.text != nil {
+		fields = append(fields, polloption.FieldText)
+	}
+	if m.position != nil {
+		fields = append(fields, polloption.FieldPosition)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *PollOptionMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case polloption.FieldText:
+		return m.Text()
+	case polloption.FieldPosition:
+		return m.Position()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *PollOptionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case polloption.FieldText:
+		return m.OldText(ctx)
+	case polloption.FieldPosition:
+		return m.OldPosition(ctx)
+	}
+	return nil, fmt.Errorf("unknown PollOption field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PollOptionMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case polloption.FieldText:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetText(v)
+		return nil
+	case polloption.FieldPosition:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPosition(v)
+		return nil
+	}
+	return fmt.Errorf("unknown PollOption field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *PollOptionMutation) AddedFields() []string {
+	var fields []string
+	if m.addposition != nil {
+		fields = append(fields, polloption.FieldPosition)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *PollOptionMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case polloption.FieldPosition:
+		return m.AddedPosition()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PollOptionMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case polloption.FieldPosition:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPosition(v)
+		return nil
+	}
+	return fmt.Errorf("unknown PollOption numeric field %s", name)
 }
 
-// DescriptionCleared returns if the "description" field was cleared in this mutation.
-func (m *MessageLinkMutation) DescriptionCleared() bool {
-	_, ok := m.clearedFields[messagelink.FieldDescription]
-	return ok
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *PollOptionMutation) ClearedFields() []string {
+	return nil
 }
 
-// ResetDescription resets all changes to the "description" field.
-func (m *MessageLinkMutation) ResetDescription() {
-	m.description = nil
-	delete(m.clearedFields, messagelink.FieldDescription)
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *PollOptionMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
 }
 
-// SetImageURL sets the "image_url" field.
-func (m *MessageLinkMutation) SetImageURL(s string) {
-	m.image_url = &s
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *PollOptionMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown PollOption nullable field %s", name)
 }
 
-// ImageURL returns the value of the "image_url" field in the mutation.
-func (m *MessageLinkMutation) ImageURL() (r string, exists bool) {
-	v := m.image_url
-	if v == nil {
-		return
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *PollOptionMutation) ResetField(name string) error {
+	switch name {
+	case polloption.FieldText:
+		m.ResetText()
+		return nil
+	case polloption.FieldPosition:
+		m.ResetPosition()
+		return nil
 	}
-	return *v, true
+	return fmt.Errorf("unknown PollOption field %s", name)
 }
 
-// OldImageURL returns the old "image_url" field's value of the MessageLink entity.
-// If the MessageLink object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MessageLinkMutation) OldImageURL(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldImageURL is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldImageURL requires an ID field in the mutation")
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *PollOptionMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.poll != nil {
+		edges = append(edges, polloption.EdgePoll)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldImageURL: %w", err)
+	if m.votes != nil {
+		edges = append(edges, polloption.EdgeVotes)
 	}
-	return oldValue.ImageURL, nil
+	return edges
 }
 
-// ClearImageURL clears the value of the "image_url" field.
-func (m *MessageLinkMutation) ClearImageURL() {
-	m.image_url = nil
-	m.clearedFields[messagelink.FieldImageURL] = struct{}{}
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *PollOptionMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case polloption.EdgePoll:
+		if id := m.poll; id != nil {
+			return []ent.Value{*id}
+		}
+	case polloption.EdgeVotes:
+		ids := make([]ent.Value, 0, len(m.votes))
+		for id := range m.votes {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
 }
 
-// ImageURLCleared returns if the "image_url" field was cleared in this mutation.
-func (m *MessageLinkMutation) ImageURLCleared() bool {
-	_, ok := m.clearedFields[messagelink.FieldImageURL]
-	return ok
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *PollOptionMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.removedvotes != nil {
+		edges = append(edges, polloption.EdgeVotes)
+	}
+	return edges
 }
 
-// ResetImageURL resets all changes to the "image_url" field.
-func (m *MessageLinkMutation) ResetImageURL() {
-	m.image_url = nil
-	delete(m.clearedFields, messagelink.FieldImageURL)
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *PollOptionMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case polloption.EdgeVotes:
+		ids := make([]ent.Value, 0, len(m.removedvotes))
+		for id := range m.removedvotes {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
 }
 
-// SetSiteName sets the "site_name" field.
-func (m *MessageLinkMutation) SetSiteName(s string) {
-	m.site_name = &s
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *PollOptionMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedpoll {
+		edges = append(edges, polloption.EdgePoll)
+	}
+	if m.clearedvotes {
+		edges = append(edges, polloption.EdgeVotes)
+	}
+	return edges
 }
 
-// SiteName returns the value of the "site_name" field in the mutation.
-func (m *MessageLinkMutation) SiteName() (r string, exists bool) {
-	v := m.site_name
-	if v == nil {
-		return
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *PollOptionMutation) EdgeCleared(name string) bool {
+	switch name {
+	case polloption.EdgePoll:
+		return m.clearedpoll
+	case polloption.EdgeVotes:
+		return m.clearedvotes
 	}
-	return *v, true
+	return false
 }
 
-// OldSiteName returns the old "site_name" field's value of the MessageLink entity.
-// If the MessageLink object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MessageLinkMutation) OldSiteName(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSiteName is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSiteName requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSiteName: %w", err)
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *PollOptionMutation) ClearEdge(name string) error {
+	switch name {
+	case polloption.EdgePoll:
+		m.ClearPoll()
+		return nil
 	}
-	return oldValue.SiteName, nil
+	return fmt.Errorf("unknown PollOption unique edge %s", name)
 }
 
-// ClearSiteName clears the value of the "site_name" field.
-func (m *MessageLinkMutation) ClearSiteName() {
-	m.site_name = nil
-	m.clearedFields[messagelink.FieldSiteName] = struct{}{}
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *PollOptionMutation) ResetEdge(name string) error {
+	switch name {
+	case polloption.EdgePoll:
+		m.ResetPoll()
+		return nil
+	case polloption.EdgeVotes:
+		m.ResetVotes()
+		return nil
+	}
+	return fmt.Errorf("unknown PollOption edge %s", name)
 }
 
-// SiteNameCleared returns if the "site_name" field was cleared in this mutation.
-func (m *MessageLinkMutation) SiteNameCleared() bool {
-	_, ok := m.clearedFields[messagelink.FieldSiteName]
-	return ok
+// PollVoteMutation represents an operation that mutates the PollVote nodes in the graph.
+type PollVoteMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *uuid.UUID
+	created_at    *time.Time
+	clearedFields map[string]struct{}
+	poll          *uuid.UUID
+	clearedpoll   bool
+	option        *uuid.UUID
+	clearedoption bool
+	user          *uuid.UUID
+	cleareduser   bool
+	done          bool
+	oldValue      func(context.Context) (*PollVote, error)
+	predicates    []predicate.PollVote
 }
 
-// ResetSiteName resets all changes to the "site_name" field.
-func (m *MessageLinkMutation) ResetSiteName() {
-	m.site_name = nil
-	delete(m.clearedFields, messagelink.FieldSiteName)
-}
+var _ ent.Mutation = (*PollVoteMutation)(nil)
 
-// SetCardType sets the "card_type" field.
-func (m *MessageLinkMutation) SetCardType(s string) {
-	m.card_type = &s
-}
+// pollvoteOption allows management of the mutation configuration using functional options.
+type pollvoteOption func(*PollVoteMutation)
 
-// CardType returns the value of the "card_type" field in the mutation.
-func (m *MessageLinkMutation) CardType() (r string, exists bool) {
-	v := m.card_type
-	if v == nil {
-		return
+// newPollVoteMutation creates new mutation for the PollVote entity.
+func newPollVoteMutation(c config, op Op, opts ...pollvoteOption) *PollVoteMutation {
+	m := &PollVoteMutation{
+		config:        c,
+		op:            op,
+		typ:           TypePollVote,
+		clearedFields: make(map[string]struct{}),
 	}
-	return *v, true
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
 }
 
-// OldCardType returns the old "card_type" field's value of the MessageLink entity.
-// If the MessageLink object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MessageLinkMutation) OldCardType(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCardType is only allowed on UpdateOne operations")
+// withPollVoteID sets the ID field of the mutation.
+func withPollVoteID(id uuid.UUID) pollvoteOption {
+	return func(m *PollVoteMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *PollVote
+		)
+		m.oldValue = func(ctx context.Context) (*PollVote, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().PollVote.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCardType requires an ID field in the mutation")
+}
+
+// withPollVote sets the old PollVote of the mutation.
+func withPollVote(node *PollVote) pollvoteOption {
+	return func(m *PollVoteMutation) {
+		m.oldValue = func(context.Context) (*PollVote, error) {
+			return node, nil
+		}
+		m.id = &node.ID
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCardType: %w", err)
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m PollVoteMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m PollVoteMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
-	return oldValue.CardType, nil
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
 }
 
-// ClearCardType clears the value of the "card_type" field.
-func (m *MessageLinkMutation) ClearCardType() {
-	m.card_type = nil
-	m.clearedFields[messagelink.FieldCardType] = struct{}{}
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of PollVote entities.
+func (m *PollVoteMutation) SetID(id uuid.UUID) {
+	m.id = &id
 }
 
-// CardTypeCleared returns if the "card_type" field was cleared in this mutation.
-func (m *MessageLinkMutation) CardTypeCleared() bool {
-	_, ok := m.clearedFields[messagelink.FieldCardType]
-	return ok
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *PollVoteMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
 }
 
-// ResetCardType resets all changes to the "card_type" field.
-func (m *MessageLinkMutation) ResetCardType() {
-	m.card_type = nil
-	delete(m.clearedFields, messagelink.FieldCardType)
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *PollVoteMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().PollVote.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *MessageLinkMutation) SetCreatedAt(t time.Time) {
+func (m *PollVoteMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *MessageLinkMutation) CreatedAt() (r time.Time, exists bool) {
+func (m *PollVoteMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -5989,10 +12336,10 @@ func (m *MessageLinkMutation) CreatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the MessageLink entity.
-// If the MessageLink object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the PollVote entity.
+// If the PollVote object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MessageLinkMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *PollVoteMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -6007,58 +12354,136 @@ func (m *MessageLinkMutation) OldCreatedAt(ctx context.Context) (v time.Time, er
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *MessageLinkMutation) ResetCreatedAt() {
+func (m *PollVoteMutation) ResetCreatedAt() {
 	m.created_at = nil
 }
 
-// SetMessageID sets the "message" edge to the Message entity by id.
-func (m *MessageLinkMutation) SetMessageID(id uuid.UUID) {
-	m.message = &id
+// SetPollID sets the "poll" edge to the Poll entity by id.
+func (m *PollVoteMutation) SetPollID(id uuid.UUID) {
+	m.poll = &id
 }
 
-// ClearMessage clears the "message" edge to the Message entity.
-func (m *MessageLinkMutation) ClearMessage() {
-	m.clearedmessage = true
+// ClearPoll clears the "poll" edge to the Poll entity.
+func (m *PollVoteMutation) ClearPoll() {
+	m.clearedpoll = true
 }
 
-// MessageCleared reports if the "message" edge to the Message entity was cleared.
-func (m *MessageLinkMutation) MessageCleared() bool {
-	return m.clearedmessage
+// PollCleared reports if the "poll" edge to the Poll entity was cleared.
+func (m *PollVoteMutation) PollCleared() bool {
+	return m.clearedpoll
 }
 
-// MessageID returns the "message" edge ID in the mutation.
-func (m *MessageLinkMutation) MessageID() (id uuid.UUID, exists bool) {
-	if m.message != nil {
-		return *m.message, true
+// PollID returns the "poll" edge ID in the mutation.
+func (m *PollVoteMutation) PollID() (id uuid.UUID, exists bool) {
+	if m.poll != nil {
+		return *m.poll, true
 	}
 	return
 }
 
-// MessageIDs returns the "message" edge IDs in the mutation.
+// PollIDs returns the "poll" edge IDs in the mutation.
 // Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// MessageID instead. It exists only for internal usage by the builders.
-func (m *MessageLinkMutation) MessageIDs() (ids []uuid.UUID) {
-	if id := m.message; id != nil {
+// PollID instead. It exists only for internal usage by the builders.
+func (m *PollVoteMutation) PollIDs() (ids []uuid.UUID) {
+	if id := m.poll; id != nil {
 		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetMessage resets all changes to the "message" edge.
-func (m *MessageLinkMutation) ResetMessage() {
-	m.message = nil
-	m.clearedmessage = false
+// ResetPoll resets all changes to the "poll" edge.
+func (m *PollVoteMutation) ResetPoll() {
+	m.poll = nil
+	m.clearedpoll = false
 }
 
-// Where appends a list predicates to the MessageLinkMutation builder.
-func (m *MessageLinkMutation) Where(ps ...predicate.MessageLink) {
+// SetOptionID sets the "option" edge to the PollOption entity by id.
+func (m *PollVoteMutation) SetOptionID(id uuid.UUID) {
+	m.option = &id
+}
+
+// ClearOption clears the "option" edge to the PollOption entity.
+func (m *PollVoteMutation) ClearOption() {
+	m.clearedoption = true
+}
+
+// OptionCleared reports if the "option" edge to the PollOption entity was cleared.
+func (m *PollVoteMutation) OptionCleared() bool {
+	return m.clearedoption
+}
+
+// OptionID returns the "option" edge ID in the mutation.
+func (m *PollVoteMutation) OptionID() (id uuid.UUID, exists bool) {
+	if m.option != nil {
+		return *m.option, true
+	}
+	return
+}
+
+// OptionIDs returns the "option" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// OptionID instead. It exists only for internal usage by the builders.
+func (m *PollVoteMutation) OptionIDs() (ids []uuid.UUID) {
+	if id := m.option; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetOption resets all changes to the "option" edge.
+func (m *PollVoteMutation) ResetOption() {
+	m.option = nil
+	m.clearedoption = false
+}
+
+// SetUserID sets the "user" edge to the User entity by id.
+func (m *PollVoteMutation) SetUserID(id uuid.UUID) {
+	m.user = &id
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (m *PollVoteMutation) ClearUser() {
+	m.cleareduser = true
+}
+
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *PollVoteMutation) UserCleared() bool {
+	return m.cleareduser
+}
+
+// UserID returns the "user" edge ID in the mutation.
+func (m *PollVoteMutation) UserID() (id uuid.UUID, exists bool) {
+	if m.user != nil {
+		return *m.user, true
+	}
+	return
+}
+
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *PollVoteMutation) UserIDs() (ids []uuid.UUID) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetUser resets all changes to the "user" edge.
+func (m *PollVoteMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
+}
+
+// Where appends a list predicates to the PollVoteMutation builder.
+func (m *PollVoteMutation) Where(ps ...predicate.PollVote) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the MessageLinkMutation builder. Using this method,
+// WhereP appends storage-level predicates to the PollVoteMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *MessageLinkMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.MessageLink, len(ps))
+func (m *PollVoteMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.PollVote, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -6066,45 +12491,27 @@ func (m *MessageLinkMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *MessageLinkMutation) Op() Op {
+func (m *PollVoteMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *MessageLinkMutation) SetOp(op Op) {
+func (m *PollVoteMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (MessageLink).
-func (m *MessageLinkMutation) Type() string {
+// Type returns the node type of this mutation (PollVote).
+func (m *PollVoteMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *MessageLinkMutation) Fields() []string {
-	fields := make([]string, 0, 7)
-	if m.url != nil {
-		fields = append(fields, messagelink.FieldURL)
-	}
-	if m.title != nil {
-		fields = append(fields, messagelink.FieldTitle)
-	}
-	if m.description != nil {
-		fields = append(fields, messagelink.FieldDescription)
-	}
-	if m.image_url != nil {
-		fields = append(fields, messagelink.FieldImageURL)
-	}
-	if m.site_name != nil {
-		fields = append(fields, messagelink.FieldSiteName)
-	}
-	if m.card_type != nil {
-		fields = append(fields, messagelink.FieldCardType)
-	}
+func (m *PollVoteMutation) Fields() []string {
+	fields := make([]string, 0, 1)
 	if m.created_at != nil {
-		fields = append(fields, messagelink.FieldCreatedAt)
+		fields = append(fields, pollvote.FieldCreatedAt)
 	}
 	return fields
 }
@@ -6112,21 +12519,9 @@ func (m *MessageLinkMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *MessageLinkMutation) Field(name string) (ent.Value, bool) {
+func (m *PollVoteMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case messagelink.FieldURL:
-		return m.URL()
-	case messagelink.FieldTitle:
-		return m.Title()
-	case messagelink.FieldDescription:
-		return m.Description()
-	case messagelink.FieldImageURL:
-		return m.ImageURL()
-	case messagelink.FieldSiteName:
-		return m.SiteName()
-	case messagelink.FieldCardType:
-		return m.CardType()
-	case messagelink.FieldCreatedAt:
+	case pollvote.FieldCreatedAt:
 		return m.CreatedAt()
 	}
 	return nil, false
@@ -6135,74 +12530,20 @@ func (m *MessageLinkMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *MessageLinkMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *PollVoteMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case messagelink.FieldURL:
-		return m.OldURL(ctx)
-	case messagelink.FieldTitle:
-		return m.OldTitle(ctx)
-	case messagelink.FieldDescription:
-		return m.OldDescription(ctx)
-	case messagelink.FieldImageURL:
-		return m.OldImageURL(ctx)
-	case messagelink.FieldSiteName:
-		return m.OldSiteName(ctx)
-	case messagelink.FieldCardType:
-		return m.OldCardType(ctx)
-	case messagelink.FieldCreatedAt:
+	case pollvote.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown MessageLink field %s", name)
+	return nil, fmt.Errorf("unknown PollVote field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *MessageLinkMutation) SetField(name string, value ent.Value) error {
+func (m *PollVoteMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case messagelink.FieldURL:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetURL(v)
-		return nil
-	case messagelink.FieldTitle:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetTitle(v)
-		return nil
-	case messagelink.FieldDescription:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetDescription(v)
-		return nil
-	case messagelink.FieldImageURL:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetImageURL(v)
-		return nil
-	case messagelink.FieldSiteName:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetSiteName(v)
-		return nil
-	case messagelink.FieldCardType:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCardType(v)
-		return nil
-	case messagelink.FieldCreatedAt:
+	case pollvote.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
@@ -6210,127 +12551,90 @@ func (m *MessageLinkMutation) SetField(name string, value ent.Value) error {
 		m.SetCreatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown MessageLink field %s", name)
+	return fmt.Errorf("unknown PollVote field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *MessageLinkMutation) AddedFields() []string {
+func (m *PollVoteMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *MessageLinkMutation) AddedField(name string) (ent.Value, bool) {
+func (m *PollVoteMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *MessageLinkMutation) AddField(name string, value ent.Value) error {
+func (m *PollVoteMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown MessageLink numeric field %s", name)
+	return fmt.Errorf("unknown PollVote numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *MessageLinkMutation) ClearedFields() []string {
-	var fields []string
-	if m.FieldCleared(messagelink.FieldTitle) {
-		fields = append(fields, messagelink.FieldTitle)
-	}
-	if m.FieldCleared(messagelink.FieldDescription) {
-		fields = append(fields, messagelink.FieldDescription)
-	}
-	if m.FieldCleared(messagelink.FieldImageURL) {
-		fields = append(fields, messagelink.FieldImageURL)
-	}
-	if m.FieldCleared(messagelink.FieldSiteName) {
-		fields = append(fields, messagelink.FieldSiteName)
-	}
-	if m.FieldCleared(messagelink.FieldCardType) {
-		fields = append(fields, messagelink.FieldCardType)
-	}
-	return fields
+func (m *PollVoteMutation) ClearedFields() []string {
+	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *MessageLinkMutation) FieldCleared(name string) bool {
+func (m *PollVoteMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *MessageLinkMutation) ClearField(name string) error {
-	switch name {
-	case messagelink.FieldTitle:
-		m.ClearTitle()
-		return nil
-	case messagelink.FieldDescription:
-		m.ClearDescription()
-		return nil
-	case messagelink.FieldImageURL:
-		m.ClearImageURL()
-		return nil
-	case messagelink.FieldSiteName:
-		m.ClearSiteName()
-		return nil
-	case messagelink.FieldCardType:
-		m.ClearCardType()
-		return nil
-	}
-	return fmt.Errorf("unknown MessageLink nullable field %s", name)
+func (m *PollVoteMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown PollVote nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *MessageLinkMutation) ResetField(name string) error {
+func (m *PollVoteMutation) ResetField(name string) error {
 	switch name {
-	case messagelink.FieldURL:
-		m.ResetURL()
-		return nil
-	case messagelink.FieldTitle:
-		m.ResetTitle()
-		return nil
-	case messagelink.FieldDescription:
-		m.ResetDescription()
-		return nil
-	case messagelink.FieldImageURL:
-		m.ResetImageURL()
-		return nil
-	case messagelink.FieldSiteName:
-		m.ResetSiteName()
-		return nil
-	case messagelink.FieldCardType:
-		m.ResetCardType()
-		return nil
-	case messagelink.FieldCreatedAt:
+	case pollvote.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown MessageLink field %s", name)
+	return fmt.Errorf("unknown PollVote field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *MessageLinkMutation) AddedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.message != nil {
-		edges = append(edges, messagelink.EdgeMessage)
+func (m *PollVoteMutation) AddedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.poll != nil {
+		edges = append(edges, pollvote.EdgePoll)
+	}
+	if m.option != nil {
+		edges = append(edges, pollvote.EdgeOption)
+	}
+	if m.user != nil {
+		edges = append(edges, pollvote.EdgeUser)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *MessageLinkMutation) AddedIDs(name string) []ent.Value {
+func (m *PollVoteMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case messagelink.EdgeMessage:
-		if id := m.message; id != nil {
+	case pollvote.EdgePoll:
+		if id := m.poll; id != nil {
+			return []ent.Value{*id}
+		}
+	case pollvote.EdgeOption:
+		if id := m.option; id != nil {
+			return []ent.Value{*id}
+		}
+	case pollvote.EdgeUser:
+		if id := m.user; id != nil {
 			return []ent.Value{*id}
 		}
 	}
@@ -6338,88 +12642,114 @@ func (m *MessageLinkMutation) AddedIDs(name string) []ent.Value {
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *MessageLinkMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 1)
+func (m *PollVoteMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 3)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *MessageLinkMutation) RemovedIDs(name string) []ent.Value {
+func (m *PollVoteMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *MessageLinkMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.clearedmessage {
-		edges = append(edges, messagelink.EdgeMessage)
+func (m *PollVoteMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.clearedpoll {
+		edges = append(edges, pollvote.EdgePoll)
+	}
+	if m.clearedoption {
+		edges = append(edges, pollvote.EdgeOption)
+	}
+	if m.cleareduser {
+		edges = append(edges, pollvote.EdgeUser)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *MessageLinkMutation) EdgeCleared(name string) bool {
+func (m *PollVoteMutation) EdgeCleared(name string) bool {
 	switch name {
-	case messagelink.EdgeMessage:
-		return m.clearedmessage
+	case pollvote.EdgePoll:
+		return m.clearedpoll
+	case pollvote.EdgeOption:
+		return m.clearedoption
+	case pollvote.EdgeUser:
+		return m.cleareduser
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *MessageLinkMutation) ClearEdge(name string) error {
+func (m *PollVoteMutation) ClearEdge(name string) error {
 	switch name {
-	case messagelink.EdgeMessage:
-		m.ClearMessage()
+	case pollvote.EdgePoll:
+		m.ClearPoll()
+		return nil
+	case pollvote.EdgeOption:
+		m.ClearOption()
+		return nil
+	case pollvote.EdgeUser:
+		m.ClearUser()
 		return nil
 	}
-	return fmt.Errorf("unknown MessageLink unique edge %s", name)
+	return fmt.Errorf("unknown PollVote unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *MessageLinkMutation) ResetEdge(name string) error {
+func (m *PollVoteMutation) ResetEdge(name string) error {
 	switch name {
-	case messagelink.EdgeMessage:
-		m.ResetMessage()
+	case pollvote.EdgePoll:
+		m.ResetPoll()
+		return nil
+	case pollvote.EdgeOption:
+		m.ResetOption()
+		return nil
+	case pollvote.EdgeUser:
+		m.ResetUser()
 		return nil
 	}
-	return fmt.Errorf("unknown MessageLink edge %s", name)
+	return fmt.Errorf("unknown PollVote edge %s", name)
 }
 
-// MessagePinMutation represents an operation that mutates the MessagePin nodes in the graph.
-type MessagePinMutation struct {
+// ReminderMutation represents an operation that mutates the Reminder nodes in the graph.
+type ReminderMutation struct {
 	config
-	op               Op
-	typ              string
-	id               *uuid.UUID
-	created_at       *time.Time
-	clearedFields    map[string]struct{}
-	channel          *uuid.UUID
-	clearedchannel   bool
-	message          *uuid.UUID
-	clearedmessage   bool
-	pinned_by        *uuid.UUID
-	clearedpinned_by bool
-	done             bool
-	oldValue         func(context.Context) (*MessagePin, error)
-	predicates       []predicate.MessagePin
+	op             Op
+	typ            string
+	id             *uuid.UUID
+	remind_at      *time.Time
+	status         *string
+	claimed_at     *time.Time
+	fired_at       *time.Time
+	completed_at   *time.Time
+	created_at     *time.Time
+	updated_at     *time.Time
+	clearedFields  map[string]struct{}
+	user           *uuid.UUID
+	cleareduser    bool
+	message        *uuid.UUID
+	clearedmessage bool
+	done           bool
+	oldValue       func(context.Context) (*Reminder, error)
+	predicates     []predicate.Reminder
 }
 
-var _ ent.Mutation = (*MessagePinMutation)(nil)
+var _ ent.Mutation = (*ReminderMutation)(nil)
 
-// messagepinOption allows management of the mutation configuration using functional options.
-type messagepinOption func(*MessagePinMutation)
+// reminderOption allows management of the mutation configuration using functional options.
+type reminderOption func(*ReminderMutation)
 
-// newMessagePinMutation creates new mutation for the MessagePin entity.
-func newMessagePinMutation(c config, op Op, opts ...messagepinOption) *MessagePinMutation {
-	m := &MessagePinMutation{
+// newReminderMutation creates new mutation for the Reminder entity.
+func newReminderMutation(c config, op Op, opts ...reminderOption) *ReminderMutation {
+	m := &ReminderMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeMessagePin,
+		typ:           TypeReminder,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -6428,20 +12758,20 @@ func newMessagePinMutation(c config, op Op, opts ...messagepinOption) *MessagePi
 	return m
 }
 
-// withMessagePinID sets the ID field of the mutation.
-func withMessagePinID(id uuid.UUID) messagepinOption {
-	return func(m *MessagePinMutation) {
+// withReminderID sets the ID field of the mutation.
+func withReminderID(id uuid.UUID) reminderOption {
+	return func(m *ReminderMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *MessagePin
+			value *Reminder
 		)
-		m.oldValue = func(ctx context.Context) (*MessagePin, error) {
+		m.oldValue = func(ctx context.Context) (*Reminder, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().MessagePin.Get(ctx, id)
+					value, err = m.Client().Reminder.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -6450,10 +12780,10 @@ func withMessagePinID(id uuid.UUID) messagepinOption {
 	}
 }
 
-// withMessagePin sets the old MessagePin of the mutation.
-func withMessagePin(node *MessagePin) messagepinOption {
-	return func(m *MessagePinMutation) {
-		m.oldValue = func(context.Context) (*MessagePin, error) {
+// withReminder sets the old Reminder of the mutation.
+func withReminder(node *Reminder) reminderOption {
+	return func(m *ReminderMutation) {
+		m.oldValue = func(context.Context) (*Reminder, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -6462,7 +12792,7 @@ func withMessagePin(node *MessagePin) messagepinOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m MessagePinMutation) Client() *Client {
+func (m ReminderMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -6470,7 +12800,7 @@ func (m MessagePinMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m MessagePinMutation) Tx() (*Tx, error) {
+func (m ReminderMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -6480,14 +12810,14 @@ func (m MessagePinMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of MessagePin entities.
-func (m *MessagePinMutation) SetID(id uuid.UUID) {
+// operation is only accepted on creation of Reminder entities.
+func (m *ReminderMutation) SetID(id uuid.UUID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *MessagePinMutation) ID() (id uuid.UUID, exists bool) {
+func (m *ReminderMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -6498,7 +12828,7 @@ func (m *MessagePinMutation) ID() (id uuid.UUID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *MessagePinMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+func (m *ReminderMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -6507,19 +12837,238 @@ func (m *MessagePinMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().MessagePin.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Reminder.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
+// SetRemindAt sets the "remind_at" field.
+func (m *ReminderMutation) SetRemindAt(t time.Time) {
+	m.remind_at = &t
+}
+
+// RemindAt returns the value of the "remind_at" field in the mutation.
+func (m *ReminderMutation) RemindAt() (r time.Time, exists bool) {
+	v := m.remind_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRemindAt returns the old "remind_at" field's value of the Reminder entity.
+// If the Reminder object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReminderMutation) OldRemindAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRemindAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRemindAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRemindAt: %w", err)
+	}
+	return oldValue.RemindAt, nil
+}
+
+// ResetRemindAt resets all changes to the "remind_at" field.
+func (m *ReminderMutation) ResetRemindAt() {
+	m.remind_at = nil
+}
+
+// SetStatus sets the "status" field.
+func (m *ReminderMutation) SetStatus(s string) {
+	m.status = &s
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *ReminderMutation) Status() (r string, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the Reminder entity.
+// If the Reminder object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReminderMutation) OldStatus(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *ReminderMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetClaimedAt sets the "claimed_at" field.
+func (m *ReminderMutation) SetClaimedAt(t time.Time) {
+	m.claimed_at = &t
+}
+
+// ClaimedAt returns the value of the "claimed_at" field in the mutation.
+func (m *ReminderMutation) ClaimedAt() (r time.Time, exists bool) {
+	v := m.claimed_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldClaimedAt returns the old "claimed_at" field's value of the Reminder entity.
+// If the Reminder object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReminderMutation) OldClaimedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldClaimedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldClaimedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldClaimedAt: %w", err)
+	}
+	return oldValue.ClaimedAt, nil
+}
+
+// ClearClaimedAt clears the value of the "claimed_at" field.
+func (m *ReminderMutation) ClearClaimedAt() {
+	m.claimed_at = nil
+	m.clearedFields[reminder.FieldClaimedAt] = struct{}{}
+}
+
+// ClaimedAtCleared returns if the "claimed_at" field was cleared in this mutation.
+func (m *ReminderMutation) ClaimedAtCleared() bool {
+	_, ok := m.clearedFields[reminder.FieldClaimedAt]
+	return ok
+}
+
+// ResetClaimedAt resets all changes to the "claimed_at" field.
+func (m *ReminderMutation) ResetClaimedAt() {
+	m.claimed_at = nil
+	delete(m.clearedFields, reminder.FieldClaimedAt)
+}
+
+// SetFiredAt sets the "fired_at" field.
+func (m *ReminderMutation) SetFiredAt(t time.Time) {
+	m.fired_at = &t
+}
+
+// FiredAt returns the value of the "fired_at" field in the mutation.
+func (m *ReminderMutation) FiredAt() (r time.Time, exists bool) {
+	v := m.fired_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFiredAt returns the old "fired_at" field's value of the Reminder entity.
+// If the Reminder object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReminderMutation) OldFiredAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFiredAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFiredAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFiredAt: %w", err)
+	}
+	return oldValue.FiredAt, nil
+}
+
+// ClearFiredAt clears the value of the "fired_at" field.
+func (m *ReminderMutation) ClearFiredAt() {
+	m.fired_at = nil
+	m.clearedFields[reminder.FieldFiredAt] = struct{}{}
+}
+
+// FiredAtCleared returns if the "fired_at" field was cleared in this mutation.
+func (m *ReminderMutation) FiredAtCleared() bool {
+	_, ok := m.clearedFields[reminder.FieldFiredAt]
+	return ok
+}
+
+// ResetFiredAt resets all changes to the "fired_at" field.
+func (m *ReminderMutation) ResetFiredAt() {
+	m.fired_at = nil
+	delete(m.clearedFields, reminder.FieldFiredAt)
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (m *ReminderMutation) SetCompletedAt(t time.Time) {
+	m.completed_at = &t
+}
+
+// CompletedAt returns the value of the "completed_at" field in the mutation.
+func (m *ReminderMutation) CompletedAt() (r time.Time, exists bool) {
+	v := m.completed_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCompletedAt returns the old "completed_at" field's value of the Reminder entity.
+// If the Reminder object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReminderMutation) OldCompletedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCompletedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCompletedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCompletedAt: %w", err)
+	}
+	return oldValue.CompletedAt, nil
+}
+
+// ClearCompletedAt clears the value of the "completed_at" field.
+func (m *ReminderMutation) ClearCompletedAt() {
+	m.completed_at = nil
+	m.clearedFields[reminder.FieldCompletedAt] = struct{}{}
+}
+
+// CompletedAtCleared returns if the "completed_at" field was cleared in this mutation.
+func (m *ReminderMutation) CompletedAtCleared() bool {
+	_, ok := m.clearedFields[reminder.FieldCompletedAt]
+	return ok
+}
+
+// ResetCompletedAt resets all changes to the "completed_at" field.
+func (m *ReminderMutation) ResetCompletedAt() {
+	m.completed_at = nil
+	delete(m.clearedFields, reminder.FieldCompletedAt)
+}
+
 // SetCreatedAt sets the "created_at" field.
-func (m *MessagePinMutation) SetCreatedAt(t time.Time) {
+func (m *ReminderMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *MessagePinMutation) CreatedAt() (r time.Time, exists bool) {
+func (m *ReminderMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -6527,10 +13076,10 @@ func (m *MessagePinMutation) CreatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the MessagePin entity.
-// If the MessagePin object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the Reminder entity.
+// If the Reminder object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MessagePinMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *ReminderMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -6545,66 +13094,102 @@ func (m *MessagePinMutation) OldCreatedAt(ctx context.Context) (v time.Time, err
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *MessagePinMutation) ResetCreatedAt() {
+func (m *ReminderMutation) ResetCreatedAt() {
 	m.created_at = nil
 }
 
-// SetChannelID sets the "channel" edge to the Channel entity by id.
-func (m *MessagePinMutation) SetChannelID(id uuid.UUID) {
-	m.channel = &id
+// SetUpdatedAt sets the "updated_at" field.
+func (m *ReminderMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
 }
 
-// ClearChannel clears the "channel" edge to the Channel entity.
-func (m *MessagePinMutation) ClearChannel() {
-	m.clearedchannel = true
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *ReminderMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Reminder entity.
+// If the Reminder object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ReminderMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *ReminderMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetUserID sets the "user" edge to the User entity by id.
+func (m *ReminderMutation) SetUserID(id uuid.UUID) {
+	m.user = &id
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (m *ReminderMutation) ClearUser() {
+	m.cleareduser = true
 }
 
-// ChannelCleared reports if the "channel" edge to the Channel entity was cleared.
-func (m *MessagePinMutation) ChannelCleared() bool {
-	return m.clearedchannel
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *ReminderMutation) UserCleared() bool {
+	return m.cleareduser
 }
 
-// ChannelID returns the "channel" edge ID in the mutation.
-func (m *MessagePinMutation) ChannelID() (id uuid.UUID, exists bool) {
-	if m.channel != nil {
-		return *m.channel, true
+// UserID returns the "user" edge ID in the mutation.
+func (m *ReminderMutation) UserID() (id uuid.UUID, exists bool) {
+	if m.user != nil {
+		return *m.user, true
 	}
 	return
 }
 
-// ChannelIDs returns the "channel" edge IDs in the mutation.
+// UserIDs returns the "user" edge IDs in the mutation.
 // Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// ChannelID instead. It exists only for internal usage by the builders.
-func (m *MessagePinMutation) ChannelIDs() (ids []uuid.UUID) {
-	if id := m.channel; id != nil {
+// UserID instead. It exists only for internal usage by the builders.
+func (m *ReminderMutation) UserIDs() (ids []uuid.UUID) {
+	if id := m.user; id != nil {
 		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetChannel resets all changes to the "channel" edge.
-func (m *MessagePinMutation) ResetChannel() {
-	m.channel = nil
-	m.clearedchannel = false
+// ResetUser resets all changes to the "user" edge.
+func (m *ReminderMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
 }
 
 // SetMessageID sets the "message" edge to the Message entity by id.
-func (m *MessagePinMutation) SetMessageID(id uuid.UUID) {
+func (m *ReminderMutation) SetMessageID(id uuid.UUID) {
 	m.message = &id
 }
 
 // ClearMessage clears the "message" edge to the Message entity.
-func (m *MessagePinMutation) ClearMessage() {
+func (m *ReminderMutation) ClearMessage() {
 	m.clearedmessage = true
 }
 
 // MessageCleared reports if the "message" edge to the Message entity was cleared.
-func (m *MessagePinMutation) MessageCleared() bool {
+func (m *ReminderMutation) MessageCleared() bool {
 	return m.clearedmessage
 }
 
 // MessageID returns the "message" edge ID in the mutation.
-func (m *MessagePinMutation) MessageID() (id uuid.UUID, exists bool) {
+func (m *ReminderMutation) MessageID() (id uuid.UUID, exists bool) {
 	if m.message != nil {
 		return *m.message, true
 	}
@@ -6614,7 +13199,7 @@ func (m *MessagePinMutation) MessageID() (id uuid.UUID, exists bool) {
 // MessageIDs returns the "message" edge IDs in the mutation.
 // Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
 // MessageID instead. It exists only for internal usage by the builders.
-func (m *MessagePinMutation) MessageIDs() (ids []uuid.UUID) {
+func (m *ReminderMutation) MessageIDs() (ids []uuid.UUID) {
 	if id := m.message; id != nil {
 		ids = append(ids, *id)
 	}
@@ -6622,59 +13207,20 @@ func (m *MessagePinMutation) MessageIDs() (ids []uuid.UUID) {
 }
 
 // ResetMessage resets all changes to the "message" edge.
-func (m *MessagePinMutation) ResetMessage() {
+func (m *ReminderMutation) ResetMessage() {
 	m.message = nil
 	m.clearedmessage = false
 }
 
-// SetPinnedByID sets the "pinned_by" edge to the User entity by id.
-func (m *MessagePinMutation) SetPinnedByID(id uuid.UUID) {
-	m.pinned_by = &id
-}
-
-// ClearPinnedBy clears the "pinned_by" edge to the User entity.
-func (m *MessagePinMutation) ClearPinnedBy() {
-	m.clearedpinned_by = true
-}
-
-// PinnedByCleared reports if the "pinned_by" edge to the User entity was cleared.
-func (m *MessagePinMutation) PinnedByCleared() bool {
-	return m.clearedpinned_by
-}
-
-// PinnedByID returns the "pinned_by" edge ID in the mutation.
-func (m *MessagePinMutation) PinnedByID() (id uuid.UUID, exists bool) {
-	if m.pinned_by != nil {
-		return *m.pinned_by, true
-	}
-	return
-}
-
-// PinnedByIDs returns the "pinned_by" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// PinnedByID instead. It exists only for internal usage by the builders.
-func (m *MessagePinMutation) PinnedByIDs() (ids []uuid.UUID) {
-	if id := m.pinned_by; id != nil {
-		ids = append(ids, *id)
-	}
-	return
-}
-
-// ResetPinnedBy resets all changes to the "pinned_by" edge.
-func (m *MessagePinMutation) ResetPinnedBy() {
-	m.pinned_by = nil
-	m.clearedpinned_by = false
-}
-
-// Where appends a list predicates to the MessagePinMutation builder.
-func (m *MessagePinMutation) Where(ps ...predicate.MessagePin) {
+// Where appends a list predicates to the ReminderMutation builder.
+func (m *ReminderMutation) Where(ps ...predicate.Reminder) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the MessagePinMutation builder. Using this method,
+// WhereP appends storage-level predicates to the ReminderMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *MessagePinMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.MessagePin, len(ps))
+func (m *ReminderMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Reminder, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -6682,27 +13228,45 @@ func (m *MessagePinMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *MessagePinMutation) Op() Op {
+func (m *ReminderMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *MessagePinMutation) SetOp(op Op) {
+func (m *ReminderMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (MessagePin).
-func (m *MessagePinMutation) Type() string {
+// Type returns the node type of this mutation (Reminder).
+func (m *ReminderMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *MessagePinMutation) Fields() []string {
-	fields := make([]string, 0, 1)
+func (m *ReminderMutation) Fields() []string {
+	fields := make([]string, 0, 7)
+	if m.remind_at != nil {
+		fields = append(fields, reminder.FieldRemindAt)
+	}
+	if m.status != nil {
+		fields = append(fields, reminder.FieldStatus)
+	}
+	if m.claimed_at != nil {
+		fields = append(fields, reminder.FieldClaimedAt)
+	}
+	if m.fired_at != nil {
+		fields = append(fields, reminder.FieldFiredAt)
+	}
+	if m.completed_at != nil {
+		fields = append(fields, reminder.FieldCompletedAt)
+	}
 	if m.created_at != nil {
-		fields = append(fields, messagepin.FieldCreatedAt)
+		fields = append(fields, reminder.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, reminder.FieldUpdatedAt)
 	}
 	return fields
 }
@@ -6710,10 +13274,22 @@ func (m *MessagePinMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *MessagePinMutation) Field(name string) (ent.Value, bool) {
+func (m *ReminderMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case messagepin.FieldCreatedAt:
+	case reminder.FieldRemindAt:
+		return m.RemindAt()
+	case reminder.FieldStatus:
+		return m.Status()
+	case reminder.FieldClaimedAt:
+		return m.ClaimedAt()
+	case reminder.FieldFiredAt:
+		return m.FiredAt()
+	case reminder.FieldCompletedAt:
+		return m.CompletedAt()
+	case reminder.FieldCreatedAt:
 		return m.CreatedAt()
+	case reminder.FieldUpdatedAt:
+		return m.UpdatedAt()
 	}
 	return nil, false
 }
@@ -6721,221 +13297,309 @@ func (m *MessagePinMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *MessagePinMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *ReminderMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case messagepin.FieldCreatedAt:
+	case reminder.FieldRemindAt:
+		return m.OldRemindAt(ctx)
+	case reminder.FieldStatus:
+		return m.OldStatus(ctx)
+	case reminder.FieldClaimedAt:
+		return m.OldClaimedAt(ctx)
+	case reminder.FieldFiredAt:
+		return m.OldFiredAt(ctx)
+	case reminder.FieldCompletedAt:
+		return m.OldCompletedAt(ctx)
+	case reminder.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
+	case reminder.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown MessagePin field %s", name)
+	return nil, fmt.Errorf("unknown Reminder field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *MessagePinMutation) SetField(name string, value ent.Value) error {
+func (m *ReminderMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case messagepin.FieldCreatedAt:
+	case reminder.FieldRemindAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRemindAt(v)
+		return nil
+	case reminder.FieldStatus:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case reminder.FieldClaimedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetClaimedAt(v)
+		return nil
+	case reminder.FieldFiredAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFiredAt(v)
+		return nil
+	case reminder.FieldCompletedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCompletedAt(v)
+		return nil
+	case reminder.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
+	case reminder.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
 	}
-	return fmt.Errorf("unknown MessagePin field %s", name)
+	return fmt.Errorf("unknown Reminder field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *MessagePinMutation) AddedFields() []string {
+func (m *ReminderMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *MessagePinMutation) AddedField(name string) (ent.Value, bool) {
+func (m *ReminderMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *MessagePinMutation) AddField(name string, value ent.Value) error {
+func (m *ReminderMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown MessagePin numeric field %s", name)
+	return fmt.Errorf("unknown Reminder numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *MessagePinMutation) ClearedFields() []string {
-	return nil
+func (m *ReminderMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(reminder.FieldClaimedAt) {
+		fields = append(fields, reminder.FieldClaimedAt)
+	}
+	if m.FieldCleared(reminder.FieldFiredAt) {
+		fields = append(fields, reminder.FieldFiredAt)
+	}
+	if m.FieldCleared(reminder.FieldCompletedAt) {
+		fields = append(fields, reminder.FieldCompletedAt)
+	}
+	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *MessagePinMutation) FieldCleared(name string) bool {
+func (m *ReminderMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *MessagePinMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown MessagePin nullable field %s", name)
+func (m *ReminderMutation) ClearField(name string) error {
+	switch name {
+	case reminder.FieldClaimedAt:
+		m.ClearClaimedAt()
+		return nil
+	case reminder.FieldFiredAt:
+		m.ClearFiredAt()
+		return nil
+	case reminder.FieldCompletedAt:
+		m.ClearCompletedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Reminder nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *MessagePinMutation) ResetField(name string) error {
+func (m *ReminderMutation) ResetField(name string) error {
 	switch name {
-	case messagepin.FieldCreatedAt:
+	case reminder.FieldRemindAt:
+		m.ResetRemindAt()
+		return nil
+	case reminder.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case reminder.FieldClaimedAt:
+		m.ResetClaimedAt()
+		return nil
+	case reminder.FieldFiredAt:
+		m.ResetFiredAt()
+		return nil
+	case reminder.FieldCompletedAt:
+		m.ResetCompletedAt()
+		return nil
+	case reminder.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
+	case reminder.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
 	}
-	return fmt.Errorf("unknown MessagePin field %s", name)
+	return fmt.Errorf("unknown Reminder field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *MessagePinMutation) AddedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.channel != nil {
-		edges = append(edges, messagepin.EdgeChannel)
+func (m *ReminderMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.user != nil {
+		edges = append(edges, reminder.EdgeUser)
 	}
 	if m.message != nil {
-		edges = append(edges, messagepin.EdgeMessage)
-	}
-	if m.pinned_by != nil {
-		edges = append(edges, messagepin.EdgePinnedBy)
+		edges = append(edges, reminder.EdgeMessage)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *MessagePinMutation) AddedIDs(name string) []ent.Value {
+func (m *ReminderMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case messagepin.EdgeChannel:
-		if id := m.channel; id != nil {
+	case reminder.EdgeUser:
+		if id := m.user; id != nil {
 			return []ent.Value{*id}
 		}
-	case messagepin.EdgeMessage:
+	case reminder.EdgeMessage:
 		if id := m.message; id != nil {
 			return []ent.Value{*id}
 		}
-	case messagepin.EdgePinnedBy:
-		if id := m.pinned_by; id != nil {
-			return []ent.Value{*id}
-		}
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *MessagePinMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 3)
+func (m *ReminderMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *MessagePinMutation) RemovedIDs(name string) []ent.Value {
+func (m *ReminderMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *MessagePinMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.clearedchannel {
-		edges = append(edges, messagepin.EdgeChannel)
+func (m *ReminderMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.cleareduser {
+		edges = append(edges, reminder.EdgeUser)
 	}
 	if m.clearedmessage {
-		edges = append(edges, messagepin.EdgeMessage)
-	}
-	if m.clearedpinned_by {
-		edges = append(edges, messagepin.EdgePinnedBy)
+		edges = append(edges, reminder.EdgeMessage)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *MessagePinMutation) EdgeCleared(name string) bool {
+func (m *ReminderMutation) EdgeCleared(name string) bool {
 	switch name {
-	case messagepin.EdgeChannel:
-		return m.clearedchannel
-	case messagepin.EdgeMessage:
+	case reminder.EdgeUser:
+		return m.cleareduser
+	case reminder.EdgeMessage:
 		return m.clearedmessage
-	case messagepin.EdgePinnedBy:
-		return m.clearedpinned_by
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *MessagePinMutation) ClearEdge(name string) error {
-	switch name {
-	case messagepin.EdgeChannel:
-		m.ClearChannel()
-		return nil
-	case messagepin.EdgeMessage:
-		m.ClearMessage()
-		return nil
-	case messagepin.EdgePinnedBy:
-		m.ClearPinnedBy()
-		return nil
-	}
-	return fmt.Errorf("unknown MessagePin unique edge %s", name)
-}
-
-// ResetEdge resets all changes to the edge with the given name in this mutation.
-// It returns an error if the edge is not defined in the schema.
-func (m *MessagePinMutation) ResetEdge(name string) error {
+func (m *ReminderMutation) ClearEdge(name string) error {
 	switch name {
-	case messagepin.EdgeChannel:
-		m.ResetChannel()
-		return nil
-	case messagepin.EdgeMessage:
-		m.ResetMessage()
-		return nil
-	case messagepin.EdgePinnedBy:
-		m.ResetPinnedBy()
-		return nil
-	}
-	return fmt.Errorf("unknown MessagePin edge %s", name)
-}
-
-// MessageReactionMutation represents an operation that mutates the MessageReaction nodes in the graph.
-type MessageReactionMutation struct {
-	config
-	op             Op
-	typ            string
-	id             *uuid.UUID
-	emoji          *string
-	created_at     *time.Time
-	clearedFields  map[string]struct{}
-	message        *uuid.UUID
-	clearedmessage bool
-	user           *uuid.UUID
-	cleareduser    bool
-	done           bool
-	oldValue       func(context.Context) (*MessageReaction, error)
-	predicates     []predicate.MessageReaction
+	case reminder.EdgeUser:
+		m.ClearUser()
+		return nil
+	case reminder.EdgeMessage:
+		m.ClearMessage()
+		return nil
+	}
+	return fmt.Errorf("unknown Reminder unique edge %s", name)
 }
 
-var _ ent.Mutation = (*MessageReactionMutation)(nil)
-
-// messagereactionOption allows management of the mutation configuration using functional options.
-type messagereactionOption func(*MessageReactionMutation)
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ReminderMutation) ResetEdge(name string) error {
+	switch name {
+	case reminder.EdgeUser:
+		m.ResetUser()
+		return nil
+	case reminder.EdgeMessage:
+		m.ResetMessage()
+		return nil
+	}
+	return fmt.Errorf("unknown Reminder edge %s", name)
+}
 
-// newMessageReactionMutation creates new mutation for the MessageReaction entity.
-func newMessageReactionMutation(c config, op Op, opts ...messagereactionOption) *MessageReactionMutation {
-	m := &MessageReactionMutation{
+// ScheduledMessageMutation represents an operation that mutates the ScheduledMessage nodes in the graph.
+type ScheduledMessageMutation struct {
+	config
+	op                   Op
+	typ                  string
+	id                   *uuid.UUID
+	body                 *string
+	attachment_ids       *[]string
+	appendattachment_ids []string
+	send_at              *time.Time
+	recurrence           *string
+	timezone             *string
+	status               *string
+	claimed_at           *time.Time
+	attempts             *int
+	addattempts          *int
+	last_error           *string
+	created_at           *time.Time
+	updated_at           *time.Time
+	clearedFields        map[string]struct{}
+	channel              *uuid.UUID
+	clearedchannel       bool
+	user                 *uuid.UUID
+	cleareduser          bool
+	parent               *uuid.UUID
+	clearedparent        bool
+	done                 bool
+	oldValue             func(context.Context) (*ScheduledMessage, error)
+	predicates           []predicate.ScheduledMessage
+}
+
+var _ ent.Mutation = (*ScheduledMessageMutation)(nil)
+
+// scheduledmessageOption allows management of the mutation configuration using functional options.
+type scheduledmessageOption func(*ScheduledMessageMutation)
+
+// newScheduledMessageMutation creates new mutation for the ScheduledMessage entity.
+func newScheduledMessageMutation(c config, op Op, opts ...scheduledmessageOption) *ScheduledMessageMutation {
+	m := &ScheduledMessageMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeMessageReaction,
+		typ:           TypeScheduledMessage,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -6944,20 +13608,20 @@ func newMessageReactionMutation(c config, op Op, opts ...messagereactionOption)
 	return m
 }
 
-// withMessageReactionID sets the ID field of the mutation.
-func withMessageReactionID(id uuid.UUID) messagereactionOption {
-	return func(m *MessageReactionMutation) {
+// withScheduledMessageID sets the ID field of the mutation.
+func withScheduledMessageID(id uuid.UUID) scheduledmessageOption {
+	return func(m *ScheduledMessageMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *MessageReaction
+			value *ScheduledMessage
 		)
-		m.oldValue = func(ctx context.Context) (*MessageReaction, error) {
+		m.oldValue = func(ctx context.Context) (*ScheduledMessage, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().MessageReaction.Get(ctx, id)
+					value, err = m.Client().ScheduledMessage.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -6966,10 +13630,10 @@ func withMessageReactionID(id uuid.UUID) messagereactionOption {
 	}
 }
 
-// withMessageReaction sets the old MessageReaction of the mutation.
-func withMessageReaction(node *MessageReaction) messagereactionOption {
-	return func(m *MessageReactionMutation) {
-		m.oldValue = func(context.Context) (*MessageReaction, error) {
+// withScheduledMessage sets the old ScheduledMessage of the mutation.
+func withScheduledMessage(node *ScheduledMessage) scheduledmessageOption {
+	return func(m *ScheduledMessageMutation) {
+		m.oldValue = func(context.Context) (*ScheduledMessage, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -6978,7 +13642,7 @@ func withMessageReaction(node *MessageReaction) messagereactionOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m MessageReactionMutation) Client() *Client {
+func (m ScheduledMessageMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -6986,7 +13650,7 @@ func (m MessageReactionMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m MessageReactionMutation) Tx() (*Tx, error) {
+func (m ScheduledMessageMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -6996,14 +13660,14 @@ func (m MessageReactionMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of MessageReaction entities.
-func (m *MessageReactionMutation) SetID(id uuid.UUID) {
+// operation is only accepted on creation of ScheduledMessage entities.
+func (m *ScheduledMessageMutation) SetID(id uuid.UUID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *MessageReactionMutation) ID() (id uuid.UUID, exists bool) {
+func (m *ScheduledMessageMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -7014,7 +13678,7 @@ func (m *MessageReactionMutation) ID() (id uuid.UUID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *MessageReactionMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+func (m *ScheduledMessageMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -7023,530 +13687,431 @@ func (m *MessageReactionMutation) IDs(ctx context.Context) ([]uuid.UUID, error)
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().MessageReaction.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().ScheduledMessage.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetEmoji sets the "emoji" field.
-func (m *MessageReactionMutation) SetEmoji(s string) {
-	m.emoji = &s
+// SetBody sets the "body" field.
+func (m *ScheduledMessageMutation) SetBody(s string) {
+	m.body = &s
 }
 
-// Emoji returns the value of the "emoji" field in the mutation.
-func (m *MessageReactionMutation) Emoji() (r string, exists bool) {
-	v := m.emoji
+// Body returns the value of the "body" field in the mutation.
+func (m *ScheduledMessageMutation) Body() (r string, exists bool) {
+	v := m.body
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldEmoji returns the old "emoji" field's value of the MessageReaction entity.
-// If the MessageReaction object wasn't provided to the builder, the object is fetched from the database.
+// OldBody returns the old "body" field's value of the ScheduledMessage entity.
+// If the ScheduledMessage object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MessageReactionMutation) OldEmoji(ctx context.Context) (v string, err error) {
+func (m *ScheduledMessageMutation) OldBody(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldEmoji is only allowed on UpdateOne operations")
+		return v, errors.New("OldBody is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldEmoji requires an ID field in the mutation")
+		return v, errors.New("OldBody requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldEmoji: %w", err)
+		return v, fmt.Errorf("querying old value for OldBody: %w", err)
 	}
-	return oldValue.Emoji, nil
+	return oldValue.Body, nil
 }
 
-// ResetEmoji resets all changes to the "emoji" field.
-func (m *MessageReactionMutation) ResetEmoji() {
-	m.emoji = nil
+// ResetBody resets all changes to the "body" field.
+func (m *ScheduledMessageMutation) ResetBody() {
+	m.body = nil
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *MessageReactionMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// SetAttachmentIds sets the "attachment_ids" field.
+func (m *ScheduledMessageMutation) SetAttachmentIds(s []string) {
+	m.attachment_ids = &s
+	m.appendattachment_ids = nil
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *MessageReactionMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
+// AttachmentIds returns the value of the "attachment_ids" field in the mutation.
+func (m *ScheduledMessageMutation) AttachmentIds() (r []string, exists bool) {
+	v := m.attachment_ids
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the MessageReaction entity.
-// If the MessageReaction object wasn't provided to the builder, the object is fetched from the database.
+// OldAttachmentIds returns the old "attachment_ids" field's value of the ScheduledMessage entity.
+// If the ScheduledMessage object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MessageReactionMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *ScheduledMessageMutation) OldAttachmentIds(ctx context.Context) (v []string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldAttachmentIds is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+		return v, errors.New("OldAttachmentIds requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldAttachmentIds: %w", err)
 	}
-	return oldValue.CreatedAt, nil
-}
-
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *MessageReactionMutation) ResetCreatedAt() {
-	m.created_at = nil
-}
-
-// SetMessageID sets the "message" edge to the Message entity by id.
-func (m *MessageReactionMutation) SetMessageID(id uuid.UUID) {
-	m.message = &id
-}
-
-// ClearMessage clears the "message" edge to the Message entity.
-func (m *MessageReactionMutation) ClearMessage() {
-	m.clearedmessage = true
-}
-
-// MessageCleared reports if the "message" edge to the Message entity was cleared.
-func (m *MessageReactionMutation) MessageCleared() bool {
-	return m.clearedmessage
+	return oldValue.AttachmentIds, nil
 }
 
-// MessageID returns the "message" edge ID in the mutation.
-func (m *MessageReactionMutation) MessageID() (id uuid.UUID, exists bool) {
-	if m.message != nil {
-		return *m.message, true
-	}
-	return
+// AppendAttachmentIds adds s to the "attachment_ids" field.
+func (m *ScheduledMessageMutation) AppendAttachmentIds(s []string) {
+	m.appendattachment_ids = append(m.appendattachment_ids, s...)
 }
 
-// MessageIDs returns the "message" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// MessageID instead. It exists only for internal usage by the builders.
-func (m *MessageReactionMutation) MessageIDs() (ids []uuid.UUID) {
-	if id := m.message; id != nil {
-		ids = append(ids, *id)
+// AppendedAttachmentIds returns the list of values that were appended to the "attachment_ids" field in this mutation.
+func (m *ScheduledMessageMutation) AppendedAttachmentIds() ([]string, bool) {
+	if len(m.appendattachment_ids) == 0 {
+		return nil, false
 	}
-	return
-}
-
-// ResetMessage resets all changes to the "message" edge.
-func (m *MessageReactionMutation) ResetMessage() {
-	m.message = nil
-	m.clearedmessage = false
+	return m.appendattachment_ids, true
 }
 
-// SetUserID sets the "user" edge to the User entity by id.
-func (m *MessageReactionMutation) SetUserID(id uuid.UUID) {
-	m.user = &id
+// ClearAttachmentIds clears the value of the "attachment_ids" field.
+func (m *ScheduledMessageMutation) ClearAttachmentIds() {
+	m.attachment_ids = nil
+	m.appendattachment_ids = nil
+	m.clearedFields[scheduledmessage.FieldAttachmentIds] = struct{}{}
 }
 
-// ClearUser clears the "user" edge to the User entity.
-func (m *MessageReactionMutation) ClearUser() {
-	m.cleareduser = true
+// AttachmentIdsCleared returns if the "attachment_ids" field was cleared in this mutation.
+func (m *ScheduledMessageMutation) AttachmentIdsCleared() bool {
+	_, ok := m.clearedFields[scheduledmessage.FieldAttachmentIds]
+	return ok
 }
 
-// UserCleared reports if the "user" edge to the User entity was cleared.
-func (m *MessageReactionMutation) UserCleared() bool {
-	return m.cleareduser
+// ResetAttachmentIds resets all changes to the "attachment_ids" field.
+func (m *ScheduledMessageMutation) ResetAttachmentIds() {
+	m.attachment_ids = nil
+	m.appendattachment_ids = nil
+	delete(m.clearedFields, scheduledmessage.FieldAttachmentIds)
 }
 
-// UserID returns the "user" edge ID in the mutation.
-func (m *MessageReactionMutation) UserID() (id uuid.UUID, exists bool) {
-	if m.user != nil {
-		return *m.user, true
-	}
-	return
+// SetSendAt sets the "send_at" field.
+func (m *ScheduledMessageMutation) SetSendAt(t time.Time) {
+	m.send_at = &t
 }
 
-// UserIDs returns the "user" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// UserID instead. It exists only for internal usage by the builders.
-func (m *MessageReactionMutation) UserIDs() (ids []uuid.UUID) {
-	if id := m.user; id != nil {
-		ids = append(ids, *id)
+// SendAt returns the value of the "send_at" field in the mutation.
+func (m *ScheduledMessageMutation) SendAt() (r time.Time, exists bool) {
+	v := m.send_at
+	if v == nil {
+		return
 	}
-	return
-}
-
-// ResetUser resets all changes to the "user" edge.
-func (m *MessageReactionMutation) ResetUser() {
-	m.user = nil
-	m.cleareduser = false
-}
-
-// Where appends a list predicates to the MessageReactionMutation builder.
-func (m *MessageReactionMutation) Where(ps ...predicate.MessageReaction) {
-	m.predicates = append(m.predicates, ps...)
+	return *v, true
 }
 
-// WhereP appends storage-level predicates to the MessageReactionMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *MessageReactionMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.MessageReaction, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
+// OldSendAt returns the old "send_at" field's value of the ScheduledMessage entity.
+// If the ScheduledMessage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ScheduledMessageMutation) OldSendAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSendAt is only allowed on UpdateOne operations")
 	}
-	m.Where(p...)
-}
-
-// Op returns the operation name.
-func (m *MessageReactionMutation) Op() Op {
-	return m.op
-}
-
-// SetOp allows setting the mutation operation.
-func (m *MessageReactionMutation) SetOp(op Op) {
-	m.op = op
-}
-
-// Type returns the node type of this mutation (MessageReaction).
-func (m *MessageReactionMutation) Type() string {
-	return m.typ
-}
-
-// Fields returns all fields that were changed during this mutation. Note that in
-// order to get all numeric fields that were incremented/decremented, call
-// AddedFields().
-func (m *MessageReactionMutation) Fields() []string {
-	fields := make([]string, 0, 2)
-	if m.emoji != nil {
-		fields = append(fields, messagereaction.FieldEmoji)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSendAt requires an ID field in the mutation")
 	}
-	if m.created_at != nil {
-		fields = append(fields, messagereaction.FieldCreatedAt)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSendAt: %w", err)
 	}
-	return fields
+	return oldValue.SendAt, nil
 }
 
-// Field returns the value of a field with the given name. The second boolean
-// return value indicates that this field was not set, or was not defined in the
-// schema.
-func (m *MessageReactionMutation) Field(name string) (ent.Value, bool) {
-	switch name {
-	case messagereaction.FieldEmoji:
-		return m.Emoji()
-	case messagereaction.FieldCreatedAt:
-		return m.CreatedAt()
-	}
-	return nil, false
+// ResetSendAt resets all changes to the "send_at" field.
+func (m *ScheduledMessageMutation) ResetSendAt() {
+	m.send_at = nil
 }
 
-// OldField returns the old value of the field from the database. An error is
-// returned if the mutation operation is not UpdateOne, or the query to the
-// database failed.
-func (m *MessageReactionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
-	switch name {
-	case messagereaction.FieldEmoji:
-		return m.OldEmoji(ctx)
-	case messagereaction.FieldCreatedAt:
-		return m.OldCreatedAt(ctx)
-	}
-	return nil, fmt.Errorf("unknown MessageReaction field %s", name)
+// SetRecurrence sets the "recurrence" field.
+func (m *ScheduledMessageMutation) SetRecurrence(s string) {
+	m.recurrence = &s
 }
 
-// SetField sets the value of a field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *MessageReactionMutation) SetField(name string, value ent.Value) error {
-	switch name {
-	case messagereaction.FieldEmoji:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetEmoji(v)
-		return nil
-	case messagereaction.FieldCreatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCreatedAt(v)
-		return nil
+// Recurrence returns the value of the "recurrence" field in the mutation.
+func (m *ScheduledMessageMutation) Recurrence() (r string, exists bool) {
+	v := m.recurrence
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown MessageReaction field %s", name)
-}
-
-// AddedFields returns all numeric fields that were incremented/decremented during
-// this mutation.
-func (m *MessageReactionMutation) AddedFields() []string {
-	return nil
-}
-
-// AddedField returns the numeric value that was incremented/decremented on a field
-// with the given name. The second boolean return value indicates that this field
-// was not set, or was not defined in the schema.
-func (m *MessageReactionMutation) AddedField(name string) (ent.Value, bool) {
-	return nil, false
+	return *v, true
 }
 
-// AddField adds the value to the field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *MessageReactionMutation) AddField(name string, value ent.Value) error {
-	switch name {
+// OldRecurrence returns the old "recurrence" field's value of the ScheduledMessage entity.
+// If the ScheduledMessage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ScheduledMessageMutation) OldRecurrence(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRecurrence is only allowed on UpdateOne operations")
 	}
-	return fmt.Errorf("unknown MessageReaction numeric field %s", name)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRecurrence requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRecurrence: %w", err)
+	}
+	return oldValue.Recurrence, nil
 }
 
-// ClearedFields returns all nullable fields that were cleared during this
-// mutation.
-func (m *MessageReactionMutation) ClearedFields() []string {
-	return nil
+// ClearRecurrence clears the value of the "recurrence" field.
+func (m *ScheduledMessageMutation) ClearRecurrence() {
+	m.recurrence = nil
+	m.clearedFields[scheduledmessage.FieldRecurrence] = struct{}{}
 }
 
-// FieldCleared returns a boolean indicating if a field with the given name was
-// cleared in this mutation.
-func (m *MessageReactionMutation) FieldCleared(name string) bool {
-	_, ok := m.clearedFields[name]
+// RecurrenceCleared returns if the "recurrence" field was cleared in this mutation.
+func (m *ScheduledMessageMutation) RecurrenceCleared() bool {
+	_, ok := m.clearedFields[scheduledmessage.FieldRecurrence]
 	return ok
 }
 
-// ClearField clears the value of the field with the given name. It returns an
-// error if the field is not defined in the schema.
-func (m *MessageReactionMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown MessageReaction nullable field %s", name)
+// ResetRecurrence resets all changes to the "recurrence" field.
+func (m *ScheduledMessageMutation) ResetRecurrence() {
+	m.recurrence = nil
+	delete(m.clearedFields, scheduledmessage.FieldRecurrence)
 }
 
-// ResetField resets all changes in the mutation for the field with the given name.
-// It returns an error if the field is not defined in the schema.
-func (m *MessageReactionMutation) ResetField(name string) error {
-	switch name {
-	case messagereaction.FieldEmoji:
-		m.ResetEmoji()
-		return nil
-	case messagereaction.FieldCreatedAt:
-		m.ResetCreatedAt()
-		return nil
+// SetTimezone sets the "timezone" field.
+func (m *ScheduledMessageMutation) SetTimezone(s string) {
+	m.timezone = &s
+}
+
+// Timezone returns the value of the "timezone" field in the mutation.
+func (m *ScheduledMessageMutation) Timezone() (r string, exists bool) {
+	v := m.timezone
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown MessageReaction field %s", name)
+	return *v, true
 }
 
-// AddedEdges returns all edge names that were set/added in this mutation.
-func (m *MessageReactionMutation) AddedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.message != nil {
-		edges = append(edges, messagereaction.EdgeMessage)
+// OldTimezone returns the old "timezone" field's value of the ScheduledMessage entity.
+// If the ScheduledMessage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ScheduledMessageMutation) OldTimezone(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTimezone is only allowed on UpdateOne operations")
 	}
-	if m.user != nil {
-		edges = append(edges, messagereaction.EdgeUser)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTimezone requires an ID field in the mutation")
 	}
-	return edges
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTimezone: %w", err)
+	}
+	return oldValue.Timezone, nil
 }
 
-// AddedIDs returns all IDs (to other nodes) that were added for the given edge
-// name in this mutation.
-func (m *MessageReactionMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case messagereaction.EdgeMessage:
-		if id := m.message; id != nil {
-			return []ent.Value{*id}
-		}
-	case messagereaction.EdgeUser:
-		if id := m.user; id != nil {
-			return []ent.Value{*id}
-		}
-	}
-	return nil
+// ResetTimezone resets all changes to the "timezone" field.
+func (m *ScheduledMessageMutation) ResetTimezone() {
+	m.timezone = nil
 }
 
-// RemovedEdges returns all edge names that were removed in this mutation.
-func (m *MessageReactionMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 2)
-	return edges
+// SetStatus sets the "status" field.
+func (m *ScheduledMessageMutation) SetStatus(s string) {
+	m.status = &s
 }
 
-// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
-// the given name in this mutation.
-func (m *MessageReactionMutation) RemovedIDs(name string) []ent.Value {
-	return nil
+// Status returns the value of the "status" field in the mutation.
+func (m *ScheduledMessageMutation) Status() (r string, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *MessageReactionMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.clearedmessage {
-		edges = append(edges, messagereaction.EdgeMessage)
+// OldStatus returns the old "status" field's value of the ScheduledMessage entity.
+// If the ScheduledMessage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ScheduledMessageMutation) OldStatus(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
 	}
-	if m.cleareduser {
-		edges = append(edges, messagereaction.EdgeUser)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
 	}
-	return edges
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
 }
 
-// EdgeCleared returns a boolean which indicates if the edge with the given name
-// was cleared in this mutation.
-func (m *MessageReactionMutation) EdgeCleared(name string) bool {
-	switch name {
-	case messagereaction.EdgeMessage:
-		return m.clearedmessage
-	case messagereaction.EdgeUser:
-		return m.cleareduser
-	}
-	return false
+// ResetStatus resets all changes to the "status" field.
+func (m *ScheduledMessageMutation) ResetStatus() {
+	m.status = nil
 }
 
-// ClearEdge clears the value of the edge with the given name. It returns an error
-// if that edge is not defined in the schema.
-func (m *MessageReactionMutation) ClearEdge(name string) error {
-	switch name {
-	case messagereaction.EdgeMessage:
-		m.ClearMessage()
-		return nil
-	case messagereaction.EdgeUser:
-		m.ClearUser()
-		return nil
+// SetClaimedAt sets the "claimed_at" field.
+func (m *ScheduledMessageMutation) SetClaimedAt(t time.Time) {
+	m.claimed_at = &t
+}
+
+// ClaimedAt returns the value of the "claimed_at" field in the mutation.
+func (m *ScheduledMessageMutation) ClaimedAt() (r time.Time, exists bool) {
+	v := m.claimed_at
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown MessageReaction unique edge %s", name)
+	return *v, true
 }
 
-// ResetEdge resets all changes to the edge with the given name in this mutation.
-// It returns an error if the edge is not defined in the schema.
-func (m *MessageReactionMutation) ResetEdge(name string) error {
-	switch name {
-	case messagereaction.EdgeMessage:
-		m.ResetMessage()
-		return nil
-	case messagereaction.EdgeUser:
-		m.ResetUser()
-		return nil
+// OldClaimedAt returns the old "claimed_at" field's value of the ScheduledMessage entity.
+// If the ScheduledMessage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ScheduledMessageMutation) OldClaimedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldClaimedAt is only allowed on UpdateOne operations")
 	}
-	return fmt.Errorf("unknown MessageReaction edge %s", name)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldClaimedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldClaimedAt: %w", err)
+	}
+	return oldValue.ClaimedAt, nil
 }
 
-// MessageUserMentionMutation represents an operation that mutates the MessageUserMention nodes in the graph.
-type MessageUserMentionMutation struct {
-	config
-	op             Op
-	typ            string
-	id             *uuid.UUID
-	created_at     *time.Time
-	clearedFields  map[string]struct{}
-	message        *uuid.UUID
-	clearedmessage bool
-	user           *uuid.UUID
-	cleareduser    bool
-	done           bool
-	oldValue       func(context.Context) (*MessageUserMention, error)
-	predicates     []predicate.MessageUserMention
+// ClearClaimedAt clears the value of the "claimed_at" field.
+func (m *ScheduledMessageMutation) ClearClaimedAt() {
+	m.claimed_at = nil
+	m.clearedFields[scheduledmessage.FieldClaimedAt] = struct{}{}
 }
 
-var _ ent.Mutation = (*MessageUserMentionMutation)(nil)
+// ClaimedAtCleared returns if the "claimed_at" field was cleared in this mutation.
+func (m *ScheduledMessageMutation) ClaimedAtCleared() bool {
+	_, ok := m.clearedFields[scheduledmessage.FieldClaimedAt]
+	return ok
+}
 
-// messageusermentionOption allows management of the mutation configuration using functional options.
-type messageusermentionOption func(*MessageUserMentionMutation)
+// ResetClaimedAt resets all changes to the "claimed_at" field.
+func (m *ScheduledMessageMutation) ResetClaimedAt() {
+	m.claimed_at = nil
+	delete(m.clearedFields, scheduledmessage.FieldClaimedAt)
+}
 
-// newMessageUserMentionMutation creates new mutation for the MessageUserMention entity.
-func newMessageUserMentionMutation(c config, op Op, opts ...messageusermentionOption) *MessageUserMentionMutation {
-	m := &MessageUserMentionMutation{
-		config:        c,
-		op:            op,
-		typ:           TypeMessageUserMention,
-		clearedFields: make(map[string]struct{}),
-	}
-	for _, opt := range opts {
-		opt(m)
-	}
-	return m
+// SetAttempts sets the "attempts" field.
+func (m *ScheduledMessageMutation) SetAttempts(i int) {
+	m.attempts = &i
+	m.addattempts = nil
 }
 
-// withMessageUserMentionID sets the ID field of the mutation.
-func withMessageUserMentionID(id uuid.UUID) messageusermentionOption {
-	return func(m *MessageUserMentionMutation) {
-		var (
-			err   error
-			once  sync.Once
-			value *MessageUserMention
-		)
-		m.oldValue = func(ctx context.Context) (*MessageUserMention, error) {
-			once.Do(func() {
-				if m.done {
-					err = errors.New("querying old values post mutation is not allowed")
-				} else {
-					value, err = m.Client().MessageUserMention.Get(ctx, id)
-				}
-			})
-			return value, err
-		}
-		m.id = &id
+// Attempts returns the value of the "attempts" field in the mutation.
+func (m *ScheduledMessageMutation) Attempts() (r int, exists bool) {
+	v := m.attempts
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// withMessageUserMention sets the old MessageUserMention of the mutation.
-func withMessageUserMention(node *MessageUserMention) messageusermentionOption {
-	return func(m *MessageUserMentionMutation) {
-		m.oldValue = func(context.Context) (*MessageUserMention, error) {
-			return node, nil
-		}
-		m.id = &node.ID
+// OldAttempts returns the old "attempts" field's value of the ScheduledMessage entity.
+// If the ScheduledMessage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ScheduledMessageMutation) OldAttempts(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAttempts is only allowed on UpdateOne operations")
 	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAttempts requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAttempts: %w", err)
+	}
+	return oldValue.Attempts, nil
 }
 
-// Client returns a new `ent.Client` from the mutation. If the mutation was
-// executed in a transaction (ent.Tx), a transactional client is returned.
-func (m MessageUserMentionMutation) Client() *Client {
-	client := &Client{config: m.config}
-	client.init()
-	return client
+// AddAttempts adds i to the "attempts" field.
+func (m *ScheduledMessageMutation) AddAttempts(i int) {
+	if m.addattempts != nil {
+		*m.addattempts += i
+	} else {
+		m.addattempts = &i
+	}
 }
 
-// Tx returns an `ent.Tx` for mutations that were executed in transactions;
-// it returns an error otherwise.
-func (m MessageUserMentionMutation) Tx() (*Tx, error) {
-	if _, ok := m.driver.(*txDriver); !ok {
-		return nil, errors.New("ent: mutation is not running in a transaction")
+// AddedAttempts returns the value that was added to the "attempts" field in this mutation.
+func (m *ScheduledMessageMutation) AddedAttempts() (r int, exists bool) {
+	v := m.addattempts
+	if v == nil {
+		return
 	}
-	tx := &Tx{config: m.config}
-	tx.init()
-	return tx, nil
+	return *v, true
 }
 
-// SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of MessageUserMention entities.
-func (m *MessageUserMentionMutation) SetID(id uuid.UUID) {
-	m.id = &id
+// ResetAttempts resets all changes to the "attempts" field.
+func (m *ScheduledMessageMutation) ResetAttempts() {
+	m.attempts = nil
+	m.addattempts = nil
 }
 
-// ID returns the ID value in the mutation. Note that the ID is only available
-// if it was provided to the builder or after it was returned from the database.
-func (m *MessageUserMentionMutation) ID() (id uuid.UUID, exists bool) {
-	if m.id == nil {
+// SetLastError sets the "last_error" field.
+func (m *ScheduledMessageMutation) SetLastError(s string) {
+	m.last_error = &s
+}
+
+// LastError returns the value of the "last_error" field in the mutation.
+func (m *ScheduledMessageMutation) LastError() (r string, exists bool) {
+	v := m.last_error
+	if v == nil {
 		return
 	}
-	return *m.id, true
+	return *v, true
+}
+
+// OldLastError returns the old "last_error" field's value of the ScheduledMessage entity.
+// If the ScheduledMessage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ScheduledMessageMutation) OldLastError(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastError is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastError requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastError: %w", err)
+	}
+	return oldValue.LastError, nil
+}
+
+// ClearLastError clears the value of the "last_error" field.
+func (m *ScheduledMessageMutation) ClearLastError() {
+	m.last_error = nil
+	m.clearedFields[scheduledmessage.FieldLastError] = struct{}{}
 }
 
-// IDs queries the database and returns the entity ids that match the mutation's predicate.
-// That means, if the mutation is applied within a transaction with an isolation level such
-// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
-// or updated by the mutation.
-func (m *MessageUserMentionMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
-	switch {
-	case m.op.Is(OpUpdateOne | OpDeleteOne):
-		id, exists := m.ID()
-		if exists {
-			return []uuid.UUID{id}, nil
-		}
-		fallthrough
-	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().MessageUserMention.Query().Where(m.predicates...).IDs(ctx)
-	default:
-		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
-	}
+// LastErrorCleared returns if the "last_error" field was cleared in this mutation.
+func (m *ScheduledMessageMutation) LastErrorCleared() bool {
+	_, ok := m.clearedFields[scheduledmessage.FieldLastError]
+	return ok
+}
+
+// ResetLastError resets all changes to the "last_error" field.
+func (m *ScheduledMessageMutation) ResetLastError() {
+	m.last_error = nil
+	delete(m.clearedFields, scheduledmessage.FieldLastError)
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *MessageUserMentionMutation) SetCreatedAt(t time.Time) {
+func (m *ScheduledMessageMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *MessageUserMentionMutation) CreatedAt() (r time.Time, exists bool) {
+func (m *ScheduledMessageMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -7554,10 +14119,10 @@ func (m *MessageUserMentionMutation) CreatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the MessageUserMention entity.
-// If the MessageUserMention object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the ScheduledMessage entity.
+// If the ScheduledMessage object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MessageUserMentionMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *ScheduledMessageMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -7572,66 +14137,102 @@ func (m *MessageUserMentionMutation) OldCreatedAt(ctx context.Context) (v time.T
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *MessageUserMentionMutation) ResetCreatedAt() {
+func (m *ScheduledMessageMutation) ResetCreatedAt() {
 	m.created_at = nil
 }
 
-// SetMessageID sets the "message" edge to the Message entity by id.
-func (m *MessageUserMentionMutation) SetMessageID(id uuid.UUID) {
-	m.message = &id
+// SetUpdatedAt sets the "updated_at" field.
+func (m *ScheduledMessageMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
 }
 
-// ClearMessage clears the "message" edge to the Message entity.
-func (m *MessageUserMentionMutation) ClearMessage() {
-	m.clearedmessage = true
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *ScheduledMessageMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// MessageCleared reports if the "message" edge to the Message entity was cleared.
-func (m *MessageUserMentionMutation) MessageCleared() bool {
-	return m.clearedmessage
+// OldUpdatedAt returns the old "updated_at" field's value of the ScheduledMessage entity.
+// If the ScheduledMessage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ScheduledMessageMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
 }
 
-// MessageID returns the "message" edge ID in the mutation.
-func (m *MessageUserMentionMutation) MessageID() (id uuid.UUID, exists bool) {
-	if m.message != nil {
-		return *m.message, true
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *ScheduledMessageMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetChannelID sets the "channel" edge to the Channel entity by id.
+func (m *ScheduledMessageMutation) SetChannelID(id uuid.UUID) {
+	m.channel = &id
+}
+
+// ClearChannel clears the "channel" edge to the Channel entity.
+func (m *ScheduledMessageMutation) ClearChannel() {
+	m.clearedchannel = true
+}
+
+// ChannelCleared reports if the "channel" edge to the Channel entity was cleared.
+func (m *ScheduledMessageMutation) ChannelCleared() bool {
+	return m.clearedchannel
+}
+
+// ChannelID returns the "channel" edge ID in the mutation.
+func (m *ScheduledMessageMutation) ChannelID() (id uuid.UUID, exists bool) {
+	if m.channel != nil {
+		return *m.channel, true
 	}
 	return
 }
 
-// MessageIDs returns the "message" edge IDs in the mutation.
+// ChannelIDs returns the "channel" edge IDs in the mutation.
 // Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// MessageID instead. It exists only for internal usage by the builders.
-func (m *MessageUserMentionMutation) MessageIDs() (ids []uuid.UUID) {
-	if id := m.message; id != nil {
+// ChannelID instead. It exists only for internal usage by the builders.
+func (m *ScheduledMessageMutation) ChannelIDs() (ids []uuid.UUID) {
+	if id := m.channel; id != nil {
 		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetMessage resets all changes to the "message" edge.
-func (m *MessageUserMentionMutation) ResetMessage() {
-	m.message = nil
-	m.clearedmessage = false
+// ResetChannel resets all changes to the "channel" edge.
+func (m *ScheduledMessageMutation) ResetChannel() {
+	m.channel = nil
+	m.clearedchannel = false
 }
 
 // SetUserID sets the "user" edge to the User entity by id.
-func (m *MessageUserMentionMutation) SetUserID(id uuid.UUID) {
+func (m *ScheduledMessageMutation) SetUserID(id uuid.UUID) {
 	m.user = &id
 }
 
 // ClearUser clears the "user" edge to the User entity.
-func (m *MessageUserMentionMutation) ClearUser() {
+func (m *ScheduledMessageMutation) ClearUser() {
 	m.cleareduser = true
 }
 
 // UserCleared reports if the "user" edge to the User entity was cleared.
-func (m *MessageUserMentionMutation) UserCleared() bool {
+func (m *ScheduledMessageMutation) UserCleared() bool {
 	return m.cleareduser
 }
 
 // UserID returns the "user" edge ID in the mutation.
-func (m *MessageUserMentionMutation) UserID() (id uuid.UUID, exists bool) {
+func (m *ScheduledMessageMutation) UserID() (id uuid.UUID, exists bool) {
 	if m.user != nil {
 		return *m.user, true
 	}
@@ -7641,7 +14242,7 @@ func (m *MessageUserMentionMutation) UserID() (id uuid.UUID, exists bool) {
 // UserIDs returns the "user" edge IDs in the mutation.
 // Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
 // UserID instead. It exists only for internal usage by the builders.
-func (m *MessageUserMentionMutation) UserIDs() (ids []uuid.UUID) {
+func (m *ScheduledMessageMutation) UserIDs() (ids []uuid.UUID) {
 	if id := m.user; id != nil {
 		ids = append(ids, *id)
 	}
@@ -7649,20 +14250,59 @@ func (m *MessageUserMentionMutation) UserIDs() (ids []uuid.UUID) {
 }
 
 // ResetUser resets all changes to the "user" edge.
-func (m *MessageUserMentionMutation) ResetUser() {
+func (m *ScheduledMessageMutation) ResetUser() {
 	m.user = nil
 	m.cleareduser = false
 }
 
-// Where appends a list predicates to the MessageUserMentionMutation builder.
-func (m *MessageUserMentionMutation) Where(ps ...predicate.MessageUserMention) {
+// SetParentID sets the "parent" edge to the Message entity by id.
+func (m *ScheduledMessageMutation) SetParentID(id uuid.UUID) {
+	m.parent = &id
+}
+
+// ClearParent clears the "parent" edge to the Message entity.
+func (m *ScheduledMessageMutation) ClearParent() {
+	m.clearedparent = true
+}
+
+// ParentCleared reports if the "parent" edge to the Message entity was cleared.
+func (m *ScheduledMessageMutation) ParentCleared() bool {
+	return m.clearedparent
+}
+
+// ParentID returns the "parent" edge ID in the mutation.
+func (m *ScheduledMessageMutation) ParentID() (id uuid.UUID, exists bool) {
+	if m.parent != nil {
+		return *m.parent, true
+	}
+	return
+}
+
+// ParentIDs returns the "parent" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ParentID instead. It exists only for internal usage by the builders.
+func (m *ScheduledMessageMutation) ParentIDs() (ids []uuid.UUID) {
+	if id := m.parent; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetParent resets all changes to the "parent" edge.
+func (m *ScheduledMessageMutation) ResetParent() {
+	m.parent = nil
+	m.clearedparent = false
+}
+
+// Where appends a list predicates to the ScheduledMessageMutation builder.
+func (m *ScheduledMessageMutation) Where(ps ...predicate.ScheduledMessage) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the MessageUserMentionMutation builder. Using this method,
+// WhereP appends storage-level predicates to the ScheduledMessageMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *MessageUserMentionMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.MessageUserMention, len(ps))
+func (m *ScheduledMessageMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ScheduledMessage, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -7670,27 +14310,57 @@ func (m *MessageUserMentionMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *MessageUserMentionMutation) Op() Op {
+func (m *ScheduledMessageMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *MessageUserMentionMutation) SetOp(op Op) {
+func (m *ScheduledMessageMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (MessageUserMention).
-func (m *MessageUserMentionMutation) Type() string {
+// Type returns the node type of this mutation (ScheduledMessage).
+func (m *ScheduledMessageMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *MessageUserMentionMutation) Fields() []string {
-	fields := make([]string, 0, 1)
+func (m *ScheduledMessageMutation) Fields() []string {
+	fields := make([]string, 0, 11)
+	if m.body != nil {
+		fields = append(fields, scheduledmessage.FieldBody)
+	}
+	if m.attachment_ids != nil {
+		fields = append(fields, scheduledmessage.FieldAttachmentIds)
+	}
+	if m.send_at != nil {
+		fields = append(fields, scheduledmessage.FieldSendAt)
+	}
+	if m.recurrence != nil {
+		fields = append(fields, scheduledmessage.FieldRecurrence)
+	}
+	if m.timezone != nil {
+		fields = append(fields, scheduledmessage.FieldTimezone)
+	}
+	if m.status != nil {
+		fields = append(fields, scheduledmessage.FieldStatus)
+	}
+	if m.claimed_at != nil {
+		fields = append(fields, scheduledmessage.FieldClaimedAt)
+	}
+	if m.attempts != nil {
+		fields = append(fields, scheduledmessage.FieldAttempts)
+	}
+	if m.last_error != nil {
+		fields = append(fields, scheduledmessage.FieldLastError)
+	}
 	if m.created_at != nil {
-		fields = append(fields, messageusermention.FieldCreatedAt)
+		fields = append(fields, scheduledmessage.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, scheduledmessage.FieldUpdatedAt)
 	}
 	return fields
 }
@@ -7698,10 +14368,30 @@ func (m *MessageUserMentionMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *MessageUserMentionMutation) Field(name string) (ent.Value, bool) {
+func (m *ScheduledMessageMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case messageusermention.FieldCreatedAt:
+	case scheduledmessage.FieldBody:
+		return m.Body()
+	case scheduledmessage.FieldAttachmentIds:
+		return m.AttachmentIds()
+	case scheduledmessage.FieldSendAt:
+		return m.SendAt()
+	case scheduledmessage.FieldRecurrence:
+		return m.Recurrence()
+	case scheduledmessage.FieldTimezone:
+		return m.Timezone()
+	case scheduledmessage.FieldStatus:
+		return m.Status()
+	case scheduledmessage.FieldClaimedAt:
+		return m.ClaimedAt()
+	case scheduledmessage.FieldAttempts:
+		return m.Attempts()
+	case scheduledmessage.FieldLastError:
+		return m.LastError()
+	case scheduledmessage.FieldCreatedAt:
 		return m.CreatedAt()
+	case scheduledmessage.FieldUpdatedAt:
+		return m.UpdatedAt()
 	}
 	return nil, false
 }
@@ -7709,172 +14399,352 @@ func (m *MessageUserMentionMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *MessageUserMentionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *ScheduledMessageMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case messageusermention.FieldCreatedAt:
+	case scheduledmessage.FieldBody:
+		return m.OldBody(ctx)
+	case scheduledmessage.FieldAttachmentIds:
+		return m.OldAttachmentIds(ctx)
+	case scheduledmessage.FieldSendAt:
+		return m.OldSendAt(ctx)
+	case scheduledmessage.FieldRecurrence:
+		return m.OldRecurrence(ctx)
+	case scheduledmessage.FieldTimezone:
+		return m.OldTimezone(ctx)
+	case scheduledmessage.FieldStatus:
+		return m.OldStatus(ctx)
+	case scheduledmessage.FieldClaimedAt:
+		return m.OldClaimedAt(ctx)
+	case scheduledmessage.FieldAttempts:
+		return m.OldAttempts(ctx)
+	case scheduledmessage.FieldLastError:
+		return m.OldLastError(ctx)
+	case scheduledmessage.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
+	case scheduledmessage.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown MessageUserMention field %s", name)
+	return nil, fmt.Errorf("unknown ScheduledMessage field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *MessageUserMentionMutation) SetField(name string, value ent.Value) error {
+func (m *ScheduledMessageMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case messageusermention.FieldCreatedAt:
+	case scheduledmessage.FieldBody:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBody(v)
+		return nil
+	case scheduledmessage.FieldAttachmentIds:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAttachmentIds(v)
+		return nil
+	case scheduledmessage.FieldSendAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSendAt(v)
+		return nil
+	case scheduledmessage.FieldRecurrence:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRecurrence(v)
+		return nil
+	case scheduledmessage.FieldTimezone:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTimezone(v)
+		return nil
+	case scheduledmessage.FieldStatus:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case scheduledmessage.FieldClaimedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetClaimedAt(v)
+		return nil
+	case scheduledmessage.FieldAttempts:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAttempts(v)
+		return nil
+	case scheduledmessage.FieldLastError:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastError(v)
+		return nil
+	case scheduledmessage.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
+	case scheduledmessage.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
 	}
-	return fmt.Errorf("unknown MessageUserMention field %s", name)
+	return fmt.Errorf("unknown ScheduledMessage field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *MessageUserMentionMutation) AddedFields() []string {
-	return nil
+func (m *ScheduledMessageMutation) AddedFields() []string {
+	var fields []string
+	if m.addattempts != nil {
+		fields = append(fields, scheduledmessage.FieldAttempts)
+	}
+	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *MessageUserMentionMutation) AddedField(name string) (ent.Value, bool) {
+func (m *ScheduledMessageMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case scheduledmessage.FieldAttempts:
+		return m.AddedAttempts()
+	}
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *MessageUserMentionMutation) AddField(name string, value ent.Value) error {
+func (m *ScheduledMessageMutation) AddField(name string, value ent.Value) error {
 	switch name {
+	case scheduledmessage.FieldAttempts:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAttempts(v)
+		return nil
 	}
-	return fmt.Errorf("unknown MessageUserMention numeric field %s", name)
+	return fmt.Errorf("unknown ScheduledMessage numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *MessageUserMentionMutation) ClearedFields() []string {
-	return nil
+func (m *ScheduledMessageMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(scheduledmessage.FieldAttachmentIds) {
+		fields = append(fields, scheduledmessage.FieldAttachmentIds)
+	}
+	if m.FieldCleared(scheduledmessage.FieldRecurrence) {
+		fields = append(fields, scheduledmessage.FieldRecurrence)
+	}
+	if m.FieldCleared(scheduledmessage.FieldClaimedAt) {
+		fields = append(fields, scheduledmessage.FieldClaimedAt)
+	}
+	if m.FieldCleared(scheduledmessage.FieldLastError) {
+		fields = append(fields, scheduledmessage.FieldLastError)
+	}
+	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *MessageUserMentionMutation) FieldCleared(name string) bool {
+func (m *ScheduledMessageMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *MessageUserMentionMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown MessageUserMention nullable field %s", name)
+func (m *ScheduledMessageMutation) ClearField(name string) error {
+	switch name {
+	case scheduledmessage.FieldAttachmentIds:
+		m.ClearAttachmentIds()
+		return nil
+	case scheduledmessage.FieldRecurrence:
+		m.ClearRecurrence()
+		return nil
+	case scheduledmessage.FieldClaimedAt:
+		m.ClearClaimedAt()
+		return nil
+	case scheduledmessage.FieldLastError:
+		m.ClearLastError()
+		return nil
+	}
+	return fmt.Errorf("unknown ScheduledMessage nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *MessageUserMentionMutation) ResetField(name string) error {
+func (m *ScheduledMessageMutation) ResetField(name string) error {
 	switch name {
-	case messageusermention.FieldCreatedAt:
+	case scheduledmessage.FieldBody:
+		m.ResetBody()
+		return nil
+	case scheduledmessage.FieldAttachmentIds:
+		m.ResetAttachmentIds()
+		return nil
+	case scheduledmessage.FieldSendAt:
+		m.ResetSendAt()
+		return nil
+	case scheduledmessage.FieldRecurrence:
+		m.ResetRecurrence()
+		return nil
+	case scheduledmessage.FieldTimezone:
+		m.ResetTimezone()
+		return nil
+	case scheduledmessage.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case scheduledmessage.FieldClaimedAt:
+		m.ResetClaimedAt()
+		return nil
+	case scheduledmessage.FieldAttempts:
+		m.ResetAttempts()
+		return nil
+	case scheduledmessage.FieldLastError:
+		m.ResetLastError()
+		return nil
+	case scheduledmessage.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
+	case scheduledmessage.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
 	}
-	return fmt.Errorf("unknown MessageUserMention field %s", name)
+	return fmt.Errorf("unknown ScheduledMessage field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *MessageUserMentionMutation) AddedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.message != nil {
-		edges = append(edges, messageusermention.EdgeMessage)
+func (m *ScheduledMessageMutation) AddedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.channel != nil {
+		edges = append(edges, scheduledmessage.EdgeChannel)
 	}
 	if m.user != nil {
-		edges = append(edges, messageusermention.EdgeUser)
+		edges = append(edges, scheduledmessage.EdgeUser)
+	}
+	if m.parent != nil {
+		edges = append(edges, scheduledmessage.EdgeParent)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *MessageUserMentionMutation) AddedIDs(name string) []ent.Value {
+func (m *ScheduledMessageMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case messageusermention.EdgeMessage:
-		if id := m.message; id != nil {
+	case scheduledmessage.EdgeChannel:
+		if id := m.channel; id != nil {
 			return []ent.Value{*id}
 		}
-	case messageusermention.EdgeUser:
+	case scheduledmessage.EdgeUser:
 		if id := m.user; id != nil {
 			return []ent.Value{*id}
 		}
+	case scheduledmessage.EdgeParent:
+		if id := m.parent; id != nil {
+			return []ent.Value{*id}
+		}
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *MessageUserMentionMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 2)
+func (m *ScheduledMessageMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 3)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *MessageUserMentionMutation) RemovedIDs(name string) []ent.Value {
+func (m *ScheduledMessageMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *MessageUserMentionMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.clearedmessage {
-		edges = append(edges, messageusermention.EdgeMessage)
+func (m *ScheduledMessageMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.clearedchannel {
+		edges = append(edges, scheduledmessage.EdgeChannel)
 	}
 	if m.cleareduser {
-		edges = append(edges, messageusermention.EdgeUser)
+		edges = append(edges, scheduledmessage.EdgeUser)
+	}
+	if m.clearedparent {
+		edges = append(edges, scheduledmessage.EdgeParent)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *MessageUserMentionMutation) EdgeCleared(name string) bool {
+func (m *ScheduledMessageMutation) EdgeCleared(name string) bool {
 	switch name {
-	case messageusermention.EdgeMessage:
-		return m.clearedmessage
-	case messageusermention.EdgeUser:
+	case scheduledmessage.EdgeChannel:
+		return m.clearedchannel
+	case scheduledmessage.EdgeUser:
 		return m.cleareduser
+	case scheduledmessage.EdgeParent:
+		return m.clearedparent
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *MessageUserMentionMutation) ClearEdge(name string) error {
+func (m *ScheduledMessageMutation) ClearEdge(name string) error {
 	switch name {
-	case messageusermention.EdgeMessage:
-		m.ClearMessage()
+	case scheduledmessage.EdgeChannel:
+		m.ClearChannel()
 		return nil
-	case messageusermention.EdgeUser:
+	case scheduledmessage.EdgeUser:
 		m.ClearUser()
 		return nil
+	case scheduledmessage.EdgeParent:
+		m.ClearParent()
+		return nil
 	}
-	return fmt.Errorf("unknown MessageUserMention unique edge %s", name)
+	return fmt.Errorf("unknown ScheduledMessage unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *MessageUserMentionMutation) ResetEdge(name string) error {
+func (m *ScheduledMessageMutation) ResetEdge(name string) error {
 	switch name {
-	case messageusermention.EdgeMessage:
-		m.ResetMessage()
+	case scheduledmessage.EdgeChannel:
+		m.ResetChannel()
 		return nil
-	case messageusermention.EdgeUser:
+	case scheduledmessage.EdgeUser:
 		m.ResetUser()
 		return nil
+	case scheduledmessage.EdgeParent:
+		m.ResetParent()
+		return nil
 	}
-	return fmt.Errorf("unknown MessageUserMention edge %s", name)
+	return fmt.Errorf("unknown ScheduledMessage edge %s", name)
 }
 
 // SessionMutation represents an operation that mutates the Session nodes in the graph.
@@ -8463,20 +15333,22 @@ func (m *SessionMutation) ResetEdge(name string) error {
 // SystemMessageMutation represents an operation that mutates the SystemMessage nodes in the graph.
 type SystemMessageMutation struct {
 	config
-	op             Op
-	typ            string
-	id             *uuid.UUID
-	kind           *string
-	payload        *map[string]interface{}
-	created_at     *time.Time
-	clearedFields  map[string]struct{}
-	channel        *uuid.UUID
-	clearedchannel bool
-	actor          *uuid.UUID
-	clearedactor   bool
-	done           bool
-	oldValue       func(context.Context) (*SystemMessage, error)
-	predicates     []predicate.SystemMessage
+	op               Op
+	typ              string
+	id               *uuid.UUID
+	kind             *string
+	payload          *map[string]interface{}
+	created_at       *time.Time
+	clearedFields    map[string]struct{}
+	channel          *uuid.UUID
+	clearedchannel   bool
+	actor            *uuid.UUID
+	clearedactor     bool
+	recipient        *uuid.UUID
+	clearedrecipient bool
+	done             bool
+	oldValue         func(context.Context) (*SystemMessage, error)
+	predicates       []predicate.SystemMessage
 }
 
 var _ ent.Mutation = (*SystemMessageMutation)(nil)
@@ -8769,6 +15641,45 @@ func (m *SystemMessageMutation) ResetActor() {
 	m.clearedactor = false
 }
 
+// SetRecipientID sets the "recipient" edge to the User entity by id.
+func (m *SystemMessageMutation) SetRecipientID(id uuid.UUID) {
+	m.recipient = &id
+}
+
+// ClearRecipient clears the "recipient" edge to the User entity.
+func (m *SystemMessageMutation) ClearRecipient() {
+	m.clearedrecipient = true
+}
+
+// RecipientCleared reports if the "recipient" edge to the User entity was cleared.
+func (m *SystemMessageMutation) RecipientCleared() bool {
+	return m.clearedrecipient
+}
+
+// RecipientID returns the "recipient" edge ID in the mutation.
+func (m *SystemMessageMutation) RecipientID() (id uuid.UUID, exists bool) {
+	if m.recipient != nil {
+		return *m.recipient, true
+	}
+	return
+}
+
+// RecipientIDs returns the "recipient" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// RecipientID instead. It exists only for internal usage by the builders.
+func (m *SystemMessageMutation) RecipientIDs() (ids []uuid.UUID) {
+	if id := m.recipient; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetRecipient resets all changes to the "recipient" edge.
+func (m *SystemMessageMutation) ResetRecipient() {
+	m.recipient = nil
+	m.clearedrecipient = false
+}
+
 // Where appends a list predicates to the SystemMessageMutation builder.
 func (m *SystemMessageMutation) Where(ps ...predicate.SystemMessage) {
 	m.predicates = append(m.predicates, ps...)
@@ -8936,13 +15847,16 @@ func (m *SystemMessageMutation) ResetField(name string) error {
 
 // AddedEdges returns all edge names that were set/added in this mutation.
 func (m *SystemMessageMutation) AddedEdges() []string {
-	edges := make([]string, 0, 2)
+	edges := make([]string, 0, 3)
 	if m.channel != nil {
 		edges = append(edges, systemmessage.EdgeChannel)
 	}
 	if m.actor != nil {
 		edges = append(edges, systemmessage.EdgeActor)
 	}
+	if m.recipient != nil {
+		edges = append(edges, systemmessage.EdgeRecipient)
+	}
 	return edges
 }
 
@@ -8958,13 +15872,17 @@ func (m *SystemMessageMutation) AddedIDs(name string) []ent.Value {
 		if id := m.actor; id != nil {
 			return []ent.Value{*id}
 		}
+	case systemmessage.EdgeRecipient:
+		if id := m.recipient; id != nil {
+			return []ent.Value{*id}
+		}
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
 func (m *SystemMessageMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 2)
+	edges := make([]string, 0, 3)
 	return edges
 }
 
@@ -8976,13 +15894,16 @@ func (m *SystemMessageMutation) RemovedIDs(name string) []ent.Value {
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
 func (m *SystemMessageMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 2)
+	edges := make([]string, 0, 3)
 	if m.clearedchannel {
 		edges = append(edges, systemmessage.EdgeChannel)
 	}
 	if m.clearedactor {
 		edges = append(edges, systemmessage.EdgeActor)
 	}
+	if m.clearedrecipient {
+		edges = append(edges, systemmessage.EdgeRecipient)
+	}
 	return edges
 }
 
@@ -8994,6 +15915,8 @@ func (m *SystemMessageMutation) EdgeCleared(name string) bool {
 		return m.clearedchannel
 	case systemmessage.EdgeActor:
 		return m.clearedactor
+	case systemmessage.EdgeRecipient:
+		return m.clearedrecipient
 	}
 	return false
 }
@@ -9008,6 +15931,9 @@ func (m *SystemMessageMutation) ClearEdge(name string) error {
 	case systemmessage.EdgeActor:
 		m.ClearActor()
 		return nil
+	case systemmessage.EdgeRecipient:
+		m.ClearRecipient()
+		return nil
 	}
 	return fmt.Errorf("unknown SystemMessage unique edge %s", name)
 }
@@ -9022,6 +15948,9 @@ func (m *SystemMessageMutation) ResetEdge(name string) error {
 	case systemmessage.EdgeActor:
 		m.ResetActor()
 		return nil
+	case systemmessage.EdgeRecipient:
+		m.ResetRecipient()
+		return nil
 	}
 	return fmt.Errorf("unknown SystemMessage edge %s", name)
 }
@@ -9603,6 +16532,8 @@ type UserMutation struct {
 	display_name               *string
 	bio                        *string
 	avatar_url                 *string
+	last_seen_at               *time.Time
+	read_receipts_enabled      *bool
 	created_at                 *time.Time
 	updated_at                 *time.Time
 	clearedFields              map[string]struct{}
@@ -9960,6 +16891,91 @@ func (m *UserMutation) ResetAvatarURL() {
 	delete(m.clearedFields, user.FieldAvatarURL)
 }
 
+// SetLastSeenAt sets the "last_seen_at" field.
+func (m *UserMutation) SetLastSeenAt(t time.Time) {
+	m.last_seen_at = &t
+}
+
+// LastSeenAt returns the value of the "last_seen_at" field in the mutation.
+func (m *UserMutation) LastSeenAt() (r time.Time, exists bool) {
+	v := m.last_seen_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLastSeenAt returns the old "last_seen_at" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldLastSeenAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastSeenAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastSeenAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastSeenAt: %w", err)
+	}
+	return oldValue.LastSeenAt, nil
+}
+
+// ClearLastSeenAt clears the value of the "last_seen_at" field.
+func (m *UserMutation) ClearLastSeenAt() {
+	m.last_seen_at = nil
+	m.clearedFields[user.FieldLastSeenAt] = struct{}{}
+}
+
+// LastSeenAtCleared returns if the "last_seen_at" field was cleared in this mutation.
+func (m *UserMutation) LastSeenAtCleared() bool {
+	_, ok := m.clearedFields[user.FieldLastSeenAt]
+	return ok
+}
+
+// ResetLastSeenAt resets all changes to the "last_seen_at" field.
+func (m *UserMutation) ResetLastSeenAt() {
+	m.last_seen_at = nil
+	delete(m.clearedFields, user.FieldLastSeenAt)
+}
+
+// SetReadReceiptsEnabled sets the "read_receipts_enabled" field.
+func (m *UserMutation) SetReadReceiptsEnabled(b bool) {
+	m.read_receipts_enabled = &b
+}
+
+// ReadReceiptsEnabled returns the value of the "read_receipts_enabled" field in the mutation.
+func (m *UserMutation) ReadReceiptsEnabled() (r bool, exists bool) {
+	v := m.read_receipts_enabled
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReadReceiptsEnabled returns the old "read_receipts_enabled" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldReadReceiptsEnabled(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReadReceiptsEnabled is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReadReceiptsEnabled requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReadReceiptsEnabled: %w", err)
+	}
+	return oldValue.ReadReceiptsEnabled, nil
+}
+
+// ResetReadReceiptsEnabled resets all changes to the "read_receipts_enabled" field.
+func (m *UserMutation) ResetReadReceiptsEnabled() {
+	m.read_receipts_enabled = nil
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (m *UserMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
@@ -10768,7 +17784,7 @@ func (m *UserMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *UserMutation) Fields() []string {
-	fields := make([]string, 0, 7)
+	fields := make([]string, 0, 9)
 	if m.email != nil {
 		fields = append(fields, user.FieldEmail)
 	}
@@ -10784,6 +17800,12 @@ func (m *UserMutation) Fields() []string {
 	if m.avatar_url != nil {
 		fields = append(fields, user.FieldAvatarURL)
 	}
+	if m.last_seen_at != nil {
+		fields = append(fields, user.FieldLastSeenAt)
+	}
+	if m.read_receipts_enabled != nil {
+		fields = append(fields, user.FieldReadReceiptsEnabled)
+	}
 	if m.created_at != nil {
 		fields = append(fields, user.FieldCreatedAt)
 	}
@@ -10808,6 +17830,10 @@ func (m *UserMutation) Field(name string) (ent.Value, bool) {
 		return m.Bio()
 	case user.FieldAvatarURL:
 		return m.AvatarURL()
+	case user.FieldLastSeenAt:
+		return m.LastSeenAt()
+	case user.FieldReadReceiptsEnabled:
+		return m.ReadReceiptsEnabled()
 	case user.FieldCreatedAt:
 		return m.CreatedAt()
 	case user.FieldUpdatedAt:
@@ -10831,6 +17857,10 @@ func (m *UserMutation) OldField(ctx context.Context, name string) (ent.Value, er
 		return m.OldBio(ctx)
 	case user.FieldAvatarURL:
 		return m.OldAvatarURL(ctx)
+	case user.FieldLastSeenAt:
+		return m.OldLastSeenAt(ctx)
+	case user.FieldReadReceiptsEnabled:
+		return m.OldReadReceiptsEnabled(ctx)
 	case user.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
 	case user.FieldUpdatedAt:
@@ -10879,6 +17909,20 @@ func (m *UserMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetAvatarURL(v)
 		return nil
+	case user.FieldLastSeenAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastSeenAt(v)
+		return nil
+	case user.FieldReadReceiptsEnabled:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReadReceiptsEnabled(v)
+		return nil
 	case user.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
@@ -10929,6 +17973,9 @@ func (m *UserMutation) ClearedFields() []string {
 	if m.FieldCleared(user.FieldAvatarURL) {
 		fields = append(fields, user.FieldAvatarURL)
 	}
+	if m.FieldCleared(user.FieldLastSeenAt) {
+		fields = append(fields, user.FieldLastSeenAt)
+	}
 	return fields
 }
 
@@ -10949,6 +17996,9 @@ func (m *UserMutation) ClearField(name string) error {
 	case user.FieldAvatarURL:
 		m.ClearAvatarURL()
 		return nil
+	case user.FieldLastSeenAt:
+		m.ClearLastSeenAt()
+		return nil
 	}
 	return fmt.Errorf("unknown User nullable field %s", name)
 }
@@ -10972,6 +18022,12 @@ func (m *UserMutation) ResetField(name string) error {
 	case user.FieldAvatarURL:
 		m.ResetAvatarURL()
 		return nil
+	case user.FieldLastSeenAt:
+		m.ResetLastSeenAt()
+		return nil
+	case user.FieldReadReceiptsEnabled:
+		m.ResetReadReceiptsEnabled()
+		return nil
 	case user.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
@@ -13107,30 +20163,33 @@ func (m *UserThreadFollowMutation) ResetEdge(name string) error {
 // WorkspaceMutation represents an operation that mutates the Workspace nodes in the graph.
 type WorkspaceMutation struct {
 	config
-	op                 Op
-	typ                string
-	id                 *string
-	name               *string
-	description        *string
-	icon_url           *string
-	is_public          *bool
-	created_at         *time.Time
-	updated_at         *time.Time
-	clearedFields      map[string]struct{}
-	created_by         *uuid.UUID
-	clearedcreated_by  bool
-	members            map[uuid.UUID]struct{}
-	removedmembers     map[uuid.UUID]struct{}
-	clearedmembers     bool
-	channels           map[uuid.UUID]struct{}
-	removedchannels    map[uuid.UUID]struct{}
-	clearedchannels    bool
-	user_groups        map[uuid.UUID]struct{}
-	removeduser_groups map[uuid.UUID]struct{}
-	cleareduser_groups bool
-	done               bool
-	oldValue           func(context.Context) (*Workspace, error)
-	predicates         []predicate.Workspace
+	op                        Op
+	typ                       string
+	id                        *string
+	name                      *string
+	description               *string
+	icon_url                  *string
+	is_public                 *bool
+	message_history_enabled   *bool
+	message_retention_days    *int
+	addmessage_retention_days *int
+	created_at                *time.Time
+	updated_at                *time.Time
+	clearedFields             map[string]struct{}
+	created_by                *uuid.UUID
+	clearedcreated_by         bool
+	members                   map[uuid.UUID]struct{}
+	removedmembers            map[uuid.UUID]struct{}
+	clearedmembers            bool
+	channels                  map[uuid.UUID]struct{}
+	removedchannels           map[uuid.UUID]struct{}
+	clearedchannels           bool
+	user_groups               map[uuid.UUID]struct{}
+	removeduser_groups        map[uuid.UUID]struct{}
+	cleareduser_groups        bool
+	done                      bool
+	oldValue                  func(context.Context) (*Workspace, error)
+	predicates                []predicate.Workspace
 }
 
 var _ ent.Mutation = (*WorkspaceMutation)(nil)
@@ -13407,6 +20466,98 @@ func (m *WorkspaceMutation) ResetIsPublic() {
 	m.is_public = nil
 }
 
+// SetMessageHistoryEnabled sets the "message_history_enabled" field.
+func (m *WorkspaceMutation) SetMessageHistoryEnabled(b bool) {
+	m.message_history_enabled = &b
+}
+
+// MessageHistoryEnabled returns the value of the "message_history_enabled" field in the mutation.
+func (m *WorkspaceMutation) MessageHistoryEnabled() (r bool, exists bool) {
+	v := m.message_history_enabled
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMessageHistoryEnabled returns the old "message_history_enabled" field's value of the Workspace entity.
+// If the Workspace object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WorkspaceMutation) OldMessageHistoryEnabled(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMessageHistoryEnabled is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMessageHistoryEnabled requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMessageHistoryEnabled: %w", err)
+	}
+	return oldValue.MessageHistoryEnabled, nil
+}
+
+// ResetMessageHistoryEnabled resets all changes to the "message_history_enabled" field.
+func (m *WorkspaceMutation) ResetMessageHistoryEnabled() {
+	m.message_history_enabled = nil
+}
+
+// SetMessageRetentionDays sets the "message_retention_days" field.
+func (m *WorkspaceMutation) SetMessageRetentionDays(i int) {
+	m.message_retention_days = &i
+	m.addmessage_retention_days = nil
+}
+
+// MessageRetentionDays returns the value of the "message_retention_days" field in the mutation.
+func (m *WorkspaceMutation) MessageRetentionDays() (r int, exists bool) {
+	v := m.message_retention_days
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMessageRetentionDays returns the old "message_retention_days" field's value of the Workspace entity.
+// If the Workspace object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WorkspaceMutation) OldMessageRetentionDays(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMessageRetentionDays is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMessageRetentionDays requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMessageRetentionDays: %w", err)
+	}
+	return oldValue.MessageRetentionDays, nil
+}
+
+// AddMessageRetentionDays adds i to the "message_retention_days" field.
+func (m *WorkspaceMutation) AddMessageRetentionDays(i int) {
+	if m.addmessage_retention_days != nil {
+		*m.addmessage_retention_days += i
+	} else {
+		m.addmessage_retention_days = &i
+	}
+}
+
+// AddedMessageRetentionDays returns the value that was added to the "message_retention_days" field in this mutation.
+func (m *WorkspaceMutation) AddedMessageRetentionDays() (r int, exists bool) {
+	v := m.addmessage_retention_days
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetMessageRetentionDays resets all changes to the "message_retention_days" field.
+func (m *WorkspaceMutation) ResetMessageRetentionDays() {
+	m.message_retention_days = nil
+	m.addmessage_retention_days = nil
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (m *WorkspaceMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
@@ -13714,7 +20865,7 @@ func (m *WorkspaceMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *WorkspaceMutation) Fields() []string {
-	fields := make([]string, 0, 6)
+	fields := make([]string, 0, 8)
 	if m.name != nil {
 		fields = append(fields, workspace.FieldName)
 	}
@@ -13727,6 +20878,12 @@ func (m *WorkspaceMutation) Fields() []string {
 	if m.is_public != nil {
 		fields = append(fields, workspace.FieldIsPublic)
 	}
+	if m.message_history_enabled != nil {
+		fields = append(fields, workspace.FieldMessageHistoryEnabled)
+	}
+	if m.message_retention_days != nil {
+		fields = append(fields, workspace.FieldMessageRetentionDays)
+	}
 	if m.created_at != nil {
 		fields = append(fields, workspace.FieldCreatedAt)
 	}
@@ -13749,6 +20906,10 @@ func (m *WorkspaceMutation) Field(name string) (ent.Value, bool) {
 		return m.IconURL()
 	case workspace.FieldIsPublic:
 		return m.IsPublic()
+	case workspace.FieldMessageHistoryEnabled:
+		return m.MessageHistoryEnabled()
+	case workspace.FieldMessageRetentionDays:
+		return m.MessageRetentionDays()
 	case workspace.FieldCreatedAt:
 		return m.CreatedAt()
 	case workspace.FieldUpdatedAt:
@@ -13770,6 +20931,10 @@ func (m *WorkspaceMutation) OldField(ctx context.Context, name string) (ent.Valu
 		return m.OldIconURL(ctx)
 	case workspace.FieldIsPublic:
 		return m.OldIsPublic(ctx)
+	case workspace.FieldMessageHistoryEnabled:
+		return m.OldMessageHistoryEnabled(ctx)
+	case workspace.FieldMessageRetentionDays:
+		return m.OldMessageRetentionDays(ctx)
 	case workspace.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
 	case workspace.FieldUpdatedAt:
@@ -13811,6 +20976,20 @@ func (m *WorkspaceMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetIsPublic(v)
 		return nil
+	case workspace.FieldMessageHistoryEnabled:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMessageHistoryEnabled(v)
+		return nil
+	case workspace.FieldMessageRetentionDays:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMessageRetentionDays(v)
+		return nil
 	case workspace.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
@@ -13832,13 +21011,21 @@ func (m *WorkspaceMutation) SetField(name string, value ent.Value) error {
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
 func (m *WorkspaceMutation) AddedFields() []string {
-	return nil
+	var fields []string
+	if m.addmessage_retention_days != nil {
+		fields = append(fields, workspace.FieldMessageRetentionDays)
+	}
+	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
 func (m *WorkspaceMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case workspace.FieldMessageRetentionDays:
+		return m.AddedMessageRetentionDays()
+	}
 	return nil, false
 }
 
@@ -13847,6 +21034,13 @@ func (m *WorkspaceMutation) AddedField(name string) (ent.Value, bool) {
 // type.
 func (m *WorkspaceMutation) AddField(name string, value ent.Value) error {
 	switch name {
+	case workspace.FieldMessageRetentionDays:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMessageRetentionDays(v)
+		return nil
 	}
 	return fmt.Errorf("unknown Workspace numeric field %s", name)
 }
@@ -13901,6 +21095,12 @@ func (m *WorkspaceMutation) ResetField(name string) error {
 	case workspace.FieldIsPublic:
 		m.ResetIsPublic()
 		return nil
+	case workspace.FieldMessageHistoryEnabled:
+		m.ResetMessageHistoryEnabled()
+		return nil
+	case workspace.FieldMessageRetentionDays:
+		m.ResetMessageRetentionDays()
+		return nil
 	case workspace.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
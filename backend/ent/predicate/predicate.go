@@ -18,6 +18,12 @@ type ChannelMember func(*sql.Selector)
 // ChannelReadState is the predicate function for channelreadstate builders.
 type ChannelReadState func(*sql.Selector)
 
+// Draft is the predicate function for draft builders.
+type Draft func(*sql.Selector)
+
+// EphemeralMessage is the predicate function for ephemeralmessage builders.
+type EphemeralMessage func(*sql.Selector)
+
 // Message is the predicate function for message builders.
 type Message func(*sql.Selector)
 
@@ -36,9 +42,30 @@ type MessagePin func(*sql.Selector)
 // MessageReaction is the predicate function for messagereaction builders.
 type MessageReaction func(*sql.Selector)
 
+// MessageRevision is the predicate function for messagerevision builders.
+type MessageRevision func(*sql.Selector)
+
+// MessageShare is the predicate function for messageshare builders.
+type MessageShare func(*sql.Selector)
+
 // MessageUserMention is the predicate function for messageusermention builders.
 type MessageUserMention func(*sql.Selector)
 
+// Poll is the predicate function for poll builders.
+type Poll func(*sql.Selector)
+
+// PollOption is the predicate function for polloption builders.
+type PollOption func(*sql.Selector)
+
+// PollVote is the predicate function for pollvote builders.
+type PollVote func(*sql.Selector)
+
+// Reminder is the predicate function for reminder builders.
+type Reminder func(*sql.Selector)
+
+// ScheduledMessage is the predicate function for scheduledmessage builders.
+type ScheduledMessage func(*sql.Selector)
+
 // Session is the predicate function for session builders.
 type Session func(*sql.Selector)
 
@@ -10,13 +10,22 @@ import (
 	"github.com/newt239/chat/ent/channel"
 	"github.com/newt239/chat/ent/channelmember"
 	"github.com/newt239/chat/ent/channelreadstate"
+	"github.com/newt239/chat/ent/draft"
+	"github.com/newt239/chat/ent/ephemeralmessage"
 	"github.com/newt239/chat/ent/message"
 	"github.com/newt239/chat/ent/messagebookmark"
 	"github.com/newt239/chat/ent/messagegroupmention"
 	"github.com/newt239/chat/ent/messagelink"
 	"github.com/newt239/chat/ent/messagepin"
 	"github.com/newt239/chat/ent/messagereaction"
+	"github.com/newt239/chat/ent/messagerevision"
+	"github.com/newt239/chat/ent/messageshare"
 	"github.com/newt239/chat/ent/messageusermention"
+	"github.com/newt239/chat/ent/poll"
+	"github.com/newt239/chat/ent/polloption"
+	"github.com/newt239/chat/ent/pollvote"
+	"github.com/newt239/chat/ent/reminder"
+	"github.com/newt239/chat/ent/scheduledmessage"
 	"github.com/newt239/chat/ent/schema"
 	"github.com/newt239/chat/ent/session"
 	"github.com/newt239/chat/ent/systemmessage"
@@ -77,12 +86,16 @@ func init() {
 	channelDescChannelType := channelFields[4].Descriptor()
 	// channel.DefaultChannelType holds the default value on creation for the channel_type field.
 	channel.DefaultChannelType = channelDescChannelType.Default.(string)
+	// channelDescMessageRetentionDays is the schema descriptor for message_retention_days field.
+	channelDescMessageRetentionDays := channelFields[5].Descriptor()
+	// channel.MessageRetentionDaysValidator is a validator for the "message_retention_days" field. It is called by the builders before save.
+	channel.MessageRetentionDaysValidator = channelDescMessageRetentionDays.Validators[0].(func(int) error)
 	// channelDescCreatedAt is the schema descriptor for created_at field.
-	channelDescCreatedAt := channelFields[5].Descriptor()
+	channelDescCreatedAt := channelFields[6].Descriptor()
 	// channel.DefaultCreatedAt holds the default value on creation for the created_at field.
 	channel.DefaultCreatedAt = channelDescCreatedAt.Default.(func() time.Time)
 	// channelDescUpdatedAt is the schema descriptor for updated_at field.
-	channelDescUpdatedAt := channelFields[6].Descriptor()
+	channelDescUpdatedAt := channelFields[7].Descriptor()
 	// channel.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	channel.DefaultUpdatedAt = channelDescUpdatedAt.Default.(func() time.Time)
 	// channel.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
@@ -115,16 +128,56 @@ func init() {
 	channelreadstateDescID := channelreadstateFields[0].Descriptor()
 	// channelreadstate.DefaultID holds the default value on creation for the id field.
 	channelreadstate.DefaultID = channelreadstateDescID.Default.(func() uuid.UUID)
+	draftFields := schema.Draft{}.Fields()
+	_ = draftFields
+	// draftDescBody is the schema descriptor for body field.
+	draftDescBody := draftFields[1].Descriptor()
+	// draft.DefaultBody holds the default value on creation for the body field.
+	draft.DefaultBody = draftDescBody.Default.(string)
+	// draftDescCreatedAt is the schema descriptor for created_at field.
+	draftDescCreatedAt := draftFields[3].Descriptor()
+	// draft.DefaultCreatedAt holds the default value on creation for the created_at field.
+	draft.DefaultCreatedAt = draftDescCreatedAt.Default.(func() time.Time)
+	// draftDescUpdatedAt is the schema descriptor for updated_at field.
+	draftDescUpdatedAt := draftFields[4].Descriptor()
+	// draft.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	draft.DefaultUpdatedAt = draftDescUpdatedAt.Default.(func() time.Time)
+	// draft.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	draft.UpdateDefaultUpdatedAt = draftDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// draftDescID is the schema descriptor for id field.
+	draftDescID := draftFields[0].Descriptor()
+	// draft.DefaultID holds the default value on creation for the id field.
+	draft.DefaultID = draftDescID.Default.(func() uuid.UUID)
+	ephemeralmessageFields := schema.EphemeralMessage{}.Fields()
+	_ = ephemeralmessageFields
+	// ephemeralmessageDescKind is the schema descriptor for kind field.
+	ephemeralmessageDescKind := ephemeralmessageFields[1].Descriptor()
+	// ephemeralmessage.KindValidator is a validator for the "kind" field. It is called by the builders before save.
+	ephemeralmessage.KindValidator = ephemeralmessageDescKind.Validators[0].(func(string) error)
+	// ephemeralmessageDescCreatedAt is the schema descriptor for created_at field.
+	ephemeralmessageDescCreatedAt := ephemeralmessageFields[4].Descriptor()
+	// ephemeralmessage.DefaultCreatedAt holds the default value on creation for the created_at field.
+	ephemeralmessage.DefaultCreatedAt = ephemeralmessageDescCreatedAt.Default.(func() time.Time)
+	// ephemeralmessageDescID is the schema descriptor for id field.
+	ephemeralmessageDescID := ephemeralmessageFields[0].Descriptor()
+	// ephemeralmessage.DefaultID holds the default value on creation for the id field.
+	ephemeralmessage.DefaultID = ephemeralmessageDescID.Default.(func() uuid.UUID)
 	messageFields := schema.Message{}.Fields()
 	_ = messageFields
-	// messageDescBody is the schema descriptor for body field.
-	messageDescBody := messageFields[1].Descriptor()
-	// message.BodyValidator is a validator for the "body" field. It is called by the builders before save.
-	message.BodyValidator = messageDescBody.Validators[0].(func(string) error)
 	// messageDescCreatedAt is the schema descriptor for created_at field.
-	messageDescCreatedAt := messageFields[2].Descriptor()
+	messageDescCreatedAt := messageFields[3].Descriptor()
 	// message.DefaultCreatedAt holds the default value on creation for the created_at field.
 	message.DefaultCreatedAt = messageDescCreatedAt.Default.(func() time.Time)
+	// messageDescClientMsgID is the schema descriptor for client_msg_id field.
+	messageDescClientMsgID := messageFields[7].Descriptor()
+	// message.ClientMsgIDValidator is a validator for the "client_msg_id" field. It is called by the builders before save.
+	message.ClientMsgIDValidator = messageDescClientMsgID.Validators[0].(func(string) error)
+	// messageDescRevisionCount is the schema descriptor for revision_count field.
+	messageDescRevisionCount := messageFields[8].Descriptor()
+	// message.DefaultRevisionCount holds the default value on creation for the revision_count field.
+	message.DefaultRevisionCount = messageDescRevisionCount.Default.(int)
+	// message.RevisionCountValidator is a validator for the "revision_count" field. It is called by the builders before save.
+	message.RevisionCountValidator = messageDescRevisionCount.Validators[0].(func(int) error)
 	// messageDescID is the schema descriptor for id field.
 	messageDescID := messageFields[0].Descriptor()
 	// message.DefaultID holds the default value on creation for the id field.
@@ -187,6 +240,26 @@ func init() {
 	messagereactionDescID := messagereactionFields[0].Descriptor()
 	// messagereaction.DefaultID holds the default value on creation for the id field.
 	messagereaction.DefaultID = messagereactionDescID.Default.(func() uuid.UUID)
+	messagerevisionFields := schema.MessageRevision{}.Fields()
+	_ = messagerevisionFields
+	// messagerevisionDescCreatedAt is the schema descriptor for created_at field.
+	messagerevisionDescCreatedAt := messagerevisionFields[2].Descriptor()
+	// messagerevision.DefaultCreatedAt holds the default value on creation for the created_at field.
+	messagerevision.DefaultCreatedAt = messagerevisionDescCreatedAt.Default.(func() time.Time)
+	// messagerevisionDescID is the schema descriptor for id field.
+	messagerevisionDescID := messagerevisionFields[0].Descriptor()
+	// messagerevision.DefaultID holds the default value on creation for the id field.
+	messagerevision.DefaultID = messagerevisionDescID.Default.(func() uuid.UUID)
+	messageshareFields := schema.MessageShare{}.Fields()
+	_ = messageshareFields
+	// messageshareDescCreatedAt is the schema descriptor for created_at field.
+	messageshareDescCreatedAt := messageshareFields[1].Descriptor()
+	// messageshare.DefaultCreatedAt holds the default value on creation for the created_at field.
+	messageshare.DefaultCreatedAt = messageshareDescCreatedAt.Default.(func() time.Time)
+	// messageshareDescID is the schema descriptor for id field.
+	messageshareDescID := messageshareFields[0].Descriptor()
+	// messageshare.DefaultID holds the default value on creation for the id field.
+	messageshare.DefaultID = messageshareDescID.Default.(func() uuid.UUID)
 	messageusermentionFields := schema.MessageUserMention{}.Fields()
 	_ = messageusermentionFields
 	// messageusermentionDescCreatedAt is the schema descriptor for created_at field.
@@ -197,6 +270,116 @@ func init() {
 	messageusermentionDescID := messageusermentionFields[0].Descriptor()
 	// messageusermention.DefaultID holds the default value on creation for the id field.
 	messageusermention.DefaultID = messageusermentionDescID.Default.(func() uuid.UUID)
+	pollFields := schema.Poll{}.Fields()
+	_ = pollFields
+	// pollDescMultipleChoice is the schema descriptor for multiple_choice field.
+	pollDescMultipleChoice := pollFields[1].Descriptor()
+	// poll.DefaultMultipleChoice holds the default value on creation for the multiple_choice field.
+	poll.DefaultMultipleChoice = pollDescMultipleChoice.Default.(bool)
+	// pollDescAnonymous is the schema descriptor for anonymous field.
+	pollDescAnonymous := pollFields[2].Descriptor()
+	// poll.DefaultAnonymous holds the default value on creation for the anonymous field.
+	poll.DefaultAnonymous = pollDescAnonymous.Default.(bool)
+	// pollDescCreatedAt is the schema descriptor for created_at field.
+	pollDescCreatedAt := pollFields[4].Descriptor()
+	// poll.DefaultCreatedAt holds the default value on creation for the created_at field.
+	poll.DefaultCreatedAt = pollDescCreatedAt.Default.(func() time.Time)
+	// pollDescID is the schema descriptor for id field.
+	pollDescID := pollFields[0].Descriptor()
+	// poll.DefaultID holds the default value on creation for the id field.
+	poll.DefaultID = pollDescID.Default.(func() uuid.UUID)
+	polloptionFields := schema.PollOption{}.Fields()
+	_ = polloptionFields
+	// polloptionDescText is the schema descriptor for text field.
+	polloptionDescText := polloptionFields[1].Descriptor()
+	// polloption.TextValidator is a validator for the "text" field. It is called by the builders before save.
+	polloption.TextValidator = func() func(string) error {
+		validators := polloptionDescText.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(text string) error {
+			for _, fn := range fns {
+				if err := fn(text); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// polloptionDescPosition is the schema descriptor for position field.
+	polloptionDescPosition := polloptionFields[2].Descriptor()
+	// polloption.PositionValidator is a validator for the "position" field. It is called by the builders before save.
+	polloption.PositionValidator = polloptionDescPosition.Validators[0].(func(int) error)
+	// polloptionDescID is the schema descriptor for id field.
+	polloptionDescID := polloptionFields[0].Descriptor()
+	// polloption.DefaultID holds the default value on creation for the id field.
+	polloption.DefaultID = polloptionDescID.Default.(func() uuid.UUID)
+	pollvoteFields := schema.PollVote{}.Fields()
+	_ = pollvoteFields
+	// pollvoteDescCreatedAt is the schema descriptor for created_at field.
+	pollvoteDescCreatedAt := pollvoteFields[1].Descriptor()
+	// pollvote.DefaultCreatedAt holds the default value on creation for the created_at field.
+	pollvote.DefaultCreatedAt = pollvoteDescCreatedAt.Default.(func() time.Time)
+	// pollvoteDescID is the schema descriptor for id field.
+	pollvoteDescID := pollvoteFields[0].Descriptor()
+	// pollvote.DefaultID holds the default value on creation for the id field.
+	pollvote.DefaultID = pollvoteDescID.Default.(func() uuid.UUID)
+	reminderFields := schema.Reminder{}.Fields()
+	_ = reminderFields
+	// reminderDescStatus is the schema descriptor for status field.
+	reminderDescStatus := reminderFields[2].Descriptor()
+	// reminder.DefaultStatus holds the default value on creation for the status field.
+	reminder.DefaultStatus = reminderDescStatus.Default.(string)
+	// reminderDescCreatedAt is the schema descriptor for created_at field.
+	reminderDescCreatedAt := reminderFields[6].Descriptor()
+	// reminder.DefaultCreatedAt holds the default value on creation for the created_at field.
+	reminder.DefaultCreatedAt = reminderDescCreatedAt.Default.(func() time.Time)
+	// reminderDescUpdatedAt is the schema descriptor for updated_at field.
+	reminderDescUpdatedAt := reminderFields[7].Descriptor()
+	// reminder.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	reminder.DefaultUpdatedAt = reminderDescUpdatedAt.Default.(func() time.Time)
+	// reminder.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	reminder.UpdateDefaultUpdatedAt = reminderDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// reminderDescID is the schema descriptor for id field.
+	reminderDescID := reminderFields[0].Descriptor()
+	// reminder.DefaultID holds the default value on creation for the id field.
+	reminder.DefaultID = reminderDescID.Default.(func() uuid.UUID)
+	scheduledmessageFields := schema.ScheduledMessage{}.Fields()
+	_ = scheduledmessageFields
+	// scheduledmessageDescBody is the schema descriptor for body field.
+	scheduledmessageDescBody := scheduledmessageFields[1].Descriptor()
+	// scheduledmessage.BodyValidator is a validator for the "body" field. It is called by the builders before save.
+	scheduledmessage.BodyValidator = scheduledmessageDescBody.Validators[0].(func(string) error)
+	// scheduledmessageDescTimezone is the schema descriptor for timezone field.
+	scheduledmessageDescTimezone := scheduledmessageFields[5].Descriptor()
+	// scheduledmessage.DefaultTimezone holds the default value on creation for the timezone field.
+	scheduledmessage.DefaultTimezone = scheduledmessageDescTimezone.Default.(string)
+	// scheduledmessageDescStatus is the schema descriptor for status field.
+	scheduledmessageDescStatus := scheduledmessageFields[6].Descriptor()
+	// scheduledmessage.DefaultStatus holds the default value on creation for the status field.
+	scheduledmessage.DefaultStatus = scheduledmessageDescStatus.Default.(string)
+	// scheduledmessageDescAttempts is the schema descriptor for attempts field.
+	scheduledmessageDescAttempts := scheduledmessageFields[8].Descriptor()
+	// scheduledmessage.DefaultAttempts holds the default value on creation for the attempts field.
+	scheduledmessage.DefaultAttempts = scheduledmessageDescAttempts.Default.(int)
+	// scheduledmessage.AttemptsValidator is a validator for the "attempts" field. It is called by the builders before save.
+	scheduledmessage.AttemptsValidator = scheduledmessageDescAttempts.Validators[0].(func(int) error)
+	// scheduledmessageDescCreatedAt is the schema descriptor for created_at field.
+	scheduledmessageDescCreatedAt := scheduledmessageFields[10].Descriptor()
+	// scheduledmessage.DefaultCreatedAt holds the default value on creation for the created_at field.
+	scheduledmessage.DefaultCreatedAt = scheduledmessageDescCreatedAt.Default.(func() time.Time)
+	// scheduledmessageDescUpdatedAt is the schema descriptor for updated_at field.
+	scheduledmessageDescUpdatedAt := scheduledmessageFields[11].Descriptor()
+	// scheduledmessage.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	scheduledmessage.DefaultUpdatedAt = scheduledmessageDescUpdatedAt.Default.(func() time.Time)
+	// scheduledmessage.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	scheduledmessage.UpdateDefaultUpdatedAt = scheduledmessageDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// scheduledmessageDescID is the schema descriptor for id field.
+	scheduledmessageDescID := scheduledmessageFields[0].Descriptor()
+	// scheduledmessage.DefaultID holds the default value on creation for the id field.
+	scheduledmessage.DefaultID = scheduledmessageDescID.Default.(func() uuid.UUID)
 	sessionFields := schema.Session{}.Fields()
 	_ = sessionFields
 	// sessionDescRefreshTokenHash is the schema descriptor for refresh_token_hash field.
@@ -259,12 +442,16 @@ func init() {
 	userDescDisplayName := userFields[3].Descriptor()
 	// user.DisplayNameValidator is a validator for the "display_name" field. It is called by the builders before save.
 	user.DisplayNameValidator = userDescDisplayName.Validators[0].(func(string) error)
+	// userDescReadReceiptsEnabled is the schema descriptor for read_receipts_enabled field.
+	userDescReadReceiptsEnabled := userFields[7].Descriptor()
+	// user.DefaultReadReceiptsEnabled holds the default value on creation for the read_receipts_enabled field.
+	user.DefaultReadReceiptsEnabled = userDescReadReceiptsEnabled.Default.(bool)
 	// userDescCreatedAt is the schema descriptor for created_at field.
-	userDescCreatedAt := userFields[6].Descriptor()
+	userDescCreatedAt := userFields[8].Descriptor()
 	// user.DefaultCreatedAt holds the default value on creation for the created_at field.
 	user.DefaultCreatedAt = userDescCreatedAt.Default.(func() time.Time)
 	// userDescUpdatedAt is the schema descriptor for updated_at field.
-	userDescUpdatedAt := userFields[7].Descriptor()
+	userDescUpdatedAt := userFields[9].Descriptor()
 	// user.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	user.DefaultUpdatedAt = userDescUpdatedAt.Default.(func() time.Time)
 	// user.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
@@ -323,12 +510,22 @@ func init() {
 	workspaceDescIsPublic := workspaceFields[4].Descriptor()
 	// workspace.DefaultIsPublic holds the default value on creation for the is_public field.
 	workspace.DefaultIsPublic = workspaceDescIsPublic.Default.(bool)
+	// workspaceDescMessageHistoryEnabled is the schema descriptor for message_history_enabled field.
+	workspaceDescMessageHistoryEnabled := workspaceFields[5].Descriptor()
+	// workspace.DefaultMessageHistoryEnabled holds the default value on creation for the message_history_enabled field.
+	workspace.DefaultMessageHistoryEnabled = workspaceDescMessageHistoryEnabled.Default.(bool)
+	// workspaceDescMessageRetentionDays is the schema descriptor for message_retention_days field.
+	workspaceDescMessageRetentionDays := workspaceFields[6].Descriptor()
+	// workspace.DefaultMessageRetentionDays holds the default value on creation for the message_retention_days field.
+	workspace.DefaultMessageRetentionDays = workspaceDescMessageRetentionDays.Default.(int)
+	// workspace.MessageRetentionDaysValidator is a validator for the "message_retention_days" field. It is called by the builders before save.
+	workspace.MessageRetentionDaysValidator = workspaceDescMessageRetentionDays.Validators[0].(func(int) error)
 	// workspaceDescCreatedAt is the schema descriptor for created_at field.
-	workspaceDescCreatedAt := workspaceFields[5].Descriptor()
+	workspaceDescCreatedAt := workspaceFields[7].Descriptor()
 	// workspace.DefaultCreatedAt holds the default value on creation for the created_at field.
 	workspace.DefaultCreatedAt = workspaceDescCreatedAt.Default.(func() time.Time)
 	// workspaceDescUpdatedAt is the schema descriptor for updated_at field.
-	workspaceDescUpdatedAt := workspaceFields[6].Descriptor()
+	workspaceDescUpdatedAt := workspaceFields[8].Descriptor()
 	// workspace.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	workspace.DefaultUpdatedAt = workspaceDescUpdatedAt.Default.(func() time.Time)
 	// workspace.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
@@ -28,6 +28,12 @@ func (Channel) Fields() []ent.Field {
 		field.String("channel_type").
 			Default("public").
 			Optional(),
+		// message_retention_days はチャンネル個別のメッセージを保存する日数で、0の場合は無期限に保存します
+		// 未設定の場合はワークスペースの設定に従います
+		field.Int("message_retention_days").
+			Optional().
+			Nillable().
+			NonNegative(),
 		field.Time("created_at").
 			Default(time.Now).
 			Immutable(),
@@ -0,0 +1,67 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// Draft holds the schema definition for the Draft entity.
+// ユーザーがチャンネル（またはスレッド）で入力中のメッセージの下書きです
+type Draft struct {
+	ent.Schema
+}
+
+// Fields of the Draft.
+func (Draft) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Immutable(),
+		field.Text("body").
+			Default(""),
+		// attachment_ids はアップロード済みで未投稿の添付ファイルのIDです
+		field.Strings("attachment_ids").
+			Optional(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the Draft.
+func (Draft) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("user", User.Type).
+			Unique().
+			Required(),
+		edge.To("channel", Channel.Type).
+			Unique().
+			Required(),
+		// 返信先のメッセージが削除された場合はスレッドの下書きも削除する
+		edge.To("parent", Message.Type).
+			Unique().
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+	}
+}
+
+// Indexes of the Draft.
+func (Draft) Indexes() []ent.Index {
+	return []ent.Index{
+		// 下書きはユーザー・チャンネル・スレッドごとに1件のみ
+		// parentがNULLの行は一意制約で区別されないため、チャンネルの下書きは部分インデックスで一意にする
+		index.Edges("user", "channel", "parent").
+			Unique(),
+		index.Edges("user", "channel").
+			Unique().
+			Annotations(entsql.IndexWhere("draft_parent IS NULL")),
+	}
+}
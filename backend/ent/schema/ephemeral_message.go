@@ -0,0 +1,60 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// EphemeralMessage holds the schema definition for the EphemeralMessage entity.
+// チャンネル内の1人のユーザーのみに表示する一時的なメッセージです
+// 再読込後も表示できるよう、有効期限まで保存します
+type EphemeralMessage struct {
+	ent.Schema
+}
+
+// Fields of the EphemeralMessage.
+func (EphemeralMessage) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Immutable(),
+		// kind: 送信元の機能ごとの種別（例: scheduled_message_failed）
+		field.String("kind").
+			NotEmpty(),
+		field.Text("body"),
+		// payload: 種別ごとの詳細情報(JSON)
+		field.JSON("payload", map[string]any{}).
+			Optional(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("expires_at"),
+	}
+}
+
+// Edges of the EphemeralMessage.
+func (EphemeralMessage) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("channel", Channel.Type).
+			Unique().
+			Required().
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("recipient", User.Type).
+			Unique().
+			Required().
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+	}
+}
+
+// Indexes of the EphemeralMessage.
+func (EphemeralMessage) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("expires_at"),
+	}
+}
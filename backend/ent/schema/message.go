@@ -19,8 +19,11 @@ func (Message) Fields() []ent.Field {
 		field.UUID("id", uuid.UUID{}).
 			Default(uuid.New).
 			Immutable(),
-		field.Text("body").
-			NotEmpty(),
+		// body は転送・共有したメッセージでは空の場合があります
+		field.Text("body"),
+		// body_ast は body を解析した書式付きテキスト（JSON）で、古いメッセージでは空の場合があります
+		field.Text("body_ast").
+			Optional(),
 		field.Time("created_at").
 			Default(time.Now).
 			Immutable(),
@@ -30,6 +33,14 @@ func (Message) Fields() []ent.Field {
 			Optional(),
 		field.UUID("deleted_by", uuid.UUID{}).
 			Optional(),
+		// client_msg_id はクライアントが採番する冪等キーで、再送時の重複作成を防ぎます
+		field.String("client_msg_id").
+			MaxLen(64).
+			Optional(),
+		// revision_count は保存している編集履歴（MessageRevision）の件数です
+		field.Int("revision_count").
+			Default(0).
+			NonNegative(),
 	}
 }
 
@@ -57,6 +68,12 @@ func (Message) Edges() []ent.Edge {
 			Ref("message"),
 		edge.From("attachments", Attachment.Type).
 			Ref("message"),
+		edge.From("revisions", MessageRevision.Type).
+			Ref("message"),
+		edge.From("shares", MessageShare.Type).
+			Ref("message"),
+		edge.From("shared_as", MessageShare.Type).
+			Ref("source"),
 		edge.From("user_thread_follows", UserThreadFollow.Type).
 			Ref("thread"),
 		edge.From("thread_read_states", ThreadReadState.Type).
@@ -67,5 +84,8 @@ func (Message) Edges() []ent.Edge {
 func (Message) Indexes() []ent.Index {
 	return []ent.Index{
 		index.Fields("created_at"),
+		index.Fields("client_msg_id").
+			Edges("user").
+			Unique(),
 	}
 }
@@ -0,0 +1,53 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// MessageRevision holds the schema definition for the MessageRevision entity.
+// メッセージの編集前の本文を保持します
+type MessageRevision struct {
+	ent.Schema
+}
+
+// Fields of the MessageRevision.
+func (MessageRevision) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Immutable(),
+		// body は編集によって置き換えられる前の本文です
+		field.Text("body").
+			Immutable(),
+		// created_at は編集された日時です
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Edges of the MessageRevision.
+func (MessageRevision) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("message", Message.Type).
+			Unique().
+			Required(),
+		edge.To("editor", User.Type).
+			Unique().
+			Required(),
+	}
+}
+
+// Indexes of the MessageRevision.
+func (MessageRevision) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("created_at").
+			Edges("message"),
+	}
+}
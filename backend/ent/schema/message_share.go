@@ -0,0 +1,55 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// MessageShare holds the schema definition for the MessageShare entity.
+// 別のチャンネルに転送・共有したメッセージと共有元のメッセージの関係を保持します
+type MessageShare struct {
+	ent.Schema
+}
+
+// Fields of the MessageShare.
+func (MessageShare) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Immutable(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Edges of the MessageShare.
+func (MessageShare) Edges() []ent.Edge {
+	return []ent.Edge{
+		// message は共有先のチャンネルに作成したメッセージです
+		edge.To("message", Message.Type).
+			Unique().
+			Required().
+			Immutable(),
+		// source は共有元のメッセージです
+		edge.To("source", Message.Type).
+			Unique().
+			Required().
+			Immutable(),
+	}
+}
+
+// Indexes of the MessageShare.
+func (MessageShare) Indexes() []ent.Index {
+	return []ent.Index{
+		// 1つのメッセージで共有できるメッセージは1件のみです
+		index.Edges("message").
+			Unique(),
+		index.Edges("source"),
+	}
+}
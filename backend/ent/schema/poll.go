@@ -0,0 +1,64 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// Poll holds the schema definition for the Poll entity.
+// メッセージとして投稿した投票です。質問はメッセージの本文です
+type Poll struct {
+	ent.Schema
+}
+
+// Fields of the Poll.
+func (Poll) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Immutable(),
+		// multiple_choice がtrueの場合は1人が複数の選択肢に投票できます
+		field.Bool("multiple_choice").
+			Default(false).
+			Immutable(),
+		// anonymous がtrueの場合は投票したユーザーを公開しません
+		field.Bool("anonymous").
+			Default(false).
+			Immutable(),
+		// closes_at を過ぎると投票を締め切ります
+		field.Time("closes_at").
+			Optional(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Edges of the Poll.
+func (Poll) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("message", Message.Type).
+			Unique().
+			Required().
+			Immutable().
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.From("options", PollOption.Type).
+			Ref("poll"),
+		edge.From("votes", PollVote.Type).
+			Ref("poll"),
+	}
+}
+
+// Indexes of the Poll.
+func (Poll) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Edges("message").
+			Unique(),
+	}
+}
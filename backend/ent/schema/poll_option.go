@@ -0,0 +1,54 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// PollOption holds the schema definition for the PollOption entity.
+// 投票の選択肢です
+type PollOption struct {
+	ent.Schema
+}
+
+// Fields of the PollOption.
+func (PollOption) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Immutable(),
+		field.String("text").
+			NotEmpty().
+			MaxLen(200).
+			Immutable(),
+		// position は選択肢の表示順です
+		field.Int("position").
+			NonNegative().
+			Immutable(),
+	}
+}
+
+// Edges of the PollOption.
+func (PollOption) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("poll", Poll.Type).
+			Unique().
+			Required().
+			Immutable().
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.From("votes", PollVote.Type).
+			Ref("option"),
+	}
+}
+
+// Indexes of the PollOption.
+func (PollOption) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("position").
+			Edges("poll"),
+	}
+}
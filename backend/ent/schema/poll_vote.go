@@ -0,0 +1,60 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// PollVote holds the schema definition for the PollVote entity.
+// ユーザーが投票した選択肢です
+type PollVote struct {
+	ent.Schema
+}
+
+// Fields of the PollVote.
+func (PollVote) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Immutable(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Edges of the PollVote.
+func (PollVote) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("poll", Poll.Type).
+			Unique().
+			Required().
+			Immutable().
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("option", PollOption.Type).
+			Unique().
+			Required().
+			Immutable().
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("user", User.Type).
+			Unique().
+			Required().
+			Immutable(),
+	}
+}
+
+// Indexes of the PollVote.
+func (PollVote) Indexes() []ent.Index {
+	return []ent.Index{
+		// 1人のユーザーが同じ選択肢に投票できるのは1回のみです
+		index.Edges("option", "user").
+			Unique(),
+		index.Edges("poll", "user"),
+	}
+}
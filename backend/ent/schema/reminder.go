@@ -0,0 +1,64 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// Reminder holds the schema definition for the Reminder entity.
+// 指定した日時にメッセージを本人に知らせるリマインダーです
+type Reminder struct {
+	ent.Schema
+}
+
+// Fields of the Reminder.
+func (Reminder) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Immutable(),
+		field.Time("remind_at"),
+		// status は pending | sending | fired | completed のいずれかです
+		field.String("status").
+			Default("pending"),
+		// claimed_at は通知処理を開始した日時で、処理中のインスタンスが停止した場合の再取得に使用します
+		field.Time("claimed_at").
+			Optional(),
+		field.Time("fired_at").
+			Optional(),
+		field.Time("completed_at").
+			Optional(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the Reminder.
+func (Reminder) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("user", User.Type).
+			Unique().
+			Required(),
+		edge.To("message", Message.Type).
+			Unique().
+			Required().
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+	}
+}
+
+// Indexes of the Reminder.
+func (Reminder) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("status", "remind_at"),
+	}
+}
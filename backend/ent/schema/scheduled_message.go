@@ -0,0 +1,75 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// ScheduledMessage holds the schema definition for the ScheduledMessage entity.
+// 指定した日時に投稿する予約メッセージです
+type ScheduledMessage struct {
+	ent.Schema
+}
+
+// Fields of the ScheduledMessage.
+func (ScheduledMessage) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Immutable(),
+		field.Text("body").
+			NotEmpty(),
+		field.Strings("attachment_ids").
+			Optional(),
+		field.Time("send_at"),
+		// recurrence は繰り返しの規則（daily | weekdays | weekly）で、空の場合は1回のみ投稿します
+		field.String("recurrence").
+			Optional(),
+		// timezone は繰り返しの日付・曜日を計算するタイムゾーン（IANA形式）です
+		field.String("timezone").
+			Default("UTC"),
+		// status は pending | sending | failed のいずれかです
+		field.String("status").
+			Default("pending"),
+		// claimed_at は投稿処理を開始した日時で、処理中のインスタンスが停止した場合の再取得に使用します
+		field.Time("claimed_at").
+			Optional(),
+		field.Int("attempts").
+			Default(0).
+			NonNegative(),
+		field.String("last_error").
+			Optional(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the ScheduledMessage.
+func (ScheduledMessage) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("channel", Channel.Type).
+			Unique().
+			Required(),
+		edge.To("user", User.Type).
+			Unique().
+			Required(),
+		edge.To("parent", Message.Type).
+			Unique(),
+	}
+}
+
+// Indexes of the ScheduledMessage.
+func (ScheduledMessage) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("status", "send_at"),
+	}
+}
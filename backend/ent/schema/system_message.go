@@ -21,7 +21,7 @@ func (SystemMessage) Fields() []ent.Field {
         field.UUID("id", uuid.UUID{}).
             Default(uuid.New).
             Immutable(),
-        // kind: 種別（例: member_joined, member_added, channel_privacy_changed, channel_name_changed, channel_description_changed, message_pinned）
+        // kind: 種別（例: member_joined, member_added, channel_privacy_changed, channel_name_changed, channel_description_changed, message_pinned, reminder, message_retention_changed）
         field.String("kind").
             NotEmpty(),
         // payload: 種別ごとの詳細情報(JSON)
@@ -41,6 +41,9 @@ func (SystemMessage) Edges() []ent.Edge {
         // actor: 操作を行ったユーザー（不在の場合あり）
         edge.To("actor", User.Type).
             Unique(),
+        // recipient: 本人のみに表示するシステムメッセージの宛先ユーザー（全員に表示する場合は不在）
+        edge.To("recipient", User.Type).
+            Unique(),
     }
 }
 
@@ -30,6 +30,10 @@ func (User) Fields() []ent.Field {
 			Optional(),
 		field.String("avatar_url").
 			Optional(),
+		field.Time("last_seen_at").
+			Optional(),
+		field.Bool("read_receipts_enabled").
+			Default(true),
 		field.Time("created_at").
 			Default(time.Now).
 			Immutable(),
@@ -33,6 +33,13 @@ func (Workspace) Fields() []ent.Field {
             Optional(),
         field.Bool("is_public").
             Default(false),
+        // message_history_enabled がfalseの場合、メッセージの編集履歴を保存しません
+        field.Bool("message_history_enabled").
+            Default(true),
+        // message_retention_days はメッセージを保存する日数で、0の場合は無期限に保存します
+        field.Int("message_retention_days").
+            Default(0).
+            NonNegative(),
 		field.Time("created_at").
 			Default(time.Now).
 			Immutable(),
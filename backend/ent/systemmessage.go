@@ -29,10 +29,11 @@ type SystemMessage struct {
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the SystemMessageQuery when eager-loading is set.
-	Edges                  SystemMessageEdges `json:"edges"`
-	system_message_channel *uuid.UUID
-	system_message_actor   *uuid.UUID
-	selectValues           sql.SelectValues
+	Edges                    SystemMessageEdges `json:"edges"`
+	system_message_channel   *uuid.UUID
+	system_message_actor     *uuid.UUID
+	system_message_recipient *uuid.UUID
+	selectValues             sql.SelectValues
 }
 
 // SystemMessageEdges holds the relations/edges for other nodes in the graph.
@@ -41,9 +42,11 @@ type SystemMessageEdges struct {
 	Channel *Channel `json:"channel,omitempty"`
 	// Actor holds the value of the actor edge.
 	Actor *User `json:"actor,omitempty"`
+	// Recipient holds the value of the recipient edge.
+	Recipient *User `json:"recipient,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [2]bool
+	loadedTypes [3]bool
 }
 
 // ChannelOrErr returns the Channel value or an error if the edge
@@ -68,6 +71,17 @@ func (e SystemMessageEdges) ActorOrErr() (*User, error) {
 	return nil, &NotLoadedError{edge: "actor"}
 }
 
+// RecipientOrErr returns the Recipient value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e SystemMessageEdges) RecipientOrErr() (*User, error) {
+	if e.Recipient != nil {
+		return e.Recipient, nil
+	} else if e.loadedTypes[2] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "recipient"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*SystemMessage) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -85,6 +99,8 @@ func (*SystemMessage) scanValues(columns []string) ([]any, error) {
 			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
 		case systemmessage.ForeignKeys[1]: // system_message_actor
 			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case systemmessage.ForeignKeys[2]: // system_message_recipient
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
 		default:
 			values[i] = new(sql.UnknownType)
 		}
@@ -140,6 +156,13 @@ func (_m *SystemMessage) assignValues(columns []string, values []any) error {
 				_m.system_message_actor = new(uuid.UUID)
 				*_m.system_message_actor = *value.S.(*uuid.UUID)
 			}
+		case systemmessage.ForeignKeys[2]:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field system_message_recipient", values[i])
+			} else if value.Valid {
+				_m.system_message_recipient = new(uuid.UUID)
+				*_m.system_message_recipient = *value.S.(*uuid.UUID)
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -163,6 +186,11 @@ func (_m *SystemMessage) QueryActor() *UserQuery {
 	return NewSystemMessageClient(_m.config).QueryActor(_m)
 }
 
+// QueryRecipient queries the "recipient" edge of the SystemMessage entity.
+func (_m *SystemMessage) QueryRecipient() *UserQuery {
+	return NewSystemMessageClient(_m.config).QueryRecipient(_m)
+}
+
 // Update returns a builder for updating this SystemMessage.
 // Note that you need to call SystemMessage.Unwrap() before calling this method if this SystemMessage
 // was returned from a transaction, and the transaction was committed or rolled back.
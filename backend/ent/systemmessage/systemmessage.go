@@ -25,6 +25,8 @@ const (
 	EdgeChannel = "channel"
 	// EdgeActor holds the string denoting the actor edge name in mutations.
 	EdgeActor = "actor"
+	// EdgeRecipient holds the string denoting the recipient edge name in mutations.
+	EdgeRecipient = "recipient"
 	// Table holds the table name of the systemmessage in the database.
 	Table = "system_messages"
 	// ChannelTable is the table that holds the channel relation/edge.
@@ -41,6 +43,13 @@ const (
 	ActorInverseTable = "users"
 	// ActorColumn is the table column denoting the actor relation/edge.
 	ActorColumn = "system_message_actor"
+	// RecipientTable is the table that holds the recipient relation/edge.
+	RecipientTable = "system_messages"
+	// RecipientInverseTable is the table name for the User entity.
+	// It exists in this package in order to avoid circular dependency with the "user" package.
+	RecipientInverseTable = "users"
+	// RecipientColumn is the table column denoting the recipient relation/edge.
+	RecipientColumn = "system_message_recipient"
 )
 
 // Columns holds all SQL columns for systemmessage fields.
@@ -56,6 +65,7 @@ var Columns = []string{
 var ForeignKeys = []string{
 	"system_message_channel",
 	"system_message_actor",
+	"system_message_recipient",
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -113,6 +123,13 @@ func ByActorField(field string, opts ...sql.OrderTermOption) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newActorStep(), sql.OrderByField(field, opts...))
 	}
 }
+
+// ByRecipientField orders the results by recipient field.
+func ByRecipientField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newRecipientStep(), sql.OrderByField(field, opts...))
+	}
+}
 func newChannelStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -127,3 +144,10 @@ func newActorStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.M2O, false, ActorTable, ActorColumn),
 	)
 }
+func newRecipientStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(RecipientInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, false, RecipientTable, RecipientColumn),
+	)
+}
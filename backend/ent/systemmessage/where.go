@@ -217,6 +217,29 @@ func HasActorWith(preds ...predicate.User) predicate.SystemMessage {
 	})
 }
 
+// HasRecipient applies the HasEdge predicate on the "recipient" edge.
+func HasRecipient() predicate.SystemMessage {
+	return predicate.SystemMessage(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, RecipientTable, RecipientColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasRecipientWith applies the HasEdge predicate on the "recipient" edge with a given conditions (other predicates).
+func HasRecipientWith(preds ...predicate.User) predicate.SystemMessage {
+	return predicate.SystemMessage(func(s *sql.Selector) {
+		step := newRecipientStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.SystemMessage) predicate.SystemMessage {
 	return predicate.SystemMessage(sql.AndPredicates(predicates...))
@@ -93,6 +93,25 @@ func (_c *SystemMessageCreate) SetActor(v *User) *SystemMessageCreate {
 	return _c.SetActorID(v.ID)
 }
 
+// SetRecipientID sets the "recipient" edge to the User entity by ID.
+func (_c *SystemMessageCreate) SetRecipientID(id uuid.UUID) *SystemMessageCreate {
+	_c.mutation.SetRecipientID(id)
+	return _c
+}
+
+// SetNillableRecipientID sets the "recipient" edge to the User entity by ID if the given value is not nil.
+func (_c *SystemMessageCreate) SetNillableRecipientID(id *uuid.UUID) *SystemMessageCreate {
+	if id != nil {
+		_c = _c.SetRecipientID(*id)
+	}
+	return _c
+}
+
+// SetRecipient sets the "recipient" edge to the User entity.
+func (_c *SystemMessageCreate) SetRecipient(v *User) *SystemMessageCreate {
+	return _c.SetRecipientID(v.ID)
+}
+
 // Mutation returns the SystemMessageMutation object of the builder.
 func (_c *SystemMessageCreate) Mutation() *SystemMessageMutation {
 	return _c.mutation
@@ -238,6 +257,23 @@ func (_c *SystemMessageCreate) createSpec() (*SystemMessage, *sqlgraph.CreateSpe
 		_node.system_message_actor = &nodes[0]
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.RecipientIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   systemmessage.RecipientTable,
+			Columns: []string{systemmessage.RecipientColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.system_message_recipient = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
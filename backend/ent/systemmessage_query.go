@@ -21,13 +21,14 @@ import (
 // SystemMessageQuery is the builder for querying SystemMessage entities.
 type SystemMessageQuery struct {
 	config
-	ctx         *QueryContext
-	order       []systemmessage.OrderOption
-	inters      []Interceptor
-	predicates  []predicate.SystemMessage
-	withChannel *ChannelQuery
-	withActor   *UserQuery
-	withFKs     bool
+	ctx           *QueryContext
+	order         []systemmessage.OrderOption
+	inters        []Interceptor
+	predicates    []predicate.SystemMessage
+	withChannel   *ChannelQuery
+	withActor     *UserQuery
+	withRecipient *UserQuery
+	withFKs       bool
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -108,6 +109,28 @@ func (_q *SystemMessageQuery) QueryActor() *UserQuery {
 	return query
 }
 
+// QueryRecipient chains the current query on the "recipient" edge.
+func (_q *SystemMessageQuery) QueryRecipient() *UserQuery {
+	query := (&UserClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(systemmessage.Table, systemmessage.FieldID, selector),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, systemmessage.RecipientTable, systemmessage.RecipientColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first SystemMessage entity from the query.
 // Returns a *NotFoundError when no SystemMessage was found.
 func (_q *SystemMessageQuery) First(ctx context.Context) (*SystemMessage, error) {
@@ -295,13 +318,14 @@ func (_q *SystemMessageQuery) Clone() *SystemMessageQuery {
 		return nil
 	}
 	return &SystemMessageQuery{
-		config:      _q.config,
-		ctx:         _q.ctx.Clone(),
-		order:       append([]systemmessage.OrderOption{}, _q.order...),
-		inters:      append([]Interceptor{}, _q.inters...),
-		predicates:  append([]predicate.SystemMessage{}, _q.predicates...),
-		withChannel: _q.withChannel.Clone(),
-		withActor:   _q.withActor.Clone(),
+		config:        _q.config,
+		ctx:           _q.ctx.Clone(),
+		order:         append([]systemmessage.OrderOption{}, _q.order...),
+		inters:        append([]Interceptor{}, _q.inters...),
+		predicates:    append([]predicate.SystemMessage{}, _q.predicates...),
+		withChannel:   _q.withChannel.Clone(),
+		withActor:     _q.withActor.Clone(),
+		withRecipient: _q.withRecipient.Clone(),
 		// clone intermediate query.
 		sql:  _q.sql.Clone(),
 		path: _q.path,
@@ -330,6 +354,17 @@ func (_q *SystemMessageQuery) WithActor(opts ...func(*UserQuery)) *SystemMessage
 	return _q
 }
 
+// WithRecipient tells the query-builder to eager-load the nodes that are connected to
+// the "recipient" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *SystemMessageQuery) WithRecipient(opts ...func(*UserQuery)) *SystemMessageQuery {
+	query := (&UserClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withRecipient = query
+	return _q
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -409,12 +444,13 @@ func (_q *SystemMessageQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([
 		nodes       = []*SystemMessage{}
 		withFKs     = _q.withFKs
 		_spec       = _q.querySpec()
-		loadedTypes = [2]bool{
+		loadedTypes = [3]bool{
 			_q.withChannel != nil,
 			_q.withActor != nil,
+			_q.withRecipient != nil,
 		}
 	)
-	if _q.withChannel != nil || _q.withActor != nil {
+	if _q.withChannel != nil || _q.withActor != nil || _q.withRecipient != nil {
 		withFKs = true
 	}
 	if withFKs {
@@ -450,6 +486,12 @@ func (_q *SystemMessageQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([
 			return nil, err
 		}
 	}
+	if query := _q.withRecipient; query != nil {
+		if err := _q.loadRecipient(ctx, query, nodes, nil,
+			func(n *SystemMessage, e *User) { n.Edges.Recipient = e }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -517,6 +559,38 @@ func (_q *SystemMessageQuery) loadActor(ctx context.Context, query *UserQuery, n
 	}
 	return nil
 }
+func (_q *SystemMessageQuery) loadRecipient(ctx context.Context, query *UserQuery, nodes []*SystemMessage, init func(*SystemMessage), assign func(*SystemMessage, *User)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*SystemMessage)
+	for i := range nodes {
+		if nodes[i].system_message_recipient == nil {
+			continue
+		}
+		fk := *nodes[i].system_message_recipient
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(user.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "system_message_recipient" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
 
 func (_q *SystemMessageQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
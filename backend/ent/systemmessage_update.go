@@ -80,6 +80,25 @@ func (_u *SystemMessageUpdate) SetActor(v *User) *SystemMessageUpdate {
 	return _u.SetActorID(v.ID)
 }
 
+// SetRecipientID sets the "recipient" edge to the User entity by ID.
+func (_u *SystemMessageUpdate) SetRecipientID(id uuid.UUID) *SystemMessageUpdate {
+	_u.mutation.SetRecipientID(id)
+	return _u
+}
+
+// SetNillableRecipientID sets the "recipient" edge to the User entity by ID if the given value is not nil.
+func (_u *SystemMessageUpdate) SetNillableRecipientID(id *uuid.UUID) *SystemMessageUpdate {
+	if id != nil {
+		_u = _u.SetRecipientID(*id)
+	}
+	return _u
+}
+
+// SetRecipient sets the "recipient" edge to the User entity.
+func (_u *SystemMessageUpdate) SetRecipient(v *User) *SystemMessageUpdate {
+	return _u.SetRecipientID(v.ID)
+}
+
 // Mutation returns the SystemMessageMutation object of the builder.
 func (_u *SystemMessageUpdate) Mutation() *SystemMessageMutation {
 	return _u.mutation
@@ -97,6 +116,12 @@ func (_u *SystemMessageUpdate) ClearActor() *SystemMessageUpdate {
 	return _u
 }
 
+// ClearRecipient clears the "recipient" edge to the User entity.
+func (_u *SystemMessageUpdate) ClearRecipient() *SystemMessageUpdate {
+	_u.mutation.ClearRecipient()
+	return _u
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *SystemMessageUpdate) Save(ctx context.Context) (int, error) {
 	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
@@ -213,6 +238,35 @@ func (_u *SystemMessageUpdate) sqlSave(ctx context.Context) (_node int, err erro
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.RecipientCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   systemmessage.RecipientTable,
+			Columns: []string{systemmessage.RecipientColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RecipientIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   systemmessage.RecipientTable,
+			Columns: []string{systemmessage.RecipientColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{systemmessage.Label}
@@ -283,6 +337,25 @@ func (_u *SystemMessageUpdateOne) SetActor(v *User) *SystemMessageUpdateOne {
 	return _u.SetActorID(v.ID)
 }
 
+// SetRecipientID sets the "recipient" edge to the User entity by ID.
+func (_u *SystemMessageUpdateOne) SetRecipientID(id uuid.UUID) *SystemMessageUpdateOne {
+	_u.mutation.SetRecipientID(id)
+	return _u
+}
+
+// SetNillableRecipientID sets the "recipient" edge to the User entity by ID if the given value is not nil.
+func (_u *SystemMessageUpdateOne) SetNillableRecipientID(id *uuid.UUID) *SystemMessageUpdateOne {
+	if id != nil {
+		_u = _u.SetRecipientID(*id)
+	}
+	return _u
+}
+
+// SetRecipient sets the "recipient" edge to the User entity.
+func (_u *SystemMessageUpdateOne) SetRecipient(v *User) *SystemMessageUpdateOne {
+	return _u.SetRecipientID(v.ID)
+}
+
 // Mutation returns the SystemMessageMutation object of the builder.
 func (_u *SystemMessageUpdateOne) Mutation() *SystemMessageMutation {
 	return _u.mutation
@@ -300,6 +373,12 @@ func (_u *SystemMessageUpdateOne) ClearActor() *SystemMessageUpdateOne {
 	return _u
 }
 
+// ClearRecipient clears the "recipient" edge to the User entity.
+func (_u *SystemMessageUpdateOne) ClearRecipient() *SystemMessageUpdateOne {
+	_u.mutation.ClearRecipient()
+	return _u
+}
+
 // Where appends a list predicates to the SystemMessageUpdate builder.
 func (_u *SystemMessageUpdateOne) Where(ps ...predicate.SystemMessage) *SystemMessageUpdateOne {
 	_u.mutation.Where(ps...)
@@ -446,6 +525,35 @@ func (_u *SystemMessageUpdateOne) sqlSave(ctx context.Context) (_node *SystemMes
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.RecipientCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   systemmessage.RecipientTable,
+			Columns: []string{systemmessage.RecipientColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RecipientIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: false,
+			Table:   systemmessage.RecipientTable,
+			Columns: []string{systemmessage.RecipientColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &SystemMessage{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
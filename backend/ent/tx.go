@@ -20,6 +20,10 @@ type Tx struct {
 	ChannelMember *ChannelMemberClient
 	// ChannelReadState is the client for interacting with the ChannelReadState builders.
 	ChannelReadState *ChannelReadStateClient
+	// Draft is the client for interacting with the Draft builders.
+	Draft *DraftClient
+	// EphemeralMessage is the client for interacting with the EphemeralMessage builders.
+	EphemeralMessage *EphemeralMessageClient
 	// Message is the client for interacting with the Message builders.
 	Message *MessageClient
 	// MessageBookmark is the client for interacting with the MessageBookmark builders.
@@ -32,8 +36,22 @@ type Tx struct {
 	MessagePin *MessagePinClient
 	// MessageReaction is the client for interacting with the MessageReaction builders.
 	MessageReaction *MessageReactionClient
+	// MessageRevision is the client for interacting with the MessageRevision builders.
+	MessageRevision *MessageRevisionClient
+	// MessageShare is the client for interacting with the MessageShare builders.
+	MessageShare *MessageShareClient
 	// MessageUserMention is the client for interacting with the MessageUserMention builders.
 	MessageUserMention *MessageUserMentionClient
+	// Poll is the client for interacting with the Poll builders.
+	Poll *PollClient
+	// PollOption is the client for interacting with the PollOption builders.
+	PollOption *PollOptionClient
+	// PollVote is the client for interacting with the PollVote builders.
+	PollVote *PollVoteClient
+	// Reminder is the client for interacting with the Reminder builders.
+	Reminder *ReminderClient
+	// ScheduledMessage is the client for interacting with the ScheduledMessage builders.
+	ScheduledMessage *ScheduledMessageClient
 	// Session is the client for interacting with the Session builders.
 	Session *SessionClient
 	// SystemMessage is the client for interacting with the SystemMessage builders.
@@ -187,13 +205,22 @@ func (tx *Tx) init() {
 	tx.Channel = NewChannelClient(tx.config)
 	tx.ChannelMember = NewChannelMemberClient(tx.config)
 	tx.ChannelReadState = NewChannelReadStateClient(tx.config)
+	tx.Draft = NewDraftClient(tx.config)
+	tx.EphemeralMessage = NewEphemeralMessageClient(tx.config)
 	tx.Message = NewMessageClient(tx.config)
 	tx.MessageBookmark = NewMessageBookmarkClient(tx.config)
 	tx.MessageGroupMention = NewMessageGroupMentionClient(tx.config)
 	tx.MessageLink = NewMessageLinkClient(tx.config)
 	tx.MessagePin = NewMessagePinClient(tx.config)
 	tx.MessageReaction = NewMessageReactionClient(tx.config)
+	tx.MessageRevision = NewMessageRevisionClient(tx.config)
+	tx.MessageShare = NewMessageShareClient(tx.config)
 	tx.MessageUserMention = NewMessageUserMentionClient(tx.config)
+	tx.Poll = NewPollClient(tx.config)
+	tx.PollOption = NewPollOptionClient(tx.config)
+	tx.PollVote = NewPollVoteClient(tx.config)
+	tx.Reminder = NewReminderClient(tx.config)
+	tx.ScheduledMessage = NewScheduledMessageClient(tx.config)
 	tx.Session = NewSessionClient(tx.config)
 	tx.SystemMessage = NewSystemMessageClient(tx.config)
 	tx.ThreadReadState = NewThreadReadStateClient(tx.config)
@@ -25,6 +25,10 @@ const (
 	FieldBio = "bio"
 	// FieldAvatarURL holds the string denoting the avatar_url field in the database.
 	FieldAvatarURL = "avatar_url"
+	// FieldLastSeenAt holds the string denoting the last_seen_at field in the database.
+	FieldLastSeenAt = "last_seen_at"
+	// FieldReadReceiptsEnabled holds the string denoting the read_receipts_enabled field in the database.
+	FieldReadReceiptsEnabled = "read_receipts_enabled"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
 	FieldCreatedAt = "created_at"
 	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
@@ -158,6 +162,8 @@ var Columns = []string{
 	FieldDisplayName,
 	FieldBio,
 	FieldAvatarURL,
+	FieldLastSeenAt,
+	FieldReadReceiptsEnabled,
 	FieldCreatedAt,
 	FieldUpdatedAt,
 }
@@ -179,6 +185,8 @@ var (
 	PasswordHashValidator func(string) error
 	// DisplayNameValidator is a validator for the "display_name" field. It is called by the builders before save.
 	DisplayNameValidator func(string) error
+	// DefaultReadReceiptsEnabled holds the default value on creation for the "read_receipts_enabled" field.
+	DefaultReadReceiptsEnabled bool
 	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
 	DefaultCreatedAt func() time.Time
 	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
@@ -222,6 +230,16 @@ func ByAvatarURL(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldAvatarURL, opts...).ToFunc()
 }
 
+// ByLastSeenAt orders the results by the last_seen_at field.
+func ByLastSeenAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLastSeenAt, opts...).ToFunc()
+}
+
+// ByReadReceiptsEnabled orders the results by the read_receipts_enabled field.
+func ByReadReceiptsEnabled(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReadReceiptsEnabled, opts...).ToFunc()
+}
+
 // ByCreatedAt orders the results by the created_at field.
 func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
@@ -80,6 +80,34 @@ func (_c *UserCreate) SetNillableAvatarURL(v *string) *UserCreate {
 	return _c
 }
 
+// SetLastSeenAt sets the "last_seen_at" field.
+func (_c *UserCreate) SetLastSeenAt(v time.Time) *UserCreate {
+	_c.mutation.SetLastSeenAt(v)
+	return _c
+}
+
+// SetNillableLastSeenAt sets the "last_seen_at" field if the given value is not nil.
+func (_c *UserCreate) SetNillableLastSeenAt(v *time.Time) *UserCreate {
+	if v != nil {
+		_c.SetLastSeenAt(*v)
+	}
+	return _c
+}
+
+// SetReadReceiptsEnabled sets the "read_receipts_enabled" field.
+func (_c *UserCreate) SetReadReceiptsEnabled(v bool) *UserCreate {
+	_c.mutation.SetReadReceiptsEnabled(v)
+	return _c
+}
+
+// SetNillableReadReceiptsEnabled sets the "read_receipts_enabled" field if the given value is not nil.
+func (_c *UserCreate) SetNillableReadReceiptsEnabled(v *bool) *UserCreate {
+	if v != nil {
+		_c.SetReadReceiptsEnabled(*v)
+	}
+	return _c
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (_c *UserCreate) SetCreatedAt(v time.Time) *UserCreate {
 	_c.mutation.SetCreatedAt(v)
@@ -352,6 +380,10 @@ func (_c *UserCreate) ExecX(ctx context.Context) {
 
 // defaults sets the default values of the builder before save.
 func (_c *UserCreate) defaults() {
+	if _, ok := _c.mutation.ReadReceiptsEnabled(); !ok {
+		v := user.DefaultReadReceiptsEnabled
+		_c.mutation.SetReadReceiptsEnabled(v)
+	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
 		v := user.DefaultCreatedAt()
 		_c.mutation.SetCreatedAt(v)
@@ -392,6 +424,9 @@ func (_c *UserCreate) check() error {
 			return &ValidationError{Name: "display_name", err: fmt.Errorf(`ent: validator failed for field "User.display_name": %w`, err)}
 		}
 	}
+	if _, ok := _c.mutation.ReadReceiptsEnabled(); !ok {
+		return &ValidationError{Name: "read_receipts_enabled", err: errors.New(`ent: missing required field "User.read_receipts_enabled"`)}
+	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "User.created_at"`)}
 	}
@@ -453,6 +488,14 @@ func (_c *UserCreate) createSpec() (*User, *sqlgraph.CreateSpec) {
 		_spec.SetField(user.FieldAvatarURL, field.TypeString, value)
 		_node.AvatarURL = value
 	}
+	if value, ok := _c.mutation.LastSeenAt(); ok {
+		_spec.SetField(user.FieldLastSeenAt, field.TypeTime, value)
+		_node.LastSeenAt = value
+	}
+	if value, ok := _c.mutation.ReadReceiptsEnabled(); ok {
+		_spec.SetField(user.FieldReadReceiptsEnabled, field.TypeBool, value)
+		_node.ReadReceiptsEnabled = value
+	}
 	if value, ok := _c.mutation.CreatedAt(); ok {
 		_spec.SetField(user.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
@@ -27,6 +27,10 @@ type Workspace struct {
 	IconURL string `json:"icon_url,omitempty"`
 	// IsPublic holds the value of the "is_public" field.
 	IsPublic bool `json:"is_public,omitempty"`
+	// MessageHistoryEnabled holds the value of the "message_history_enabled" field.
+	MessageHistoryEnabled bool `json:"message_history_enabled,omitempty"`
+	// MessageRetentionDays holds the value of the "message_retention_days" field.
+	MessageRetentionDays int `json:"message_retention_days,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// UpdatedAt holds the value of the "updated_at" field.
@@ -96,8 +100,10 @@ func (*Workspace) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case workspace.FieldIsPublic:
+		case workspace.FieldIsPublic, workspace.FieldMessageHistoryEnabled:
 			values[i] = new(sql.NullBool)
+		case workspace.FieldMessageRetentionDays:
+			values[i] = new(sql.NullInt64)
 		case workspace.FieldID, workspace.FieldName, workspace.FieldDescription, workspace.FieldIconURL:
 			values[i] = new(sql.NullString)
 		case workspace.FieldCreatedAt, workspace.FieldUpdatedAt:
@@ -149,6 +155,18 @@ func (_m *Workspace) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.IsPublic = value.Bool
 			}
+		case workspace.FieldMessageHistoryEnabled:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field message_history_enabled", values[i])
+			} else if value.Valid {
+				_m.MessageHistoryEnabled = value.Bool
+			}
+		case workspace.FieldMessageRetentionDays:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field message_retention_days", values[i])
+			} else if value.Valid {
+				_m.MessageRetentionDays = int(value.Int64)
+			}
 		case workspace.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
@@ -236,6 +254,12 @@ func (_m *Workspace) String() string {
 	builder.WriteString("is_public=")
 	builder.WriteString(fmt.Sprintf("%v", _m.IsPublic))
 	builder.WriteString(", ")
+	builder.WriteString("message_history_enabled=")
+	builder.WriteString(fmt.Sprintf("%v", _m.MessageHistoryEnabled))
+	builder.WriteString(", ")
+	builder.WriteString("message_retention_days=")
+	builder.WriteString(fmt.Sprintf("%v", _m.MessageRetentionDays))
+	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
 	builder.WriteString(", ")
@@ -85,6 +85,16 @@ func IsPublic(v bool) predicate.Workspace {
 	return predicate.Workspace(sql.FieldEQ(FieldIsPublic, v))
 }
 
+// MessageHistoryEnabled applies equality check predicate on the "message_history_enabled" field. It's identical to MessageHistoryEnabledEQ.
+func MessageHistoryEnabled(v bool) predicate.Workspace {
+	return predicate.Workspace(sql.FieldEQ(FieldMessageHistoryEnabled, v))
+}
+
+// MessageRetentionDays applies equality check predicate on the "message_retention_days" field. It's identical to MessageRetentionDaysEQ.
+func MessageRetentionDays(v int) predicate.Workspace {
+	return predicate.Workspace(sql.FieldEQ(FieldMessageRetentionDays, v))
+}
+
 // CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
 func CreatedAt(v time.Time) predicate.Workspace {
 	return predicate.Workspace(sql.FieldEQ(FieldCreatedAt, v))
@@ -320,6 +330,56 @@ func IsPublicNEQ(v bool) predicate.Workspace {
 	return predicate.Workspace(sql.FieldNEQ(FieldIsPublic, v))
 }
 
+// MessageHistoryEnabledEQ applies the EQ predicate on the "message_history_enabled" field.
+func MessageHistoryEnabledEQ(v bool) predicate.Workspace {
+	return predicate.Workspace(sql.FieldEQ(FieldMessageHistoryEnabled, v))
+}
+
+// MessageHistoryEnabledNEQ applies the NEQ predicate on the "message_history_enabled" field.
+func MessageHistoryEnabledNEQ(v bool) predicate.Workspace {
+	return predicate.Workspace(sql.FieldNEQ(FieldMessageHistoryEnabled, v))
+}
+
+// MessageRetentionDaysEQ applies the EQ predicate on the "message_retention_days" field.
+func MessageRetentionDaysEQ(v int) predicate.Workspace {
+	return predicate.Workspace(sql.FieldEQ(FieldMessageRetentionDays, v))
+}
+
+// MessageRetentionDaysNEQ applies the NEQ predicate on the "message_retention_days" field.
+func MessageRetentionDaysNEQ(v int) predicate.Workspace {
+	return predicate.Workspace(sql.FieldNEQ(FieldMessageRetentionDays, v))
+}
+
+// MessageRetentionDaysIn applies the In predicate on the "message_retention_days" field.
+func MessageRetentionDaysIn(vs ...int) predicate.Workspace {
+	return predicate.Workspace(sql.FieldIn(FieldMessageRetentionDays, vs...))
+}
+
+// MessageRetentionDaysNotIn applies the NotIn predicate on the "message_retention_days" field.
+func MessageRetentionDaysNotIn(vs ...int) predicate.Workspace {
+	return predicate.Workspace(sql.FieldNotIn(FieldMessageRetentionDays, vs...))
+}
+
+// MessageRetentionDaysGT applies the GT predicate on the "message_retention_days" field.
+func MessageRetentionDaysGT(v int) predicate.Workspace {
+	return predicate.Workspace(sql.FieldGT(FieldMessageRetentionDays, v))
+}
+
+// MessageRetentionDaysGTE applies the GTE predicate on the "message_retention_days" field.
+func MessageRetentionDaysGTE(v int) predicate.Workspace {
+	return predicate.Workspace(sql.FieldGTE(FieldMessageRetentionDays, v))
+}
+
+// MessageRetentionDaysLT applies the LT predicate on the "message_retention_days" field.
+func MessageRetentionDaysLT(v int) predicate.Workspace {
+	return predicate.Workspace(sql.FieldLT(FieldMessageRetentionDays, v))
+}
+
+// MessageRetentionDaysLTE applies the LTE predicate on the "message_retention_days" field.
+func MessageRetentionDaysLTE(v int) predicate.Workspace {
+	return predicate.Workspace(sql.FieldLTE(FieldMessageRetentionDays, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.Workspace {
 	return predicate.Workspace(sql.FieldEQ(FieldCreatedAt, v))
@@ -22,6 +22,10 @@ const (
 	FieldIconURL = "icon_url"
 	// FieldIsPublic holds the string denoting the is_public field in the database.
 	FieldIsPublic = "is_public"
+	// FieldMessageHistoryEnabled holds the string denoting the message_history_enabled field in the database.
+	FieldMessageHistoryEnabled = "message_history_enabled"
+	// FieldMessageRetentionDays holds the string denoting the message_retention_days field in the database.
+	FieldMessageRetentionDays = "message_retention_days"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
 	FieldCreatedAt = "created_at"
 	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
@@ -73,6 +77,8 @@ var Columns = []string{
 	FieldDescription,
 	FieldIconURL,
 	FieldIsPublic,
+	FieldMessageHistoryEnabled,
+	FieldMessageRetentionDays,
 	FieldCreatedAt,
 	FieldUpdatedAt,
 }
@@ -103,6 +109,12 @@ var (
 	NameValidator func(string) error
 	// DefaultIsPublic holds the default value on creation for the "is_public" field.
 	DefaultIsPublic bool
+	// DefaultMessageHistoryEnabled holds the default value on creation for the "message_history_enabled" field.
+	DefaultMessageHistoryEnabled bool
+	// DefaultMessageRetentionDays holds the default value on creation for the "message_retention_days" field.
+	DefaultMessageRetentionDays int
+	// MessageRetentionDaysValidator is a validator for the "message_retention_days" field. It is called by the builders before save.
+	MessageRetentionDaysValidator func(int) error
 	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
 	DefaultCreatedAt func() time.Time
 	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
@@ -141,6 +153,16 @@ func ByIsPublic(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldIsPublic, opts...).ToFunc()
 }
 
+// ByMessageHistoryEnabled orders the results by the message_history_enabled field.
+func ByMessageHistoryEnabled(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMessageHistoryEnabled, opts...).ToFunc()
+}
+
+// ByMessageRetentionDays orders the results by the message_retention_days field.
+func ByMessageRetentionDays(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMessageRetentionDays, opts...).ToFunc()
+}
+
 // ByCreatedAt orders the results by the created_at field.
 func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
@@ -73,6 +73,34 @@ func (_c *WorkspaceCreate) SetNillableIsPublic(v *bool) *WorkspaceCreate {
 	return _c
 }
 
+// SetMessageHistoryEnabled sets the "message_history_enabled" field.
+func (_c *WorkspaceCreate) SetMessageHistoryEnabled(v bool) *WorkspaceCreate {
+	_c.mutation.SetMessageHistoryEnabled(v)
+	return _c
+}
+
+// SetNillableMessageHistoryEnabled sets the "message_history_enabled" field if the given value is not nil.
+func (_c *WorkspaceCreate) SetNillableMessageHistoryEnabled(v *bool) *WorkspaceCreate {
+	if v != nil {
+		_c.SetMessageHistoryEnabled(*v)
+	}
+	return _c
+}
+
+// SetMessageRetentionDays sets the "message_retention_days" field.
+func (_c *WorkspaceCreate) SetMessageRetentionDays(v int) *WorkspaceCreate {
+	_c.mutation.SetMessageRetentionDays(v)
+	return _c
+}
+
+// SetNillableMessageRetentionDays sets the "message_retention_days" field if the given value is not nil.
+func (_c *WorkspaceCreate) SetNillableMessageRetentionDays(v *int) *WorkspaceCreate {
+	if v != nil {
+		_c.SetMessageRetentionDays(*v)
+	}
+	return _c
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (_c *WorkspaceCreate) SetCreatedAt(v time.Time) *WorkspaceCreate {
 	_c.mutation.SetCreatedAt(v)
@@ -202,6 +230,14 @@ func (_c *WorkspaceCreate) defaults() {
 		v := workspace.DefaultIsPublic
 		_c.mutation.SetIsPublic(v)
 	}
+	if _, ok := _c.mutation.MessageHistoryEnabled(); !ok {
+		v := workspace.DefaultMessageHistoryEnabled
+		_c.mutation.SetMessageHistoryEnabled(v)
+	}
+	if _, ok := _c.mutation.MessageRetentionDays(); !ok {
+		v := workspace.DefaultMessageRetentionDays
+		_c.mutation.SetMessageRetentionDays(v)
+	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
 		v := workspace.DefaultCreatedAt()
 		_c.mutation.SetCreatedAt(v)
@@ -225,6 +261,17 @@ func (_c *WorkspaceCreate) check() error {
 	if _, ok := _c.mutation.IsPublic(); !ok {
 		return &ValidationError{Name: "is_public", err: errors.New(`ent: missing required field "Workspace.is_public"`)}
 	}
+	if _, ok := _c.mutation.MessageHistoryEnabled(); !ok {
+		return &ValidationError{Name: "message_history_enabled", err: errors.New(`ent: missing required field "Workspace.message_history_enabled"`)}
+	}
+	if _, ok := _c.mutation.MessageRetentionDays(); !ok {
+		return &ValidationError{Name: "message_retention_days", err: errors.New(`ent: missing required field "Workspace.message_retention_days"`)}
+	}
+	if v, ok := _c.mutation.MessageRetentionDays(); ok {
+		if err := workspace.MessageRetentionDaysValidator(v); err != nil {
+			return &ValidationError{Name: "message_retention_days", err: fmt.Errorf(`ent: validator failed for field "Workspace.message_retention_days": %w`, err)}
+		}
+	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Workspace.created_at"`)}
 	}
@@ -290,6 +337,14 @@ func (_c *WorkspaceCreate) createSpec() (*Workspace, *sqlgraph.CreateSpec) {
 		_spec.SetField(workspace.FieldIsPublic, field.TypeBool, value)
 		_node.IsPublic = value
 	}
+	if value, ok := _c.mutation.MessageHistoryEnabled(); ok {
+		_spec.SetField(workspace.FieldMessageHistoryEnabled, field.TypeBool, value)
+		_node.MessageHistoryEnabled = value
+	}
+	if value, ok := _c.mutation.MessageRetentionDays(); ok {
+		_spec.SetField(workspace.FieldMessageRetentionDays, field.TypeInt, value)
+		_node.MessageRetentionDays = value
+	}
 	if value, ok := _c.mutation.CreatedAt(); ok {
 		_spec.SetField(workspace.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
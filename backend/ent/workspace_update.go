@@ -101,6 +101,41 @@ func (_u *WorkspaceUpdate) SetNillableIsPublic(v *bool) *WorkspaceUpdate {
 	return _u
 }
 
+// SetMessageHistoryEnabled sets the "message_history_enabled" field.
+func (_u *WorkspaceUpdate) SetMessageHistoryEnabled(v bool) *WorkspaceUpdate {
+	_u.mutation.SetMessageHistoryEnabled(v)
+	return _u
+}
+
+// SetNillableMessageHistoryEnabled sets the "message_history_enabled" field if the given value is not nil.
+func (_u *WorkspaceUpdate) SetNillableMessageHistoryEnabled(v *bool) *WorkspaceUpdate {
+	if v != nil {
+		_u.SetMessageHistoryEnabled(*v)
+	}
+	return _u
+}
+
+// SetMessageRetentionDays sets the "message_retention_days" field.
+func (_u *WorkspaceUpdate) SetMessageRetentionDays(v int) *WorkspaceUpdate {
+	_u.mutation.ResetMessageRetentionDays()
+	_u.mutation.SetMessageRetentionDays(v)
+	return _u
+}
+
+// SetNillableMessageRetentionDays sets the "message_retention_days" field if the given value is not nil.
+func (_u *WorkspaceUpdate) SetNillableMessageRetentionDays(v *int) *WorkspaceUpdate {
+	if v != nil {
+		_u.SetMessageRetentionDays(*v)
+	}
+	return _u
+}
+
+// AddMessageRetentionDays adds value to the "message_retention_days" field.
+func (_u *WorkspaceUpdate) AddMessageRetentionDays(v int) *WorkspaceUpdate {
+	_u.mutation.AddMessageRetentionDays(v)
+	return _u
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (_u *WorkspaceUpdate) SetUpdatedAt(v time.Time) *WorkspaceUpdate {
 	_u.mutation.SetUpdatedAt(v)
@@ -280,6 +315,11 @@ func (_u *WorkspaceUpdate) check() error {
 			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Workspace.name": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.MessageRetentionDays(); ok {
+		if err := workspace.MessageRetentionDaysValidator(v); err != nil {
+			return &ValidationError{Name: "message_retention_days", err: fmt.Errorf(`ent: validator failed for field "Workspace.message_retention_days": %w`, err)}
+		}
+	}
 	if _u.mutation.CreatedByCleared() && len(_u.mutation.CreatedByIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "Workspace.created_by"`)
 	}
@@ -316,6 +356,15 @@ func (_u *WorkspaceUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if value, ok := _u.mutation.IsPublic(); ok {
 		_spec.SetField(workspace.FieldIsPublic, field.TypeBool, value)
 	}
+	if value, ok := _u.mutation.MessageHistoryEnabled(); ok {
+		_spec.SetField(workspace.FieldMessageHistoryEnabled, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.MessageRetentionDays(); ok {
+		_spec.SetField(workspace.FieldMessageRetentionDays, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMessageRetentionDays(); ok {
+		_spec.AddField(workspace.FieldMessageRetentionDays, field.TypeInt, value)
+	}
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(workspace.FieldUpdatedAt, field.TypeTime, value)
 	}
@@ -571,6 +620,41 @@ func (_u *WorkspaceUpdateOne) SetNillableIsPublic(v *bool) *WorkspaceUpdateOne {
 	return _u
 }
 
+// SetMessageHistoryEnabled sets the "message_history_enabled" field.
+func (_u *WorkspaceUpdateOne) SetMessageHistoryEnabled(v bool) *WorkspaceUpdateOne {
+	_u.mutation.SetMessageHistoryEnabled(v)
+	return _u
+}
+
+// SetNillableMessageHistoryEnabled sets the "message_history_enabled" field if the given value is not nil.
+func (_u *WorkspaceUpdateOne) SetNillableMessageHistoryEnabled(v *bool) *WorkspaceUpdateOne {
+	if v != nil {
+		_u.SetMessageHistoryEnabled(*v)
+	}
+	return _u
+}
+
+// SetMessageRetentionDays sets the "message_retention_days" field.
+func (_u *WorkspaceUpdateOne) SetMessageRetentionDays(v int) *WorkspaceUpdateOne {
+	_u.mutation.ResetMessageRetentionDays()
+	_u.mutation.SetMessageRetentionDays(v)
+	return _u
+}
+
+// SetNillableMessageRetentionDays sets the "message_retention_days" field if the given value is not nil.
+func (_u *WorkspaceUpdateOne) SetNillableMessageRetentionDays(v *int) *WorkspaceUpdateOne {
+	if v != nil {
+		_u.SetMessageRetentionDays(*v)
+	}
+	return _u
+}
+
+// AddMessageRetentionDays adds value to the "message_retention_days" field.
+func (_u *WorkspaceUpdateOne) AddMessageRetentionDays(v int) *WorkspaceUpdateOne {
+	_u.mutation.AddMessageRetentionDays(v)
+	return _u
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (_u *WorkspaceUpdateOne) SetUpdatedAt(v time.Time) *WorkspaceUpdateOne {
 	_u.mutation.SetUpdatedAt(v)
@@ -763,6 +847,11 @@ func (_u *WorkspaceUpdateOne) check() error {
 			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Workspace.name": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.MessageRetentionDays(); ok {
+		if err := workspace.MessageRetentionDaysValidator(v); err != nil {
+			return &ValidationError{Name: "message_retention_days", err: fmt.Errorf(`ent: validator failed for field "Workspace.message_retention_days": %w`, err)}
+		}
+	}
 	if _u.mutation.CreatedByCleared() && len(_u.mutation.CreatedByIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "Workspace.created_by"`)
 	}
@@ -816,6 +905,15 @@ func (_u *WorkspaceUpdateOne) sqlSave(ctx context.Context) (_node *Workspace, er
 	if value, ok := _u.mutation.IsPublic(); ok {
 		_spec.SetField(workspace.FieldIsPublic, field.TypeBool, value)
 	}
+	if value, ok := _u.mutation.MessageHistoryEnabled(); ok {
+		_spec.SetField(workspace.FieldMessageHistoryEnabled, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.MessageRetentionDays(); ok {
+		_spec.SetField(workspace.FieldMessageRetentionDays, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMessageRetentionDays(); ok {
+		_spec.AddField(workspace.FieldMessageRetentionDays, field.TypeInt, value)
+	}
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(workspace.FieldUpdatedAt, field.TypeTime, value)
 	}
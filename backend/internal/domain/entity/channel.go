@@ -141,6 +141,12 @@ func (c *Channel) ChangeName(newName string) error {
 	return nil
 }
 
+// IsDirectMessage はDM・グループDMかどうかを返します
+// 既読（read_receipt）はDM・グループDMでのみ共有します
+func (c *Channel) IsDirectMessage() bool {
+	return c.Type == ChannelTypeDM || c.Type == ChannelTypeGroupDM
+}
+
 func cloneString(value *string) *string {
 	if value == nil {
 		return nil
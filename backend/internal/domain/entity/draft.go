@@ -0,0 +1,17 @@
+package entity
+
+import "time"
+
+// Draft はユーザーがチャンネル（ParentIDが設定されている場合はスレッド）で入力中のメッセージの下書きを表します
+// 下書きはユーザー・チャンネル・スレッドごとに1件のみです
+type Draft struct {
+	ID        string
+	UserID    string
+	ChannelID string
+	ParentID  *string
+	Body      string
+	// AttachmentIDs はアップロード済みで未投稿の添付ファイルのIDです
+	AttachmentIDs []string
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
@@ -0,0 +1,26 @@
+package entity
+
+import "time"
+
+// EphemeralMessageKind は一時的なメッセージの送信元の機能ごとの種別です
+type EphemeralMessageKind string
+
+const (
+	// EphemeralMessageKindScheduledMessageFailed は予約メッセージを投稿できなかったことを作成者に知らせます
+	EphemeralMessageKindScheduledMessageFailed EphemeralMessageKind = "scheduled_message_failed"
+)
+
+// EphemeralMessage はチャンネル内の1人のユーザーのみに表示する一時的なメッセージです
+// 通常のメッセージとは別に扱い、未読数・検索の対象になりません
+type EphemeralMessage struct {
+	ID          string
+	ChannelID   string
+	RecipientID string
+	Kind        EphemeralMessageKind
+	Body        string
+	// Payload の内容は Kind ごとに異なります
+	Payload   map[string]any
+	CreatedAt time.Time
+	// ExpiresAt は保存した場合の有効期限です。保存せず接続中の画面にのみ表示する場合はnilです
+	ExpiresAt *time.Time
+}
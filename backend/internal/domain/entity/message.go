@@ -8,10 +8,27 @@ type Message struct {
 	UserID    string
 	ParentID  *string
 	Body      string
+	// BodyAST は Body を解析した書式付きテキストです。保存されていない古いメッセージではnilです
+	BodyAST   []MessageNode
 	CreatedAt time.Time
 	EditedAt  *time.Time
 	DeletedAt *time.Time
 	DeletedBy *string
+	// ClientMsgID はクライアントが採番する冪等キーです（同一ユーザー内で一意）
+	ClientMsgID *string
+	// RevisionCount は保存している編集履歴の件数です
+	RevisionCount int
+}
+
+// MessageRevision はメッセージの編集前の本文を表します
+type MessageRevision struct {
+	ID        string
+	MessageID string
+	// EditorID はこの本文を置き換える編集を行ったユーザーのIDです
+	EditorID string
+	Body     string
+	// CreatedAt は編集された日時です
+	CreatedAt time.Time
 }
 
 type MessageReaction struct {
@@ -20,3 +37,13 @@ type MessageReaction struct {
 	Emoji     string
 	CreatedAt time.Time
 }
+
+// MessageShare は別のチャンネルに転送・共有したメッセージと共有元のメッセージの関係を表します
+type MessageShare struct {
+	ID string
+	// MessageID は共有先のチャンネルに作成したメッセージのIDです
+	MessageID string
+	// SourceMessageID は共有元のメッセージのIDです
+	SourceMessageID string
+	CreatedAt       time.Time
+}
@@ -0,0 +1,85 @@
+package entity
+
+// MessageFormatVersion は ParseMessageBody が出力する書式付きテキストの形式のバージョンです
+// 解析の仕様を変更した場合は値を増やし、保存済みの書式付きテキストを本文から解析し直します
+const MessageFormatVersion = 1
+
+// MessageNodeType はメッセージ本文を解析した書式付きテキストの要素の種類です
+type MessageNodeType string
+
+const (
+	// ブロック要素
+	MessageNodeParagraph MessageNodeType = "paragraph"
+	MessageNodeCodeBlock MessageNodeType = "code_block"
+	MessageNodeQuote     MessageNodeType = "quote"
+	MessageNodeList      MessageNodeType = "list"
+	MessageNodeListItem  MessageNodeType = "list_item"
+
+	// インライン要素
+	MessageNodeText    MessageNodeType = "text"
+	MessageNodeCode    MessageNodeType = "code"
+	MessageNodeLink    MessageNodeType = "link"
+	MessageNodeMention MessageNodeType = "mention"
+	MessageNodeChannel MessageNodeType = "channel"
+	MessageNodeEmoji   MessageNodeType = "emoji"
+)
+
+// MessageNode はメッセージ本文を解析した書式付きテキストの要素を表します
+// 要素の種類によって使用するフィールドが異なり、使用しないフィールドはゼロ値です
+type MessageNode struct {
+	Type MessageNodeType
+	// Text は text・code・code_block の文字列です
+	Text string
+	// Name は mention のユーザー名・グループ名、channel のチャンネル名、emoji のショートコードです
+	Name string
+	// URL は link のリンク先で、http・https・mailto のいずれかです
+	URL string
+	// Language は code_block に指定された言語です
+	Language string
+	// Ordered は list が番号付きリストかどうかです
+	Ordered  bool
+	Children []MessageNode
+}
+
+// MessageMentionNames は本文中のメンションの名前を重複を除いて出現順に返します
+// コードスパン・コードブロック内の @name はメンションとして扱いません
+func MessageMentionNames(nodes []MessageNode) []string {
+	return collectMessageNodeValues(nodes, func(node MessageNode) string {
+		if node.Type == MessageNodeMention {
+			return node.Name
+		}
+		return ""
+	})
+}
+
+// MessageLinkURLs は本文中のリンク先を重複を除いて出現順に返します
+// コードスパン・コードブロック内のURLはリンクとして扱いません
+func MessageLinkURLs(nodes []MessageNode) []string {
+	return collectMessageNodeValues(nodes, func(node MessageNode) string {
+		if node.Type == MessageNodeLink {
+			return node.URL
+		}
+		return ""
+	})
+}
+
+func collectMessageNodeValues(nodes []MessageNode, value func(MessageNode) string) []string {
+	var values []string
+	seen := make(map[string]bool)
+	walkMessageNodes(nodes, func(node MessageNode) {
+		v := value(node)
+		if v == "" || seen[v] {
+			return
+		}
+		seen[v] = true
+		values = append(values, v)
+	})
+	return values
+}
+
+func walkMessageNodes(nodes []MessageNode, fn func(MessageNode)) {
+	for _, node := range nodes {
+		fn(node)
+		walkMessageNodes(node.Children, fn)
+	}
+}
@@ -0,0 +1,379 @@
+package entity
+
+import (
+	"net/url"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+const (
+	// maxQuoteDepth を超えて入れ子にした引用は段落として扱います
+	maxQuoteDepth        = 3
+	maxCodeLanguageLen   = 32
+	maxEmojiShortcodeLen = 64
+)
+
+// ParseMessageBody はメッセージ本文をMarkdownのサブセットとして解析します
+//
+// ブロック要素は段落・コードブロック（```）・引用（>）・リスト（- * 1.）、
+// インライン要素はコードスパン・リンク（[text](url) と URL の自動リンク）・
+// メンション（@name）・チャンネル参照（#name）・絵文字（:name:）に対応します。
+// HTMLは解釈せず文字列として扱い、リンク先は http・https・mailto のみ許可します。
+// 制御文字と双方向テキストの制御文字は取り除きます。
+func ParseMessageBody(body string) []MessageNode {
+	body = sanitizeMessageText(body)
+	if strings.TrimSpace(body) == "" {
+		return []MessageNode{}
+	}
+	return parseMessageBlocks(strings.Split(body, "\n"), 0)
+}
+
+func sanitizeMessageText(body string) string {
+	body = strings.ToValidUTF8(body, "\uFFFD")
+	body = strings.ReplaceAll(body, "\r\n", "\n")
+	body = strings.ReplaceAll(body, "\r", "\n")
+	return strings.Map(func(r rune) rune {
+		switch {
+		case r == '\n' || r == '\t':
+			return r
+		case unicode.IsControl(r):
+			return -1
+		case (r >= '\u202A' && r <= '\u202E') || (r >= '\u2066' && r <= '\u2069'):
+			return -1
+		default:
+			return r
+		}
+	}, body)
+}
+
+func parseMessageBlocks(lines []string, depth int) []MessageNode {
+	nodes := []MessageNode{}
+	var paragraph []string
+	flushParagraph := func() {
+		if len(paragraph) == 0 {
+			return
+		}
+		nodes = append(nodes, MessageNode{
+			Type:     MessageNodeParagraph,
+			Children: parseMessageInline(strings.Join(paragraph, "\n"), false),
+		})
+		paragraph = nil
+	}
+
+	for i := 0; i < len(lines); {
+		trimmed := strings.TrimSpace(lines[i])
+
+		if trimmed == "" {
+			flushParagraph()
+			i++
+			continue
+		}
+
+		if strings.HasPrefix(trimmed, "```") {
+			flushParagraph()
+			rest := strings.TrimPrefix(trimmed, "```")
+			// ```code``` のように1行で閉じている場合
+			if len(rest) >= 3 && strings.HasSuffix(rest, "```") {
+				nodes = append(nodes, MessageNode{Type: MessageNodeCodeBlock, Text: strings.TrimSuffix(rest, "```")})
+				i++
+				continue
+			}
+
+			var code []string
+			j := i + 1
+			for ; j < len(lines) && strings.TrimSpace(lines[j]) != "```"; j++ {
+				code = append(code, lines[j])
+			}
+			nodes = append(nodes, MessageNode{
+				Type:     MessageNodeCodeBlock,
+				Text:     strings.Join(code, "\n"),
+				Language: codeLanguage(rest),
+			})
+			i = j + 1
+			continue
+		}
+
+		if strings.HasPrefix(trimmed, ">") && depth < maxQuoteDepth {
+			flushParagraph()
+			var quoted []string
+			for ; i < len(lines); i++ {
+				line := strings.TrimSpace(lines[i])
+				if !strings.HasPrefix(line, ">") {
+					break
+				}
+				line = strings.TrimPrefix(line, ">")
+				quoted = append(quoted, strings.TrimPrefix(line, " "))
+			}
+			nodes = append(nodes, MessageNode{
+				Type:     MessageNodeQuote,
+				Children: parseMessageBlocks(quoted, depth+1),
+			})
+			continue
+		}
+
+		if _, ordered, ok := parseListItem(trimmed); ok {
+			flushParagraph()
+			list := MessageNode{Type: MessageNodeList, Ordered: ordered}
+			for ; i < len(lines); i++ {
+				text, itemOrdered, ok := parseListItem(strings.TrimSpace(lines[i]))
+				if !ok || itemOrdered != ordered {
+					break
+				}
+				list.Children = append(list.Children, MessageNode{
+					Type:     MessageNodeListItem,
+					Children: parseMessageInline(text, false),
+				})
+			}
+			nodes = append(nodes, list)
+			continue
+		}
+
+		paragraph = append(paragraph, lines[i])
+		i++
+	}
+	flushParagraph()
+
+	return nodes
+}
+
+// parseListItem は "- text"・"* text"・"1. text" 形式の行からリストの項目を取り出します
+func parseListItem(line string) (text string, ordered bool, ok bool) {
+	if strings.HasPrefix(line, "- ") || strings.HasPrefix(line, "* ") {
+		return strings.TrimSpace(line[2:]), false, true
+	}
+
+	digits := 0
+	for digits < len(line) && digits < 9 && line[digits] >= '0' && line[digits] <= '9' {
+		digits++
+	}
+	if digits > 0 && strings.HasPrefix(line[digits:], ". ") {
+		return strings.TrimSpace(line[digits+2:]), true, true
+	}
+	return "", false, false
+}
+
+func codeLanguage(s string) string {
+	s = strings.TrimSpace(s)
+	if s == "" || len(s) > maxCodeLanguageLen {
+		return ""
+	}
+	for _, r := range s {
+		if !isASCIIAlnum(r) && !strings.ContainsRune("_+#.-", r) {
+			return ""
+		}
+	}
+	return s
+}
+
+// parseMessageInline はインライン要素を解析します
+// inLink がtrueの場合はリンクのテキストとして、リンク・メンション・チャンネル参照を解析しません
+func parseMessageInline(s string, inLink bool) []MessageNode {
+	nodes := []MessageNode{}
+	var text strings.Builder
+	flushText := func() {
+		if text.Len() == 0 {
+			return
+		}
+		nodes = append(nodes, MessageNode{Type: MessageNodeText, Text: text.String()})
+		text.Reset()
+	}
+
+	for i := 0; i < len(s); {
+		switch c := s[i]; {
+		case c == '`':
+			n := countLeading(s[i:], '`')
+			if end := strings.Index(s[i+n:], strings.Repeat("`", n)); end > 0 {
+				flushText()
+				nodes = append(nodes, MessageNode{Type: MessageNodeCode, Text: s[i+n : i+n+end]})
+				i += n + end + n
+			} else {
+				text.WriteString(s[i : i+n])
+				i += n
+			}
+			continue
+
+		case c == '[' && !inLink:
+			if label, href, length, ok := parseInlineLink(s[i:]); ok {
+				flushText()
+				nodes = append(nodes, MessageNode{
+					Type:     MessageNodeLink,
+					URL:      href,
+					Children: parseMessageInline(label, true),
+				})
+				i += length
+				continue
+			}
+
+		case (c == 'h' || c == 'H') && !inLink && isWordBoundary(s, i):
+			if href := matchAutolink(s[i:]); href != "" {
+				flushText()
+				nodes = append(nodes, MessageNode{
+					Type:     MessageNodeLink,
+					URL:      href,
+					Children: []MessageNode{{Type: MessageNodeText, Text: href}},
+				})
+				i += len(href)
+				continue
+			}
+
+		case c == '@' && !inLink && isWordBoundary(s, i):
+			if name := matchWhile(s[i+1:], isMentionRune); name != "" {
+				flushText()
+				nodes = append(nodes, MessageNode{Type: MessageNodeMention, Name: name})
+				i += 1 + len(name)
+				continue
+			}
+
+		case c == '#' && !inLink && isWordBoundary(s, i):
+			if name := matchWhile(s[i+1:], isChannelRune); name != "" {
+				flushText()
+				nodes = append(nodes, MessageNode{Type: MessageNodeChannel, Name: name})
+				i += 1 + len(name)
+				continue
+			}
+
+		case c == ':' && isWordBoundary(s, i):
+			name := matchWhile(s[i+1:], isEmojiRune)
+			if name != "" && len(name) <= maxEmojiShortcodeLen && strings.HasPrefix(s[i+1+len(name):], ":") {
+				flushText()
+				nodes = append(nodes, MessageNode{Type: MessageNodeEmoji, Name: name})
+				i += len(name) + 2
+				continue
+			}
+		}
+
+		text.WriteByte(s[i])
+		i++
+	}
+	flushText()
+
+	return nodes
+}
+
+// parseInlineLink は "[label](url)" 形式のリンクを解析します
+// リンク先が許可されていないスキームの場合は解析せず、文字列として扱います
+func parseInlineLink(s string) (label string, href string, length int, ok bool) {
+	closeLabel := strings.IndexByte(s, ']')
+	if closeLabel <= 1 || strings.ContainsAny(s[1:closeLabel], "[\n") {
+		return "", "", 0, false
+	}
+	if !strings.HasPrefix(s[closeLabel+1:], "(") {
+		return "", "", 0, false
+	}
+
+	rest := s[closeLabel+2:]
+	closeURL := strings.IndexByte(rest, ')')
+	if closeURL <= 0 || strings.ContainsAny(rest[:closeURL], " \t\n") {
+		return "", "", 0, false
+	}
+
+	href = rest[:closeURL]
+	if !isAllowedLinkURL(href) {
+		return "", "", 0, false
+	}
+	return s[1:closeLabel], href, closeLabel + 2 + closeURL + 1, true
+}
+
+// matchAutolink は先頭のhttp・httpsのURLを返します
+// 末尾の句読点と対応しない閉じ括弧はURLに含めません
+func matchAutolink(s string) string {
+	lower := strings.ToLower(s[:min(len(s), len("https://"))])
+	var scheme int
+	switch {
+	case strings.HasPrefix(lower, "https://"):
+		scheme = len("https://")
+	case strings.HasPrefix(lower, "http://"):
+		scheme = len("http://")
+	default:
+		return ""
+	}
+
+	end := scheme
+	for end < len(s) {
+		r, size := utf8.DecodeRuneInString(s[end:])
+		if unicode.IsSpace(r) || strings.ContainsRune("<>\"{}|\\^`[]", r) {
+			break
+		}
+		end += size
+	}
+
+	for end > scheme {
+		last := s[end-1]
+		if strings.IndexByte(".,;:!?'\"", last) >= 0 {
+			end--
+			continue
+		}
+		if last == ')' && strings.Count(s[:end], "(") < strings.Count(s[:end], ")") {
+			end--
+			continue
+		}
+		break
+	}
+
+	if end == scheme {
+		return ""
+	}
+	return s[:end]
+}
+
+func isAllowedLinkURL(href string) bool {
+	parsed, err := url.Parse(href)
+	if err != nil {
+		return false
+	}
+	switch strings.ToLower(parsed.Scheme) {
+	case "http", "https":
+		return parsed.Host != ""
+	case "mailto":
+		return parsed.Opaque != ""
+	default:
+		return false
+	}
+}
+
+// isWordBoundary はs[i]の直前が単語の途中でないか判定します
+// "user@example.com" や "10:30:00" を誤ってメンション・絵文字として扱わないために使用します
+func isWordBoundary(s string, i int) bool {
+	if i == 0 {
+		return true
+	}
+	r, _ := utf8.DecodeLastRuneInString(s[:i])
+	return !unicode.IsLetter(r) && !unicode.IsDigit(r) && r != '_'
+}
+
+func matchWhile(s string, accept func(rune) bool) string {
+	end := 0
+	for end < len(s) {
+		r, size := utf8.DecodeRuneInString(s[end:])
+		if !accept(r) {
+			break
+		}
+		end += size
+	}
+	return s[:end]
+}
+
+func countLeading(s string, c byte) int {
+	n := 0
+	for n < len(s) && s[n] == c {
+		n++
+	}
+	return n
+}
+
+func isASCIIAlnum(r rune) bool {
+	return (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9')
+}
+
+func isMentionRune(r rune) bool {
+	return isASCIIAlnum(r) || r == '_' || r == '-'
+}
+
+func isChannelRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r) || r == '_' || r == '-'
+}
+
+func isEmojiRune(r rune) bool {
+	return (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') || r == '_' || r == '+' || r == '-'
+}
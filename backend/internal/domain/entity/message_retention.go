@@ -0,0 +1,65 @@
+package entity
+
+import (
+	"errors"
+	"time"
+)
+
+const (
+	// MessageRetentionForever はメッセージを無期限に保存する場合の保存日数です
+	MessageRetentionForever = 0
+	// MaxMessageRetentionDays は設定できる保存日数の上限です
+	MaxMessageRetentionDays = 3650
+)
+
+var ErrInvalidMessageRetentionDays = errors.New("保存日数は0（無期限）から3650日の間で指定してください")
+
+// ValidateMessageRetentionDays はメッセージの保存日数を検証します
+func ValidateMessageRetentionDays(days int) error {
+	if days < MessageRetentionForever || days > MaxMessageRetentionDays {
+		return ErrInvalidMessageRetentionDays
+	}
+	return nil
+}
+
+// ChannelRetentionPolicy はチャンネルのメッセージの保存期間の設定です
+type ChannelRetentionPolicy struct {
+	ChannelID   string
+	ChannelName string
+	WorkspaceID string
+	// ChannelRetentionDays はチャンネル個別の保存日数で、nilの場合はワークスペースの設定に従います
+	ChannelRetentionDays   *int
+	WorkspaceRetentionDays int
+}
+
+// EffectiveDays はチャンネルに適用する保存日数を返します。0の場合は無期限に保存します
+func (p *ChannelRetentionPolicy) EffectiveDays() int {
+	if p.ChannelRetentionDays != nil {
+		return *p.ChannelRetentionDays
+	}
+	return p.WorkspaceRetentionDays
+}
+
+// Cutoff はnowの時点で保存期間を過ぎたメッセージの投稿日時の境界を返します
+// cutoff より前に投稿されたメッセージが削除の対象です。無期限に保存する場合はfalseを返します
+func (p *ChannelRetentionPolicy) Cutoff(now time.Time) (time.Time, bool) {
+	days := p.EffectiveDays()
+	if days == MessageRetentionForever {
+		return time.Time{}, false
+	}
+	return now.AddDate(0, 0, -days), true
+}
+
+// ExpiredMessage は保存期間を過ぎたメッセージです
+type ExpiredMessage struct {
+	ID string
+	// AttachmentStorageKeys はストレージから削除する添付ファイルのキーです
+	AttachmentStorageKeys []string
+}
+
+// MessageRetentionCount は保存期間を過ぎたメッセージの集計です
+type MessageRetentionCount struct {
+	Messages        int
+	Attachments     int
+	OldestMessageAt *time.Time
+}
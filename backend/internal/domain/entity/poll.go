@@ -0,0 +1,61 @@
+package entity
+
+import "time"
+
+// Poll はメッセージとして投稿した投票を表します
+type Poll struct {
+	ID        string
+	MessageID string
+	// ChannelID はメッセージが投稿されたチャンネルのIDです
+	ChannelID      string
+	MultipleChoice bool
+	Anonymous      bool
+	ClosesAt       *time.Time
+	Options        []*PollOption
+	CreatedAt      time.Time
+}
+
+// PollOption は投票の選択肢と、その選択肢に投票したユーザーを表します
+type PollOption struct {
+	ID       string
+	Text     string
+	Position int
+	// VoterIDs は投票した日時の古い順のユーザーIDです
+	VoterIDs []string
+}
+
+// IsClosed はnowの時点で投票が締め切られているか判定します
+func (p *Poll) IsClosed(now time.Time) bool {
+	return p.ClosesAt != nil && !now.Before(*p.ClosesAt)
+}
+
+// FindOption は投票の選択肢を取得します。存在しない場合はnilを返します
+func (p *Poll) FindOption(optionID string) *PollOption {
+	for _, option := range p.Options {
+		if option.ID == optionID {
+			return option
+		}
+	}
+	return nil
+}
+
+// VoterCount はいずれかの選択肢に投票したユーザーの人数を返します
+func (p *Poll) VoterCount() int {
+	voters := make(map[string]bool)
+	for _, option := range p.Options {
+		for _, userID := range option.VoterIDs {
+			voters[userID] = true
+		}
+	}
+	return len(voters)
+}
+
+// HasVoted はユーザーが選択肢に投票しているか判定します
+func (o *PollOption) HasVoted(userID string) bool {
+	for _, voterID := range o.VoterIDs {
+		if voterID == userID {
+			return true
+		}
+	}
+	return false
+}
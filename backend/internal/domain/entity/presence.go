@@ -0,0 +1,9 @@
+package entity
+
+type PresenceStatus string
+
+const (
+	PresenceStatusOnline  PresenceStatus = "online"
+	PresenceStatusAway    PresenceStatus = "away"
+	PresenceStatusOffline PresenceStatus = "offline"
+)
@@ -7,3 +7,9 @@ type ChannelReadState struct {
 	UserID     string
 	LastReadAt time.Time
 }
+
+// ChannelUnreadCount はユーザーのチャンネルの未読数と未読のメンション数を表します
+type ChannelUnreadCount struct {
+	UnreadCount  int
+	MentionCount int
+}
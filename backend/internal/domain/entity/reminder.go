@@ -0,0 +1,76 @@
+package entity
+
+import "time"
+
+// ReminderStatus はリマインダーの状態です
+type ReminderStatus string
+
+const (
+	// ReminderStatusPending は通知日時を待っている状態です
+	ReminderStatusPending ReminderStatus = "pending"
+	// ReminderStatusSending はいずれかのインスタンスが通知処理中の状態です
+	ReminderStatusSending ReminderStatus = "sending"
+	// ReminderStatusFired は通知済みで、まだ完了していない状態です
+	ReminderStatusFired ReminderStatus = "fired"
+	// ReminderStatusCompleted はユーザーが完了にした状態です
+	ReminderStatusCompleted ReminderStatus = "completed"
+)
+
+// ReminderPreset は通知日時のプリセットです
+type ReminderPreset string
+
+const (
+	// ReminderPresetIn20Minutes は20分後に通知します
+	ReminderPresetIn20Minutes ReminderPreset = "in_20_minutes"
+	// ReminderPresetIn1Hour は1時間後に通知します
+	ReminderPresetIn1Hour ReminderPreset = "in_1_hour"
+	// ReminderPresetIn3Hours は3時間後に通知します
+	ReminderPresetIn3Hours ReminderPreset = "in_3_hours"
+	// ReminderPresetTomorrow は翌日の9:00に通知します
+	ReminderPresetTomorrow ReminderPreset = "tomorrow"
+	// ReminderPresetNextWeek は翌週の月曜日の9:00に通知します
+	ReminderPresetNextWeek ReminderPreset = "next_week"
+)
+
+// reminderPresetHour はプリセットで日付を指定した場合に通知する時刻です
+const reminderPresetHour = 9
+
+// RemindAt はnowを基準にしたプリセットの通知日時を返します
+// 日付はlocのタイムゾーンで計算します。未対応のプリセットの場合はfalseを返します
+func (p ReminderPreset) RemindAt(now time.Time, loc *time.Location) (time.Time, bool) {
+	switch p {
+	case ReminderPresetIn20Minutes:
+		return now.Add(20 * time.Minute), true
+	case ReminderPresetIn1Hour:
+		return now.Add(time.Hour), true
+	case ReminderPresetIn3Hours:
+		return now.Add(3 * time.Hour), true
+	case ReminderPresetTomorrow:
+		t := now.In(loc)
+		return time.Date(t.Year(), t.Month(), t.Day()+1, reminderPresetHour, 0, 0, 0, loc), true
+	case ReminderPresetNextWeek:
+		t := now.In(loc)
+		days := (int(time.Monday) - int(t.Weekday()) + 7) % 7
+		if days == 0 {
+			days = 7
+		}
+		return time.Date(t.Year(), t.Month(), t.Day()+days, reminderPresetHour, 0, 0, 0, loc), true
+	}
+	return time.Time{}, false
+}
+
+// Reminder はメッセージのリマインダーを表します
+type Reminder struct {
+	ID        string
+	UserID    string
+	MessageID string
+	// ChannelID はメッセージが投稿されたチャンネルのIDです
+	ChannelID   string
+	RemindAt    time.Time
+	Status      ReminderStatus
+	ClaimedAt   *time.Time
+	FiredAt     *time.Time
+	CompletedAt *time.Time
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
@@ -0,0 +1,101 @@
+package entity
+
+import "time"
+
+// ScheduledMessageStatus は予約メッセージの状態です
+type ScheduledMessageStatus string
+
+const (
+	// ScheduledMessageStatusPending は投稿日時を待っている状態です
+	ScheduledMessageStatusPending ScheduledMessageStatus = "pending"
+	// ScheduledMessageStatusSending はいずれかのインスタンスが投稿処理中の状態です
+	ScheduledMessageStatusSending ScheduledMessageStatus = "sending"
+	// ScheduledMessageStatusFailed は投稿できなかった状態です（編集すると再び予約されます）
+	ScheduledMessageStatusFailed ScheduledMessageStatus = "failed"
+)
+
+// Recurrence は予約メッセージの繰り返しの規則です
+type Recurrence string
+
+const (
+	// RecurrenceNone は繰り返さず1回のみ投稿します
+	RecurrenceNone Recurrence = ""
+	// RecurrenceDaily は毎日同じ時刻に投稿します
+	RecurrenceDaily Recurrence = "daily"
+	// RecurrenceWeekdays は平日（月〜金）の同じ時刻に投稿します
+	RecurrenceWeekdays Recurrence = "weekdays"
+	// RecurrenceWeekly は毎週同じ曜日・時刻に投稿します
+	RecurrenceWeekly Recurrence = "weekly"
+)
+
+func (r Recurrence) IsValid() bool {
+	switch r {
+	case RecurrenceNone, RecurrenceDaily, RecurrenceWeekdays, RecurrenceWeekly:
+		return true
+	}
+	return false
+}
+
+// Next はsendAtの次の投稿日時を返します
+// 日付と曜日はlocのタイムゾーンで計算するため、夏時間の切り替えがあっても同じ時刻に投稿されます
+func (r Recurrence) Next(sendAt time.Time, loc *time.Location) time.Time {
+	t := sendAt.In(loc)
+	switch r {
+	case RecurrenceDaily:
+		return t.AddDate(0, 0, 1)
+	case RecurrenceWeekdays:
+		t = t.AddDate(0, 0, 1)
+		for t.Weekday() == time.Saturday || t.Weekday() == time.Sunday {
+			t = t.AddDate(0, 0, 1)
+		}
+		return t
+	case RecurrenceWeekly:
+		return t.AddDate(0, 0, 7)
+	}
+	return sendAt
+}
+
+// ScheduledMessage は指定した日時に投稿する予約メッセージを表します
+type ScheduledMessage struct {
+	ID            string
+	ChannelID     string
+	UserID        string
+	ParentID      *string
+	Body          string
+	AttachmentIDs []string
+	SendAt        time.Time
+	Recurrence    Recurrence
+	// Timezone は繰り返しの日付・曜日を計算するタイムゾーン（IANA形式）です
+	Timezone  string
+	Status    ScheduledMessageStatus
+	ClaimedAt *time.Time
+	// Attempts は連続して投稿に失敗した回数です
+	Attempts  int
+	LastError *string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// Location は繰り返しの計算に使用するタイムゾーンを返します
+// 読み込めない場合はUTCを返します
+func (m *ScheduledMessage) Location() *time.Location {
+	loc, err := time.LoadLocation(m.Timezone)
+	if err != nil {
+		return time.UTC
+	}
+	return loc
+}
+
+// NextSendAt は繰り返しの予約メッセージについて、afterより後の最初の投稿日時を返します
+// 停止中に過ぎた投稿日時はまとめて投稿せず読み飛ばします
+func (m *ScheduledMessage) NextSendAt(after time.Time) (time.Time, bool) {
+	if m.Recurrence == RecurrenceNone {
+		return time.Time{}, false
+	}
+	loc := m.Location()
+	next := m.Recurrence.Next(m.SendAt, loc)
+	for !next.After(after) {
+		next = m.Recurrence.Next(next, loc)
+	}
+	return next, true
+}
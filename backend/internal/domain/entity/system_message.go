@@ -11,6 +11,8 @@ const (
     SystemMessageKindChannelNameChanged      SystemMessageKind = "channel_name_changed"
     SystemMessageKindChannelDescriptionChanged SystemMessageKind = "channel_description_changed"
     SystemMessageKindMessagePinned           SystemMessageKind = "message_pinned"
+    SystemMessageKindReminder                SystemMessageKind = "reminder"
+    SystemMessageKindMessageRetentionChanged SystemMessageKind = "message_retention_changed"
 )
 
 type SystemMessage struct {
@@ -19,6 +21,8 @@ type SystemMessage struct {
     Kind      SystemMessageKind
     Payload   map[string]any
     ActorID   *string
+    // RecipientID が設定されている場合は、そのユーザーのみに表示します
+    RecipientID *string
     CreatedAt time.Time
 }
 
@@ -0,0 +1,10 @@
+package entity
+
+import "time"
+
+// TimelineCursor はチャンネルのタイムライン上の位置です
+// 作成日時が同じ項目はIDの順に並べるため、作成日時とIDの組で位置を一意に表します
+type TimelineCursor struct {
+	CreatedAt time.Time
+	ID        string
+}
@@ -16,6 +16,10 @@ type User struct {
 	DisplayName  string
     Bio          *string
 	AvatarURL    *string
+	LastSeenAt   *time.Time
 	CreatedAt    time.Time
 	UpdatedAt    time.Time
+
+	// ReadReceiptsEnabled がfalseのユーザーはDMの既読を送信せず、他のメンバーの既読も受け取りません
+	ReadReceiptsEnabled bool
 }
@@ -21,6 +21,8 @@ type Workspace struct {
 	Description *string
 	IconURL     *string
     IsPublic    bool
+	// MessageHistoryEnabled がfalseの場合、メッセージの編集履歴を保存しません
+	MessageHistoryEnabled bool
 	CreatedBy   string
 	CreatedAt   time.Time
 	UpdatedAt   time.Time
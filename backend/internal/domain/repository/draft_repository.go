@@ -0,0 +1,19 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/newt239/chat/internal/domain/entity"
+)
+
+// DraftRepository はメッセージの下書きを管理します
+// 下書きはユーザー・チャンネル・スレッド（parentIDがnilの場合はチャンネル）で識別します
+type DraftRepository interface {
+	Find(ctx context.Context, userID, channelID string, parentID *string) (*entity.Draft, error)
+	// FindByWorkspaceID はユーザーのWorkspace内の下書きを更新日時の新しい順で返します
+	FindByWorkspaceID(ctx context.Context, userID, workspaceID string) ([]*entity.Draft, error)
+	// Save は下書きを作成し、既に存在する場合は本文と添付ファイルを置き換えます
+	Save(ctx context.Context, draft *entity.Draft) error
+	// Delete は下書きを削除し、削除した下書きが存在したかを返します
+	Delete(ctx context.Context, userID, channelID string, parentID *string) (bool, error)
+}
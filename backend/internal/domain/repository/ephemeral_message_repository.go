@@ -0,0 +1,24 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/newt239/chat/internal/domain/entity"
+)
+
+// EphemeralMessageRepository は有効期限まで保存する一時的なメッセージを管理します
+type EphemeralMessageRepository interface {
+	Create(ctx context.Context, msg *entity.EphemeralMessage) error
+	// FindActiveByChannelID はチャンネルの recipientID のユーザー宛ての一時的なメッセージのうち、
+	// now の時点で有効期限内のものを作成日時の新しい順で返します
+	FindActiveByChannelID(ctx context.Context, channelID string, recipientID string, now time.Time, limit int, since *time.Time, until *time.Time) ([]*entity.EphemeralMessage, error)
+	// FindActiveByChannelIDBefore は有効期限内の recipientID のユーザー宛ての一時的なメッセージのうち、
+	// cursor より前のものを新しい順に最大limit件返します
+	FindActiveByChannelIDBefore(ctx context.Context, channelID string, recipientID string, now time.Time, cursor entity.TimelineCursor, limit int) ([]*entity.EphemeralMessage, error)
+	// FindActiveByChannelIDAfter は有効期限内の recipientID のユーザー宛ての一時的なメッセージのうち、
+	// cursor より後のものを cursor に近い順に最大limit件選び、新しい順に返します
+	FindActiveByChannelIDAfter(ctx context.Context, channelID string, recipientID string, now time.Time, cursor entity.TimelineCursor, limit int) ([]*entity.EphemeralMessage, error)
+	// DeleteExpired は now の時点で有効期限を過ぎた一時的なメッセージを削除し、削除した件数を返します
+	DeleteExpired(ctx context.Context, now time.Time) (int, error)
+}
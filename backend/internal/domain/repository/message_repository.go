@@ -9,8 +9,18 @@ import (
 
 type MessageRepository interface {
 	FindByID(ctx context.Context, id string) (*entity.Message, error)
+	// Lock はトランザクションが終了するまでメッセージの行をロックします。トランザクション内で呼び出してください
+	Lock(ctx context.Context, id string) error
+	FindByIDs(ctx context.Context, ids []string) ([]*entity.Message, error)
+	FindByClientMsgID(ctx context.Context, userID string, clientMsgID string) (*entity.Message, error)
 	FindByChannelID(ctx context.Context, channelID string, limit int, since *time.Time, until *time.Time) ([]*entity.Message, error)
 	FindByChannelIDIncludingDeleted(ctx context.Context, channelID string, limit int, since *time.Time, until *time.Time) ([]*entity.Message, error)
+	// FindByChannelIDBefore はチャンネルのスレッドの返信を除く削除されていないメッセージのうち、
+	// cursor より前のものを新しい順に最大limit件返します
+	FindByChannelIDBefore(ctx context.Context, channelID string, cursor entity.TimelineCursor, limit int) ([]*entity.Message, error)
+	// FindByChannelIDAfter はチャンネルのスレッドの返信を除く削除されていないメッセージのうち、
+	// cursor より後のものを cursor に近い順に最大limit件選び、新しい順に返します
+	FindByChannelIDAfter(ctx context.Context, channelID string, cursor entity.TimelineCursor, limit int) ([]*entity.Message, error)
 	FindThreadReplies(ctx context.Context, parentID string) ([]*entity.Message, error)
 	FindThreadRepliesIncludingDeleted(ctx context.Context, parentID string) ([]*entity.Message, error)
 	SoftDeleteByIDs(ctx context.Context, ids []string, deletedBy string) error
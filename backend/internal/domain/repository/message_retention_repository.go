@@ -0,0 +1,36 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/newt239/chat/internal/domain/entity"
+)
+
+// MessageRetentionRepository はメッセージの保存期間の設定と、保存期間を過ぎたメッセージの削除を扱います
+type MessageRetentionRepository interface {
+	// FindWorkspaceRetentionDays はワークスペースの保存日数を返します。ワークスペースが存在しない場合はnilを返します
+	FindWorkspaceRetentionDays(ctx context.Context, workspaceID string) (*int, error)
+	UpdateWorkspaceRetentionDays(ctx context.Context, workspaceID string, days int) error
+	// FindChannelPolicy はチャンネルの保存期間の設定を返します。チャンネルが存在しない場合はnilを返します
+	FindChannelPolicy(ctx context.Context, channelID string) (*entity.ChannelRetentionPolicy, error)
+	// FindChannelPolicies はワークスペースのすべてのチャンネルの保存期間の設定を返します
+	FindChannelPolicies(ctx context.Context, workspaceID string) ([]*entity.ChannelRetentionPolicy, error)
+	// FindPurgeTargets は保存期間を設定しているすべてのワークスペースのチャンネルの設定を返します
+	FindPurgeTargets(ctx context.Context) ([]*entity.ChannelRetentionPolicy, error)
+	// UpdateChannelRetentionDays はチャンネル個別の保存日数を更新します。nilの場合はワークスペースの設定に従います
+	UpdateChannelRetentionDays(ctx context.Context, channelID string, days *int) error
+
+	// CountExpired はチャンネルで cutoff より前に投稿されたメッセージと添付ファイルを集計します
+	CountExpired(ctx context.Context, channelID string, cutoff time.Time) (*entity.MessageRetentionCount, error)
+	// FindExpired はチャンネルで cutoff より前に投稿されたメッセージのうち、削除できるものを最大limit件返します
+	// cutoff 以降の返信があるスレッドの親メッセージは含みません
+	FindExpired(ctx context.Context, channelID string, cutoff time.Time, limit int) ([]*entity.ExpiredMessage, error)
+	// FindExpiredThreadParents はチャンネルで cutoff より前に投稿され、cutoff 以降の返信があるスレッドの親メッセージのうち、
+	// 内容を消去していないものを最大limit件返します
+	FindExpiredThreadParents(ctx context.Context, channelID string, cutoff time.Time, limit int) ([]*entity.ExpiredMessage, error)
+	// Delete はメッセージと、リアクション・メンション・リンク・ピン・ブックマーク・添付ファイルなどの関連するデータを削除します
+	Delete(ctx context.Context, messageIDs []string) error
+	// Redact はメッセージの本文と関連するデータを削除し、スレッドの返信を残すため削除済みのメッセージとして残します
+	Redact(ctx context.Context, messageIDs []string, now time.Time) error
+}
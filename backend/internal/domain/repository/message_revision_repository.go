@@ -0,0 +1,17 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/newt239/chat/internal/domain/entity"
+)
+
+// MessageRevisionRepository はメッセージの編集履歴を管理します
+type MessageRevisionRepository interface {
+	// Create は編集前の本文を編集履歴として保存します
+	Create(ctx context.Context, revision *entity.MessageRevision) error
+	// FindByMessageID はメッセージの編集履歴を古い順で返します
+	FindByMessageID(ctx context.Context, messageID string) ([]*entity.MessageRevision, error)
+	// DeleteByWorkspaceID はWorkspaceのメッセージの編集履歴をすべて削除し、メッセージの編集履歴の件数を0にします
+	DeleteByWorkspaceID(ctx context.Context, workspaceID string) error
+}
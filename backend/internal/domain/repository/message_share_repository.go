@@ -0,0 +1,18 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/newt239/chat/internal/domain/entity"
+)
+
+// MessageShareRepository はメッセージの転送・共有を管理します
+type MessageShareRepository interface {
+	// Create は共有先のメッセージと共有元のメッセージの関係を保存します
+	Create(ctx context.Context, share *entity.MessageShare) error
+	// FindByMessageIDs は共有先のメッセージIDごとの共有を返します
+	FindByMessageIDs(ctx context.Context, messageIDs []string) (map[string]*entity.MessageShare, error)
+	// CountBySourceMessageIDs は共有元のメッセージIDごとの共有された回数を返します
+	// 共有先のメッセージが削除された共有は数えません
+	CountBySourceMessageIDs(ctx context.Context, sourceMessageIDs []string) (map[string]int, error)
+}
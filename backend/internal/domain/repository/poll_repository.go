@@ -0,0 +1,25 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/newt239/chat/internal/domain/entity"
+)
+
+// PollRepository は投票と選択肢、投票したユーザーを管理します
+type PollRepository interface {
+	// Create は投票と選択肢を保存します
+	Create(ctx context.Context, poll *entity.Poll) error
+	// FindByID は選択肢と投票したユーザーを含む投票を返します。存在しない場合はnilを返します
+	FindByID(ctx context.Context, id string) (*entity.Poll, error)
+	// FindByMessageIDs はメッセージIDごとの投票を返します
+	FindByMessageIDs(ctx context.Context, messageIDs []string) (map[string]*entity.Poll, error)
+	// Lock はトランザクションが終了するまで投票の行をロックします。トランザクション内で呼び出してください
+	Lock(ctx context.Context, pollID string) error
+	// AddVote は選択肢への投票を保存します。既に投票している場合は何もしません
+	AddVote(ctx context.Context, pollID, optionID, userID string) error
+	// RemoveVote は選択肢への投票を取り消します
+	RemoveVote(ctx context.Context, optionID, userID string) error
+	// RemoveVotesByUser はユーザーの投票のすべての選択肢への投票を取り消します
+	RemoveVotesByUser(ctx context.Context, pollID, userID string) error
+}
@@ -8,9 +8,12 @@ import (
 
 type ReadStateRepository interface {
 	FindByChannelAndUser(ctx context.Context, channelID string, userID string) (*entity.ChannelReadState, error)
+	FindByChannel(ctx context.Context, channelID string) ([]*entity.ChannelReadState, error)
 	Upsert(ctx context.Context, readState *entity.ChannelReadState) error
 	GetUnreadCount(ctx context.Context, channelID string, userID string) (int, error)
 	GetUnreadChannels(ctx context.Context, userID string) (map[string]int, error)
 	GetUnreadMentionCount(ctx context.Context, channelID string, userID string) (int, error)
 	GetUnreadMentionCountBatch(ctx context.Context, channelIDs []string, userID string) (map[string]int, error)
+	// GetChannelUnreadCountsByUsers は1つのチャンネルについて複数ユーザーの未読数と未読のメンション数をまとめて取得します
+	GetChannelUnreadCountsByUsers(ctx context.Context, channelID string, userIDs []string) (map[string]entity.ChannelUnreadCount, error)
 }
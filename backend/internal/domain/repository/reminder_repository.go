@@ -0,0 +1,27 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/newt239/chat/internal/domain/entity"
+)
+
+// ReminderRepository はメッセージのリマインダーを管理します
+type ReminderRepository interface {
+	Create(ctx context.Context, reminder *entity.Reminder) error
+	FindByID(ctx context.Context, id string) (*entity.Reminder, error)
+	// FindByUserID はユーザーのリマインダーを通知日時の早い順で返します
+	FindByUserID(ctx context.Context, userID string) ([]*entity.Reminder, error)
+	Update(ctx context.Context, reminder *entity.Reminder) error
+	Delete(ctx context.Context, id string) error
+	// FindDue は通知日時を過ぎたリマインダーを最大limit件返します
+	// staleBefore より前に処理を開始したまま完了していないリマインダーも含みます
+	FindDue(ctx context.Context, now time.Time, staleBefore time.Time, limit int) ([]*entity.Reminder, error)
+	// Claim はリマインダーを通知処理中にします
+	// 他のインスタンスが既に処理を開始していた場合はfalseを返します
+	Claim(ctx context.Context, id string, now time.Time, staleBefore time.Time) (bool, error)
+	// Release は claimedAt に取得したまま通知処理中のリマインダーを通知待ちに戻します
+	// 取得後にユーザーが変更・削除した場合や他のインスタンスが取得し直した場合はfalseを返します
+	Release(ctx context.Context, id string, claimedAt time.Time) (bool, error)
+}
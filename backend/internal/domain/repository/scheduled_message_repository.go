@@ -0,0 +1,30 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/newt239/chat/internal/domain/entity"
+)
+
+// ScheduledMessageRepository は予約メッセージを管理します
+type ScheduledMessageRepository interface {
+	Create(ctx context.Context, scheduled *entity.ScheduledMessage) error
+	FindByID(ctx context.Context, id string) (*entity.ScheduledMessage, error)
+	// FindByUserID はユーザーの予約メッセージを投稿日時の早い順で返します
+	FindByUserID(ctx context.Context, userID string) ([]*entity.ScheduledMessage, error)
+	Update(ctx context.Context, scheduled *entity.ScheduledMessage) error
+	// UpdateUnclaimed はいずれのインスタンスも投稿処理を行っていない場合のみ予約メッセージを更新します
+	// 投稿処理中の場合や削除されていた場合はfalseを返します
+	UpdateUnclaimed(ctx context.Context, scheduled *entity.ScheduledMessage) (bool, error)
+	Delete(ctx context.Context, id string) error
+	// DeleteUnclaimed はいずれのインスタンスも投稿処理を行っていない場合のみ予約メッセージを削除します
+	// 投稿処理中の場合や削除されていた場合はfalseを返します
+	DeleteUnclaimed(ctx context.Context, id string) (bool, error)
+	// FindDue は投稿日時を過ぎた予約メッセージを最大limit件返します
+	// staleBefore より前に処理を開始したまま完了していない予約メッセージも含みます
+	FindDue(ctx context.Context, now time.Time, staleBefore time.Time, limit int) ([]*entity.ScheduledMessage, error)
+	// Claim は予約メッセージを投稿処理中にします
+	// 他のインスタンスが既に処理を開始していた場合はfalseを返します
+	Claim(ctx context.Context, id string, now time.Time, staleBefore time.Time) (bool, error)
+}
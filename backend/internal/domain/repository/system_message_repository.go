@@ -10,7 +10,13 @@ import (
 // SystemMessageRepository はシステムメッセージの永続化を扱います
 type SystemMessageRepository interface {
     Create(ctx context.Context, msg *entity.SystemMessage) error
-    FindByChannelID(ctx context.Context, channelID string, limit int, since *time.Time, until *time.Time) ([]*entity.SystemMessage, error)
+    // FindByChannelID はチャンネルのシステムメッセージのうち、viewerIDのユーザーに表示するものを返します
+    FindByChannelID(ctx context.Context, channelID string, viewerID string, limit int, since *time.Time, until *time.Time) ([]*entity.SystemMessage, error)
+    // FindByChannelIDBefore は viewerID のユーザーに表示するシステムメッセージのうち、cursor より前のものを新しい順に最大limit件返します
+    FindByChannelIDBefore(ctx context.Context, channelID string, viewerID string, cursor entity.TimelineCursor, limit int) ([]*entity.SystemMessage, error)
+    // FindByChannelIDAfter は viewerID のユーザーに表示するシステムメッセージのうち、
+    // cursor より後のものを cursor に近い順に最大limit件選び、新しい順に返します
+    FindByChannelIDAfter(ctx context.Context, channelID string, viewerID string, cursor entity.TimelineCursor, limit int) ([]*entity.SystemMessage, error)
 }
 
 
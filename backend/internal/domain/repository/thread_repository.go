@@ -32,6 +32,9 @@ type ThreadRepository interface {
 	FollowThread(ctx context.Context, userID, threadID string) error
 	UnfollowThread(ctx context.Context, userID, threadID string) error
 	IsFollowing(ctx context.Context, userID, threadID string) (bool, error)
+
+	// スレッドの未読返信数を取得
+	CountUnreadReplies(ctx context.Context, userID, threadID string) (int, error)
 }
 
 type FindParticipatingThreadsInput struct {
@@ -2,6 +2,7 @@ package repository
 
 import (
 	"context"
+	"time"
 
 	"github.com/newt239/chat/internal/domain/entity"
 )
@@ -13,4 +14,5 @@ type UserRepository interface {
 	Create(ctx context.Context, user *entity.User) error
 	Update(ctx context.Context, user *entity.User) error
 	Delete(ctx context.Context, id string) error
+	UpdateLastSeenAt(ctx context.Context, id string, lastSeenAt time.Time) error
 }
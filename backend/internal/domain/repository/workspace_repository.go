@@ -23,4 +23,6 @@ type WorkspaceRepository interface {
     FindAllPublic(ctx context.Context) ([]*entity.Workspace, error)
     CountMembers(ctx context.Context, workspaceID string) (int, error)
     ExistsByID(ctx context.Context, id string) (bool, error)
+    // LockShared はトランザクションが終了するまでWorkspaceの行の更新を待たせます。トランザクション内で呼び出してください
+    LockShared(ctx context.Context, id string) error
 }
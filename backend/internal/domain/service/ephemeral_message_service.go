@@ -0,0 +1,89 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/newt239/chat/internal/domain/entity"
+	domainerrors "github.com/newt239/chat/internal/domain/errors"
+	domainrepository "github.com/newt239/chat/internal/domain/repository"
+)
+
+// MaxEphemeralMessageTTL は一時的なメッセージを保存できる期間の上限です
+const MaxEphemeralMessageTTL = 24 * time.Hour
+
+// EphemeralMessageInput は一時的なメッセージの送信内容です
+type EphemeralMessageInput struct {
+	ChannelID   string
+	RecipientID string
+	Kind        entity.EphemeralMessageKind
+	Body        string
+	Payload     map[string]any
+	// TTL が正の場合は再読込後も表示できるよう保存します（MaxEphemeralMessageTTL を上限とします）
+	// 0 以下の場合は保存せず、接続中の画面にのみ表示します
+	TTL time.Duration
+}
+
+// EphemeralMessageService はチャンネル内の1人のユーザーのみに表示する一時的なメッセージを送信します
+// システムメッセージ・予約投稿などのサーバー側の機能から利用します
+type EphemeralMessageService interface {
+	Send(ctx context.Context, input EphemeralMessageInput) (*entity.EphemeralMessage, error)
+}
+
+type ephemeralMessageService struct {
+	ephemeralRepo    domainrepository.EphemeralMessageRepository
+	channelAccessSvc ChannelAccessService
+	notificationSvc  NotificationService
+}
+
+func NewEphemeralMessageService(
+	ephemeralRepo domainrepository.EphemeralMessageRepository,
+	channelAccessSvc ChannelAccessService,
+	notificationSvc NotificationService,
+) EphemeralMessageService {
+	return &ephemeralMessageService{
+		ephemeralRepo:    ephemeralRepo,
+		channelAccessSvc: channelAccessSvc,
+		notificationSvc:  notificationSvc,
+	}
+}
+
+func (s *ephemeralMessageService) Send(ctx context.Context, input EphemeralMessageInput) (*entity.EphemeralMessage, error) {
+	if input.Kind == "" || strings.TrimSpace(input.Body) == "" {
+		return nil, domainerrors.ErrValidation
+	}
+
+	// 宛先のユーザーが閲覧できないチャンネルには送信しない
+	ch, err := s.channelAccessSvc.EnsureChannelAccess(ctx, input.ChannelID, input.RecipientID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	msg := &entity.EphemeralMessage{
+		ID:          uuid.NewString(),
+		ChannelID:   ch.ID,
+		RecipientID: input.RecipientID,
+		Kind:        input.Kind,
+		Body:        input.Body,
+		Payload:     input.Payload,
+		CreatedAt:   now,
+	}
+
+	if input.TTL > 0 {
+		expiresAt := now.Add(min(input.TTL, MaxEphemeralMessageTTL))
+		msg.ExpiresAt = &expiresAt
+
+		// 有効期限を過ぎたメッセージはretention.Purgerが定期的に削除する
+		if err := s.ephemeralRepo.Create(ctx, msg); err != nil {
+			return nil, fmt.Errorf("failed to save ephemeral message: %w", err)
+		}
+	}
+
+	s.notificationSvc.NotifyEphemeralMessage(ch.WorkspaceID, msg.RecipientID, msg)
+
+	return msg, nil
+}
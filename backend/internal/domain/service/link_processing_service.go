@@ -8,5 +8,5 @@ import (
 
 // LinkProcessingService defines the interface for link processing operations
 type LinkProcessingService interface {
-	ProcessLinks(ctx context.Context, body string) ([]*entity.MessageLink, error)
+	ProcessLinks(ctx context.Context, bodyAST []entity.MessageNode) ([]*entity.MessageLink, error)
 }
@@ -8,6 +8,6 @@ import (
 
 // MentionService defines the interface for mention operations
 type MentionService interface {
-	ExtractUserMentions(ctx context.Context, body, workspaceID string) ([]*entity.MessageUserMention, error)
-	ExtractGroupMentions(ctx context.Context, body, workspaceID string) ([]*entity.MessageGroupMention, error)
+	ExtractUserMentions(ctx context.Context, bodyAST []entity.MessageNode, workspaceID string) ([]*entity.MessageUserMention, error)
+	ExtractGroupMentions(ctx context.Context, bodyAST []entity.MessageNode, workspaceID string) ([]*entity.MessageGroupMention, error)
 }
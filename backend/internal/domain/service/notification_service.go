@@ -1,5 +1,11 @@
 package service
 
+import (
+	"time"
+
+	"github.com/newt239/chat/internal/domain/entity"
+)
+
 // NotificationService はリアルタイム通知を管理するサービスです
 type NotificationService interface {
 	// NotifyNewMessage は新しいメッセージをチャンネル参加者に通知します
@@ -17,10 +23,55 @@ type NotificationService interface {
 	// NotifyUnreadCount は未読数の更新を特定ユーザーに通知します
 	NotifyUnreadCount(workspaceID string, userID string, channelID string, unreadCount int)
 
+	// NotifyReadReceipt はDM・グループDMのメンバーが既読位置を進めたことを他のメンバーに通知します
+	NotifyReadReceipt(workspaceID string, channelID string, userID string, lastReadAt time.Time, recipientIDs []string)
+
+	// NotifyDraftUpdated は下書きの保存・削除をユーザーの接続に通知します
+	// draftがnilの場合は削除として通知します
+	NotifyDraftUpdated(workspaceID string, userID string, channelID string, parentID *string, draft *entity.Draft)
+
+	// NotifyReminderDue はリマインダーの通知日時になったことをユーザーの接続に通知します
+	// systemMessageはそのユーザーのみに表示するシステムメッセージです
+	NotifyReminderDue(workspaceID string, userID string, reminder *entity.Reminder, systemMessage *entity.SystemMessage)
+
+	// NotifyEphemeralMessage は一時的なメッセージを宛先のユーザーの接続のみに通知します
+	NotifyEphemeralMessage(workspaceID string, userID string, message *entity.EphemeralMessage)
+
+	// NotifyPollUpdated は投票の集計の更新をチャンネル参加者に通知します
+	NotifyPollUpdated(workspaceID string, channelID string, poll *entity.Poll)
+
+	// スレッド関連
+	// NotifyThreadReply はスレッドへの返信をスレッドの購読者に通知します
+	NotifyThreadReply(workspaceID string, channelID string, threadID string, message interface{})
+	// NotifyThreadUpdated はスレッドのメタデータ（返信数・最終返信者・参加者）の更新をチャンネル参加者に通知します
+	NotifyThreadUpdated(workspaceID string, channelID string, metadata interface{})
+	// NotifyThreadUnread はフォローしているスレッドの未読数の更新を特定ユーザーに通知します
+	NotifyThreadUnread(workspaceID string, userID string, channelID string, threadID string, unreadCount int)
+
 	// ピン関連
 	NotifyPinCreated(workspaceID string, channelID string, pin interface{})
 	NotifyPinDeleted(workspaceID string, channelID string, pin interface{})
 
     // システムメッセージ関連
     NotifySystemMessageCreated(workspaceID string, channelID string, message interface{})
+
+	// チャンネル購読の取り消し
+	// NotifyChannelMemberRemoved はチャンネルから削除されたユーザーの購読を取り消します
+	NotifyChannelMemberRemoved(workspaceID string, channelID string, userID string)
+	// NotifyChannelMemberLeft はチャンネルから退出したユーザーの購読を取り消します
+	NotifyChannelMemberLeft(workspaceID string, channelID string, userID string)
+	// NotifyChannelPrivatized はプライベート化されたチャンネルのメンバー以外の購読を取り消します
+	NotifyChannelPrivatized(workspaceID string, channelID string, memberIDs []string)
+
+	// チャンネルのメンバーの変更（サイドバーの購読に反映します）
+	// NotifyChannelMembersAdded はチャンネルに追加されたユーザーの接続のサイドバーにチャンネルを追加します
+	NotifyChannelMembersAdded(workspaceID string, channelID string, userIDs []string)
+	// NotifyChannelMembersRemoved はチャンネルから外れたユーザーの接続のサイドバーからチャンネルを削除します
+	NotifyChannelMembersRemoved(workspaceID string, channelID string, userIDs []string)
+
+	// Workspace所属の変更
+	// NotifyWorkspaceMemberAdded はWorkspaceに参加したユーザーの接続にWorkspaceを追加します
+	NotifyWorkspaceMemberAdded(workspaceID string, userID string)
+	// NotifyWorkspaceMemberRemoved はWorkspaceから削除されたユーザーの接続からWorkspaceを外します
+	NotifyWorkspaceMemberRemoved(workspaceID string, userID string)
 }
@@ -14,5 +14,4 @@ type OGPData struct {
 // OGPService defines the interface for OGP operations
 type OGPService interface {
 	FetchOGP(ctx context.Context, url string) (*OGPData, error)
-	ExtractURLs(text string) []string
 }
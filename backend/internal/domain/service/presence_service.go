@@ -0,0 +1,10 @@
+package service
+
+import "github.com/newt239/chat/internal/domain/entity"
+
+// PresenceService はWebSocket接続に基づくユーザーのオンライン状態を提供するサービスです
+type PresenceService interface {
+	// GetWorkspacePresence はWorkspace内で接続中のユーザーのプレゼンス状態を返します
+	// 接続していないユーザーは結果に含まれません
+	GetWorkspacePresence(workspaceID string) map[string]entity.PresenceStatus
+}
@@ -1,9 +1,13 @@
 package service
 
+import "context"
+
 // StorageService defines the interface for storage operations
 type StorageService interface {
 	GenerateUploadURL(storageKey, mimeType string, sizeBytes int64, expiresIn interface{}) (string, error)
 	GenerateDownloadURL(storageKey string, expiresIn interface{}) (string, error)
+	// DeleteObject はストレージからオブジェクトを削除します。存在しないオブジェクトの削除はエラーになりません
+	DeleteObject(ctx context.Context, storageKey string) error
 }
 
 // StorageConfig defines the configuration for storage operations
@@ -15,8 +15,9 @@ var (
 )
 
 type Claims struct {
-	UserID string `json:"user_id"`
-	Email  string `json:"email"`
+	UserID    string `json:"user_id"`
+	Email     string `json:"email"`
+	SessionID string `json:"sid,omitempty"`
 	jwt.RegisteredClaims
 }
 
@@ -30,9 +31,10 @@ func NewJWTService(secret string) authuc.JWTService {
 	}
 }
 
-func (s *jwtService) GenerateToken(userID string, duration time.Duration) (string, error) {
+func (s *jwtService) GenerateToken(userID string, sessionID string, duration time.Duration) (string, error) {
 	claims := Claims{
-		UserID: userID,
+		UserID:    userID,
+		SessionID: sessionID,
 		RegisteredClaims: jwt.RegisteredClaims{
 			ExpiresAt: jwt.NewNumericDate(time.Now().Add(duration)),
 			IssuedAt:  jwt.NewNumericDate(time.Now()),
@@ -63,8 +65,13 @@ func (s *jwtService) VerifyToken(tokenString string) (*authuc.TokenClaims, error
 		return nil, ErrInvalidToken
 	}
 
-	return &authuc.TokenClaims{
-		UserID: claims.UserID,
-		Email:  claims.Email,
-	}, nil
+	result := &authuc.TokenClaims{
+		UserID:    claims.UserID,
+		Email:     claims.Email,
+		SessionID: claims.SessionID,
+	}
+	if claims.ExpiresAt != nil {
+		result.ExpiresAt = claims.ExpiresAt.Time
+	}
+	return result, nil
 }
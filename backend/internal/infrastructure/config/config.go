@@ -14,6 +14,7 @@ type Config struct {
 	JWT      JWTConfig
 	Wasabi   WasabiConfig
 	CORS     CORSConfig
+	Realtime RealtimeConfig
 }
 
 type ServerConfig struct {
@@ -43,6 +44,27 @@ type CORSConfig struct {
 	AllowedOrigins []string
 }
 
+const (
+	// RealtimeBrokerLocal は単一プロセス内でのみWebSocketイベントを配信します
+	RealtimeBrokerLocal = "local"
+	// RealtimeBrokerPostgres はPostgreSQLのLISTEN/NOTIFYで複数インスタンスへ配信します
+	RealtimeBrokerPostgres = "postgres"
+
+	// RealtimeSlowConsumerDropOldest は送信キューが溢れた場合に古いイベントから破棄します
+	RealtimeSlowConsumerDropOldest = "drop_oldest"
+	// RealtimeSlowConsumerDisconnect は送信キューが溢れた場合にresync_requiredを送信して切断します
+	RealtimeSlowConsumerDisconnect = "disconnect"
+)
+
+type RealtimeConfig struct {
+	Broker string
+
+	// SlowConsumerPolicy は送信キューが溢れたクライアントの扱い（drop_oldest | disconnect）です
+	SlowConsumerPolicy string
+	// SendQueueSize はクライアントごとの送信キューの上限です
+	SendQueueSize int
+}
+
 func Load() (*Config, error) {
 	_ = godotenv.Load()
 
@@ -69,6 +91,11 @@ func Load() (*Config, error) {
 		CORS: CORSConfig{
 			AllowedOrigins: []string{getEnv("CORS_ALLOWED_ORIGINS", "http://localhost:5173")},
 		},
+		Realtime: RealtimeConfig{
+			Broker:             getEnv("REALTIME_BROKER", RealtimeBrokerLocal),
+			SlowConsumerPolicy: getEnv("REALTIME_SLOW_CONSUMER_POLICY", RealtimeSlowConsumerDisconnect),
+			SendQueueSize:      getEnvInt("REALTIME_SEND_QUEUE_SIZE", 256),
+		},
 	}
 
 	return cfg, nil
@@ -97,5 +124,14 @@ func (c *Config) Validate() error {
 	if c.Server.Env == "production" && (c.Wasabi.AccessKeyID == "" || c.Wasabi.SecretAccessKey == "") {
 		return fmt.Errorf("wasabi credentials must be set in production")
 	}
+	if c.Realtime.Broker != RealtimeBrokerLocal && c.Realtime.Broker != RealtimeBrokerPostgres {
+		return fmt.Errorf("REALTIME_BROKER must be %q or %q", RealtimeBrokerLocal, RealtimeBrokerPostgres)
+	}
+	if c.Realtime.SlowConsumerPolicy != RealtimeSlowConsumerDropOldest && c.Realtime.SlowConsumerPolicy != RealtimeSlowConsumerDisconnect {
+		return fmt.Errorf("REALTIME_SLOW_CONSUMER_POLICY must be %q or %q", RealtimeSlowConsumerDropOldest, RealtimeSlowConsumerDisconnect)
+	}
+	if c.Realtime.SendQueueSize <= 0 {
+		return fmt.Errorf("REALTIME_SEND_QUEUE_SIZE must be positive")
+	}
 	return nil
 }
@@ -23,10 +23,11 @@ func NewLinkProcessingService(
 	}
 }
 
-// ProcessLinks はメッセージ本文からリンクを抽出し、OGP情報を取得します
-func (s *linkProcessingService) ProcessLinks(ctx context.Context, body string) ([]*entity.MessageLink, error) {
+// ProcessLinks はメッセージ本文の書式付きテキストからリンクを抽出し、OGP情報を取得します
+// コードスパン・コードブロック内のURLは展開しません
+func (s *linkProcessingService) ProcessLinks(ctx context.Context, bodyAST []entity.MessageNode) ([]*entity.MessageLink, error) {
 	// URLを抽出
-	urls := s.ogpService.ExtractURLs(body)
+	urls := entity.MessageLinkURLs(bodyAST)
 
 	var links []*entity.MessageLink
 
@@ -2,7 +2,6 @@ package mention
 
 import (
 	"context"
-	"regexp"
 	"strings"
 
 	"github.com/newt239/chat/internal/domain/entity"
@@ -34,11 +33,10 @@ func NewMentionService(
 	}
 }
 
-// ExtractUserMentions はメッセージ本文からユーザーメンションを抽出します
-func (s *mentionService) ExtractUserMentions(ctx context.Context, body, workspaceID string) ([]*entity.MessageUserMention, error) {
-	// @username パターンを検出
-	mentionRegex := regexp.MustCompile(`@([a-zA-Z0-9_-]+)`)
-	matches := mentionRegex.FindAllStringSubmatch(body, -1)
+// ExtractUserMentions はメッセージ本文の書式付きテキストからユーザーメンションを抽出します
+// コードスパン・コードブロック内の @username はメンションとして扱いません
+func (s *mentionService) ExtractUserMentions(ctx context.Context, bodyAST []entity.MessageNode, workspaceID string) ([]*entity.MessageUserMention, error) {
+	names := entity.MessageMentionNames(bodyAST)
 
 	var mentions []*entity.MessageUserMention
 	userIDSet := make(map[string]bool)
@@ -68,12 +66,7 @@ func (s *mentionService) ExtractUserMentions(ctx context.Context, body, workspac
 	}
 
 	// メンションを処理
-	for _, match := range matches {
-		if len(match) < 2 {
-			continue
-		}
-		username := match[1]
-
+	for _, username := range names {
 		// ユーザー名でマッチング
 		for _, member := range workspaceMembers {
 			user, exists := userMap[member.UserID]
@@ -96,11 +89,9 @@ func (s *mentionService) ExtractUserMentions(ctx context.Context, body, workspac
 	return mentions, nil
 }
 
-// ExtractGroupMentions はメッセージ本文からグループメンションを抽出します
-func (s *mentionService) ExtractGroupMentions(ctx context.Context, body, workspaceID string) ([]*entity.MessageGroupMention, error) {
-	// @groupname パターンを検出
-	mentionRegex := regexp.MustCompile(`@([a-zA-Z0-9_-]+)`)
-	matches := mentionRegex.FindAllStringSubmatch(body, -1)
+// ExtractGroupMentions はメッセージ本文の書式付きテキストからグループメンションを抽出します
+func (s *mentionService) ExtractGroupMentions(ctx context.Context, bodyAST []entity.MessageNode, workspaceID string) ([]*entity.MessageGroupMention, error) {
+	names := entity.MessageMentionNames(bodyAST)
 
 	var mentions []*entity.MessageGroupMention
 	groupIDSet := make(map[string]bool)
@@ -118,11 +109,7 @@ func (s *mentionService) ExtractGroupMentions(ctx context.Context, body, workspa
 	}
 
 	// メンションを処理
-	for _, match := range matches {
-		if len(match) < 2 {
-			continue
-		}
-		groupname := match[1]
+	for _, groupname := range names {
 
 		// グループ名でマッチング
 		if group, exists := groupMap[groupname]; exists {
@@ -2,10 +2,15 @@ package notification
 
 import (
 	"log"
-	"reflect"
+	"time"
 
+	"github.com/newt239/chat/internal/domain/entity"
+	domainrepository "github.com/newt239/chat/internal/domain/repository"
 	"github.com/newt239/chat/internal/domain/service"
 	"github.com/newt239/chat/internal/interfaces/handler/websocket"
+	messageuc "github.com/newt239/chat/internal/usecase/message"
+	pinuc "github.com/newt239/chat/internal/usecase/pin"
+	reactionuc "github.com/newt239/chat/internal/usecase/reaction"
 )
 
 // WebSocketNotificationService はWebSocketを利用した通知サービスの実装です
@@ -22,9 +27,14 @@ func NewWebSocketNotificationService(hub *websocket.Hub) service.NotificationSer
 
 // NotifyNewMessage は新しいメッセージをチャンネル購読者に通知します
 func (s *WebSocketNotificationService) NotifyNewMessage(workspaceID string, channelID string, message interface{}) {
+	output, ok := toMessageOutput(message)
+	if !ok {
+		log.Printf("new_messageイベントの生成に失敗しました: 未対応のデータ型です (%T)", message)
+		return
+	}
 	payload := websocket.NewMessagePayload{
 		ChannelID: channelID,
-		Message:   convertToMap(message),
+		Message:   output,
 	}
 
 	data, err := websocket.SendServerMessage(websocket.EventTypeNewMessage, payload)
@@ -34,38 +44,59 @@ func (s *WebSocketNotificationService) NotifyNewMessage(workspaceID string, chan
 	}
 
 	s.hub.BroadcastToChannelSubscribers(workspaceID, channelID, data)
+	// 購読していない接続にはサイドバー向けの要約のみを送信する
+	s.hub.PublishChannelActivity(workspaceID, websocket.ChannelActivity{
+		ChannelID: channelID,
+		MessageID: output.ID,
+		At:        output.CreatedAt,
+	})
 	log.Printf("Notified new message to workspace=%s channel=%s", workspaceID, channelID)
 }
 
 // NotifySystemMessageCreated はシステムメッセージ作成をチャンネル購読者に通知します
 func (s *WebSocketNotificationService) NotifySystemMessageCreated(workspaceID string, channelID string, message interface{}) {
-    payload := map[string]interface{}{
-        "channelId": channelID,
-        "message":   convertToMap(message),
-    }
+	msg, ok := message.(*entity.SystemMessage)
+	if !ok || msg == nil {
+		log.Printf("system_message_createdイベントの生成に失敗しました: 未対応のデータ型です (%T)", message)
+		return
+	}
+	payload := websocket.SystemMessageCreatedPayload{
+		ChannelID: channelID,
+		Message: websocket.SystemMessageData{
+			ID:        msg.ID,
+			ChannelID: msg.ChannelID,
+			Kind:      string(msg.Kind),
+			Payload:   msg.Payload,
+			ActorID:   msg.ActorID,
+			CreatedAt: msg.CreatedAt,
+		},
+	}
 
-    data, err := websocket.SendServerMessage(websocket.EventTypeSystemMessageCreated, payload)
-    if err != nil {
-        log.Printf("system_message_createdイベントのエンコードに失敗しました: %v", err)
-        return
-    }
+	data, err := websocket.SendServerMessage(websocket.EventTypeSystemMessageCreated, payload)
+	if err != nil {
+		log.Printf("system_message_createdイベントのエンコードに失敗しました: %v", err)
+		return
+	}
 
-    s.hub.BroadcastToChannelSubscribers(workspaceID, channelID, data)
-    log.Printf("Notified system message to workspace=%s channel=%s", workspaceID, channelID)
+	s.hub.BroadcastToChannelSubscribers(workspaceID, channelID, data)
+	log.Printf("Notified system message to workspace=%s channel=%s", workspaceID, channelID)
 }
 
 // NotifyReaction はリアクション追加をチャンネル購読者に通知します
 func (s *WebSocketNotificationService) NotifyReaction(workspaceID string, channelID string, reaction interface{}) {
-	// リアクションは new_message イベントの一種として扱う
-	// 将来的に専用のイベントタイプを追加することも検討
-	payload := websocket.NewMessagePayload{
+	output, ok := reaction.(reactionuc.ReactionOutput)
+	if !ok {
+		log.Printf("reaction_addedイベントの生成に失敗しました: 未対応のデータ型です (%T)", reaction)
+		return
+	}
+	payload := websocket.ReactionAddedPayload{
 		ChannelID: channelID,
-		Message:   convertToMap(reaction),
+		Reaction:  output,
 	}
 
-	data, err := websocket.SendServerMessage(websocket.EventTypeNewMessage, payload)
+	data, err := websocket.SendServerMessage(websocket.EventTypeReactionAdded, payload)
 	if err != nil {
-		log.Printf("reactionイベントのエンコードに失敗しました: %v", err)
+		log.Printf("reaction_addedイベントのエンコードに失敗しました: %v", err)
 		return
 	}
 
@@ -75,9 +106,14 @@ func (s *WebSocketNotificationService) NotifyReaction(workspaceID string, channe
 
 // NotifyUpdatedMessage はメッセージ更新をチャンネル購読者に通知します
 func (s *WebSocketNotificationService) NotifyUpdatedMessage(workspaceID string, channelID string, message interface{}) {
+	output, ok := toMessageOutput(message)
+	if !ok {
+		log.Printf("message_updatedイベントの生成に失敗しました: 未対応のデータ型です (%T)", message)
+		return
+	}
 	payload := websocket.MessageUpdatedPayload{
 		ChannelID: channelID,
-		Message:   convertToMap(message),
+		Message:   output,
 	}
 
 	data, err := websocket.SendServerMessage(websocket.EventTypeMessageUpdated, payload)
@@ -92,9 +128,14 @@ func (s *WebSocketNotificationService) NotifyUpdatedMessage(workspaceID string,
 
 // NotifyDeletedMessage はメッセージ削除をチャンネル購読者に通知します
 func (s *WebSocketNotificationService) NotifyDeletedMessage(workspaceID string, channelID string, deleteData interface{}) {
+	output, ok := deleteData.(messageuc.DeletedMessageOutput)
+	if !ok {
+		log.Printf("message_deletedイベントの生成に失敗しました: 未対応のデータ型です (%T)", deleteData)
+		return
+	}
 	payload := websocket.MessageDeletedPayload{
 		ChannelID:  channelID,
-		DeleteData: convertToMap(deleteData),
+		DeleteData: output,
 	}
 
 	data, err := websocket.SendServerMessage(websocket.EventTypeMessageDeleted, payload)
@@ -109,44 +150,30 @@ func (s *WebSocketNotificationService) NotifyDeletedMessage(workspaceID string,
 
 // NotifyPinCreated はピン追加をチャンネル参加者に通知します
 func (s *WebSocketNotificationService) NotifyPinCreated(workspaceID string, channelID string, pin interface{}) {
-	payload := websocket.PinPayload{
-		ChannelID: channelID,
-		Message:   convertToMap(pin),
-		// PinnedBy/PinnedAt は pin の中に含めている前提。トップレベルにも複製しておく
-	}
-	if m, ok := pin.(map[string]interface{}); ok {
-		if v, ok := m["pinnedBy"].(string); ok {
-			payload.PinnedBy = v
-		}
-		if v, ok := m["pinnedAt"].(string); ok {
-			payload.PinnedAt = v
-		}
-	}
-	data, err := websocket.SendServerMessage(websocket.EventTypePinCreated, payload)
-	if err != nil {
-		log.Printf("pin_createdイベントのエンコードに失敗しました: %v", err)
-		return
-	}
-	s.hub.BroadcastToChannel(workspaceID, channelID, data)
+	s.notifyPin(websocket.EventTypePinCreated, workspaceID, channelID, pin)
 }
 
 // NotifyPinDeleted はピン削除をチャンネル参加者に通知します
 func (s *WebSocketNotificationService) NotifyPinDeleted(workspaceID string, channelID string, pin interface{}) {
+	s.notifyPin(websocket.EventTypePinDeleted, workspaceID, channelID, pin)
+}
+
+// notifyPin はpin_created/pin_deletedイベントをチャンネル参加者に通知します
+func (s *WebSocketNotificationService) notifyPin(eventType websocket.EventType, workspaceID string, channelID string, pin interface{}) {
+	output, ok := pin.(pinuc.PinEventOutput)
+	if !ok {
+		log.Printf("%sイベントの生成に失敗しました: 未対応のデータ型です (%T)", eventType, pin)
+		return
+	}
 	payload := websocket.PinPayload{
 		ChannelID: channelID,
-		Message:   convertToMap(pin),
-	}
-	if m, ok := pin.(map[string]interface{}); ok {
-		if v, ok := m["pinnedBy"].(string); ok {
-			payload.PinnedBy = v
-		}
-		if v, ok := m["pinnedAt"].(string); ok {
-			payload.PinnedAt = v
-		}
+		MessageID: output.MessageID,
+		PinnedBy:  output.PinnedBy,
+		PinnedAt:  output.PinnedAt,
 	}
-	data, err := websocket.SendServerMessage(websocket.EventTypePinDeleted, payload)
+	data, err := websocket.SendServerMessage(eventType, payload)
 	if err != nil {
-		log.Printf("pin_deletedイベントのエンコードに失敗しました: %v", err)
+		log.Printf("%sイベントのエンコードに失敗しました: %v", eventType, err)
 		return
 	}
 	s.hub.BroadcastToChannel(workspaceID, channelID, data)
@@ -169,60 +196,261 @@ func (s *WebSocketNotificationService) NotifyUnreadCount(workspaceID string, use
 		return
 	}
 
-	s.hub.BroadcastToUser(workspaceID, userID, data)
+	// 未送信の同じチャンネルの未読数は最新の値で置き換える
+	s.hub.BroadcastToUserCoalesced(workspaceID, userID, websocket.UnreadCountCoalesceKey(channelID), data)
 	log.Printf("Notified unread count to workspace=%s user=%s channel=%s count=%d mention=%t", workspaceID, userID, channelID, unreadCount, hasMention)
 }
 
-// convertToMap は任意の構造体をmap[string]interface{}に変換します
-func convertToMap(data interface{}) map[string]interface{} {
-	// データが既にマップの場合はそのまま返す
-	if m, ok := data.(map[string]interface{}); ok {
-		return m
+// NotifyReadReceipt はDM・グループDMのメンバーが既読位置を進めたことを他のメンバーに通知します
+func (s *WebSocketNotificationService) NotifyReadReceipt(workspaceID string, channelID string, userID string, lastReadAt time.Time, recipientIDs []string) {
+	payload := websocket.ReadReceiptPayload{
+		ChannelID:  channelID,
+		UserID:     userID,
+		LastReadAt: lastReadAt,
+	}
+
+	data, err := websocket.SendServerMessage(websocket.EventTypeReadReceipt, payload)
+	if err != nil {
+		log.Printf("read_receiptイベントのエンコードに失敗しました: %v", err)
+		return
 	}
 
-	// リフレクションを使った効率的な変換
-	return convertStructToMap(data)
+	// 未送信の同じメンバーの既読は最新の値で置き換える
+	coalesceKey := websocket.ReadReceiptCoalesceKey(channelID, userID)
+	for _, recipientID := range recipientIDs {
+		s.hub.BroadcastToUserCoalesced(workspaceID, recipientID, coalesceKey, data)
+	}
 }
 
-// convertStructToMap はリフレクションを使って構造体をmap[string]interface{}に変換します
-func convertStructToMap(data interface{}) map[string]interface{} {
-	result := make(map[string]interface{})
+// NotifyDraftUpdated は下書きの保存・削除をユーザーの接続に通知します
+func (s *WebSocketNotificationService) NotifyDraftUpdated(workspaceID string, userID string, channelID string, parentID *string, draft *entity.Draft) {
+	payload := websocket.DraftUpdatedPayload{
+		ChannelID:     channelID,
+		ParentID:      parentID,
+		AttachmentIDs: []string{},
+		Deleted:       draft == nil,
+		UpdatedAt:     time.Now(),
+	}
+	if draft != nil {
+		payload.Body = draft.Body
+		payload.AttachmentIDs = draft.AttachmentIDs
+		payload.UpdatedAt = draft.UpdatedAt
+	}
 
-	v := reflect.ValueOf(data)
-	if v.Kind() == reflect.Ptr {
-		v = v.Elem()
+	data, err := websocket.SendServerMessage(websocket.EventTypeDraftUpdated, payload)
+	if err != nil {
+		log.Printf("draft_updatedイベントのエンコードに失敗しました: %v", err)
+		return
 	}
 
-	if v.Kind() != reflect.Struct {
-		log.Printf("警告: データが構造体ではありません (kind=%v)", v.Kind())
-		return result
+	// 未送信の同じ下書きの更新は最新の内容で置き換える
+	s.hub.BroadcastToUserCoalesced(workspaceID, userID, websocket.DraftUpdatedCoalesceKey(channelID, parentID), data)
+}
+
+// NotifyReminderDue はリマインダーの通知日時になったことをユーザーの接続に通知します
+func (s *WebSocketNotificationService) NotifyReminderDue(workspaceID string, userID string, reminder *entity.Reminder, systemMessage *entity.SystemMessage) {
+	if reminder == nil || systemMessage == nil {
+		return
+	}
+	payload := websocket.ReminderDuePayload{
+		ReminderID: reminder.ID,
+		MessageID:  reminder.MessageID,
+		ChannelID:  reminder.ChannelID,
+		RemindAt:   reminder.RemindAt,
+		SystemMessage: websocket.SystemMessageData{
+			ID:        systemMessage.ID,
+			ChannelID: systemMessage.ChannelID,
+			Kind:      string(systemMessage.Kind),
+			Payload:   systemMessage.Payload,
+			ActorID:   systemMessage.ActorID,
+			CreatedAt: systemMessage.CreatedAt,
+		},
 	}
 
-	t := v.Type()
-	for i := 0; i < v.NumField(); i++ {
-		field := t.Field(i)
-		fieldValue := v.Field(i)
+	data, err := websocket.SendServerMessage(websocket.EventTypeReminderDue, payload)
+	if err != nil {
+		log.Printf("reminder_dueイベントのエンコードに失敗しました: %v", err)
+		return
+	}
 
-		// フィールド名を取得（jsonタグがあればそれを使用）
-		fieldName := field.Name
-		if jsonTag := field.Tag.Get("json"); jsonTag != "" && jsonTag != "-" {
-			// jsonタグからカンマ前の部分を取得
-			if commaIndex := len(jsonTag); commaIndex > 0 {
-				for j, c := range jsonTag {
-					if c == ',' {
-						commaIndex = j
-						break
-					}
-				}
-				fieldName = jsonTag[:commaIndex]
-			}
-		}
+	// リマインダーごとに1回のみ送信するため、同種のイベントで置き換えない
+	s.hub.BroadcastToUser(workspaceID, userID, data)
+	log.Printf("Notified reminder to workspace=%s user=%s reminder=%s", workspaceID, userID, reminder.ID)
+}
+
+// NotifyEphemeralMessage は一時的なメッセージを宛先のユーザーの接続のみに通知します
+func (s *WebSocketNotificationService) NotifyEphemeralMessage(workspaceID string, userID string, message *entity.EphemeralMessage) {
+	if message == nil {
+		return
+	}
+	payload := websocket.EphemeralMessagePayload{
+		ChannelID: message.ChannelID,
+		Message: websocket.EphemeralMessageData{
+			ID:        message.ID,
+			ChannelID: message.ChannelID,
+			Kind:      string(message.Kind),
+			Body:      message.Body,
+			Payload:   message.Payload,
+			CreatedAt: message.CreatedAt,
+			ExpiresAt: message.ExpiresAt,
+		},
+	}
+
+	data, err := websocket.SendServerMessage(websocket.EventTypeEphemeralMessage, payload)
+	if err != nil {
+		log.Printf("ephemeral_messageイベントのエンコードに失敗しました: %v", err)
+		return
+	}
+
+	// 宛先のユーザー以外には表示しないため、チャンネルの購読者には送信しない
+	s.hub.BroadcastToUser(workspaceID, userID, data)
+}
 
-		// フィールドが公開されている場合のみ処理
-		if fieldValue.CanInterface() {
-			result[fieldName] = fieldValue.Interface()
+// NotifyPollUpdated は投票の集計の更新をチャンネル参加者に通知します
+func (s *WebSocketNotificationService) NotifyPollUpdated(workspaceID string, channelID string, poll *entity.Poll) {
+	if poll == nil {
+		return
+	}
+	options := make([]websocket.PollOptionData, 0, len(poll.Options))
+	for _, option := range poll.Options {
+		data := websocket.PollOptionData{
+			ID:        option.ID,
+			VoteCount: len(option.VoterIDs),
+		}
+		if !poll.Anonymous {
+			data.VoterIDs = option.VoterIDs
 		}
+		options = append(options, data)
+	}
+	payload := websocket.PollUpdatedPayload{
+		ChannelID:  channelID,
+		MessageID:  poll.MessageID,
+		PollID:     poll.ID,
+		ClosesAt:   poll.ClosesAt,
+		VoterCount: poll.VoterCount(),
+		Options:    options,
+	}
+
+	data, err := websocket.SendServerMessage(websocket.EventTypePollUpdated, payload)
+	if err != nil {
+		log.Printf("poll_updatedイベントのエンコードに失敗しました: %v", err)
+		return
+	}
+
+	// 未送信の同じ投票の集計は最新の値で置き換える
+	s.hub.BroadcastToChannelCoalesced(workspaceID, channelID, websocket.PollUpdatedCoalesceKey(poll.ID), data)
+}
+
+// NotifyThreadReply はスレッドへの返信をスレッドの購読者に通知します
+func (s *WebSocketNotificationService) NotifyThreadReply(workspaceID string, channelID string, threadID string, message interface{}) {
+	output, ok := toMessageOutput(message)
+	if !ok {
+		log.Printf("thread_reply_createdイベントの生成に失敗しました: 未対応のデータ型です (%T)", message)
+		return
+	}
+	payload := websocket.ThreadReplyCreatedPayload{
+		ChannelID: channelID,
+		ThreadID:  threadID,
+		Message:   output,
+	}
+
+	data, err := websocket.SendServerMessage(websocket.EventTypeThreadReplyCreated, payload)
+	if err != nil {
+		log.Printf("thread_reply_createdイベントのエンコードに失敗しました: %v", err)
+		return
+	}
+
+	s.hub.BroadcastToThreadSubscribers(workspaceID, channelID, threadID, data)
+	log.Printf("Notified thread reply to workspace=%s channel=%s thread=%s", workspaceID, channelID, threadID)
+}
+
+// NotifyThreadUpdated はスレッドのメタデータの更新をチャンネル購読者に通知します
+func (s *WebSocketNotificationService) NotifyThreadUpdated(workspaceID string, channelID string, metadata interface{}) {
+	meta, ok := metadata.(*domainrepository.ThreadMetadata)
+	if !ok || meta == nil {
+		log.Printf("thread_updatedイベントの生成に失敗しました: 未対応のデータ型です (%T)", metadata)
+		return
+	}
+	payload := websocket.ThreadUpdatedPayload{
+		ChannelID:          channelID,
+		ThreadID:           meta.MessageID,
+		ReplyCount:         meta.ReplyCount,
+		LastReplyAt:        meta.LastReplyAt,
+		LastReplyUserID:    meta.LastReplyUserID,
+		ParticipantUserIDs: meta.ParticipantUserIDs,
 	}
 
-	return result
+	data, err := websocket.SendServerMessage(websocket.EventTypeThreadUpdated, payload)
+	if err != nil {
+		log.Printf("thread_updatedイベントのエンコードに失敗しました: %v", err)
+		return
+	}
+
+	// 未送信の同じスレッドのメタデータは最新の値で置き換える
+	s.hub.BroadcastToChannelCoalesced(workspaceID, channelID, websocket.ThreadUpdatedCoalesceKey(meta.MessageID), data)
+}
+
+// NotifyThreadUnread はフォローしているスレッドの未読数の更新を特定ユーザーに通知します
+func (s *WebSocketNotificationService) NotifyThreadUnread(workspaceID string, userID string, channelID string, threadID string, unreadCount int) {
+	payload := websocket.ThreadUnreadPayload{
+		ChannelID:   channelID,
+		ThreadID:    threadID,
+		UnreadCount: unreadCount,
+	}
+
+	data, err := websocket.SendServerMessage(websocket.EventTypeThreadUnread, payload)
+	if err != nil {
+		log.Printf("thread_unreadイベントのエンコードに失敗しました: %v", err)
+		return
+	}
+
+	s.hub.BroadcastToUserCoalesced(workspaceID, userID, websocket.ThreadUnreadCoalesceKey(threadID), data)
+}
+
+// NotifyChannelMemberRemoved はチャンネルから削除されたユーザーの購読を取り消します
+func (s *WebSocketNotificationService) NotifyChannelMemberRemoved(workspaceID string, channelID string, userID string) {
+	s.hub.RevokeChannelAccess(workspaceID, channelID, []string{userID}, websocket.RevokeReasonRemoved)
+}
+
+// NotifyChannelMemberLeft はチャンネルから退出したユーザーの購読を取り消します
+func (s *WebSocketNotificationService) NotifyChannelMemberLeft(workspaceID string, channelID string, userID string) {
+	s.hub.RevokeChannelAccess(workspaceID, channelID, []string{userID}, websocket.RevokeReasonLeft)
+}
+
+// NotifyChannelPrivatized はプライベート化されたチャンネルのメンバー以外の購読を取り消します
+func (s *WebSocketNotificationService) NotifyChannelPrivatized(workspaceID string, channelID string, memberIDs []string) {
+	s.hub.RestrictChannelSubscribers(workspaceID, channelID, memberIDs, websocket.RevokeReasonChannelPrivate)
+}
+
+// NotifyChannelMembersAdded はチャンネルに追加されたユーザーの接続のサイドバーにチャンネルを追加します
+func (s *WebSocketNotificationService) NotifyChannelMembersAdded(workspaceID string, channelID string, userIDs []string) {
+	s.hub.AddSidebarChannel(workspaceID, channelID, userIDs)
+}
+
+// NotifyChannelMembersRemoved はチャンネルから外れたユーザーの接続のサイドバーからチャンネルを削除します
+func (s *WebSocketNotificationService) NotifyChannelMembersRemoved(workspaceID string, channelID string, userIDs []string) {
+	s.hub.RemoveSidebarChannel(workspaceID, channelID, userIDs)
+}
+
+// NotifyWorkspaceMemberAdded はWorkspaceに参加したユーザーの接続にWorkspaceを追加します
+func (s *WebSocketNotificationService) NotifyWorkspaceMemberAdded(workspaceID string, userID string) {
+	s.hub.AddWorkspaceMember(workspaceID, userID)
+}
+
+// NotifyWorkspaceMemberRemoved はWorkspaceから削除されたユーザーの接続からWorkspaceを外します
+func (s *WebSocketNotificationService) NotifyWorkspaceMemberRemoved(workspaceID string, userID string) {
+	s.hub.RemoveWorkspaceMember(workspaceID, userID)
+}
+
+// toMessageOutput は通知データをメッセージの出力形式に変換します
+func toMessageOutput(data interface{}) (messageuc.MessageOutput, bool) {
+	switch v := data.(type) {
+	case messageuc.MessageOutput:
+		return v, true
+	case *messageuc.MessageOutput:
+		if v != nil {
+			return *v, true
+		}
+	}
+	return messageuc.MessageOutput{}, false
 }
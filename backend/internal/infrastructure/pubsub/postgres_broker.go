@@ -0,0 +1,285 @@
+package pubsub
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+
+	"github.com/newt239/chat/internal/interfaces/handler/websocket"
+)
+
+const (
+	// notifyChannel はLISTEN/NOTIFYで使用するチャンネル名です
+	notifyChannel = "chat_ws_broadcast"
+
+	// maxChunkSize は1回のNOTIFYで送信するデータ部の最大バイト数です
+	// NOTIFYのペイロード上限(8000バイト)からヘッダー分を差し引いた値にしています
+	maxChunkSize = 7000
+
+	// incompleteMessageTTL は全チャンクが揃わなかったメッセージを破棄するまでの時間です
+	incompleteMessageTTL = 30 * time.Second
+
+	// publishTimeout はNOTIFY送信のタイムアウトです
+	publishTimeout = 5 * time.Second
+)
+
+// wireMessage はインスタンス間で送受信するブロードキャストメッセージの形式です
+type wireMessage struct {
+	WorkspaceID string  `json:"workspace_id"`
+	ChannelID   *string `json:"channel_id,omitempty"`
+	ThreadID    *string `json:"thread_id,omitempty"`
+	UserID      *string `json:"user_id,omitempty"`
+	ExcludeUser *string `json:"exclude_user,omitempty"`
+	Data        []byte  `json:"data"`
+	CoalesceKey string  `json:"coalesce_key,omitempty"`
+
+	Revocation *websocket.SubscriptionRevocation    `json:"revocation,omitempty"`
+	Membership *websocket.WorkspaceMembershipChange `json:"membership,omitempty"`
+	Typing     *websocket.TypingChange              `json:"typing,omitempty"`
+	Sidebar    *websocket.SidebarChange             `json:"sidebar,omitempty"`
+	Activity   *websocket.ChannelActivity           `json:"activity,omitempty"`
+}
+
+// pendingMessage は受信途中の分割メッセージを表します
+type pendingMessage struct {
+	chunks    []string
+	received  int
+	firstSeen time.Time
+}
+
+// PostgresBroker はPostgreSQLのLISTEN/NOTIFYを利用したブローカーです
+// 8000バイトを超えるメッセージはJSONのまま複数のNOTIFYに分割し、
+// 同一トランザクションで送信することで受信側で順序通りに再構築します
+type PostgresBroker struct {
+	dsn      string
+	db       *sql.DB
+	listener *pq.Listener
+	pending  map[string]*pendingMessage
+	done     chan struct{}
+	once     sync.Once
+}
+
+// NewPostgresBroker は新しいPostgresBrokerを作成します
+func NewPostgresBroker(dsn string) (*PostgresBroker, error) {
+	db, err := sql.Open("postgres", dsn)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open database: %w", err)
+	}
+
+	return &PostgresBroker{
+		dsn:     dsn,
+		db:      db,
+		pending: make(map[string]*pendingMessage),
+		done:    make(chan struct{}),
+	}, nil
+}
+
+// Publish はメッセージをNOTIFYで全インスタンスへ送信します
+func (b *PostgresBroker) Publish(msg *websocket.BroadcastMessage) error {
+	encoded, err := json.Marshal(wireMessage{
+		WorkspaceID: msg.WorkspaceID,
+		ChannelID:   msg.ChannelID,
+		ThreadID:    msg.ThreadID,
+		UserID:      msg.UserID,
+		ExcludeUser: msg.ExcludeUser,
+		Data:        msg.Data,
+		CoalesceKey: msg.CoalesceKey,
+		Revocation:  msg.Revocation,
+		Membership:  msg.Membership,
+		Typing:      msg.Typing,
+		Sidebar:     msg.Sidebar,
+		Activity:    msg.Activity,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to encode broadcast message: %w", err)
+	}
+
+	// JSONはNOTIFYのペイロードにそのまま使えるテキストのため、エンコードし直さずに分割する
+	payloads := splitPayload(uuid.NewString(), string(encoded))
+
+	ctx, cancel := context.WithTimeout(context.Background(), publishTimeout)
+	defer cancel()
+
+	// 分割したチャンクが他のメッセージと混ざらないよう1トランザクションで送信する
+	tx, err := b.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	for _, payload := range payloads {
+		if _, err := tx.ExecContext(ctx, "SELECT pg_notify($1, $2)", notifyChannel, payload); err != nil {
+			_ = tx.Rollback()
+			return fmt.Errorf("failed to notify: %w", err)
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit notifications: %w", err)
+	}
+
+	return nil
+}
+
+// Subscribe はLISTENを開始し、受信したメッセージをハンドラーに渡します
+func (b *PostgresBroker) Subscribe(handler func(*websocket.BroadcastMessage)) error {
+	b.listener = pq.NewListener(b.dsn, 10*time.Second, time.Minute, func(event pq.ListenerEventType, err error) {
+		switch event {
+		case pq.ListenerEventConnectionAttemptFailed, pq.ListenerEventDisconnected:
+			log.Printf("[PubSub] LISTEN接続エラー: event=%d err=%v", event, err)
+		case pq.ListenerEventReconnected:
+			log.Printf("[PubSub] LISTEN再接続しました（切断中のメッセージは失われた可能性があります）")
+		}
+	})
+	if err := b.listener.Listen(notifyChannel); err != nil {
+		return fmt.Errorf("failed to listen channel %s: %w", notifyChannel, err)
+	}
+
+	go b.listen(handler)
+
+	log.Printf("[PubSub] LISTEN開始: channel=%s", notifyChannel)
+	return nil
+}
+
+// Close はLISTEN接続とデータベース接続を終了します
+func (b *PostgresBroker) Close() error {
+	var err error
+	b.once.Do(func() {
+		close(b.done)
+		if b.listener != nil {
+			err = b.listener.Close()
+		}
+		if dbErr := b.db.Close(); err == nil {
+			err = dbErr
+		}
+	})
+	return err
+}
+
+// listen は通知を受信し、分割されたメッセージを再構築してハンドラーに渡します
+func (b *PostgresBroker) listen(handler func(*websocket.BroadcastMessage)) {
+	ticker := time.NewTicker(incompleteMessageTTL)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-b.done:
+			return
+
+		case n, ok := <-b.listener.Notify:
+			if !ok {
+				return
+			}
+			// 再接続時にはnilが送られてくる
+			if n == nil {
+				continue
+			}
+			msg, err := b.receive(n.Extra)
+			if err != nil {
+				log.Printf("[PubSub] 通知の解析に失敗しました: %v", err)
+				continue
+			}
+			if msg != nil {
+				handler(msg)
+			}
+
+		case <-ticker.C:
+			b.discardStale()
+		}
+	}
+}
+
+// receive は1件の通知を処理し、全チャンクが揃った場合にメッセージを返します
+func (b *PostgresBroker) receive(payload string) (*websocket.BroadcastMessage, error) {
+	parts := strings.SplitN(payload, ":", 4)
+	if len(parts) != 4 {
+		return nil, fmt.Errorf("invalid payload format")
+	}
+	id := parts[0]
+	seq, err := strconv.Atoi(parts[1])
+	if err != nil {
+		return nil, fmt.Errorf("invalid chunk sequence: %w", err)
+	}
+	total, err := strconv.Atoi(parts[2])
+	if err != nil || total <= 0 || seq < 0 || seq >= total {
+		return nil, fmt.Errorf("invalid chunk count: seq=%d total=%d", seq, total)
+	}
+
+	var encoded string
+	if total == 1 {
+		encoded = parts[3]
+	} else {
+		p, ok := b.pending[id]
+		if !ok {
+			p = &pendingMessage{chunks: make([]string, total), firstSeen: time.Now()}
+			b.pending[id] = p
+		}
+		if p.chunks[seq] == "" {
+			p.chunks[seq] = parts[3]
+			p.received++
+		}
+		if p.received < total {
+			return nil, nil
+		}
+		delete(b.pending, id)
+		encoded = strings.Join(p.chunks, "")
+	}
+
+	var wire wireMessage
+	if err := json.Unmarshal([]byte(encoded), &wire); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal payload: %w", err)
+	}
+
+	return &websocket.BroadcastMessage{
+		WorkspaceID: wire.WorkspaceID,
+		ChannelID:   wire.ChannelID,
+		ThreadID:    wire.ThreadID,
+		UserID:      wire.UserID,
+		ExcludeUser: wire.ExcludeUser,
+		Data:        wire.Data,
+		CoalesceKey: wire.CoalesceKey,
+		Revocation:  wire.Revocation,
+		Membership:  wire.Membership,
+		Typing:      wire.Typing,
+		Sidebar:     wire.Sidebar,
+		Activity:    wire.Activity,
+	}, nil
+}
+
+// discardStale は一定時間内に揃わなかった分割メッセージを破棄します
+func (b *PostgresBroker) discardStale() {
+	for id, p := range b.pending {
+		if time.Since(p.firstSeen) > incompleteMessageTTL {
+			log.Printf("[PubSub] 不完全なメッセージを破棄しました: id=%s received=%d/%d", id, p.received, len(p.chunks))
+			delete(b.pending, id)
+		}
+	}
+}
+
+// splitPayload はデータを「ID:連番:総数:データ」形式のNOTIFYペイロードに分割します
+// 各チャンクが正しいUTF-8のテキストになるよう、文字の途中では分割しません
+func splitPayload(id string, data string) []string {
+	var chunks []string
+	for len(data) > maxChunkSize {
+		end := maxChunkSize
+		for end > 0 && !utf8.RuneStart(data[end]) {
+			end--
+		}
+		chunks = append(chunks, data[:end])
+		data = data[end:]
+	}
+	chunks = append(chunks, data)
+
+	payloads := make([]string, 0, len(chunks))
+	for seq, chunk := range chunks {
+		payloads = append(payloads, fmt.Sprintf("%s:%d:%d:%s", id, seq, len(chunks), chunk))
+	}
+	return payloads
+}
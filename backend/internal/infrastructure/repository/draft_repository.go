@@ -0,0 +1,230 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/newt239/chat/ent"
+	"github.com/newt239/chat/ent/channel"
+	"github.com/newt239/chat/ent/draft"
+	"github.com/newt239/chat/ent/message"
+	"github.com/newt239/chat/ent/predicate"
+	"github.com/newt239/chat/ent/user"
+	"github.com/newt239/chat/ent/workspace"
+	"github.com/newt239/chat/internal/domain/entity"
+	domainrepository "github.com/newt239/chat/internal/domain/repository"
+	"github.com/newt239/chat/internal/infrastructure/transaction"
+	"github.com/newt239/chat/internal/infrastructure/utils"
+)
+
+type draftRepository struct {
+	client *ent.Client
+}
+
+func NewDraftRepository(client *ent.Client) domainrepository.DraftRepository {
+	return &draftRepository{client: client}
+}
+
+func (r *draftRepository) Find(ctx context.Context, userID, channelID string, parentID *string) (*entity.Draft, error) {
+	scope, err := draftScope(userID, channelID, parentID)
+	if err != nil {
+		return nil, err
+	}
+
+	client := transaction.ResolveClient(ctx, r.client)
+	return r.find(ctx, client, scope)
+}
+
+func (r *draftRepository) find(ctx context.Context, client *ent.Client, scope predicate.Draft) (*entity.Draft, error) {
+	d, err := r.query(client).
+		Where(scope).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return draftToEntity(d), nil
+}
+
+func (r *draftRepository) FindByWorkspaceID(ctx context.Context, userID, workspaceID string) ([]*entity.Draft, error) {
+	uid, err := utils.ParseUUID(userID, "user ID")
+	if err != nil {
+		return nil, err
+	}
+
+	client := transaction.ResolveClient(ctx, r.client)
+	rows, err := r.query(client).
+		Where(
+			draft.HasUserWith(user.ID(uid)),
+			draft.HasChannelWith(channel.HasWorkspaceWith(workspace.ID(workspaceID))),
+		).
+		Order(ent.Desc(draft.FieldUpdatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*entity.Draft, 0, len(rows))
+	for _, d := range rows {
+		result = append(result, draftToEntity(d))
+	}
+	return result, nil
+}
+
+func (r *draftRepository) Save(ctx context.Context, d *entity.Draft) error {
+	scope, err := draftScope(d.UserID, d.ChannelID, d.ParentID)
+	if err != nil {
+		return err
+	}
+
+	client := transaction.ResolveClient(ctx, r.client)
+
+	saved, err := r.update(ctx, client, scope, d)
+	if err != nil {
+		return err
+	}
+	if saved == nil {
+		saved, err = r.create(ctx, client, d)
+		if ent.IsConstraintError(err) {
+			// 他の端末から同時に作成された場合は作成された下書きを更新する
+			saved, err = r.update(ctx, client, scope, d)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	*d = *saved
+	return nil
+}
+
+// update は既存の下書きの本文と添付ファイルを置き換えます
+// 下書きが存在しない場合はnilを返します
+func (r *draftRepository) update(ctx context.Context, client *ent.Client, scope predicate.Draft, d *entity.Draft) (*entity.Draft, error) {
+	affected, err := client.Draft.Update().
+		Where(scope).
+		SetBody(d.Body).
+		SetAttachmentIds(d.AttachmentIDs).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if affected == 0 {
+		return nil, nil
+	}
+	return r.find(ctx, client, scope)
+}
+
+func (r *draftRepository) create(ctx context.Context, client *ent.Client, d *entity.Draft) (*entity.Draft, error) {
+	userID, err := utils.ParseUUID(d.UserID, "user ID")
+	if err != nil {
+		return nil, err
+	}
+	channelID, err := utils.ParseUUID(d.ChannelID, "channel ID")
+	if err != nil {
+		return nil, err
+	}
+
+	builder := client.Draft.Create().
+		SetUserID(userID).
+		SetChannelID(channelID).
+		SetBody(d.Body).
+		SetAttachmentIds(d.AttachmentIDs)
+
+	if d.ParentID != nil {
+		parentID, err := utils.ParseUUID(*d.ParentID, "parent message ID")
+		if err != nil {
+			return nil, err
+		}
+		builder = builder.SetParentID(parentID)
+	}
+
+	created, err := builder.Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return r.find(ctx, client, draft.ID(created.ID))
+}
+
+func (r *draftRepository) Delete(ctx context.Context, userID, channelID string, parentID *string) (bool, error) {
+	scope, err := draftScope(userID, channelID, parentID)
+	if err != nil {
+		return false, err
+	}
+
+	client := transaction.ResolveClient(ctx, r.client)
+	affected, err := client.Draft.Delete().
+		Where(scope).
+		Exec(ctx)
+	if err != nil {
+		return false, err
+	}
+	return affected > 0, nil
+}
+
+// draftScope はユーザー・チャンネル・スレッドで下書きを絞り込む条件を返します
+func draftScope(userID, channelID string, parentID *string) (predicate.Draft, error) {
+	uid, err := utils.ParseUUID(userID, "user ID")
+	if err != nil {
+		return nil, err
+	}
+	cid, err := utils.ParseUUID(channelID, "channel ID")
+	if err != nil {
+		return nil, err
+	}
+
+	parentScope := draft.Not(draft.HasParent())
+	if parentID != nil {
+		pid, err := utils.ParseUUID(*parentID, "parent message ID")
+		if err != nil {
+			return nil, err
+		}
+		parentScope = draft.HasParentWith(message.ID(pid))
+	}
+
+	return draft.And(
+		draft.HasUserWith(user.ID(uid)),
+		draft.HasChannelWith(channel.ID(cid)),
+		parentScope,
+	), nil
+}
+
+func (r *draftRepository) query(client *ent.Client) *ent.DraftQuery {
+	return client.Draft.Query().
+		WithUser().
+		WithChannel().
+		WithParent()
+}
+
+func draftToEntity(d *ent.Draft) *entity.Draft {
+	var userID, channelID string
+	if d.Edges.User != nil {
+		userID = d.Edges.User.ID.String()
+	}
+	if d.Edges.Channel != nil {
+		channelID = d.Edges.Channel.ID.String()
+	}
+
+	var parentID *string
+	if d.Edges.Parent != nil {
+		pid := d.Edges.Parent.ID.String()
+		parentID = &pid
+	}
+
+	attachmentIDs := d.AttachmentIds
+	if attachmentIDs == nil {
+		attachmentIDs = []string{}
+	}
+
+	return &entity.Draft{
+		ID:            d.ID.String(),
+		UserID:        userID,
+		ChannelID:     channelID,
+		ParentID:      parentID,
+		Body:          d.Body,
+		AttachmentIDs: attachmentIDs,
+		CreatedAt:     d.CreatedAt,
+		UpdatedAt:     d.UpdatedAt,
+	}
+}
@@ -0,0 +1,208 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/newt239/chat/ent"
+	"github.com/newt239/chat/ent/channel"
+	"github.com/newt239/chat/ent/ephemeralmessage"
+	"github.com/newt239/chat/ent/user"
+	"github.com/newt239/chat/internal/domain/entity"
+	domainrepository "github.com/newt239/chat/internal/domain/repository"
+	"github.com/newt239/chat/internal/infrastructure/transaction"
+	"github.com/newt239/chat/internal/infrastructure/utils"
+)
+
+type ephemeralMessageRepository struct {
+	client *ent.Client
+}
+
+func NewEphemeralMessageRepository(client *ent.Client) domainrepository.EphemeralMessageRepository {
+	return &ephemeralMessageRepository{client: client}
+}
+
+func (r *ephemeralMessageRepository) Create(ctx context.Context, msg *entity.EphemeralMessage) error {
+	if msg.ExpiresAt == nil {
+		return fmt.Errorf("expires at is required to persist ephemeral message")
+	}
+	channelID, err := utils.ParseUUID(msg.ChannelID, "channel ID")
+	if err != nil {
+		return err
+	}
+	recipientID, err := utils.ParseUUID(msg.RecipientID, "recipient ID")
+	if err != nil {
+		return err
+	}
+
+	client := transaction.ResolveClient(ctx, r.client)
+
+	builder := client.EphemeralMessage.Create().
+		SetChannelID(channelID).
+		SetRecipientID(recipientID).
+		SetKind(string(msg.Kind)).
+		SetBody(msg.Body).
+		SetExpiresAt(*msg.ExpiresAt)
+
+	if msg.ID != "" {
+		id, err := utils.ParseUUID(msg.ID, "ephemeral message ID")
+		if err != nil {
+			return err
+		}
+		builder = builder.SetID(id)
+	}
+	if msg.Payload != nil {
+		builder = builder.SetPayload(msg.Payload)
+	}
+	if !msg.CreatedAt.IsZero() {
+		builder = builder.SetCreatedAt(msg.CreatedAt)
+	}
+
+	created, err := builder.Save(ctx)
+	if err != nil {
+		return err
+	}
+
+	msg.ID = created.ID.String()
+	msg.CreatedAt = created.CreatedAt
+	return nil
+}
+
+func (r *ephemeralMessageRepository) FindActiveByChannelID(ctx context.Context, channelID string, recipientID string, now time.Time, limit int, since *time.Time, until *time.Time) ([]*entity.EphemeralMessage, error) {
+	q, err := r.activeQuery(ctx, channelID, recipientID, now)
+	if err != nil {
+		return nil, err
+	}
+
+	if since != nil {
+		q = q.Where(ephemeralmessage.CreatedAtGT(*since))
+	}
+	if until != nil {
+		q = q.Where(ephemeralmessage.CreatedAtLT(*until))
+	}
+	if limit > 0 {
+		q = q.Limit(limit)
+	}
+
+	rows, err := q.
+		WithChannel().
+		WithRecipient().
+		Order(ent.Desc(ephemeralmessage.FieldCreatedAt), ent.Desc(ephemeralmessage.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]*entity.EphemeralMessage, 0, len(rows))
+	for _, row := range rows {
+		out = append(out, ephemeralMessageToEntity(row))
+	}
+	return out, nil
+}
+
+func (r *ephemeralMessageRepository) FindActiveByChannelIDBefore(ctx context.Context, channelID string, recipientID string, now time.Time, cursor entity.TimelineCursor, limit int) ([]*entity.EphemeralMessage, error) {
+	return r.findActiveFromCursor(ctx, channelID, recipientID, now, cursor, false, limit)
+}
+
+func (r *ephemeralMessageRepository) FindActiveByChannelIDAfter(ctx context.Context, channelID string, recipientID string, now time.Time, cursor entity.TimelineCursor, limit int) ([]*entity.EphemeralMessage, error) {
+	return r.findActiveFromCursor(ctx, channelID, recipientID, now, cursor, true, limit)
+}
+
+// findActiveFromCursor は作成日時・IDの順で cursor の前または後にある一時的なメッセージを、cursor に近いものから取得して新しい順に返します
+func (r *ephemeralMessageRepository) findActiveFromCursor(ctx context.Context, channelID string, recipientID string, now time.Time, cursor entity.TimelineCursor, after bool, limit int) ([]*entity.EphemeralMessage, error) {
+	q, err := r.activeQuery(ctx, channelID, recipientID, now)
+	if err != nil {
+		return nil, err
+	}
+	cursorID, err := utils.ParseUUID(cursor.ID, "cursor ID")
+	if err != nil {
+		return nil, err
+	}
+
+	if after {
+		q = q.
+			Where(ephemeralmessage.Or(
+				ephemeralmessage.CreatedAtGT(cursor.CreatedAt),
+				ephemeralmessage.And(ephemeralmessage.CreatedAtEQ(cursor.CreatedAt), ephemeralmessage.IDGT(cursorID)),
+			)).
+			Order(ent.Asc(ephemeralmessage.FieldCreatedAt), ent.Asc(ephemeralmessage.FieldID))
+	} else {
+		q = q.
+			Where(ephemeralmessage.Or(
+				ephemeralmessage.CreatedAtLT(cursor.CreatedAt),
+				ephemeralmessage.And(ephemeralmessage.CreatedAtEQ(cursor.CreatedAt), ephemeralmessage.IDLT(cursorID)),
+			)).
+			Order(ent.Desc(ephemeralmessage.FieldCreatedAt), ent.Desc(ephemeralmessage.FieldID))
+	}
+	if limit > 0 {
+		q = q.Limit(limit)
+	}
+
+	rows, err := q.
+		WithChannel().
+		WithRecipient().
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]*entity.EphemeralMessage, 0, len(rows))
+	for _, row := range rows {
+		out = append(out, ephemeralMessageToEntity(row))
+	}
+	if after {
+		slices.Reverse(out)
+	}
+	return out, nil
+}
+
+// activeQuery はチャンネルの recipientID のユーザー宛ての一時的なメッセージのうち、now の時点で有効期限内のものを絞り込むクエリを返します
+func (r *ephemeralMessageRepository) activeQuery(ctx context.Context, channelID string, recipientID string, now time.Time) (*ent.EphemeralMessageQuery, error) {
+	chID, err := utils.ParseUUID(channelID, "channel ID")
+	if err != nil {
+		return nil, err
+	}
+	recipientUUID, err := utils.ParseUUID(recipientID, "recipient ID")
+	if err != nil {
+		return nil, err
+	}
+
+	client := transaction.ResolveClient(ctx, r.client)
+	return client.EphemeralMessage.Query().
+		Where(
+			ephemeralmessage.HasChannelWith(channel.ID(chID)),
+			ephemeralmessage.HasRecipientWith(user.ID(recipientUUID)),
+			ephemeralmessage.ExpiresAtGT(now),
+		), nil
+}
+
+func (r *ephemeralMessageRepository) DeleteExpired(ctx context.Context, now time.Time) (int, error) {
+	client := transaction.ResolveClient(ctx, r.client)
+	return client.EphemeralMessage.Delete().
+		Where(ephemeralmessage.ExpiresAtLTE(now)).
+		Exec(ctx)
+}
+
+func ephemeralMessageToEntity(row *ent.EphemeralMessage) *entity.EphemeralMessage {
+	var channelID, recipientID string
+	if row.Edges.Channel != nil {
+		channelID = row.Edges.Channel.ID.String()
+	}
+	if row.Edges.Recipient != nil {
+		recipientID = row.Edges.Recipient.ID.String()
+	}
+	expiresAt := row.ExpiresAt
+
+	return &entity.EphemeralMessage{
+		ID:          row.ID.String(),
+		ChannelID:   channelID,
+		RecipientID: recipientID,
+		Kind:        entity.EphemeralMessageKind(row.Kind),
+		Body:        row.Body,
+		Payload:     row.Payload,
+		CreatedAt:   row.CreatedAt,
+		ExpiresAt:   &expiresAt,
+	}
+}
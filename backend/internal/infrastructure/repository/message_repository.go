@@ -2,9 +2,11 @@ package repository
 
 import (
 	"context"
+	"slices"
 	"strings"
 	"time"
 
+	"entgo.io/ent/dialect/sql"
 	"github.com/google/uuid"
 	"github.com/newt239/chat/ent"
 	"github.com/newt239/chat/ent/channel"
@@ -50,6 +52,86 @@ func (r *messageRepository) FindByID(ctx context.Context, id string) (*entity.Me
 	return utils.MessageToEntity(m), nil
 }
 
+func (r *messageRepository) Lock(ctx context.Context, id string) error {
+	messageID, err := utils.ParseUUID(id, "message ID")
+	if err != nil {
+		return err
+	}
+
+	client := transaction.ResolveClient(ctx, r.client)
+	_, err = client.Message.Query().
+		Where(
+			message.ID(messageID),
+			func(s *sql.Selector) {
+				s.ForUpdate()
+			},
+		).
+		OnlyID(ctx)
+	return err
+}
+
+func (r *messageRepository) FindByIDs(ctx context.Context, ids []string) ([]*entity.Message, error) {
+	if len(ids) == 0 {
+		return []*entity.Message{}, nil
+	}
+
+	parsedIDs := make([]uuid.UUID, 0, len(ids))
+	for _, id := range ids {
+		parsedID, err := utils.ParseUUID(id, "message ID")
+		if err != nil {
+			return nil, err
+		}
+		parsedIDs = append(parsedIDs, parsedID)
+	}
+
+	client := transaction.ResolveClient(ctx, r.client)
+	messages, err := client.Message.Query().
+		Where(message.IDIn(parsedIDs...)).
+		WithChannel(func(q *ent.ChannelQuery) {
+			q.WithWorkspace().WithCreatedBy()
+		}).
+		WithUser().
+		WithParent().
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*entity.Message, 0, len(messages))
+	for _, m := range messages {
+		result = append(result, utils.MessageToEntity(m))
+	}
+	return result, nil
+}
+
+func (r *messageRepository) FindByClientMsgID(ctx context.Context, userID string, clientMsgID string) (*entity.Message, error) {
+	uid, err := utils.ParseUUID(userID, "user ID")
+	if err != nil {
+		return nil, err
+	}
+
+	client := transaction.ResolveClient(ctx, r.client)
+	m, err := client.Message.Query().
+		Where(
+			message.ClientMsgID(clientMsgID),
+			message.HasUserWith(user.ID(uid)),
+		).
+		WithChannel(func(q *ent.ChannelQuery) {
+			q.WithWorkspace().WithCreatedBy()
+		}).
+		WithUser().
+		WithParent().
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return utils.MessageToEntity(m), nil
+}
+
 func (r *messageRepository) FindByChannelID(ctx context.Context, channelID string, limit int, since *time.Time, until *time.Time) ([]*entity.Message, error) {
 	chID, err := utils.ParseUUID(channelID, "channel ID")
 	if err != nil {
@@ -82,7 +164,73 @@ func (r *messageRepository) FindByChannelID(ctx context.Context, channelID strin
 		}).
 		WithUser().
 		WithParent().
-		Order(ent.Desc(message.FieldCreatedAt)).
+		Order(ent.Desc(message.FieldCreatedAt), ent.Desc(message.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*entity.Message, 0, len(messages))
+	for _, m := range messages {
+		result = append(result, utils.MessageToEntity(m))
+	}
+
+	return result, nil
+}
+
+func (r *messageRepository) FindByChannelIDBefore(ctx context.Context, channelID string, cursor entity.TimelineCursor, limit int) ([]*entity.Message, error) {
+	return r.findByChannelIDFromCursor(ctx, channelID, cursor, false, limit)
+}
+
+func (r *messageRepository) FindByChannelIDAfter(ctx context.Context, channelID string, cursor entity.TimelineCursor, limit int) ([]*entity.Message, error) {
+	return r.findByChannelIDFromCursor(ctx, channelID, cursor, true, limit)
+}
+
+// findByChannelIDFromCursor は作成日時・IDの順で cursor の前または後にあるメッセージを、cursor に近いものから取得して新しい順に返します
+func (r *messageRepository) findByChannelIDFromCursor(ctx context.Context, channelID string, cursor entity.TimelineCursor, after bool, limit int) ([]*entity.Message, error) {
+	chID, err := utils.ParseUUID(channelID, "channel ID")
+	if err != nil {
+		return nil, err
+	}
+	cursorID, err := utils.ParseUUID(cursor.ID, "cursor ID")
+	if err != nil {
+		return nil, err
+	}
+
+	client := transaction.ResolveClient(ctx, r.client)
+	query := client.Message.Query().
+		Where(
+			message.HasChannelWith(channel.ID(chID)),
+			message.Not(message.HasParent()),
+			message.DeletedAtIsNil(),
+		)
+
+	if after {
+		query = query.
+			Where(message.Or(
+				message.CreatedAtGT(cursor.CreatedAt),
+				message.And(message.CreatedAtEQ(cursor.CreatedAt), message.IDGT(cursorID)),
+			)).
+			Order(ent.Asc(message.FieldCreatedAt), ent.Asc(message.FieldID))
+	} else {
+		query = query.
+			Where(message.Or(
+				message.CreatedAtLT(cursor.CreatedAt),
+				message.And(message.CreatedAtEQ(cursor.CreatedAt), message.IDLT(cursorID)),
+			)).
+			Order(ent.Desc(message.FieldCreatedAt), ent.Desc(message.FieldID))
+	}
+
+	if limit > 0 {
+		query = query.Limit(limit)
+	}
+
+	messages, err := query.
+		WithChannel(func(q *ent.ChannelQuery) {
+			q.WithWorkspace().WithCreatedBy()
+		}).
+		WithUser().
+		WithParent().
 		All(ctx)
 	if err != nil {
 		return nil, err
@@ -92,6 +240,9 @@ func (r *messageRepository) FindByChannelID(ctx context.Context, channelID strin
 	for _, m := range messages {
 		result = append(result, utils.MessageToEntity(m))
 	}
+	if after {
+		slices.Reverse(result)
+	}
 
 	return result, nil
 }
@@ -284,6 +435,14 @@ func (r *messageRepository) Create(ctx context.Context, msg *entity.Message) err
 		SetUserID(userID).
 		SetBody(msg.Body)
 
+	if msg.BodyAST != nil {
+		bodyAST, err := utils.MessageBodyASTToJSON(msg.BodyAST)
+		if err != nil {
+			return err
+		}
+		builder = builder.SetBodyAst(bodyAST)
+	}
+
 	if msg.ID != "" {
 		messageID, err := utils.ParseUUID(msg.ID, "message ID")
 		if err != nil {
@@ -316,6 +475,10 @@ func (r *messageRepository) Create(ctx context.Context, msg *entity.Message) err
 		builder = builder.SetDeletedBy(deletedBy)
 	}
 
+	if msg.ClientMsgID != nil {
+		builder = builder.SetClientMsgID(*msg.ClientMsgID)
+	}
+
 	saved, err := builder.Save(ctx)
 	if err != nil {
 		return err
@@ -347,7 +510,18 @@ func (r *messageRepository) Update(ctx context.Context, msg *entity.Message) err
 	client := transaction.ResolveClient(ctx, r.client)
 
 	builder := client.Message.UpdateOneID(messageID).
-		SetBody(msg.Body)
+		SetBody(msg.Body).
+		SetRevisionCount(msg.RevisionCount)
+
+	if msg.BodyAST != nil {
+		bodyAST, err := utils.MessageBodyASTToJSON(msg.BodyAST)
+		if err != nil {
+			return err
+		}
+		builder = builder.SetBodyAst(bodyAST)
+	} else {
+		builder = builder.ClearBodyAst()
+	}
 
 	if msg.EditedAt != nil {
 		builder = builder.SetEditedAt(*msg.EditedAt)
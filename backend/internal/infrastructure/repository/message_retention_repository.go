@@ -0,0 +1,378 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/newt239/chat/ent"
+	"github.com/newt239/chat/ent/attachment"
+	"github.com/newt239/chat/ent/channel"
+	"github.com/newt239/chat/ent/message"
+	"github.com/newt239/chat/ent/messagebookmark"
+	"github.com/newt239/chat/ent/messagegroupmention"
+	"github.com/newt239/chat/ent/messagelink"
+	"github.com/newt239/chat/ent/messagepin"
+	"github.com/newt239/chat/ent/messagereaction"
+	"github.com/newt239/chat/ent/messagerevision"
+	"github.com/newt239/chat/ent/messageshare"
+	"github.com/newt239/chat/ent/messageusermention"
+	"github.com/newt239/chat/ent/poll"
+	"github.com/newt239/chat/ent/predicate"
+	"github.com/newt239/chat/ent/reminder"
+	"github.com/newt239/chat/ent/scheduledmessage"
+	"github.com/newt239/chat/ent/threadreadstate"
+	"github.com/newt239/chat/ent/userthreadfollow"
+	"github.com/newt239/chat/ent/workspace"
+	"github.com/newt239/chat/internal/domain/entity"
+	domainrepository "github.com/newt239/chat/internal/domain/repository"
+	"github.com/newt239/chat/internal/infrastructure/transaction"
+	"github.com/newt239/chat/internal/infrastructure/utils"
+)
+
+type messageRetentionRepository struct {
+	client *ent.Client
+}
+
+func NewMessageRetentionRepository(client *ent.Client) domainrepository.MessageRetentionRepository {
+	return &messageRetentionRepository{client: client}
+}
+
+func (r *messageRetentionRepository) FindWorkspaceRetentionDays(ctx context.Context, workspaceID string) (*int, error) {
+	client := transaction.ResolveClient(ctx, r.client)
+	ws, err := client.Workspace.Get(ctx, workspaceID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	days := ws.MessageRetentionDays
+	return &days, nil
+}
+
+func (r *messageRetentionRepository) UpdateWorkspaceRetentionDays(ctx context.Context, workspaceID string, days int) error {
+	client := transaction.ResolveClient(ctx, r.client)
+	return client.Workspace.UpdateOneID(workspaceID).
+		SetMessageRetentionDays(days).
+		Exec(ctx)
+}
+
+func (r *messageRetentionRepository) FindChannelPolicy(ctx context.Context, channelID string) (*entity.ChannelRetentionPolicy, error) {
+	chID, err := utils.ParseUUID(channelID, "channel ID")
+	if err != nil {
+		return nil, err
+	}
+
+	client := transaction.ResolveClient(ctx, r.client)
+	ch, err := client.Channel.Query().
+		Where(channel.ID(chID)).
+		WithWorkspace().
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return channelRetentionPolicyToEntity(ch), nil
+}
+
+func (r *messageRetentionRepository) FindChannelPolicies(ctx context.Context, workspaceID string) ([]*entity.ChannelRetentionPolicy, error) {
+	client := transaction.ResolveClient(ctx, r.client)
+	rows, err := client.Channel.Query().
+		Where(channel.HasWorkspaceWith(workspace.ID(workspaceID))).
+		WithWorkspace().
+		Order(ent.Asc(channel.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return channelRetentionPoliciesToEntities(rows), nil
+}
+
+func (r *messageRetentionRepository) FindPurgeTargets(ctx context.Context) ([]*entity.ChannelRetentionPolicy, error) {
+	client := transaction.ResolveClient(ctx, r.client)
+	rows, err := client.Channel.Query().
+		Where(channel.Or(
+			channel.MessageRetentionDaysGT(entity.MessageRetentionForever),
+			channel.And(
+				channel.MessageRetentionDaysIsNil(),
+				channel.HasWorkspaceWith(workspace.MessageRetentionDaysGT(entity.MessageRetentionForever)),
+			),
+		)).
+		WithWorkspace().
+		Order(ent.Asc(channel.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return channelRetentionPoliciesToEntities(rows), nil
+}
+
+func (r *messageRetentionRepository) UpdateChannelRetentionDays(ctx context.Context, channelID string, days *int) error {
+	chID, err := utils.ParseUUID(channelID, "channel ID")
+	if err != nil {
+		return err
+	}
+
+	client := transaction.ResolveClient(ctx, r.client)
+	builder := client.Channel.UpdateOneID(chID)
+	if days != nil {
+		builder = builder.SetMessageRetentionDays(*days)
+	} else {
+		builder = builder.ClearMessageRetentionDays()
+	}
+	return builder.Exec(ctx)
+}
+
+func (r *messageRetentionRepository) CountExpired(ctx context.Context, channelID string, cutoff time.Time) (*entity.MessageRetentionCount, error) {
+	chID, err := utils.ParseUUID(channelID, "channel ID")
+	if err != nil {
+		return nil, err
+	}
+
+	client := transaction.ResolveClient(ctx, r.client)
+	expired := []predicate.Message{
+		message.HasChannelWith(channel.ID(chID)),
+		message.CreatedAtLT(cutoff),
+		// 内容を消去済みのスレッドの親メッセージは含まない
+		message.Or(
+			message.Not(message.HasRepliesWith(message.CreatedAtGTE(cutoff))),
+			unredactedPredicate(),
+		),
+	}
+
+	messages, err := client.Message.Query().
+		Where(expired...).
+		Count(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	attachments, err := client.Attachment.Query().
+		Where(attachment.HasMessageWith(expired...)).
+		Count(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	count := &entity.MessageRetentionCount{
+		Messages:    messages,
+		Attachments: attachments,
+	}
+	if messages > 0 {
+		oldest, err := client.Message.Query().
+			Where(expired...).
+			Order(ent.Asc(message.FieldCreatedAt)).
+			First(ctx)
+		if err != nil {
+			return nil, err
+		}
+		count.OldestMessageAt = &oldest.CreatedAt
+	}
+	return count, nil
+}
+
+func (r *messageRetentionRepository) FindExpired(ctx context.Context, channelID string, cutoff time.Time, limit int) ([]*entity.ExpiredMessage, error) {
+	chID, err := utils.ParseUUID(channelID, "channel ID")
+	if err != nil {
+		return nil, err
+	}
+
+	// 新しい順に取得し、親メッセージを削除する場合は保存期間を過ぎた返信も同じ件に含める
+	// （返信は親メッセージより後に投稿されるため）
+	client := transaction.ResolveClient(ctx, r.client)
+	rows, err := client.Message.Query().
+		Where(
+			message.HasChannelWith(channel.ID(chID)),
+			message.CreatedAtLT(cutoff),
+			message.Not(message.HasRepliesWith(message.CreatedAtGTE(cutoff))),
+		).
+		WithAttachments().
+		Order(ent.Desc(message.FieldCreatedAt)).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return expiredMessagesToEntities(rows), nil
+}
+
+func (r *messageRetentionRepository) FindExpiredThreadParents(ctx context.Context, channelID string, cutoff time.Time, limit int) ([]*entity.ExpiredMessage, error) {
+	chID, err := utils.ParseUUID(channelID, "channel ID")
+	if err != nil {
+		return nil, err
+	}
+
+	client := transaction.ResolveClient(ctx, r.client)
+	rows, err := client.Message.Query().
+		Where(
+			message.HasChannelWith(channel.ID(chID)),
+			message.CreatedAtLT(cutoff),
+			message.HasRepliesWith(message.CreatedAtGTE(cutoff)),
+			unredactedPredicate(),
+		).
+		WithAttachments().
+		Order(ent.Asc(message.FieldCreatedAt)).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return expiredMessagesToEntities(rows), nil
+}
+
+func (r *messageRetentionRepository) Delete(ctx context.Context, messageIDs []string) error {
+	ids, err := parseMessageIDs(messageIDs)
+	if err != nil || len(ids) == 0 {
+		return err
+	}
+
+	client := transaction.ResolveClient(ctx, r.client)
+	if err := deleteMessageContents(ctx, client, ids); err != nil {
+		return err
+	}
+
+	if _, err := client.UserThreadFollow.Delete().
+		Where(userthreadfollow.HasThreadWith(message.IDIn(ids...))).
+		Exec(ctx); err != nil {
+		return err
+	}
+	if _, err := client.ThreadReadState.Delete().
+		Where(threadreadstate.HasThreadWith(message.IDIn(ids...))).
+		Exec(ctx); err != nil {
+		return err
+	}
+	// 削除したスレッドへの予約した返信は投稿できないため削除する
+	if _, err := client.ScheduledMessage.Delete().
+		Where(scheduledmessage.HasParentWith(message.IDIn(ids...))).
+		Exec(ctx); err != nil {
+		return err
+	}
+
+	_, err = client.Message.Delete().
+		Where(message.IDIn(ids...)).
+		Exec(ctx)
+	return err
+}
+
+func (r *messageRetentionRepository) Redact(ctx context.Context, messageIDs []string, now time.Time) error {
+	ids, err := parseMessageIDs(messageIDs)
+	if err != nil || len(ids) == 0 {
+		return err
+	}
+
+	client := transaction.ResolveClient(ctx, r.client)
+	if err := deleteMessageContents(ctx, client, ids); err != nil {
+		return err
+	}
+
+	if _, err := client.Message.Update().
+		Where(message.IDIn(ids...), message.DeletedAtIsNil()).
+		SetDeletedAt(now).
+		Save(ctx); err != nil {
+		return err
+	}
+	_, err = client.Message.Update().
+		Where(message.IDIn(ids...)).
+		SetBody("").
+		ClearBodyAst().
+		SetRevisionCount(0).
+		Save(ctx)
+	return err
+}
+
+// deleteMessageContents はメッセージの内容に関連するデータを削除します
+func deleteMessageContents(ctx context.Context, client *ent.Client, ids []uuid.UUID) error {
+	deletes := []func() (int, error){
+		func() (int, error) {
+			return client.Attachment.Delete().Where(attachment.HasMessageWith(message.IDIn(ids...))).Exec(ctx)
+		},
+		func() (int, error) {
+			return client.MessageReaction.Delete().Where(messagereaction.HasMessageWith(message.IDIn(ids...))).Exec(ctx)
+		},
+		func() (int, error) {
+			return client.MessageBookmark.Delete().Where(messagebookmark.HasMessageWith(message.IDIn(ids...))).Exec(ctx)
+		},
+		func() (int, error) {
+			return client.MessageUserMention.Delete().Where(messageusermention.HasMessageWith(message.IDIn(ids...))).Exec(ctx)
+		},
+		func() (int, error) {
+			return client.MessageGroupMention.Delete().Where(messagegroupmention.HasMessageWith(message.IDIn(ids...))).Exec(ctx)
+		},
+		func() (int, error) {
+			return client.MessageLink.Delete().Where(messagelink.HasMessageWith(message.IDIn(ids...))).Exec(ctx)
+		},
+		func() (int, error) {
+			return client.MessagePin.Delete().Where(messagepin.HasMessageWith(message.IDIn(ids...))).Exec(ctx)
+		},
+		func() (int, error) {
+			return client.MessageRevision.Delete().Where(messagerevision.HasMessageWith(message.IDIn(ids...))).Exec(ctx)
+		},
+		func() (int, error) {
+			return client.MessageShare.Delete().Where(messageshare.Or(
+				messageshare.HasMessageWith(message.IDIn(ids...)),
+				messageshare.HasSourceWith(message.IDIn(ids...)),
+			)).Exec(ctx)
+		},
+		func() (int, error) {
+			return client.Poll.Delete().Where(poll.HasMessageWith(message.IDIn(ids...))).Exec(ctx)
+		},
+		func() (int, error) {
+			return client.Reminder.Delete().Where(reminder.HasMessageWith(message.IDIn(ids...))).Exec(ctx)
+		},
+	}
+
+	for _, del := range deletes {
+		if _, err := del(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// unredactedPredicate は内容を消去していないメッセージの条件です
+func unredactedPredicate() predicate.Message {
+	return message.Or(
+		message.DeletedAtIsNil(),
+		message.BodyNEQ(""),
+		message.HasAttachments(),
+	)
+}
+
+func expiredMessagesToEntities(rows []*ent.Message) []*entity.ExpiredMessage {
+	result := make([]*entity.ExpiredMessage, 0, len(rows))
+	for _, row := range rows {
+		keys := make([]string, 0, len(row.Edges.Attachments))
+		for _, att := range row.Edges.Attachments {
+			keys = append(keys, att.StorageKey)
+		}
+		result = append(result, &entity.ExpiredMessage{
+			ID:                    row.ID.String(),
+			AttachmentStorageKeys: keys,
+		})
+	}
+	return result
+}
+
+func channelRetentionPoliciesToEntities(rows []*ent.Channel) []*entity.ChannelRetentionPolicy {
+	result := make([]*entity.ChannelRetentionPolicy, 0, len(rows))
+	for _, row := range rows {
+		result = append(result, channelRetentionPolicyToEntity(row))
+	}
+	return result
+}
+
+func channelRetentionPolicyToEntity(ch *ent.Channel) *entity.ChannelRetentionPolicy {
+	policy := &entity.ChannelRetentionPolicy{
+		ChannelID:            ch.ID.String(),
+		ChannelName:          ch.Name,
+		ChannelRetentionDays: ch.MessageRetentionDays,
+	}
+	if ch.Edges.Workspace != nil {
+		policy.WorkspaceID = ch.Edges.Workspace.ID
+		policy.WorkspaceRetentionDays = ch.Edges.Workspace.MessageRetentionDays
+	}
+	return policy
+}
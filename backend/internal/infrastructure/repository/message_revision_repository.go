@@ -0,0 +1,112 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/newt239/chat/ent"
+	"github.com/newt239/chat/ent/channel"
+	"github.com/newt239/chat/ent/message"
+	"github.com/newt239/chat/ent/messagerevision"
+	"github.com/newt239/chat/ent/workspace"
+	"github.com/newt239/chat/internal/domain/entity"
+	domainrepository "github.com/newt239/chat/internal/domain/repository"
+	"github.com/newt239/chat/internal/infrastructure/transaction"
+	"github.com/newt239/chat/internal/infrastructure/utils"
+)
+
+type messageRevisionRepository struct {
+	client *ent.Client
+}
+
+func NewMessageRevisionRepository(client *ent.Client) domainrepository.MessageRevisionRepository {
+	return &messageRevisionRepository{client: client}
+}
+
+func (r *messageRevisionRepository) Create(ctx context.Context, revision *entity.MessageRevision) error {
+	messageID, err := utils.ParseUUID(revision.MessageID, "message ID")
+	if err != nil {
+		return err
+	}
+	editorID, err := utils.ParseUUID(revision.EditorID, "editor ID")
+	if err != nil {
+		return err
+	}
+
+	client := transaction.ResolveClient(ctx, r.client)
+
+	builder := client.MessageRevision.Create().
+		SetMessageID(messageID).
+		SetEditorID(editorID).
+		SetBody(revision.Body)
+
+	if !revision.CreatedAt.IsZero() {
+		builder = builder.SetCreatedAt(revision.CreatedAt)
+	}
+
+	mr, err := builder.Save(ctx)
+	if err != nil {
+		return err
+	}
+
+	revision.ID = mr.ID.String()
+	revision.CreatedAt = mr.CreatedAt
+	return nil
+}
+
+func (r *messageRevisionRepository) FindByMessageID(ctx context.Context, messageID string) ([]*entity.MessageRevision, error) {
+	mid, err := utils.ParseUUID(messageID, "message ID")
+	if err != nil {
+		return nil, err
+	}
+
+	client := transaction.ResolveClient(ctx, r.client)
+
+	rows, err := client.MessageRevision.Query().
+		Where(messagerevision.HasMessageWith(message.ID(mid))).
+		WithMessage().
+		WithEditor().
+		Order(ent.Asc(messagerevision.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	revisions := make([]*entity.MessageRevision, 0, len(rows))
+	for _, mr := range rows {
+		revisions = append(revisions, messageRevisionToEntity(mr))
+	}
+	return revisions, nil
+}
+
+func (r *messageRevisionRepository) DeleteByWorkspaceID(ctx context.Context, workspaceID string) error {
+	client := transaction.ResolveClient(ctx, r.client)
+	inWorkspace := message.HasChannelWith(channel.HasWorkspaceWith(workspace.ID(workspaceID)))
+
+	if _, err := client.MessageRevision.Delete().
+		Where(messagerevision.HasMessageWith(inWorkspace)).
+		Exec(ctx); err != nil {
+		return err
+	}
+	_, err := client.Message.Update().
+		Where(inWorkspace, message.RevisionCountGT(0)).
+		SetRevisionCount(0).
+		Save(ctx)
+	return err
+}
+
+func messageRevisionToEntity(mr *ent.MessageRevision) *entity.MessageRevision {
+	var messageID, editorID string
+	if mr.Edges.Message != nil {
+		messageID = mr.Edges.Message.ID.String()
+	}
+	if mr.Edges.Editor != nil {
+		editorID = mr.Edges.Editor.ID.String()
+	}
+	return &entity.MessageRevision{
+		ID:        mr.ID.String(),
+		MessageID: messageID,
+		EditorID:  editorID,
+		Body:      mr.Body,
+		CreatedAt: mr.CreatedAt,
+	}
+}
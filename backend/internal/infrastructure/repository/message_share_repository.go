@@ -0,0 +1,139 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/newt239/chat/ent"
+	"github.com/newt239/chat/ent/message"
+	"github.com/newt239/chat/ent/messageshare"
+	"github.com/newt239/chat/internal/domain/entity"
+	domainrepository "github.com/newt239/chat/internal/domain/repository"
+	"github.com/newt239/chat/internal/infrastructure/transaction"
+	"github.com/newt239/chat/internal/infrastructure/utils"
+)
+
+type messageShareRepository struct {
+	client *ent.Client
+}
+
+func NewMessageShareRepository(client *ent.Client) domainrepository.MessageShareRepository {
+	return &messageShareRepository{client: client}
+}
+
+func (r *messageShareRepository) Create(ctx context.Context, share *entity.MessageShare) error {
+	messageID, err := utils.ParseUUID(share.MessageID, "message ID")
+	if err != nil {
+		return err
+	}
+	sourceID, err := utils.ParseUUID(share.SourceMessageID, "source message ID")
+	if err != nil {
+		return err
+	}
+
+	client := transaction.ResolveClient(ctx, r.client)
+
+	builder := client.MessageShare.Create().
+		SetMessageID(messageID).
+		SetSourceID(sourceID)
+
+	if !share.CreatedAt.IsZero() {
+		builder = builder.SetCreatedAt(share.CreatedAt)
+	}
+
+	ms, err := builder.Save(ctx)
+	if err != nil {
+		return err
+	}
+
+	share.ID = ms.ID.String()
+	share.CreatedAt = ms.CreatedAt
+	return nil
+}
+
+func (r *messageShareRepository) FindByMessageIDs(ctx context.Context, messageIDs []string) (map[string]*entity.MessageShare, error) {
+	if len(messageIDs) == 0 {
+		return map[string]*entity.MessageShare{}, nil
+	}
+
+	parsedIDs, err := parseMessageIDs(messageIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	client := transaction.ResolveClient(ctx, r.client)
+	rows, err := client.MessageShare.Query().
+		Where(messageshare.HasMessageWith(message.IDIn(parsedIDs...))).
+		WithMessage().
+		WithSource().
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(map[string]*entity.MessageShare, len(rows))
+	for _, ms := range rows {
+		share := messageShareToEntity(ms)
+		result[share.MessageID] = share
+	}
+	return result, nil
+}
+
+func (r *messageShareRepository) CountBySourceMessageIDs(ctx context.Context, sourceMessageIDs []string) (map[string]int, error) {
+	if len(sourceMessageIDs) == 0 {
+		return map[string]int{}, nil
+	}
+
+	parsedIDs, err := parseMessageIDs(sourceMessageIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	client := transaction.ResolveClient(ctx, r.client)
+	rows, err := client.MessageShare.Query().
+		Where(
+			messageshare.HasSourceWith(message.IDIn(parsedIDs...)),
+			messageshare.HasMessageWith(message.DeletedAtIsNil()),
+		).
+		WithSource().
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(map[string]int)
+	for _, ms := range rows {
+		if ms.Edges.Source != nil {
+			result[ms.Edges.Source.ID.String()]++
+		}
+	}
+	return result, nil
+}
+
+func parseMessageIDs(ids []string) ([]uuid.UUID, error) {
+	parsedIDs := make([]uuid.UUID, 0, len(ids))
+	for _, id := range ids {
+		parsedID, err := utils.ParseUUID(id, "message ID")
+		if err != nil {
+			return nil, err
+		}
+		parsedIDs = append(parsedIDs, parsedID)
+	}
+	return parsedIDs, nil
+}
+
+func messageShareToEntity(ms *ent.MessageShare) *entity.MessageShare {
+	var messageID, sourceMessageID string
+	if ms.Edges.Message != nil {
+		messageID = ms.Edges.Message.ID.String()
+	}
+	if ms.Edges.Source != nil {
+		sourceMessageID = ms.Edges.Source.ID.String()
+	}
+	return &entity.MessageShare{
+		ID:              ms.ID.String(),
+		MessageID:       messageID,
+		SourceMessageID: sourceMessageID,
+		CreatedAt:       ms.CreatedAt,
+	}
+}
@@ -0,0 +1,261 @@
+package repository
+
+import (
+	"context"
+	"sort"
+
+	"entgo.io/ent/dialect/sql"
+
+	"github.com/newt239/chat/ent"
+	"github.com/newt239/chat/ent/message"
+	"github.com/newt239/chat/ent/poll"
+	"github.com/newt239/chat/ent/polloption"
+	"github.com/newt239/chat/ent/pollvote"
+	"github.com/newt239/chat/ent/user"
+	"github.com/newt239/chat/internal/domain/entity"
+	domainrepository "github.com/newt239/chat/internal/domain/repository"
+	"github.com/newt239/chat/internal/infrastructure/transaction"
+	"github.com/newt239/chat/internal/infrastructure/utils"
+)
+
+type pollRepository struct {
+	client *ent.Client
+}
+
+func NewPollRepository(client *ent.Client) domainrepository.PollRepository {
+	return &pollRepository{client: client}
+}
+
+func (r *pollRepository) Create(ctx context.Context, p *entity.Poll) error {
+	messageID, err := utils.ParseUUID(p.MessageID, "message ID")
+	if err != nil {
+		return err
+	}
+
+	client := transaction.ResolveClient(ctx, r.client)
+
+	builder := client.Poll.Create().
+		SetMessageID(messageID).
+		SetMultipleChoice(p.MultipleChoice).
+		SetAnonymous(p.Anonymous)
+	if p.ClosesAt != nil {
+		builder = builder.SetClosesAt(*p.ClosesAt)
+	}
+
+	created, err := builder.Save(ctx)
+	if err != nil {
+		return err
+	}
+
+	optionBuilders := make([]*ent.PollOptionCreate, len(p.Options))
+	for idx, option := range p.Options {
+		optionBuilders[idx] = client.PollOption.Create().
+			SetPollID(created.ID).
+			SetText(option.Text).
+			SetPosition(idx)
+	}
+	if _, err := client.PollOption.CreateBulk(optionBuilders...).Save(ctx); err != nil {
+		return err
+	}
+
+	found, err := r.findByID(ctx, client, created.ID.String())
+	if err != nil {
+		return err
+	}
+	*p = *found
+	return nil
+}
+
+func (r *pollRepository) FindByID(ctx context.Context, id string) (*entity.Poll, error) {
+	return r.findByID(ctx, transaction.ResolveClient(ctx, r.client), id)
+}
+
+func (r *pollRepository) findByID(ctx context.Context, client *ent.Client, id string) (*entity.Poll, error) {
+	pollID, err := utils.ParseUUID(id, "poll ID")
+	if err != nil {
+		return nil, err
+	}
+
+	p, err := r.query(client).
+		Where(poll.ID(pollID)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return pollToEntity(p), nil
+}
+
+func (r *pollRepository) FindByMessageIDs(ctx context.Context, messageIDs []string) (map[string]*entity.Poll, error) {
+	if len(messageIDs) == 0 {
+		return map[string]*entity.Poll{}, nil
+	}
+
+	parsedIDs, err := parseMessageIDs(messageIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	client := transaction.ResolveClient(ctx, r.client)
+	rows, err := r.query(client).
+		Where(poll.HasMessageWith(message.IDIn(parsedIDs...))).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(map[string]*entity.Poll, len(rows))
+	for _, p := range rows {
+		converted := pollToEntity(p)
+		result[converted.MessageID] = converted
+	}
+	return result, nil
+}
+
+func (r *pollRepository) Lock(ctx context.Context, pollID string) error {
+	pid, err := utils.ParseUUID(pollID, "poll ID")
+	if err != nil {
+		return err
+	}
+
+	client := transaction.ResolveClient(ctx, r.client)
+	_, err = client.Poll.Query().
+		Where(
+			poll.ID(pid),
+			func(s *sql.Selector) {
+				s.ForUpdate()
+			},
+		).
+		OnlyID(ctx)
+	return err
+}
+
+func (r *pollRepository) AddVote(ctx context.Context, pollID, optionID, userID string) error {
+	pid, err := utils.ParseUUID(pollID, "poll ID")
+	if err != nil {
+		return err
+	}
+	oid, err := utils.ParseUUID(optionID, "option ID")
+	if err != nil {
+		return err
+	}
+	uid, err := utils.ParseUUID(userID, "user ID")
+	if err != nil {
+		return err
+	}
+
+	client := transaction.ResolveClient(ctx, r.client)
+	exists, err := client.PollVote.Query().
+		Where(
+			pollvote.HasOptionWith(polloption.ID(oid)),
+			pollvote.HasUserWith(user.ID(uid)),
+		).
+		Exist(ctx)
+	if err != nil {
+		return err
+	}
+	if exists {
+		return nil
+	}
+
+	return client.PollVote.Create().
+		SetPollID(pid).
+		SetOptionID(oid).
+		SetUserID(uid).
+		Exec(ctx)
+}
+
+func (r *pollRepository) RemoveVote(ctx context.Context, optionID, userID string) error {
+	oid, err := utils.ParseUUID(optionID, "option ID")
+	if err != nil {
+		return err
+	}
+	uid, err := utils.ParseUUID(userID, "user ID")
+	if err != nil {
+		return err
+	}
+
+	client := transaction.ResolveClient(ctx, r.client)
+	_, err = client.PollVote.Delete().
+		Where(
+			pollvote.HasOptionWith(polloption.ID(oid)),
+			pollvote.HasUserWith(user.ID(uid)),
+		).
+		Exec(ctx)
+	return err
+}
+
+func (r *pollRepository) RemoveVotesByUser(ctx context.Context, pollID, userID string) error {
+	pid, err := utils.ParseUUID(pollID, "poll ID")
+	if err != nil {
+		return err
+	}
+	uid, err := utils.ParseUUID(userID, "user ID")
+	if err != nil {
+		return err
+	}
+
+	client := transaction.ResolveClient(ctx, r.client)
+	_, err = client.PollVote.Delete().
+		Where(
+			pollvote.HasPollWith(poll.ID(pid)),
+			pollvote.HasUserWith(user.ID(uid)),
+		).
+		Exec(ctx)
+	return err
+}
+
+func (r *pollRepository) query(client *ent.Client) *ent.PollQuery {
+	return client.Poll.Query().
+		WithMessage(func(q *ent.MessageQuery) {
+			q.WithChannel()
+		}).
+		WithOptions(func(q *ent.PollOptionQuery) {
+			q.WithVotes(func(vq *ent.PollVoteQuery) {
+				vq.WithUser().
+					Order(ent.Asc(pollvote.FieldCreatedAt))
+			})
+		})
+}
+
+func pollToEntity(p *ent.Poll) *entity.Poll {
+	var messageID, channelID string
+	if p.Edges.Message != nil {
+		messageID = p.Edges.Message.ID.String()
+		if p.Edges.Message.Edges.Channel != nil {
+			channelID = p.Edges.Message.Edges.Channel.ID.String()
+		}
+	}
+
+	options := make([]*entity.PollOption, 0, len(p.Edges.Options))
+	for _, o := range p.Edges.Options {
+		voterIDs := make([]string, 0, len(o.Edges.Votes))
+		for _, v := range o.Edges.Votes {
+			if v.Edges.User != nil {
+				voterIDs = append(voterIDs, v.Edges.User.ID.String())
+			}
+		}
+		options = append(options, &entity.PollOption{
+			ID:       o.ID.String(),
+			Text:     o.Text,
+			Position: o.Position,
+			VoterIDs: voterIDs,
+		})
+	}
+	sort.Slice(options, func(i, j int) bool {
+		return options[i].Position < options[j].Position
+	})
+
+	return &entity.Poll{
+		ID:             p.ID.String(),
+		MessageID:      messageID,
+		ChannelID:      channelID,
+		MultipleChoice: p.MultipleChoice,
+		Anonymous:      p.Anonymous,
+		ClosesAt:       optionalTime(p.ClosesAt),
+		Options:        options,
+		CreatedAt:      p.CreatedAt,
+	}
+}
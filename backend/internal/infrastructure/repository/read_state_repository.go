@@ -142,6 +142,30 @@ func (r *readStateRepository) FindByChannelAndUser(ctx context.Context, channelI
 	return utils.ChannelReadStateToEntity(crs), nil
 }
 
+func (r *readStateRepository) FindByChannel(ctx context.Context, channelID string) ([]*entity.ChannelReadState, error) {
+	cid, err := utils.ParseUUID(channelID, "channel ID")
+	if err != nil {
+		return nil, err
+	}
+
+	client := transaction.ResolveClient(ctx, r.client)
+	states, err := client.ChannelReadState.Query().
+		Where(channelreadstate.HasChannelWith(channel.ID(cid))).
+		WithChannel().
+		WithUser().
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*entity.ChannelReadState, 0, len(states))
+	for _, crs := range states {
+		result = append(result, utils.ChannelReadStateToEntity(crs))
+	}
+
+	return result, nil
+}
+
 func (r *readStateRepository) UpdateLastReadAt(ctx context.Context, channelID, userID string, lastReadAt time.Time) error {
 	readState := &entity.ChannelReadState{
 		ChannelID:  channelID,
@@ -420,3 +444,192 @@ func (r *readStateRepository) GetUnreadMentionCountBatch(ctx context.Context, ch
 
 	return result, nil
 }
+
+// GetChannelUnreadCountsByUsers は1つのチャンネルについて複数ユーザーの未読数と未読のメンション数をまとめて取得します
+// ユーザー数に関わらず問い合わせの回数が一定になるよう、既読時刻・メンションを一括で取得して集計します
+func (r *readStateRepository) GetChannelUnreadCountsByUsers(ctx context.Context, channelID string, userIDs []string) (map[string]entity.ChannelUnreadCount, error) {
+	result := make(map[string]entity.ChannelUnreadCount, len(userIDs))
+	if len(userIDs) == 0 {
+		return result, nil
+	}
+
+	cid, err := utils.ParseUUID(channelID, "channel ID")
+	if err != nil {
+		return nil, err
+	}
+
+	uids := make([]uuid.UUID, 0, len(userIDs))
+	for _, userID := range userIDs {
+		parsed, err := utils.ParseUUID(userID, "user ID")
+		if err != nil {
+			return nil, err
+		}
+		uids = append(uids, parsed)
+	}
+
+	client := transaction.ResolveClient(ctx, r.client)
+
+	readStates, err := client.ChannelReadState.Query().
+		Where(
+			channelreadstate.HasChannelWith(channel.ID(cid)),
+			channelreadstate.HasUserWith(user.IDIn(uids...)),
+		).
+		WithUser().
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// 既読時刻がないユーザーはチャンネルの全メッセージが未読になる
+	lastReadAtMap := make(map[uuid.UUID]time.Time, len(uids))
+	for _, rs := range readStates {
+		if rs.Edges.User != nil {
+			lastReadAtMap[rs.Edges.User.ID] = rs.LastReadAt
+		}
+	}
+
+	// 既読時刻がある最も古いユーザーより後のメッセージの投稿時刻を取得し、各ユーザーの未読数を数える
+	var earliestRead time.Time
+	hasNeverRead := false
+	for _, uid := range uids {
+		lastReadAt, ok := lastReadAtMap[uid]
+		if !ok {
+			hasNeverRead = true
+			continue
+		}
+		if earliestRead.IsZero() || lastReadAt.Before(earliestRead) {
+			earliestRead = lastReadAt
+		}
+	}
+
+	unreadCounts := make(map[uuid.UUID]int, len(uids))
+	if len(lastReadAtMap) > 0 {
+		unreadMessages, err := client.Message.Query().
+			Where(
+				message.HasChannelWith(channel.ID(cid)),
+				message.CreatedAtGT(earliestRead),
+				message.DeletedAtIsNil(),
+			).
+			Select(message.FieldCreatedAt).
+			All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for uid, lastReadAt := range lastReadAtMap {
+			for _, m := range unreadMessages {
+				if m.CreatedAt.After(lastReadAt) {
+					unreadCounts[uid]++
+				}
+			}
+		}
+	}
+	if hasNeverRead {
+		total, err := client.Message.Query().
+			Where(
+				message.HasChannelWith(channel.ID(cid)),
+				message.DeletedAtIsNil(),
+			).
+			Count(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, uid := range uids {
+			if _, ok := lastReadAtMap[uid]; !ok {
+				unreadCounts[uid] = total
+			}
+		}
+	}
+
+	// メンションは最も古い既読時刻より後のものをまとめて取得し、ユーザーごとに既読時刻より後のものを数える
+	mentionedMessages := make(map[uuid.UUID]map[uuid.UUID]bool, len(uids))
+	addMention := func(uid uuid.UUID, m *ent.Message) {
+		if m == nil || !m.CreatedAt.After(lastReadAtMap[uid]) {
+			return
+		}
+		if mentionedMessages[uid] == nil {
+			mentionedMessages[uid] = make(map[uuid.UUID]bool)
+		}
+		mentionedMessages[uid][m.ID] = true
+	}
+	mentionSince := earliestRead
+	if hasNeverRead {
+		mentionSince = time.Time{}
+	}
+
+	userMentions, err := client.MessageUserMention.Query().
+		Where(
+			messageusermention.HasUserWith(user.IDIn(uids...)),
+			messageusermention.HasMessageWith(
+				message.HasChannelWith(channel.ID(cid)),
+				message.CreatedAtGT(mentionSince),
+				message.DeletedAtIsNil(),
+			),
+		).
+		WithUser().
+		WithMessage().
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, mention := range userMentions {
+		if mention.Edges.User != nil {
+			addMention(mention.Edges.User.ID, mention.Edges.Message)
+		}
+	}
+
+	groupMembers, err := client.UserGroupMember.Query().
+		Where(usergroupmember.HasUserWith(user.IDIn(uids...))).
+		WithUser().
+		WithGroup().
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	membersByGroup := make(map[uuid.UUID][]uuid.UUID)
+	for _, member := range groupMembers {
+		if member.Edges.User == nil || member.Edges.Group == nil {
+			continue
+		}
+		groupID := member.Edges.Group.ID
+		membersByGroup[groupID] = append(membersByGroup[groupID], member.Edges.User.ID)
+	}
+
+	if len(membersByGroup) > 0 {
+		groupIDs := make([]uuid.UUID, 0, len(membersByGroup))
+		for groupID := range membersByGroup {
+			groupIDs = append(groupIDs, groupID)
+		}
+		groupMentions, err := client.MessageGroupMention.Query().
+			Where(
+				messagegroupmention.HasGroupWith(usergroup.IDIn(groupIDs...)),
+				messagegroupmention.HasMessageWith(
+					message.HasChannelWith(channel.ID(cid)),
+					message.CreatedAtGT(mentionSince),
+					message.DeletedAtIsNil(),
+				),
+			).
+			WithGroup().
+			WithMessage().
+			All(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, mention := range groupMentions {
+			if mention.Edges.Group == nil {
+				continue
+			}
+			for _, uid := range membersByGroup[mention.Edges.Group.ID] {
+				addMention(uid, mention.Edges.Message)
+			}
+		}
+	}
+
+	for _, uid := range uids {
+		result[uid.String()] = entity.ChannelUnreadCount{
+			UnreadCount:  unreadCounts[uid],
+			MentionCount: len(mentionedMessages[uid]),
+		}
+	}
+
+	return result, nil
+}
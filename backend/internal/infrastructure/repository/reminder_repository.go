@@ -0,0 +1,274 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/newt239/chat/ent"
+	"github.com/newt239/chat/ent/predicate"
+	"github.com/newt239/chat/ent/reminder"
+	"github.com/newt239/chat/ent/user"
+	"github.com/newt239/chat/internal/domain/entity"
+	domainrepository "github.com/newt239/chat/internal/domain/repository"
+	"github.com/newt239/chat/internal/infrastructure/transaction"
+	"github.com/newt239/chat/internal/infrastructure/utils"
+)
+
+type reminderRepository struct {
+	client *ent.Client
+}
+
+func NewReminderRepository(client *ent.Client) domainrepository.ReminderRepository {
+	return &reminderRepository{client: client}
+}
+
+func (r *reminderRepository) Create(ctx context.Context, rem *entity.Reminder) error {
+	userID, err := utils.ParseUUID(rem.UserID, "user ID")
+	if err != nil {
+		return err
+	}
+	messageID, err := utils.ParseUUID(rem.MessageID, "message ID")
+	if err != nil {
+		return err
+	}
+
+	client := transaction.ResolveClient(ctx, r.client)
+
+	created, err := client.Reminder.Create().
+		SetUserID(userID).
+		SetMessageID(messageID).
+		SetRemindAt(rem.RemindAt).
+		SetStatus(string(rem.Status)).
+		Save(ctx)
+	if err != nil {
+		return err
+	}
+
+	found, err := r.findByID(ctx, client, created.ID.String())
+	if err != nil {
+		return err
+	}
+	*rem = *found
+	return nil
+}
+
+func (r *reminderRepository) FindByID(ctx context.Context, id string) (*entity.Reminder, error) {
+	return r.findByID(ctx, transaction.ResolveClient(ctx, r.client), id)
+}
+
+func (r *reminderRepository) findByID(ctx context.Context, client *ent.Client, id string) (*entity.Reminder, error) {
+	reminderID, err := utils.ParseUUID(id, "reminder ID")
+	if err != nil {
+		return nil, err
+	}
+
+	rem, err := r.query(client).
+		Where(reminder.ID(reminderID)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return reminderToEntity(rem), nil
+}
+
+func (r *reminderRepository) FindByUserID(ctx context.Context, userID string) ([]*entity.Reminder, error) {
+	uid, err := utils.ParseUUID(userID, "user ID")
+	if err != nil {
+		return nil, err
+	}
+
+	client := transaction.ResolveClient(ctx, r.client)
+	rows, err := r.query(client).
+		Where(reminder.HasUserWith(user.ID(uid))).
+		Order(ent.Asc(reminder.FieldRemindAt)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*entity.Reminder, 0, len(rows))
+	for _, rem := range rows {
+		result = append(result, reminderToEntity(rem))
+	}
+	return result, nil
+}
+
+func (r *reminderRepository) Update(ctx context.Context, rem *entity.Reminder) error {
+	reminderID, err := utils.ParseUUID(rem.ID, "reminder ID")
+	if err != nil {
+		return err
+	}
+
+	client := transaction.ResolveClient(ctx, r.client)
+
+	builder := client.Reminder.UpdateOneID(reminderID).
+		SetRemindAt(rem.RemindAt).
+		SetStatus(string(rem.Status))
+
+	if rem.ClaimedAt != nil {
+		builder = builder.SetClaimedAt(*rem.ClaimedAt)
+	} else {
+		builder = builder.ClearClaimedAt()
+	}
+
+	if rem.FiredAt != nil {
+		builder = builder.SetFiredAt(*rem.FiredAt)
+	} else {
+		builder = builder.ClearFiredAt()
+	}
+
+	if rem.CompletedAt != nil {
+		builder = builder.SetCompletedAt(*rem.CompletedAt)
+	} else {
+		builder = builder.ClearCompletedAt()
+	}
+
+	if _, err := builder.Save(ctx); err != nil {
+		return err
+	}
+
+	updated, err := r.findByID(ctx, client, rem.ID)
+	if err != nil {
+		return err
+	}
+	if updated != nil {
+		*rem = *updated
+	}
+	return nil
+}
+
+func (r *reminderRepository) Delete(ctx context.Context, id string) error {
+	reminderID, err := utils.ParseUUID(id, "reminder ID")
+	if err != nil {
+		return err
+	}
+
+	client := transaction.ResolveClient(ctx, r.client)
+	_, err = client.Reminder.Delete().
+		Where(reminder.ID(reminderID)).
+		Exec(ctx)
+	return err
+}
+
+func (r *reminderRepository) FindDue(ctx context.Context, now time.Time, staleBefore time.Time, limit int) ([]*entity.Reminder, error) {
+	client := transaction.ResolveClient(ctx, r.client)
+	rows, err := r.query(client).
+		Where(reminderDuePredicate(now, staleBefore)).
+		Order(ent.Asc(reminder.FieldRemindAt)).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*entity.Reminder, 0, len(rows))
+	for _, rem := range rows {
+		result = append(result, reminderToEntity(rem))
+	}
+	return result, nil
+}
+
+func (r *reminderRepository) Claim(ctx context.Context, id string, now time.Time, staleBefore time.Time) (bool, error) {
+	reminderID, err := utils.ParseUUID(id, "reminder ID")
+	if err != nil {
+		return false, err
+	}
+
+	// 条件付きの更新により、複数のインスタンスが同じリマインダーを取得しても1つだけが成功する
+	client := transaction.ResolveClient(ctx, r.client)
+	affected, err := client.Reminder.Update().
+		Where(
+			reminder.ID(reminderID),
+			reminderDuePredicate(now, staleBefore),
+		).
+		SetStatus(string(entity.ReminderStatusSending)).
+		SetClaimedAt(now).
+		Save(ctx)
+	if err != nil {
+		return false, err
+	}
+	return affected == 1, nil
+}
+
+func (r *reminderRepository) Release(ctx context.Context, id string, claimedAt time.Time) (bool, error) {
+	reminderID, err := utils.ParseUUID(id, "reminder ID")
+	if err != nil {
+		return false, err
+	}
+
+	// 取得した時点から変更されていない場合のみ戻し、ユーザーの変更やスヌーズを上書きしない
+	client := transaction.ResolveClient(ctx, r.client)
+	affected, err := client.Reminder.Update().
+		Where(
+			reminder.ID(reminderID),
+			reminder.Status(string(entity.ReminderStatusSending)),
+			reminder.ClaimedAt(claimedAt),
+		).
+		SetStatus(string(entity.ReminderStatusPending)).
+		ClearClaimedAt().
+		Save(ctx)
+	if err != nil {
+		return false, err
+	}
+	return affected == 1, nil
+}
+
+// reminderDuePredicate は通知処理を開始できるリマインダーの条件です
+func reminderDuePredicate(now time.Time, staleBefore time.Time) predicate.Reminder {
+	return reminder.Or(
+		reminder.And(
+			reminder.Status(string(entity.ReminderStatusPending)),
+			reminder.RemindAtLTE(now),
+		),
+		reminder.And(
+			reminder.Status(string(entity.ReminderStatusSending)),
+			reminder.ClaimedAtLT(staleBefore),
+		),
+	)
+}
+
+func (r *reminderRepository) query(client *ent.Client) *ent.ReminderQuery {
+	return client.Reminder.Query().
+		WithUser().
+		WithMessage(func(q *ent.MessageQuery) {
+			q.WithChannel()
+		})
+}
+
+func reminderToEntity(rem *ent.Reminder) *entity.Reminder {
+	var userID, messageID, channelID string
+	if rem.Edges.User != nil {
+		userID = rem.Edges.User.ID.String()
+	}
+	if rem.Edges.Message != nil {
+		messageID = rem.Edges.Message.ID.String()
+		if rem.Edges.Message.Edges.Channel != nil {
+			channelID = rem.Edges.Message.Edges.Channel.ID.String()
+		}
+	}
+
+	return &entity.Reminder{
+		ID:          rem.ID.String(),
+		UserID:      userID,
+		MessageID:   messageID,
+		ChannelID:   channelID,
+		RemindAt:    rem.RemindAt,
+		Status:      entity.ReminderStatus(rem.Status),
+		ClaimedAt:   optionalTime(rem.ClaimedAt),
+		FiredAt:     optionalTime(rem.FiredAt),
+		CompletedAt: optionalTime(rem.CompletedAt),
+		CreatedAt:   rem.CreatedAt,
+		UpdatedAt:   rem.UpdatedAt,
+	}
+}
+
+// optionalTime は未設定（ゼロ値）の日時をnilに変換します
+func optionalTime(t time.Time) *time.Time {
+	if t.IsZero() {
+		return nil
+	}
+	return &t
+}
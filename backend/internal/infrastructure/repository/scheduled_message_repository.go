@@ -0,0 +1,317 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/newt239/chat/ent"
+	"github.com/newt239/chat/ent/predicate"
+	"github.com/newt239/chat/ent/scheduledmessage"
+	"github.com/newt239/chat/ent/user"
+	"github.com/newt239/chat/internal/domain/entity"
+	domainrepository "github.com/newt239/chat/internal/domain/repository"
+	"github.com/newt239/chat/internal/infrastructure/transaction"
+	"github.com/newt239/chat/internal/infrastructure/utils"
+)
+
+type scheduledMessageRepository struct {
+	client *ent.Client
+}
+
+func NewScheduledMessageRepository(client *ent.Client) domainrepository.ScheduledMessageRepository {
+	return &scheduledMessageRepository{client: client}
+}
+
+func (r *scheduledMessageRepository) Create(ctx context.Context, scheduled *entity.ScheduledMessage) error {
+	channelID, err := utils.ParseUUID(scheduled.ChannelID, "channel ID")
+	if err != nil {
+		return err
+	}
+	userID, err := utils.ParseUUID(scheduled.UserID, "user ID")
+	if err != nil {
+		return err
+	}
+
+	client := transaction.ResolveClient(ctx, r.client)
+
+	builder := client.ScheduledMessage.Create().
+		SetChannelID(channelID).
+		SetUserID(userID).
+		SetBody(scheduled.Body).
+		SetAttachmentIds(scheduled.AttachmentIDs).
+		SetSendAt(scheduled.SendAt).
+		SetRecurrence(string(scheduled.Recurrence)).
+		SetTimezone(scheduled.Timezone).
+		SetStatus(string(scheduled.Status))
+
+	if scheduled.ParentID != nil {
+		parentID, err := utils.ParseUUID(*scheduled.ParentID, "parent message ID")
+		if err != nil {
+			return err
+		}
+		builder = builder.SetParentID(parentID)
+	}
+
+	sm, err := builder.Save(ctx)
+	if err != nil {
+		return err
+	}
+
+	created, err := r.findByID(ctx, client, sm.ID.String())
+	if err != nil {
+		return err
+	}
+	*scheduled = *created
+	return nil
+}
+
+func (r *scheduledMessageRepository) FindByID(ctx context.Context, id string) (*entity.ScheduledMessage, error) {
+	return r.findByID(ctx, transaction.ResolveClient(ctx, r.client), id)
+}
+
+func (r *scheduledMessageRepository) findByID(ctx context.Context, client *ent.Client, id string) (*entity.ScheduledMessage, error) {
+	scheduledID, err := utils.ParseUUID(id, "scheduled message ID")
+	if err != nil {
+		return nil, err
+	}
+
+	sm, err := r.query(client).
+		Where(scheduledmessage.ID(scheduledID)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return scheduledMessageToEntity(sm), nil
+}
+
+func (r *scheduledMessageRepository) FindByUserID(ctx context.Context, userID string) ([]*entity.ScheduledMessage, error) {
+	uid, err := utils.ParseUUID(userID, "user ID")
+	if err != nil {
+		return nil, err
+	}
+
+	client := transaction.ResolveClient(ctx, r.client)
+	rows, err := r.query(client).
+		Where(scheduledmessage.HasUserWith(user.ID(uid))).
+		Order(ent.Asc(scheduledmessage.FieldSendAt)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*entity.ScheduledMessage, 0, len(rows))
+	for _, sm := range rows {
+		result = append(result, scheduledMessageToEntity(sm))
+	}
+	return result, nil
+}
+
+func (r *scheduledMessageRepository) Update(ctx context.Context, scheduled *entity.ScheduledMessage) error {
+	updated, err := r.update(ctx, scheduled)
+	if err != nil {
+		return err
+	}
+	if !updated {
+		return fmt.Errorf("scheduled message not found: %s", scheduled.ID)
+	}
+	return nil
+}
+
+func (r *scheduledMessageRepository) UpdateUnclaimed(ctx context.Context, scheduled *entity.ScheduledMessage) (bool, error) {
+	return r.update(ctx, scheduled, unclaimedPredicate())
+}
+
+// update は条件に一致する場合のみ予約メッセージを更新し、更新したかを返します
+func (r *scheduledMessageRepository) update(ctx context.Context, scheduled *entity.ScheduledMessage, predicates ...predicate.ScheduledMessage) (bool, error) {
+	scheduledID, err := utils.ParseUUID(scheduled.ID, "scheduled message ID")
+	if err != nil {
+		return false, err
+	}
+
+	client := transaction.ResolveClient(ctx, r.client)
+
+	builder := client.ScheduledMessage.Update().
+		Where(append([]predicate.ScheduledMessage{scheduledmessage.ID(scheduledID)}, predicates...)...).
+		SetBody(scheduled.Body).
+		SetAttachmentIds(scheduled.AttachmentIDs).
+		SetSendAt(scheduled.SendAt).
+		SetRecurrence(string(scheduled.Recurrence)).
+		SetTimezone(scheduled.Timezone).
+		SetStatus(string(scheduled.Status)).
+		SetAttempts(scheduled.Attempts)
+
+	if scheduled.ClaimedAt != nil {
+		builder = builder.SetClaimedAt(*scheduled.ClaimedAt)
+	} else {
+		builder = builder.ClearClaimedAt()
+	}
+
+	if scheduled.LastError != nil {
+		builder = builder.SetLastError(*scheduled.LastError)
+	} else {
+		builder = builder.ClearLastError()
+	}
+
+	affected, err := builder.Save(ctx)
+	if err != nil {
+		return false, err
+	}
+	if affected == 0 {
+		return false, nil
+	}
+
+	updated, err := r.findByID(ctx, client, scheduled.ID)
+	if err != nil {
+		return false, err
+	}
+	if updated != nil {
+		*scheduled = *updated
+	}
+	return true, nil
+}
+
+func (r *scheduledMessageRepository) Delete(ctx context.Context, id string) error {
+	scheduledID, err := utils.ParseUUID(id, "scheduled message ID")
+	if err != nil {
+		return err
+	}
+
+	client := transaction.ResolveClient(ctx, r.client)
+	_, err = client.ScheduledMessage.Delete().
+		Where(scheduledmessage.ID(scheduledID)).
+		Exec(ctx)
+	return err
+}
+
+func (r *scheduledMessageRepository) DeleteUnclaimed(ctx context.Context, id string) (bool, error) {
+	scheduledID, err := utils.ParseUUID(id, "scheduled message ID")
+	if err != nil {
+		return false, err
+	}
+
+	client := transaction.ResolveClient(ctx, r.client)
+	affected, err := client.ScheduledMessage.Delete().
+		Where(scheduledmessage.ID(scheduledID), unclaimedPredicate()).
+		Exec(ctx)
+	if err != nil {
+		return false, err
+	}
+	return affected == 1, nil
+}
+
+func (r *scheduledMessageRepository) FindDue(ctx context.Context, now time.Time, staleBefore time.Time, limit int) ([]*entity.ScheduledMessage, error) {
+	client := transaction.ResolveClient(ctx, r.client)
+	rows, err := r.query(client).
+		Where(duePredicate(now, staleBefore)).
+		Order(ent.Asc(scheduledmessage.FieldSendAt)).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*entity.ScheduledMessage, 0, len(rows))
+	for _, sm := range rows {
+		result = append(result, scheduledMessageToEntity(sm))
+	}
+	return result, nil
+}
+
+func (r *scheduledMessageRepository) Claim(ctx context.Context, id string, now time.Time, staleBefore time.Time) (bool, error) {
+	scheduledID, err := utils.ParseUUID(id, "scheduled message ID")
+	if err != nil {
+		return false, err
+	}
+
+	// 条件付きの更新により、複数のインスタンスが同じ予約メッセージを取得しても1つだけが成功する
+	client := transaction.ResolveClient(ctx, r.client)
+	affected, err := client.ScheduledMessage.Update().
+		Where(
+			scheduledmessage.ID(scheduledID),
+			duePredicate(now, staleBefore),
+		).
+		SetStatus(string(entity.ScheduledMessageStatusSending)).
+		SetClaimedAt(now).
+		Save(ctx)
+	if err != nil {
+		return false, err
+	}
+	return affected == 1, nil
+}
+
+// duePredicate は投稿処理を開始できる予約メッセージの条件です
+func duePredicate(now time.Time, staleBefore time.Time) predicate.ScheduledMessage {
+	return scheduledmessage.Or(
+		scheduledmessage.And(
+			scheduledmessage.Status(string(entity.ScheduledMessageStatusPending)),
+			scheduledmessage.SendAtLTE(now),
+		),
+		scheduledmessage.And(
+			scheduledmessage.Status(string(entity.ScheduledMessageStatusSending)),
+			scheduledmessage.ClaimedAtLT(staleBefore),
+		),
+	)
+}
+
+// unclaimedPredicate はいずれのインスタンスも投稿処理を行っていない予約メッセージの条件です
+func unclaimedPredicate() predicate.ScheduledMessage {
+	return scheduledmessage.StatusIn(
+		string(entity.ScheduledMessageStatusPending),
+		string(entity.ScheduledMessageStatusFailed),
+	)
+}
+
+func (r *scheduledMessageRepository) query(client *ent.Client) *ent.ScheduledMessageQuery {
+	return client.ScheduledMessage.Query().
+		WithChannel().
+		WithUser().
+		WithParent()
+}
+
+func scheduledMessageToEntity(sm *ent.ScheduledMessage) *entity.ScheduledMessage {
+	var channelID, userID string
+	if sm.Edges.Channel != nil {
+		channelID = sm.Edges.Channel.ID.String()
+	}
+	if sm.Edges.User != nil {
+		userID = sm.Edges.User.ID.String()
+	}
+
+	var parentID *string
+	if sm.Edges.Parent != nil {
+		pid := sm.Edges.Parent.ID.String()
+		parentID = &pid
+	}
+
+	var claimedAt *time.Time
+	if !sm.ClaimedAt.IsZero() {
+		claimedAt = &sm.ClaimedAt
+	}
+
+	attachmentIDs := sm.AttachmentIds
+	if attachmentIDs == nil {
+		attachmentIDs = []string{}
+	}
+
+	return &entity.ScheduledMessage{
+		ID:            sm.ID.String(),
+		ChannelID:     channelID,
+		UserID:        userID,
+		ParentID:      parentID,
+		Body:          sm.Body,
+		AttachmentIDs: attachmentIDs,
+		SendAt:        sm.SendAt,
+		Recurrence:    entity.Recurrence(sm.Recurrence),
+		Timezone:      sm.Timezone,
+		Status:        entity.ScheduledMessageStatus(sm.Status),
+		ClaimedAt:     claimedAt,
+		Attempts:      sm.Attempts,
+		LastError:     utils.StringPtrFromNullable(sm.LastError),
+		CreatedAt:     sm.CreatedAt,
+		UpdatedAt:     sm.UpdatedAt,
+	}
+}
@@ -2,11 +2,13 @@ package repository
 
 import (
 	"context"
+	"slices"
 	"time"
 
 	"github.com/newt239/chat/ent"
 	"github.com/newt239/chat/ent/channel"
 	"github.com/newt239/chat/ent/systemmessage"
+	"github.com/newt239/chat/ent/user"
 	"github.com/newt239/chat/internal/domain/entity"
 	domainrepository "github.com/newt239/chat/internal/domain/repository"
 	"github.com/newt239/chat/internal/infrastructure/transaction"
@@ -24,6 +26,7 @@ func NewSystemMessageRepository(client *ent.Client) domainrepository.SystemMessa
 func (r *systemMessageRepository) Create(ctx context.Context, msg *entity.SystemMessage) error {
 	chID := utils.ParseUUIDOrNil(msg.ChannelID)
 	actorIDPtr := utils.ParseUUIDPtrOrNil(msg.ActorID)
+	recipientIDPtr := utils.ParseUUIDPtrOrNil(msg.RecipientID)
 
 	client := transaction.ResolveClient(ctx, r.client)
 
@@ -35,6 +38,9 @@ func (r *systemMessageRepository) Create(ctx context.Context, msg *entity.System
 	if actorIDPtr != nil {
 		builder = builder.SetActorID(*actorIDPtr)
 	}
+	if recipientIDPtr != nil {
+		builder = builder.SetRecipientID(*recipientIDPtr)
+	}
 
 	sm, err := builder.Save(ctx)
 	if err != nil {
@@ -53,16 +59,12 @@ func (r *systemMessageRepository) Create(ctx context.Context, msg *entity.System
 	return nil
 }
 
-func (r *systemMessageRepository) FindByChannelID(ctx context.Context, channelID string, limit int, since *time.Time, until *time.Time) ([]*entity.SystemMessage, error) {
-	chID, err := utils.ParseUUID(channelID, "channel ID")
+func (r *systemMessageRepository) FindByChannelID(ctx context.Context, channelID string, viewerID string, limit int, since *time.Time, until *time.Time) ([]*entity.SystemMessage, error) {
+	q, err := r.visibleQuery(ctx, channelID, viewerID)
 	if err != nil {
 		return nil, err
 	}
 
-	client := transaction.ResolveClient(ctx, r.client)
-	q := client.SystemMessage.Query().
-		Where(systemmessage.HasChannelWith(channel.ID(chID)))
-
 	if since != nil {
 		q = q.Where(systemmessage.CreatedAtGT(*since))
 	}
@@ -76,12 +78,94 @@ func (r *systemMessageRepository) FindByChannelID(ctx context.Context, channelID
 	rows, err := q.
 		WithChannel().
 		WithActor().
-		Order(ent.Desc(systemmessage.FieldCreatedAt)).
+		WithRecipient().
+		Order(ent.Desc(systemmessage.FieldCreatedAt), ent.Desc(systemmessage.FieldID)).
 		All(ctx)
 	if err != nil {
 		return nil, err
 	}
 
+	return systemMessagesToEntities(rows), nil
+}
+
+func (r *systemMessageRepository) FindByChannelIDBefore(ctx context.Context, channelID string, viewerID string, cursor entity.TimelineCursor, limit int) ([]*entity.SystemMessage, error) {
+	return r.findByChannelIDFromCursor(ctx, channelID, viewerID, cursor, false, limit)
+}
+
+func (r *systemMessageRepository) FindByChannelIDAfter(ctx context.Context, channelID string, viewerID string, cursor entity.TimelineCursor, limit int) ([]*entity.SystemMessage, error) {
+	return r.findByChannelIDFromCursor(ctx, channelID, viewerID, cursor, true, limit)
+}
+
+// findByChannelIDFromCursor は作成日時・IDの順で cursor の前または後にあるシステムメッセージを、cursor に近いものから取得して新しい順に返します
+func (r *systemMessageRepository) findByChannelIDFromCursor(ctx context.Context, channelID string, viewerID string, cursor entity.TimelineCursor, after bool, limit int) ([]*entity.SystemMessage, error) {
+	q, err := r.visibleQuery(ctx, channelID, viewerID)
+	if err != nil {
+		return nil, err
+	}
+	cursorID, err := utils.ParseUUID(cursor.ID, "cursor ID")
+	if err != nil {
+		return nil, err
+	}
+
+	if after {
+		q = q.
+			Where(systemmessage.Or(
+				systemmessage.CreatedAtGT(cursor.CreatedAt),
+				systemmessage.And(systemmessage.CreatedAtEQ(cursor.CreatedAt), systemmessage.IDGT(cursorID)),
+			)).
+			Order(ent.Asc(systemmessage.FieldCreatedAt), ent.Asc(systemmessage.FieldID))
+	} else {
+		q = q.
+			Where(systemmessage.Or(
+				systemmessage.CreatedAtLT(cursor.CreatedAt),
+				systemmessage.And(systemmessage.CreatedAtEQ(cursor.CreatedAt), systemmessage.IDLT(cursorID)),
+			)).
+			Order(ent.Desc(systemmessage.FieldCreatedAt), ent.Desc(systemmessage.FieldID))
+	}
+	if limit > 0 {
+		q = q.Limit(limit)
+	}
+
+	rows, err := q.
+		WithChannel().
+		WithActor().
+		WithRecipient().
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	out := systemMessagesToEntities(rows)
+	if after {
+		slices.Reverse(out)
+	}
+	return out, nil
+}
+
+// visibleQuery はチャンネルのシステムメッセージのうち、viewerIDのユーザーに表示するものを絞り込むクエリを返します
+func (r *systemMessageRepository) visibleQuery(ctx context.Context, channelID string, viewerID string) (*ent.SystemMessageQuery, error) {
+	chID, err := utils.ParseUUID(channelID, "channel ID")
+	if err != nil {
+		return nil, err
+	}
+	viewerUUID, err := utils.ParseUUID(viewerID, "viewer ID")
+	if err != nil {
+		return nil, err
+	}
+
+	client := transaction.ResolveClient(ctx, r.client)
+	return client.SystemMessage.Query().
+		Where(
+			systemmessage.HasChannelWith(channel.ID(chID)),
+			// 宛先のあるシステムメッセージは宛先のユーザーのみに表示する
+			systemmessage.Or(
+				systemmessage.Not(systemmessage.HasRecipient()),
+				systemmessage.HasRecipientWith(user.ID(viewerUUID)),
+			),
+		), nil
+}
+
+func systemMessagesToEntities(rows []*ent.SystemMessage) []*entity.SystemMessage {
 	out := make([]*entity.SystemMessage, 0, len(rows))
 	for _, sm := range rows {
 		var actorID *string
@@ -89,18 +173,24 @@ func (r *systemMessageRepository) FindByChannelID(ctx context.Context, channelID
 			s := sm.Edges.Actor.ID.String()
 			actorID = &s
 		}
+		var recipientID *string
+		if sm.Edges.Recipient != nil {
+			s := sm.Edges.Recipient.ID.String()
+			recipientID = &s
+		}
 		chID := ""
 		if sm.Edges.Channel != nil {
 			chID = sm.Edges.Channel.ID.String()
 		}
 		out = append(out, &entity.SystemMessage{
-			ID:        sm.ID.String(),
-			ChannelID: chID,
-			Kind:      entity.SystemMessageKind(sm.Kind),
-			Payload:   sm.Payload,
-			ActorID:   actorID,
-			CreatedAt: sm.CreatedAt,
+			ID:          sm.ID.String(),
+			ChannelID:   chID,
+			Kind:        entity.SystemMessageKind(sm.Kind),
+			Payload:     sm.Payload,
+			ActorID:     actorID,
+			RecipientID: recipientID,
+			CreatedAt:   sm.CreatedAt,
 		})
 	}
-	return out, nil
+	return out
 }
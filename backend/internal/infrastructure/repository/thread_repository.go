@@ -49,6 +49,7 @@ func (r *threadRepository) CalculateMetadataByMessageID(ctx context.Context, mes
 	// 返信を取得
 	replies, err := client.Message.Query().
 		Where(message.HasParentWith(message.ID(mid))).
+		WithUser().
 		Order(ent.Desc(message.FieldCreatedAt)).
 		All(ctx)
 	if err != nil {
@@ -536,3 +537,28 @@ func (r *threadRepository) IsFollowing(ctx context.Context, userID, threadID str
 		).
 		Exist(ctx)
 }
+
+// CountUnreadReplies はユーザーが最後にスレッドを読んだ後の返信数を返します
+// 既読状態がない場合は全ての返信を未読として数えます
+func (r *threadRepository) CountUnreadReplies(ctx context.Context, userID, threadID string) (int, error) {
+	lastReadAt, err := r.GetReadState(ctx, userID, threadID)
+	if err != nil {
+		return 0, err
+	}
+	tid, err := utils.ParseUUID(threadID, "thread ID")
+	if err != nil {
+		return 0, err
+	}
+
+	client := transaction.ResolveClient(ctx, r.client)
+
+	query := client.Message.Query().
+		Where(
+			message.HasParentWith(message.ID(tid)),
+			message.DeletedAtIsNil(),
+		)
+	if lastReadAt != nil {
+		query = query.Where(message.CreatedAtGT(*lastReadAt))
+	}
+	return query.Count(ctx)
+}
@@ -2,6 +2,7 @@ package repository
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 
@@ -126,7 +127,8 @@ func (r *userRepository) Update(ctx context.Context, usr *entity.User) error {
 	builder := client.User.UpdateOneID(userID).
 		SetEmail(usr.Email).
 		SetPasswordHash(usr.PasswordHash).
-		SetDisplayName(usr.DisplayName)
+		SetDisplayName(usr.DisplayName).
+		SetReadReceiptsEnabled(usr.ReadReceiptsEnabled)
 
 	if usr.AvatarURL != nil {
 		builder = builder.SetAvatarURL(*usr.AvatarURL)
@@ -152,3 +154,15 @@ func (r *userRepository) Delete(ctx context.Context, id string) error {
 	client := transaction.ResolveClient(ctx, r.client)
 	return client.User.DeleteOneID(userID).Exec(ctx)
 }
+
+func (r *userRepository) UpdateLastSeenAt(ctx context.Context, id string, lastSeenAt time.Time) error {
+	userID, err := utils.ParseUUID(id, "user ID")
+	if err != nil {
+		return err
+	}
+
+	client := transaction.ResolveClient(ctx, r.client)
+	return client.User.UpdateOneID(userID).
+		SetLastSeenAt(lastSeenAt).
+		Exec(ctx)
+}
@@ -119,7 +119,8 @@ func (r *workspaceRepository) Update(ctx context.Context, w *entity.Workspace) e
         builder = builder.ClearIconURL()
     }
 
-    builder = builder.SetIsPublic(w.IsPublic)
+    builder = builder.SetIsPublic(w.IsPublic).
+        SetMessageHistoryEnabled(w.MessageHistoryEnabled)
 
     ws, err := builder.Save(ctx)
     if err != nil {
@@ -354,3 +355,16 @@ func (r *workspaceRepository) ExistsByID(ctx context.Context, id string) (bool,
     }
     return count > 0, nil
 }
+
+func (r *workspaceRepository) LockShared(ctx context.Context, id string) error {
+    client := transaction.ResolveClient(ctx, r.client)
+    _, err := client.Workspace.Query().
+        Where(
+            workspace.ID(id),
+            func(s *sql.Selector) {
+                s.ForShare()
+            },
+        ).
+        OnlyID(ctx)
+    return err
+}
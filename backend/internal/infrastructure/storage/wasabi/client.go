@@ -42,12 +42,14 @@ func NewClient(ctx context.Context, cfg *Config) (*Client, error) {
 }
 
 type PresignService struct {
+	s3Client      *s3.Client
 	presignClient *s3.PresignClient
 	config        *Config
 }
 
 func NewPresignService(client *Client) *PresignService {
 	return &PresignService{
+		s3Client:      client.s3Client,
 		presignClient: s3.NewPresignClient(client.s3Client),
 		config:        client.config,
 	}
@@ -103,3 +105,11 @@ func (p *PresignService) GenerateDownloadURL(key string, expires interface{}) (s
 
 	return request.URL, nil
 }
+
+func (p *PresignService) DeleteObject(ctx context.Context, key string) error {
+	_, err := p.s3Client.DeleteObject(ctx, &s3.DeleteObjectInput{
+		Bucket: aws.String(p.config.BucketName),
+		Key:    aws.String(key),
+	})
+	return err
+}
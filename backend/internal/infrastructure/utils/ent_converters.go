@@ -13,14 +13,21 @@ func UserToEntity(u *ent.User) *entity.User {
 	if u == nil {
 		return nil
 	}
+	var lastSeenAt *time.Time
+	if !u.LastSeenAt.IsZero() {
+		lastSeenAt = &u.LastSeenAt
+	}
+
 	return &entity.User{
-		ID:           u.ID.String(),
-		Email:        u.Email,
-		PasswordHash: u.PasswordHash,
-		DisplayName:  u.DisplayName,
-		AvatarURL:    StringPtrFromNullable(u.AvatarURL),
-		CreatedAt:    u.CreatedAt,
-		UpdatedAt:    u.UpdatedAt,
+		ID:                  u.ID.String(),
+		Email:               u.Email,
+		PasswordHash:        u.PasswordHash,
+		DisplayName:         u.DisplayName,
+		AvatarURL:           StringPtrFromNullable(u.AvatarURL),
+		LastSeenAt:          lastSeenAt,
+		ReadReceiptsEnabled: u.ReadReceiptsEnabled,
+		CreatedAt:           u.CreatedAt,
+		UpdatedAt:           u.UpdatedAt,
 	}
 }
 
@@ -62,6 +69,7 @@ func WorkspaceToEntity(w *ent.Workspace) *entity.Workspace {
 		Description: StringPtrFromNullable(w.Description),
 		IconURL:     StringPtrFromNullable(w.IconURL),
         IsPublic:    w.IsPublic,
+		MessageHistoryEnabled: w.MessageHistoryEnabled,
 		CreatedBy:   createdBy,
 		CreatedAt:   w.CreatedAt,
 		UpdatedAt:   w.UpdatedAt,
@@ -176,15 +184,18 @@ func MessageToEntity(m *ent.Message) *entity.Message {
 	}
 
 	return &entity.Message{
-		ID:        m.ID.String(),
-		ChannelID: channelID,
-		UserID:    userID,
-		ParentID:  parentID,
-		Body:      m.Body,
-		CreatedAt: m.CreatedAt,
-		EditedAt:  editedAt,
-		DeletedAt: deletedAt,
-		DeletedBy: deletedBy,
+		ID:          m.ID.String(),
+		ChannelID:   channelID,
+		UserID:      userID,
+		ParentID:    parentID,
+		Body:        m.Body,
+		BodyAST:     MessageBodyASTFromJSON(m.BodyAst),
+		CreatedAt:   m.CreatedAt,
+		EditedAt:    editedAt,
+		DeletedAt:   deletedAt,
+		DeletedBy:   deletedBy,
+		ClientMsgID: StringPtrFromNullable(m.ClientMsgID),
+		RevisionCount: m.RevisionCount,
 	}
 }
 
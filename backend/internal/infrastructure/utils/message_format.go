@@ -0,0 +1,89 @@
+package utils
+
+import (
+	"encoding/json"
+
+	"github.com/newt239/chat/internal/domain/entity"
+)
+
+// messageBodyASTRecord はmessages.body_astに保存する書式付きテキストの形式です
+// Versionが現在のentity.MessageFormatVersionと異なる場合は読み込まず、本文を解析し直します
+type messageBodyASTRecord struct {
+	Version int                 `json:"version"`
+	Nodes   []messageNodeRecord `json:"nodes"`
+}
+
+type messageNodeRecord struct {
+	Type     string              `json:"type"`
+	Text     string              `json:"text,omitempty"`
+	Name     string              `json:"name,omitempty"`
+	URL      string              `json:"url,omitempty"`
+	Language string              `json:"language,omitempty"`
+	Ordered  bool                `json:"ordered,omitempty"`
+	Children []messageNodeRecord `json:"children,omitempty"`
+}
+
+// MessageBodyASTToJSON は書式付きテキストをmessages.body_astに保存するJSONに変換します
+func MessageBodyASTToJSON(nodes []entity.MessageNode) (string, error) {
+	data, err := json.Marshal(messageBodyASTRecord{
+		Version: entity.MessageFormatVersion,
+		Nodes:   messageNodesToRecords(nodes),
+	})
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+// MessageBodyASTFromJSON はmessages.body_astのJSONを書式付きテキストに変換します
+// 保存されていない場合や形式のバージョンが異なる場合はnilを返します
+func MessageBodyASTFromJSON(data string) []entity.MessageNode {
+	if data == "" {
+		return nil
+	}
+
+	var record messageBodyASTRecord
+	if err := json.Unmarshal([]byte(data), &record); err != nil {
+		return nil
+	}
+	if record.Version != entity.MessageFormatVersion {
+		return nil
+	}
+	return messageNodesFromRecords(record.Nodes)
+}
+
+func messageNodesToRecords(nodes []entity.MessageNode) []messageNodeRecord {
+	records := make([]messageNodeRecord, 0, len(nodes))
+	for _, node := range nodes {
+		records = append(records, messageNodeRecord{
+			Type:     string(node.Type),
+			Text:     node.Text,
+			Name:     node.Name,
+			URL:      node.URL,
+			Language: node.Language,
+			Ordered:  node.Ordered,
+			Children: messageNodesToRecords(node.Children),
+		})
+	}
+	return records
+}
+
+func messageNodesFromRecords(records []messageNodeRecord) []entity.MessageNode {
+	nodes := make([]entity.MessageNode, 0, len(records))
+	for _, record := range records {
+		var children []entity.MessageNode
+		if len(record.Children) > 0 {
+			children = messageNodesFromRecords(record.Children)
+		}
+		nodes = append(nodes, entity.MessageNode{
+			Type:     entity.MessageNodeType(record.Type),
+			Text:     record.Text,
+			Name:     record.Name,
+			URL:      record.URL,
+			Language: record.Language,
+			Ordered:  record.Ordered,
+			Children: children,
+		})
+	}
+	return nodes
+}
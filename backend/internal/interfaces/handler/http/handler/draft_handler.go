@@ -0,0 +1,119 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/newt239/chat/internal/infrastructure/utils"
+	openapi "github.com/newt239/chat/internal/openapi_gen"
+	draftuc "github.com/newt239/chat/internal/usecase/draft"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+type DraftHandler struct {
+	DraftUC draftuc.DraftUseCase
+}
+
+func (h *DraftHandler) GetDraft(c echo.Context, channelId openapi_types.UUID, params openapi.GetDraftParams) error {
+	userID, ok := c.Get("userID").(string)
+	if !ok {
+		return utils.HandleAuthError()
+	}
+
+	input := draftuc.GetDraftInput{
+		UserID:    userID,
+		ChannelID: channelId.String(),
+		ParentID:  toParentID(params.ParentId),
+	}
+
+	output, err := h.DraftUC.GetDraft(c.Request().Context(), input)
+	if err != nil {
+		return mapDraftError(err)
+	}
+
+	return c.JSON(http.StatusOK, output)
+}
+
+func (h *DraftHandler) SaveDraft(c echo.Context, channelId openapi_types.UUID, params openapi.SaveDraftParams) error {
+	userID, ok := c.Get("userID").(string)
+	if !ok {
+		return utils.HandleAuthError()
+	}
+
+	var req openapi.SaveDraftRequest
+	if err := c.Bind(&req); err != nil {
+		return utils.HandleBindError(err)
+	}
+
+	input := draftuc.SaveDraftInput{
+		UserID:        userID,
+		ChannelID:     channelId.String(),
+		ParentID:      toParentID(params.ParentId),
+		Body:          req.Body,
+		AttachmentIDs: toAttachmentIDs(req.AttachmentIds),
+	}
+
+	output, err := h.DraftUC.SaveDraft(c.Request().Context(), input)
+	if err != nil {
+		return mapDraftError(err)
+	}
+
+	return c.JSON(http.StatusOK, output)
+}
+
+func (h *DraftHandler) DeleteDraft(c echo.Context, channelId openapi_types.UUID, params openapi.DeleteDraftParams) error {
+	userID, ok := c.Get("userID").(string)
+	if !ok {
+		return utils.HandleAuthError()
+	}
+
+	input := draftuc.DeleteDraftInput{
+		UserID:    userID,
+		ChannelID: channelId.String(),
+		ParentID:  toParentID(params.ParentId),
+	}
+
+	if err := h.DraftUC.DeleteDraft(c.Request().Context(), input); err != nil {
+		return mapDraftError(err)
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+func (h *DraftHandler) ListDrafts(c echo.Context, id string) error {
+	userID, ok := c.Get("userID").(string)
+	if !ok {
+		return utils.HandleAuthError()
+	}
+
+	input := draftuc.ListDraftsInput{
+		UserID:      userID,
+		WorkspaceID: id,
+	}
+
+	output, err := h.DraftUC.ListDrafts(c.Request().Context(), input)
+	if err != nil {
+		return mapDraftError(err)
+	}
+
+	return c.JSON(http.StatusOK, output)
+}
+
+func toParentID(id *openapi_types.UUID) *string {
+	if id == nil {
+		return nil
+	}
+	parentID := id.String()
+	return &parentID
+}
+
+func mapDraftError(err error) error {
+	switch err {
+	case draftuc.ErrDraftNotFound:
+		return echo.NewHTTPError(http.StatusNotFound, err.Error())
+	case draftuc.ErrParentMessageNotFound, draftuc.ErrEmptyDraft:
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	default:
+		return handleUseCaseError(err)
+	}
+}
@@ -139,11 +139,17 @@ func (h *MessageHandler) ListMessages(c echo.Context, channelId openapi_types.UU
 		Limit:     limit,
 		Since:     sinceTime,
 		Until:     untilTime,
+		Before:    params.Before,
+		After:     params.After,
+	}
+	if params.Around != nil {
+		around := params.Around.String()
+		input.Around = &around
 	}
 
 	messages, err := h.MessageUC.ListMessages(c.Request().Context(), input)
 	if err != nil {
-		return handleUseCaseError(err)
+		return mapMessageError(err)
 	}
 
 	return c.JSON(http.StatusOK, messages)
@@ -184,11 +190,52 @@ func (h *MessageHandler) CreateMessage(c echo.Context, channelId openapi_types.U
 		Body:          req.Body,
 		ParentID:      parentID,
 		AttachmentIDs: attachmentIDs,
+		ClientMsgID:   req.ClientMsgId,
 	}
 
 	message, err := h.MessageUC.CreateMessage(c.Request().Context(), input)
 	if err != nil {
-		return handleUseCaseError(err)
+		return mapMessageError(err)
+	}
+
+	return c.JSON(http.StatusCreated, message)
+}
+
+func (h *MessageHandler) ShareMessage(c echo.Context, messageId openapi_types.UUID) error {
+	userID, ok := c.Get("userID").(string)
+	if !ok {
+		return utils.HandleAuthError()
+	}
+
+	var req openapi.ShareMessageRequest
+	if err := c.Bind(&req); err != nil {
+		return utils.HandleBindError(err)
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	var parentID *string
+	if req.ParentId != nil {
+		parentIDStr := req.ParentId.String()
+		parentID = &parentIDStr
+	}
+
+	input := messageuc.ShareMessageInput{
+		MessageID:   messageId.String(),
+		ChannelID:   req.ChannelId.String(),
+		UserID:      userID,
+		ParentID:    parentID,
+		ClientMsgID: req.ClientMsgId,
+	}
+	if req.Body != nil {
+		input.Body = *req.Body
+	}
+
+	message, err := h.MessageUC.ShareMessage(c.Request().Context(), input)
+	if err != nil {
+		return mapMessageError(err)
 	}
 
 	return c.JSON(http.StatusCreated, message)
@@ -223,6 +270,25 @@ func (h *MessageHandler) UpdateMessage(c echo.Context, messageId openapi_types.U
 	return c.JSON(http.StatusOK, message)
 }
 
+func (h *MessageHandler) ListMessageRevisions(c echo.Context, messageId openapi_types.UUID) error {
+	userID, ok := c.Get("userID").(string)
+	if !ok {
+		return utils.HandleAuthError()
+	}
+
+	input := messageuc.ListMessageRevisionsInput{
+		MessageID: messageId.String(),
+		UserID:    userID,
+	}
+
+	output, err := h.MessageUC.ListMessageRevisions(c.Request().Context(), input)
+	if err != nil {
+		return mapMessageError(err)
+	}
+
+	return c.JSON(http.StatusOK, output)
+}
+
 func (h *MessageHandler) DeleteMessage(c echo.Context, messageId openapi_types.UUID) error {
 	userID, ok := c.Get("userID").(string)
 	if !ok {
@@ -243,11 +309,19 @@ func (h *MessageHandler) DeleteMessage(c echo.Context, messageId openapi_types.U
 
 func mapMessageError(err error) error {
 	switch err {
-	case messageuc.ErrMessageNotFound, messageuc.ErrChannelNotFound:
+	case messageuc.ErrMessageNotFound, messageuc.ErrChannelNotFound, messageuc.ErrSharedMessageNotFound:
 		return echo.NewHTTPError(http.StatusNotFound, err.Error())
 	case messageuc.ErrUnauthorized:
 		return echo.NewHTTPError(http.StatusForbidden, err.Error())
-	case messageuc.ErrMessageAlreadyDeleted, messageuc.ErrCannotEditDeleted:
+	case messageuc.ErrClientMsgIDConflict:
+		return echo.NewHTTPError(http.StatusConflict, err.Error())
+	case messageuc.ErrMessageAlreadyDeleted,
+		messageuc.ErrCannotEditDeleted,
+		messageuc.ErrParentMessageNotFound,
+		messageuc.ErrEmptyMessageBody,
+		messageuc.ErrShareAcrossWorkspaces,
+		messageuc.ErrInvalidCursor,
+		messageuc.ErrConflictingListRange:
 		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
 	default:
 		return handleUseCaseError(err)
@@ -0,0 +1,115 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/newt239/chat/internal/infrastructure/utils"
+	openapi "github.com/newt239/chat/internal/openapi_gen"
+	polluc "github.com/newt239/chat/internal/usecase/poll"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+type PollHandler struct {
+	PollUC polluc.PollUseCase
+}
+
+func (h *PollHandler) CreatePoll(c echo.Context, channelId openapi_types.UUID) error {
+	userID, ok := c.Get("userID").(string)
+	if !ok {
+		return utils.HandleAuthError()
+	}
+
+	var req openapi.CreatePollRequest
+	if err := c.Bind(&req); err != nil {
+		return utils.HandleBindError(err)
+	}
+
+	input := polluc.CreatePollInput{
+		ChannelID:   channelId.String(),
+		UserID:      userID,
+		Question:    req.Question,
+		Options:     req.Options,
+		ClosesAt:    req.ClosesAt,
+		ClientMsgID: req.ClientMsgId,
+	}
+	if req.MultipleChoice != nil {
+		input.MultipleChoice = *req.MultipleChoice
+	}
+	if req.Anonymous != nil {
+		input.Anonymous = *req.Anonymous
+	}
+	if req.ParentId != nil {
+		parentID := req.ParentId.String()
+		input.ParentID = &parentID
+	}
+
+	output, err := h.PollUC.CreatePoll(c.Request().Context(), input)
+	if err != nil {
+		return mapPollError(err)
+	}
+
+	return c.JSON(http.StatusCreated, output)
+}
+
+func (h *PollHandler) VotePoll(c echo.Context, id openapi_types.UUID) error {
+	userID, ok := c.Get("userID").(string)
+	if !ok {
+		return utils.HandleAuthError()
+	}
+
+	var req openapi.VotePollRequest
+	if err := c.Bind(&req); err != nil {
+		return utils.HandleBindError(err)
+	}
+
+	input := polluc.VoteInput{
+		PollID:   id.String(),
+		OptionID: req.OptionId.String(),
+		UserID:   userID,
+	}
+
+	output, err := h.PollUC.Vote(c.Request().Context(), input)
+	if err != nil {
+		return mapPollError(err)
+	}
+
+	return c.JSON(http.StatusOK, output)
+}
+
+func (h *PollHandler) UnvotePoll(c echo.Context, id openapi_types.UUID, optionId openapi_types.UUID) error {
+	userID, ok := c.Get("userID").(string)
+	if !ok {
+		return utils.HandleAuthError()
+	}
+
+	input := polluc.UnvoteInput{
+		PollID:   id.String(),
+		OptionID: optionId.String(),
+		UserID:   userID,
+	}
+
+	output, err := h.PollUC.Unvote(c.Request().Context(), input)
+	if err != nil {
+		return mapPollError(err)
+	}
+
+	return c.JSON(http.StatusOK, output)
+}
+
+func mapPollError(err error) error {
+	switch err {
+	case polluc.ErrPollNotFound, polluc.ErrOptionNotFound:
+		return echo.NewHTTPError(http.StatusNotFound, err.Error())
+	case polluc.ErrPollClosed:
+		return echo.NewHTTPError(http.StatusConflict, err.Error())
+	case polluc.ErrInvalidQuestion,
+		polluc.ErrInvalidOptionCount,
+		polluc.ErrInvalidOptionText,
+		polluc.ErrDuplicateOption,
+		polluc.ErrInvalidClosesAt:
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	default:
+		return mapMessageError(err)
+	}
+}
@@ -0,0 +1,33 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/newt239/chat/internal/infrastructure/utils"
+	presenceuc "github.com/newt239/chat/internal/usecase/presence"
+)
+
+type PresenceHandler struct {
+	PresenceUC presenceuc.PresenceUseCase
+}
+
+func (h *PresenceHandler) GetWorkspacePresence(c echo.Context, id string) error {
+	userID, ok := c.Get("userID").(string)
+	if !ok {
+		return utils.HandleAuthError()
+	}
+
+	input := presenceuc.GetWorkspacePresenceInput{
+		WorkspaceID: id,
+		UserID:      userID,
+	}
+
+	output, err := h.PresenceUC.GetWorkspacePresence(c.Request().Context(), input)
+	if err != nil {
+		return handleUseCaseError(err)
+	}
+
+	return c.JSON(http.StatusOK, output)
+}
@@ -0,0 +1,147 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/newt239/chat/internal/infrastructure/utils"
+	openapi "github.com/newt239/chat/internal/openapi_gen"
+	reminderuc "github.com/newt239/chat/internal/usecase/reminder"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+type ReminderHandler struct {
+	ReminderUC reminderuc.ReminderUseCase
+}
+
+func (h *ReminderHandler) ListReminders(c echo.Context, params openapi.ListRemindersParams) error {
+	userID, ok := c.Get("userID").(string)
+	if !ok {
+		return utils.HandleAuthError()
+	}
+
+	input := reminderuc.ListRemindersInput{
+		UserID:           userID,
+		IncludeCompleted: params.IncludeCompleted != nil && *params.IncludeCompleted,
+	}
+
+	output, err := h.ReminderUC.ListReminders(c.Request().Context(), input)
+	if err != nil {
+		return mapReminderError(err)
+	}
+
+	return c.JSON(http.StatusOK, output)
+}
+
+func (h *ReminderHandler) CreateReminder(c echo.Context) error {
+	userID, ok := c.Get("userID").(string)
+	if !ok {
+		return utils.HandleAuthError()
+	}
+
+	var req openapi.CreateReminderRequest
+	if err := c.Bind(&req); err != nil {
+		return utils.HandleBindError(err)
+	}
+
+	input := reminderuc.CreateReminderInput{
+		UserID:    userID,
+		MessageID: req.MessageId.String(),
+		RemindAt:  req.RemindAt,
+		Preset:    req.Preset,
+	}
+	if req.Timezone != nil {
+		input.Timezone = *req.Timezone
+	}
+
+	output, err := h.ReminderUC.CreateReminder(c.Request().Context(), input)
+	if err != nil {
+		return mapReminderError(err)
+	}
+
+	return c.JSON(http.StatusCreated, output)
+}
+
+func (h *ReminderHandler) SnoozeReminder(c echo.Context, id openapi_types.UUID) error {
+	userID, ok := c.Get("userID").(string)
+	if !ok {
+		return utils.HandleAuthError()
+	}
+
+	var req openapi.SnoozeReminderRequest
+	if err := c.Bind(&req); err != nil {
+		return utils.HandleBindError(err)
+	}
+
+	input := reminderuc.SnoozeReminderInput{
+		ID:       id.String(),
+		UserID:   userID,
+		RemindAt: req.RemindAt,
+		Preset:   req.Preset,
+	}
+	if req.Timezone != nil {
+		input.Timezone = *req.Timezone
+	}
+
+	output, err := h.ReminderUC.SnoozeReminder(c.Request().Context(), input)
+	if err != nil {
+		return mapReminderError(err)
+	}
+
+	return c.JSON(http.StatusOK, output)
+}
+
+func (h *ReminderHandler) CompleteReminder(c echo.Context, id openapi_types.UUID) error {
+	userID, ok := c.Get("userID").(string)
+	if !ok {
+		return utils.HandleAuthError()
+	}
+
+	input := reminderuc.CompleteReminderInput{
+		ID:     id.String(),
+		UserID: userID,
+	}
+
+	output, err := h.ReminderUC.CompleteReminder(c.Request().Context(), input)
+	if err != nil {
+		return mapReminderError(err)
+	}
+
+	return c.JSON(http.StatusOK, output)
+}
+
+func (h *ReminderHandler) DeleteReminder(c echo.Context, id openapi_types.UUID) error {
+	userID, ok := c.Get("userID").(string)
+	if !ok {
+		return utils.HandleAuthError()
+	}
+
+	input := reminderuc.DeleteReminderInput{
+		ID:     id.String(),
+		UserID: userID,
+	}
+
+	if err := h.ReminderUC.DeleteReminder(c.Request().Context(), input); err != nil {
+		return mapReminderError(err)
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+func mapReminderError(err error) error {
+	switch err {
+	case reminderuc.ErrReminderNotFound, reminderuc.ErrMessageNotFound:
+		return echo.NewHTTPError(http.StatusNotFound, err.Error())
+	case reminderuc.ErrUnauthorized:
+		return echo.NewHTTPError(http.StatusForbidden, err.Error())
+	case reminderuc.ErrReminderInProgress, reminderuc.ErrReminderCompleted:
+		return echo.NewHTTPError(http.StatusConflict, err.Error())
+	case reminderuc.ErrRemindAtRequired,
+		reminderuc.ErrInvalidRemindAt,
+		reminderuc.ErrInvalidPreset,
+		reminderuc.ErrInvalidTimezone:
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	default:
+		return handleUseCaseError(err)
+	}
+}
@@ -0,0 +1,136 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/newt239/chat/internal/domain/entity"
+	"github.com/newt239/chat/internal/infrastructure/utils"
+	openapi "github.com/newt239/chat/internal/openapi_gen"
+	retentionuc "github.com/newt239/chat/internal/usecase/retention"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+type RetentionHandler struct {
+	RetentionUC retentionuc.RetentionUseCase
+}
+
+func (h *RetentionHandler) GetWorkspaceRetentionPolicy(c echo.Context, id string) error {
+	userID, ok := c.Get("userID").(string)
+	if !ok {
+		return utils.HandleAuthError()
+	}
+
+	input := retentionuc.GetWorkspacePolicyInput{
+		WorkspaceID: id,
+		UserID:      userID,
+	}
+
+	output, err := h.RetentionUC.GetWorkspacePolicy(c.Request().Context(), input)
+	if err != nil {
+		return mapRetentionError(err)
+	}
+
+	return c.JSON(http.StatusOK, output)
+}
+
+func (h *RetentionHandler) UpdateWorkspaceRetentionPolicy(c echo.Context, id string) error {
+	userID, ok := c.Get("userID").(string)
+	if !ok {
+		return utils.HandleAuthError()
+	}
+
+	var req openapi.UpdateWorkspaceRetentionPolicyRequest
+	if err := c.Bind(&req); err != nil {
+		return utils.HandleBindError(err)
+	}
+
+	input := retentionuc.UpdateWorkspacePolicyInput{
+		WorkspaceID:   id,
+		UserID:        userID,
+		RetentionDays: req.RetentionDays,
+	}
+
+	output, err := h.RetentionUC.UpdateWorkspacePolicy(c.Request().Context(), input)
+	if err != nil {
+		return mapRetentionError(err)
+	}
+
+	return c.JSON(http.StatusOK, output)
+}
+
+func (h *RetentionHandler) GetRetentionReport(c echo.Context, id string) error {
+	userID, ok := c.Get("userID").(string)
+	if !ok {
+		return utils.HandleAuthError()
+	}
+
+	input := retentionuc.GetReportInput{
+		WorkspaceID: id,
+		UserID:      userID,
+	}
+
+	output, err := h.RetentionUC.GetReport(c.Request().Context(), input)
+	if err != nil {
+		return mapRetentionError(err)
+	}
+
+	return c.JSON(http.StatusOK, output)
+}
+
+func (h *RetentionHandler) GetChannelRetentionPolicy(c echo.Context, channelId openapi_types.UUID) error {
+	userID, ok := c.Get("userID").(string)
+	if !ok {
+		return utils.HandleAuthError()
+	}
+
+	input := retentionuc.GetChannelPolicyInput{
+		ChannelID: channelId.String(),
+		UserID:    userID,
+	}
+
+	output, err := h.RetentionUC.GetChannelPolicy(c.Request().Context(), input)
+	if err != nil {
+		return mapRetentionError(err)
+	}
+
+	return c.JSON(http.StatusOK, output)
+}
+
+func (h *RetentionHandler) UpdateChannelRetentionPolicy(c echo.Context, channelId openapi_types.UUID) error {
+	userID, ok := c.Get("userID").(string)
+	if !ok {
+		return utils.HandleAuthError()
+	}
+
+	var req openapi.UpdateChannelRetentionPolicyRequest
+	if err := c.Bind(&req); err != nil {
+		return utils.HandleBindError(err)
+	}
+
+	input := retentionuc.UpdateChannelPolicyInput{
+		ChannelID:     channelId.String(),
+		UserID:        userID,
+		RetentionDays: req.RetentionDays,
+	}
+
+	output, err := h.RetentionUC.UpdateChannelPolicy(c.Request().Context(), input)
+	if err != nil {
+		return mapRetentionError(err)
+	}
+
+	return c.JSON(http.StatusOK, output)
+}
+
+func mapRetentionError(err error) error {
+	switch err {
+	case retentionuc.ErrWorkspaceNotFound, retentionuc.ErrChannelNotFound:
+		return echo.NewHTTPError(http.StatusNotFound, err.Error())
+	case retentionuc.ErrUnauthorized:
+		return echo.NewHTTPError(http.StatusForbidden, err.Error())
+	case entity.ErrInvalidMessageRetentionDays:
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	default:
+		return handleUseCaseError(err)
+	}
+}
@@ -0,0 +1,169 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/newt239/chat/internal/infrastructure/utils"
+	openapi "github.com/newt239/chat/internal/openapi_gen"
+	scheduledmessageuc "github.com/newt239/chat/internal/usecase/scheduledmessage"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+type ScheduledMessageHandler struct {
+	ScheduledMessageUC scheduledmessageuc.ScheduledMessageUseCase
+}
+
+func (h *ScheduledMessageHandler) ListScheduledMessages(c echo.Context, params openapi.ListScheduledMessagesParams) error {
+	userID, ok := c.Get("userID").(string)
+	if !ok {
+		return utils.HandleAuthError()
+	}
+
+	var channelID *string
+	if params.ChannelId != nil {
+		channelIDStr := params.ChannelId.String()
+		channelID = &channelIDStr
+	}
+
+	input := scheduledmessageuc.ListScheduledMessagesInput{
+		UserID:    userID,
+		ChannelID: channelID,
+	}
+
+	output, err := h.ScheduledMessageUC.ListScheduledMessages(c.Request().Context(), input)
+	if err != nil {
+		return mapScheduledMessageError(err)
+	}
+
+	return c.JSON(http.StatusOK, output)
+}
+
+func (h *ScheduledMessageHandler) CreateScheduledMessage(c echo.Context) error {
+	userID, ok := c.Get("userID").(string)
+	if !ok {
+		return utils.HandleAuthError()
+	}
+
+	var req openapi.CreateScheduledMessageRequest
+	if err := c.Bind(&req); err != nil {
+		return utils.HandleBindError(err)
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	var parentID *string
+	if req.ParentId != nil {
+		parentIDStr := req.ParentId.String()
+		parentID = &parentIDStr
+	}
+
+	input := scheduledmessageuc.CreateScheduledMessageInput{
+		UserID:        userID,
+		ChannelID:     req.ChannelId.String(),
+		ParentID:      parentID,
+		Body:          req.Body,
+		AttachmentIDs: toAttachmentIDs(req.AttachmentIds),
+		SendAt:        req.SendAt,
+	}
+	if req.Recurrence != nil {
+		input.Recurrence = *req.Recurrence
+	}
+	if req.Timezone != nil {
+		input.Timezone = *req.Timezone
+	}
+
+	output, err := h.ScheduledMessageUC.CreateScheduledMessage(c.Request().Context(), input)
+	if err != nil {
+		return mapScheduledMessageError(err)
+	}
+
+	return c.JSON(http.StatusCreated, output)
+}
+
+func (h *ScheduledMessageHandler) UpdateScheduledMessage(c echo.Context, id openapi_types.UUID) error {
+	userID, ok := c.Get("userID").(string)
+	if !ok {
+		return utils.HandleAuthError()
+	}
+
+	var req openapi.UpdateScheduledMessageRequest
+	if err := c.Bind(&req); err != nil {
+		return utils.HandleBindError(err)
+	}
+
+	if err := c.Validate(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	input := scheduledmessageuc.UpdateScheduledMessageInput{
+		ID:         id.String(),
+		UserID:     userID,
+		Body:       req.Body,
+		SendAt:     req.SendAt,
+		Recurrence: req.Recurrence,
+		Timezone:   req.Timezone,
+	}
+	if req.AttachmentIds != nil {
+		attachmentIDs := toAttachmentIDs(req.AttachmentIds)
+		input.AttachmentIDs = &attachmentIDs
+	}
+
+	output, err := h.ScheduledMessageUC.UpdateScheduledMessage(c.Request().Context(), input)
+	if err != nil {
+		return mapScheduledMessageError(err)
+	}
+
+	return c.JSON(http.StatusOK, output)
+}
+
+func (h *ScheduledMessageHandler) DeleteScheduledMessage(c echo.Context, id openapi_types.UUID) error {
+	userID, ok := c.Get("userID").(string)
+	if !ok {
+		return utils.HandleAuthError()
+	}
+
+	input := scheduledmessageuc.DeleteScheduledMessageInput{
+		ID:     id.String(),
+		UserID: userID,
+	}
+
+	if err := h.ScheduledMessageUC.DeleteScheduledMessage(c.Request().Context(), input); err != nil {
+		return mapScheduledMessageError(err)
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+func toAttachmentIDs(ids *[]openapi_types.UUID) []string {
+	if ids == nil {
+		return []string{}
+	}
+	attachmentIDs := make([]string, len(*ids))
+	for i, id := range *ids {
+		attachmentIDs[i] = id.String()
+	}
+	return attachmentIDs
+}
+
+func mapScheduledMessageError(err error) error {
+	switch err {
+	case scheduledmessageuc.ErrScheduledMessageNotFound:
+		return echo.NewHTTPError(http.StatusNotFound, err.Error())
+	case scheduledmessageuc.ErrUnauthorized:
+		return echo.NewHTTPError(http.StatusForbidden, err.Error())
+	case scheduledmessageuc.ErrScheduledMessageInProgress:
+		return echo.NewHTTPError(http.StatusConflict, err.Error())
+	case scheduledmessageuc.ErrParentMessageNotFound,
+		scheduledmessageuc.ErrInvalidBody,
+		scheduledmessageuc.ErrInvalidSendAt,
+		scheduledmessageuc.ErrInvalidRecurrence,
+		scheduledmessageuc.ErrInvalidTimezone,
+		scheduledmessageuc.ErrRecurringAttachments:
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	default:
+		return handleUseCaseError(err)
+	}
+}
@@ -25,10 +25,11 @@ func (h *UserHandler) UpdateMe(c echo.Context) error {
 	}
 
 	out, err := h.UC.UpdateMe(c.Request().Context(), useruc.UpdateMeInput{
-		UserID:      userID,
-		DisplayName: req.DisplayName,
-		Bio:         req.Bio,
-		AvatarURL:   req.AvatarUrl,
+		UserID:              userID,
+		DisplayName:         req.DisplayName,
+		Bio:                 req.Bio,
+		AvatarURL:           req.AvatarUrl,
+		ReadReceiptsEnabled: req.ReadReceiptsEnabled,
 	})
 	if err != nil {
 		return handleUseCaseError(err)
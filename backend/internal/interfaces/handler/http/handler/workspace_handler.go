@@ -112,6 +112,7 @@ func (h *WorkspaceHandler) UpdateWorkspace(c echo.Context, id string) error {
 		Description: req.Description,
 		IconURL:     req.IconUrl,
 		IsPublic:    req.IsPublic,
+		MessageHistoryEnabled: req.MessageHistoryEnabled,
 		UserID:      userID,
 	}
 
@@ -8,6 +8,7 @@ import (
 	openapi_types "github.com/oapi-codegen/runtime/types"
 
 	"github.com/newt239/chat/internal/domain/repository"
+	"github.com/newt239/chat/internal/domain/service"
 	"github.com/newt239/chat/internal/interfaces/handler/http/handler"
 	custommw "github.com/newt239/chat/internal/interfaces/handler/http/middleware"
 	"github.com/newt239/chat/internal/interfaces/handler/websocket"
@@ -19,27 +20,36 @@ type RouterConfig struct {
 	JWTService     authuc.JWTService
 	AllowedOrigins []string
 
-	WebSocketHub        *websocket.Hub
-	WorkspaceRepository repository.WorkspaceRepository
-	MessageUseCase      websocket.MessageUseCase
-	ReadStateUseCase    websocket.ReadStateUseCase
-
-	AuthHandler          *handler.AuthHandler
-	WorkspaceHandler     *handler.WorkspaceHandler
-	ChannelHandler       *handler.ChannelHandler
-	ChannelMemberHandler *handler.ChannelMemberHandler
-	MessageHandler       *handler.MessageHandler
-	ReadStateHandler     *handler.ReadStateHandler
-	ReactionHandler      *handler.ReactionHandler
-	UserGroupHandler     *handler.UserGroupHandler
-	LinkHandler          *handler.LinkHandler
-	BookmarkHandler      *handler.BookmarkHandler
-	PinHandler           *handler.PinHandler
-	AttachmentHandler    *handler.AttachmentHandler
-	SearchHandler        *handler.SearchHandler
-	DMHandler            *handler.DMHandler
-	ThreadHandler        *handler.ThreadHandler
-	UserHandler          *handler.UserHandler
+	WebSocketHub         *websocket.Hub
+	WorkspaceRepository  repository.WorkspaceRepository
+	SessionRepository    repository.SessionRepository
+	ChannelAccessService service.ChannelAccessService
+	MessageUseCase       websocket.MessageUseCase
+	ReactionUseCase      websocket.ReactionUseCase
+	ReadStateUseCase     websocket.ReadStateUseCase
+
+	AuthHandler             *handler.AuthHandler
+	WorkspaceHandler        *handler.WorkspaceHandler
+	ChannelHandler          *handler.ChannelHandler
+	ChannelMemberHandler    *handler.ChannelMemberHandler
+	MessageHandler          *handler.MessageHandler
+	ReadStateHandler        *handler.ReadStateHandler
+	ReactionHandler         *handler.ReactionHandler
+	UserGroupHandler        *handler.UserGroupHandler
+	LinkHandler             *handler.LinkHandler
+	BookmarkHandler         *handler.BookmarkHandler
+	PinHandler              *handler.PinHandler
+	AttachmentHandler       *handler.AttachmentHandler
+	SearchHandler           *handler.SearchHandler
+	DMHandler               *handler.DMHandler
+	ThreadHandler           *handler.ThreadHandler
+	UserHandler             *handler.UserHandler
+	PresenceHandler         *handler.PresenceHandler
+	ScheduledMessageHandler *handler.ScheduledMessageHandler
+	DraftHandler            *handler.DraftHandler
+	ReminderHandler         *handler.ReminderHandler
+	PollHandler             *handler.PollHandler
+	RetentionHandler        *handler.RetentionHandler
 }
 
 type serverImpl struct {
@@ -162,6 +172,14 @@ func (s *serverImpl) UpdateMessage(ctx echo.Context, messageId openapi_types.UUI
 	return s.cfg.MessageHandler.UpdateMessage(ctx, messageId)
 }
 
+func (s *serverImpl) ListMessageRevisions(ctx echo.Context, messageId openapi_types.UUID) error {
+	return s.cfg.MessageHandler.ListMessageRevisions(ctx, messageId)
+}
+
+func (s *serverImpl) ShareMessage(ctx echo.Context, messageId openapi_types.UUID) error {
+	return s.cfg.MessageHandler.ShareMessage(ctx, messageId)
+}
+
 func (s *serverImpl) GetThreadReplies(ctx echo.Context, messageId openapi_types.UUID, params openapi.GetThreadRepliesParams) error {
 	return s.cfg.MessageHandler.GetThreadReplies(ctx, messageId, params)
 }
@@ -206,6 +224,90 @@ func (s *serverImpl) SearchWorkspace(ctx echo.Context, workspaceId string, param
 	return s.cfg.SearchHandler.SearchWorkspace(ctx, workspaceId, params)
 }
 
+func (s *serverImpl) ListScheduledMessages(ctx echo.Context, params openapi.ListScheduledMessagesParams) error {
+	return s.cfg.ScheduledMessageHandler.ListScheduledMessages(ctx, params)
+}
+
+func (s *serverImpl) CreateScheduledMessage(ctx echo.Context) error {
+	return s.cfg.ScheduledMessageHandler.CreateScheduledMessage(ctx)
+}
+
+func (s *serverImpl) UpdateScheduledMessage(ctx echo.Context, id openapi_types.UUID) error {
+	return s.cfg.ScheduledMessageHandler.UpdateScheduledMessage(ctx, id)
+}
+
+func (s *serverImpl) DeleteScheduledMessage(ctx echo.Context, id openapi_types.UUID) error {
+	return s.cfg.ScheduledMessageHandler.DeleteScheduledMessage(ctx, id)
+}
+
+func (s *serverImpl) GetDraft(ctx echo.Context, channelId openapi_types.UUID, params openapi.GetDraftParams) error {
+	return s.cfg.DraftHandler.GetDraft(ctx, channelId, params)
+}
+
+func (s *serverImpl) SaveDraft(ctx echo.Context, channelId openapi_types.UUID, params openapi.SaveDraftParams) error {
+	return s.cfg.DraftHandler.SaveDraft(ctx, channelId, params)
+}
+
+func (s *serverImpl) DeleteDraft(ctx echo.Context, channelId openapi_types.UUID, params openapi.DeleteDraftParams) error {
+	return s.cfg.DraftHandler.DeleteDraft(ctx, channelId, params)
+}
+
+func (s *serverImpl) ListDrafts(ctx echo.Context, id string) error {
+	return s.cfg.DraftHandler.ListDrafts(ctx, id)
+}
+
+func (s *serverImpl) ListReminders(ctx echo.Context, params openapi.ListRemindersParams) error {
+	return s.cfg.ReminderHandler.ListReminders(ctx, params)
+}
+
+func (s *serverImpl) CreateReminder(ctx echo.Context) error {
+	return s.cfg.ReminderHandler.CreateReminder(ctx)
+}
+
+func (s *serverImpl) SnoozeReminder(ctx echo.Context, id openapi_types.UUID) error {
+	return s.cfg.ReminderHandler.SnoozeReminder(ctx, id)
+}
+
+func (s *serverImpl) CompleteReminder(ctx echo.Context, id openapi_types.UUID) error {
+	return s.cfg.ReminderHandler.CompleteReminder(ctx, id)
+}
+
+func (s *serverImpl) DeleteReminder(ctx echo.Context, id openapi_types.UUID) error {
+	return s.cfg.ReminderHandler.DeleteReminder(ctx, id)
+}
+
+func (s *serverImpl) CreatePoll(ctx echo.Context, channelId openapi_types.UUID) error {
+	return s.cfg.PollHandler.CreatePoll(ctx, channelId)
+}
+
+func (s *serverImpl) VotePoll(ctx echo.Context, id openapi_types.UUID) error {
+	return s.cfg.PollHandler.VotePoll(ctx, id)
+}
+
+func (s *serverImpl) UnvotePoll(ctx echo.Context, id openapi_types.UUID, optionId openapi_types.UUID) error {
+	return s.cfg.PollHandler.UnvotePoll(ctx, id, optionId)
+}
+
+func (s *serverImpl) GetWorkspaceRetentionPolicy(ctx echo.Context, id string) error {
+	return s.cfg.RetentionHandler.GetWorkspaceRetentionPolicy(ctx, id)
+}
+
+func (s *serverImpl) UpdateWorkspaceRetentionPolicy(ctx echo.Context, id string) error {
+	return s.cfg.RetentionHandler.UpdateWorkspaceRetentionPolicy(ctx, id)
+}
+
+func (s *serverImpl) GetRetentionReport(ctx echo.Context, id string) error {
+	return s.cfg.RetentionHandler.GetRetentionReport(ctx, id)
+}
+
+func (s *serverImpl) GetChannelRetentionPolicy(ctx echo.Context, channelId openapi_types.UUID) error {
+	return s.cfg.RetentionHandler.GetChannelRetentionPolicy(ctx, channelId)
+}
+
+func (s *serverImpl) UpdateChannelRetentionPolicy(ctx echo.Context, channelId openapi_types.UUID) error {
+	return s.cfg.RetentionHandler.UpdateChannelRetentionPolicy(ctx, channelId)
+}
+
 func (s *serverImpl) MarkThreadRead(ctx echo.Context, threadId openapi_types.UUID) error {
 	return s.cfg.ThreadHandler.MarkThreadRead(ctx, threadId)
 }
@@ -278,6 +380,10 @@ func (s *serverImpl) JoinPublicWorkspace(ctx echo.Context, id string) error {
 	return s.cfg.WorkspaceHandler.JoinPublicWorkspace(ctx, id)
 }
 
+func (s *serverImpl) GetWorkspacePresence(ctx echo.Context, id string) error {
+	return s.cfg.PresenceHandler.GetWorkspacePresence(ctx, id)
+}
+
 func (s *serverImpl) ListMembers(ctx echo.Context, id string) error {
 	return s.cfg.WorkspaceHandler.ListMembers(ctx, id)
 }
@@ -329,9 +435,42 @@ func registerProtectedRoutes(protectedAPI *echo.Group, wrapper *openapi.ServerIn
 	protectedAPI.GET("/channels/:channelId/messages/with-threads", wrapper.ListMessagesWithThread)
 	protectedAPI.PATCH("/messages/:messageId", wrapper.UpdateMessage)
 	protectedAPI.DELETE("/messages/:messageId", wrapper.DeleteMessage)
+	protectedAPI.GET("/messages/:messageId/revisions", wrapper.ListMessageRevisions)
+	protectedAPI.POST("/messages/:messageId/share", wrapper.ShareMessage)
 	protectedAPI.GET("/messages/:messageId/thread", wrapper.GetThreadReplies)
 	protectedAPI.GET("/messages/:messageId/thread/metadata", wrapper.GetThreadMetadata)
 
+	// 予約メッセージ
+	protectedAPI.GET("/scheduled-messages", wrapper.ListScheduledMessages)
+	protectedAPI.POST("/scheduled-messages", wrapper.CreateScheduledMessage)
+	protectedAPI.PATCH("/scheduled-messages/:id", wrapper.UpdateScheduledMessage)
+	protectedAPI.DELETE("/scheduled-messages/:id", wrapper.DeleteScheduledMessage)
+
+	// 下書き
+	protectedAPI.GET("/channels/:channelId/draft", wrapper.GetDraft)
+	protectedAPI.PUT("/channels/:channelId/draft", wrapper.SaveDraft)
+	protectedAPI.DELETE("/channels/:channelId/draft", wrapper.DeleteDraft)
+	protectedAPI.GET("/workspaces/:id/drafts", wrapper.ListDrafts)
+
+	// リマインダー
+	protectedAPI.GET("/reminders", wrapper.ListReminders)
+	protectedAPI.POST("/reminders", wrapper.CreateReminder)
+	protectedAPI.POST("/reminders/:id/snooze", wrapper.SnoozeReminder)
+	protectedAPI.POST("/reminders/:id/complete", wrapper.CompleteReminder)
+	protectedAPI.DELETE("/reminders/:id", wrapper.DeleteReminder)
+
+	// 投票
+	protectedAPI.POST("/channels/:channelId/polls", wrapper.CreatePoll)
+	protectedAPI.POST("/polls/:id/votes", wrapper.VotePoll)
+	protectedAPI.DELETE("/polls/:id/votes/:optionId", wrapper.UnvotePoll)
+
+	// メッセージの保存期間
+	protectedAPI.GET("/workspaces/:id/retention-policy", wrapper.GetWorkspaceRetentionPolicy)
+	protectedAPI.PUT("/workspaces/:id/retention-policy", wrapper.UpdateWorkspaceRetentionPolicy)
+	protectedAPI.GET("/workspaces/:id/retention-policy/report", wrapper.GetRetentionReport)
+	protectedAPI.GET("/channels/:channelId/retention-policy", wrapper.GetChannelRetentionPolicy)
+	protectedAPI.PUT("/channels/:channelId/retention-policy", wrapper.UpdateChannelRetentionPolicy)
+
 	// リアクション
 	protectedAPI.GET("/messages/:messageId/reactions", wrapper.ListReactions)
 	protectedAPI.POST("/messages/:messageId/reactions", wrapper.AddReaction)
@@ -379,6 +518,7 @@ func registerProtectedRoutes(protectedAPI *echo.Group, wrapper *openapi.ServerIn
 	protectedAPI.POST("/workspaces/:id/members", wrapper.AddMemberByEmail)
 	protectedAPI.DELETE("/workspaces/:id/members/:userId", wrapper.RemoveMember)
 	protectedAPI.PATCH("/workspaces/:id/members/:userId/role", wrapper.UpdateMemberRole)
+	protectedAPI.GET("/workspaces/:id/presence", wrapper.GetWorkspacePresence)
 	protectedAPI.GET("/workspaces/:workspaceId/search", wrapper.SearchWorkspace)
 
 	// ユーザー
@@ -404,7 +544,7 @@ func NewRouter(cfg RouterConfig) *echo.Echo {
 	e.Use(middleware.Recover())
 
 	// WebSocket
-	e.GET("/ws", websocket.Handler(cfg.WebSocketHub, cfg.JWTService, cfg.WorkspaceRepository, cfg.MessageUseCase, cfg.ReadStateUseCase))
+	e.GET("/ws", websocket.Handler(cfg.WebSocketHub, cfg.JWTService, cfg.SessionRepository, cfg.WorkspaceRepository, cfg.ChannelAccessService, cfg.AllowedOrigins, cfg.MessageUseCase, cfg.ReactionUseCase, cfg.ReadStateUseCase))
 
 	// ServerInterfaceを実装する構造体を作成
 	server := &serverImpl{cfg: cfg}
@@ -423,5 +563,8 @@ func NewRouter(cfg RouterConfig) *echo.Echo {
 	protectedAPI.Use(custommw.Auth(cfg.JWTService))
 	registerProtectedRoutes(protectedAPI, wrapper)
 
+	// Server-Sent Events（WebSocketを利用できない環境向けのリアルタイム配信）
+	protectedAPI.GET("/events", websocket.EventStreamHandler(cfg.WebSocketHub, cfg.JWTService, cfg.SessionRepository, cfg.WorkspaceRepository, cfg.ChannelAccessService))
+
 	return e
 }
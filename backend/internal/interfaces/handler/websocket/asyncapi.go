@@ -0,0 +1,340 @@
+package websocket
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"path"
+	"reflect"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// asyncAPIHeader は生成したドキュメントの先頭に付与するコメントです
+const asyncAPIHeader = "# Code generated by cmd/asyncapi from websocket.EventCatalog. DO NOT EDIT.\n"
+
+// asyncAPIDocument はAsyncAPI 2.6ドキュメントのうち使用する部分を表します
+type asyncAPIDocument struct {
+	AsyncAPI           string                     `yaml:"asyncapi"`
+	Info               asyncAPIInfo               `yaml:"info"`
+	DefaultContentType string                     `yaml:"defaultContentType"`
+	Channels           map[string]asyncAPIChannel `yaml:"channels"`
+	Components         asyncAPIComponents         `yaml:"components"`
+}
+
+type asyncAPIInfo struct {
+	Title       string `yaml:"title"`
+	Version     string `yaml:"version"`
+	Description string `yaml:"description"`
+}
+
+type asyncAPIChannel struct {
+	Description string            `yaml:"description"`
+	Bindings    asyncAPIBindings  `yaml:"bindings"`
+	Publish     asyncAPIOperation `yaml:"publish"`
+	Subscribe   asyncAPIOperation `yaml:"subscribe"`
+}
+
+type asyncAPIBindings struct {
+	WS asyncAPIWebSocketBinding `yaml:"ws"`
+}
+
+type asyncAPIWebSocketBinding struct {
+	Method string      `yaml:"method"`
+	Query  *jsonSchema `yaml:"query"`
+}
+
+type asyncAPIOperation struct {
+	OperationID string              `yaml:"operationId"`
+	Summary     string              `yaml:"summary"`
+	Message     asyncAPIMessageList `yaml:"message"`
+}
+
+type asyncAPIMessageList struct {
+	OneOf []*jsonSchema `yaml:"oneOf"`
+}
+
+type asyncAPIComponents struct {
+	Messages map[string]asyncAPIMessage `yaml:"messages"`
+	Schemas  map[string]*jsonSchema     `yaml:"schemas"`
+}
+
+type asyncAPIMessage struct {
+	Name    string      `yaml:"name"`
+	Summary string      `yaml:"summary"`
+	Payload *jsonSchema `yaml:"payload"`
+}
+
+// jsonSchema はJSON Schema(draft-07)のうち使用する部分を表します
+type jsonSchema struct {
+	Ref                  string            `yaml:"$ref,omitempty"`
+	Type                 interface{}       `yaml:"type,omitempty"`
+	Format               string            `yaml:"format,omitempty"`
+	Description          string            `yaml:"description,omitempty"`
+	Const                string            `yaml:"const,omitempty"`
+	Properties           *schemaProperties `yaml:"properties,omitempty"`
+	Required             []string          `yaml:"required,omitempty"`
+	Items                *jsonSchema       `yaml:"items,omitempty"`
+	AdditionalProperties *jsonSchema       `yaml:"additionalProperties,omitempty"`
+	OneOf                []*jsonSchema     `yaml:"oneOf,omitempty"`
+}
+
+// schemaProperties はフィールドの宣言順を保ったままプロパティを出力します
+type schemaProperties struct {
+	keys   []string
+	values map[string]*jsonSchema
+}
+
+func (p *schemaProperties) set(name string, schema *jsonSchema) {
+	if p.values == nil {
+		p.values = make(map[string]*jsonSchema)
+	}
+	if _, exists := p.values[name]; !exists {
+		p.keys = append(p.keys, name)
+	}
+	p.values[name] = schema
+}
+
+// MarshalYAML はプロパティを宣言順のマッピングとして出力します
+func (p *schemaProperties) MarshalYAML() (interface{}, error) {
+	node := &yaml.Node{Kind: yaml.MappingNode}
+	for _, key := range p.keys {
+		var value yaml.Node
+		if err := value.Encode(p.values[key]); err != nil {
+			return nil, err
+		}
+		node.Content = append(node.Content, &yaml.Node{Kind: yaml.ScalarNode, Value: key}, &value)
+	}
+	return node, nil
+}
+
+var (
+	timeType       = reflect.TypeOf(time.Time{})
+	rawMessageType = reflect.TypeOf(json.RawMessage{})
+)
+
+// schemaRegistry はGoの型からJSON Schemaを生成し、名前付きの構造体をcomponents/schemasに登録します
+type schemaRegistry struct {
+	schemas map[string]*jsonSchema
+	names   map[reflect.Type]string
+}
+
+func newSchemaRegistry() *schemaRegistry {
+	return &schemaRegistry{
+		schemas: make(map[string]*jsonSchema),
+		names:   make(map[reflect.Type]string),
+	}
+}
+
+// schemaFor は型に対応するスキーマを返します
+func (r *schemaRegistry) schemaFor(t reflect.Type) *jsonSchema {
+	switch {
+	case t == timeType:
+		return &jsonSchema{Type: "string", Format: "date-time"}
+	case t == rawMessageType:
+		return &jsonSchema{}
+	}
+
+	switch t.Kind() {
+	case reflect.Ptr:
+		inner := r.schemaFor(t.Elem())
+		return nullable(inner)
+	case reflect.String:
+		return &jsonSchema{Type: "string"}
+	case reflect.Bool:
+		return &jsonSchema{Type: "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return &jsonSchema{Type: "integer"}
+	case reflect.Float32, reflect.Float64:
+		return &jsonSchema{Type: "number"}
+	case reflect.Slice, reflect.Array:
+		return &jsonSchema{Type: "array", Items: r.schemaFor(t.Elem())}
+	case reflect.Map:
+		return &jsonSchema{Type: "object", AdditionalProperties: r.schemaFor(t.Elem())}
+	case reflect.Struct:
+		if t.Name() == "" {
+			return r.objectSchema(t)
+		}
+		return &jsonSchema{Ref: "#/components/schemas/" + r.register(t)}
+	default:
+		// interface{}など型が決まらないものは任意の値とする
+		return &jsonSchema{}
+	}
+}
+
+// register は名前付きの構造体をcomponents/schemasに登録し、スキーマ名を返します
+// 別パッケージに同名の型がある場合はパッケージ名を接頭辞にします
+func (r *schemaRegistry) register(t reflect.Type) string {
+	if name, ok := r.names[t]; ok {
+		return name
+	}
+
+	name := t.Name()
+	if _, taken := r.schemas[name]; taken {
+		name = exportedName(path.Base(t.PkgPath())) + name
+	}
+	r.names[t] = name
+	// 再帰的な型に備えて先に登録してから中身を生成する
+	r.schemas[name] = &jsonSchema{}
+	*r.schemas[name] = *r.objectSchema(t)
+	return name
+}
+
+// objectSchema は構造体のフィールドからobjectのスキーマを生成します
+// omitemptyが指定されていないフィールドを必須とします
+func (r *schemaRegistry) objectSchema(t reflect.Type) *jsonSchema {
+	schema := &jsonSchema{Type: "object", Properties: &schemaProperties{}}
+	r.addFields(schema, t)
+	if len(schema.Properties.keys) == 0 {
+		schema.Properties = nil
+	}
+	return schema
+}
+
+func (r *schemaRegistry) addFields(schema *jsonSchema, t reflect.Type) {
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		tag := field.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		name, opts, _ := strings.Cut(tag, ",")
+
+		// 埋め込み構造体のフィールドは親に展開される
+		if field.Anonymous && name == "" && field.Type.Kind() == reflect.Struct {
+			r.addFields(schema, field.Type)
+			continue
+		}
+		if !field.IsExported() {
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+
+		schema.Properties.set(name, r.schemaFor(field.Type))
+		if !strings.Contains(opts, "omitempty") {
+			schema.Required = append(schema.Required, name)
+		}
+	}
+}
+
+// nullable はnullを許容するスキーマを返します
+func nullable(schema *jsonSchema) *jsonSchema {
+	if t, ok := schema.Type.(string); ok && schema.Ref == "" {
+		copied := *schema
+		copied.Type = []string{t, "null"}
+		return &copied
+	}
+	return &jsonSchema{OneOf: []*jsonSchema{schema, {Type: "null"}}}
+}
+
+// exportedName は先頭を大文字にした名前を返します
+func exportedName(s string) string {
+	if s == "" {
+		return s
+	}
+	return strings.ToUpper(s[:1]) + s[1:]
+}
+
+// messageName はcomponents/messagesのキーを返します
+// typingのように双方向で使用するイベントがあるため方向を接頭辞にします
+func messageName(def EventDefinition) string {
+	return fmt.Sprintf("%s.%s", def.Direction, def.Type)
+}
+
+// GenerateAsyncAPI はEventCatalogからAsyncAPIドキュメント(YAML)を生成します
+func GenerateAsyncAPI() ([]byte, error) {
+	registry := newSchemaRegistry()
+	messages := make(map[string]asyncAPIMessage, len(EventCatalog))
+	var publish, subscribe []*jsonSchema
+
+	for _, def := range EventCatalog {
+		envelope := &jsonSchema{Type: "object", Properties: &schemaProperties{}, Required: []string{"type"}}
+		envelope.Properties.set("type", &jsonSchema{Type: "string", Const: string(def.Type)})
+		if def.Direction == DirectionServer {
+			envelope.Properties.set("workspace_id", &jsonSchema{
+				Type:        "string",
+				Description: "イベントが発生したWorkspaceのID（ack/errorなど接続宛の応答には付与されません）",
+			})
+		}
+		if def.Broadcast {
+			envelope.Properties.set("seq", &jsonSchema{
+				Type:        "integer",
+				Description: "Workspace単位で単調増加するシーケンス番号",
+			})
+		}
+		if def.Payload != nil {
+			envelope.Properties.set("payload", registry.schemaFor(reflect.TypeOf(def.Payload)))
+			envelope.Required = append(envelope.Required, "payload")
+		}
+
+		name := messageName(def)
+		messages[name] = asyncAPIMessage{
+			Name:    string(def.Type),
+			Summary: def.Summary,
+			Payload: envelope,
+		}
+		ref := &jsonSchema{Ref: "#/components/messages/" + name}
+		if def.Direction == DirectionClient {
+			publish = append(publish, ref)
+		} else {
+			subscribe = append(subscribe, ref)
+		}
+	}
+
+	query := &jsonSchema{Type: "object", Properties: &schemaProperties{}, Required: []string{"workspaceId"}}
+	query.Properties.set("workspaceId", &jsonSchema{Type: "string", Description: "接続するWorkspaceのID"})
+	query.Properties.set("token", &jsonSchema{Type: "string", Description: "アクセストークン（Authorizationヘッダーを指定できない場合）"})
+	query.Properties.set("v", &jsonSchema{Type: "integer", Description: "クライアントが対応している最新のプロトコルバージョン"})
+	query.Properties.set("since", &jsonSchema{Type: "integer", Description: "最後に受信したシーケンス番号（再接続時）"})
+	query.Properties.set("epoch", &jsonSchema{Type: "string", Description: "最後に受信したconnectedイベントのepoch（再接続時）"})
+	query.Properties.set("channel_ids", &jsonSchema{Type: "string", Description: "再送前に購読するチャンネルIDのカンマ区切り"})
+
+	doc := asyncAPIDocument{
+		AsyncAPI: "2.6.0",
+		Info: asyncAPIInfo{
+			Title:       "Chat WebSocket API",
+			Version:     fmt.Sprintf("%d", ProtocolVersion),
+			Description: "メッセージは{\"type\", \"payload\"}形式のJSONで送受信します。info.versionは?v=でネゴシエーションするプロトコルバージョンです。",
+		},
+		DefaultContentType: "application/json",
+		Channels: map[string]asyncAPIChannel{
+			"/ws": {
+				Description: "Workspace単位のリアルタイムイベント",
+				Bindings: asyncAPIBindings{
+					WS: asyncAPIWebSocketBinding{Method: "GET", Query: query},
+				},
+				Publish: asyncAPIOperation{
+					OperationID: "sendClientEvent",
+					Summary:     "クライアントからサーバーへ送信するイベント",
+					Message:     asyncAPIMessageList{OneOf: publish},
+				},
+				Subscribe: asyncAPIOperation{
+					OperationID: "receiveServerEvent",
+					Summary:     "サーバーからクライアントへ送信するイベント",
+					Message:     asyncAPIMessageList{OneOf: subscribe},
+				},
+			},
+		},
+		Components: asyncAPIComponents{
+			Messages: messages,
+			Schemas:  registry.schemas,
+		},
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString(asyncAPIHeader)
+	encoder := yaml.NewEncoder(&buf)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(doc); err != nil {
+		return nil, fmt.Errorf("failed to encode asyncapi document: %w", err)
+	}
+	if err := encoder.Close(); err != nil {
+		return nil, fmt.Errorf("failed to encode asyncapi document: %w", err)
+	}
+	return buf.Bytes(), nil
+}
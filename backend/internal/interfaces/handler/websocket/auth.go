@@ -0,0 +1,279 @@
+package websocket
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gorilla/websocket"
+
+	"github.com/newt239/chat/internal/domain/repository"
+	authuc "github.com/newt239/chat/internal/usecase/auth"
+)
+
+const (
+	// authHandshakeTimeout はトークンなしで接続した場合にauthイベントを待つ時間です
+	authHandshakeTimeout = 10 * time.Second
+
+	// maxAuthMessageSize は認証前に受け付けるauthイベントの最大サイズです
+	// 認証後はreadPumpでmaxMessageSizeに引き上げます
+	maxAuthMessageSize = 4 * 1024
+
+	// sessionCheckInterval は接続中のセッションが取り消されていないか確認する間隔です
+	sessionCheckInterval = time.Minute
+
+	// sessionCheckTimeout はセッションの確認のタイムアウトです
+	sessionCheckTimeout = 5 * time.Second
+)
+
+const (
+	// CloseCodeUnauthorized は認証に失敗した接続を閉じる際のクローズコードです
+	CloseCodeUnauthorized = 4001
+	// CloseCodeTokenExpired はアクセストークンの有効期限が切れた接続を閉じる際のクローズコードです
+	CloseCodeTokenExpired = 4002
+	// CloseCodeForbidden はWorkspaceのメンバーでない接続を閉じる際のクローズコードです
+	CloseCodeForbidden = 4003
+	// CloseCodeSessionRevoked はセッションが取り消された接続を閉じる際のクローズコードです
+	CloseCodeSessionRevoked = 4004
+
+	closeReasonUnauthorized   = "unauthorized"
+	closeReasonTokenExpired   = "token_expired"
+	closeReasonForbidden      = "forbidden"
+	closeReasonSessionRevoked = "session_revoked"
+)
+
+// errSessionRevoked はアクセストークンを発行したセッションが無効であることを表します
+var errSessionRevoked = errors.New("session revoked")
+
+// newUpgrader はCORSで許可したオリジンからの接続のみを受け付けるUpgraderを作成します
+func newUpgrader(allowedOrigins []string) websocket.Upgrader {
+	return websocket.Upgrader{
+		CheckOrigin: func(r *http.Request) bool {
+			origin := r.Header.Get("Origin")
+			if originAllowed(origin, allowedOrigins) {
+				return true
+			}
+			log.Printf("[WebSocket] 許可されていないオリジンからの接続を拒否しました: origin=%s RemoteAddr=%s", origin, r.RemoteAddr)
+			return false
+		},
+	}
+}
+
+// originAllowed はオリジンが許可されているか判定します
+// ブラウザ以外のクライアントはOriginヘッダーを送信しないため許可します
+func originAllowed(origin string, allowedOrigins []string) bool {
+	if origin == "" {
+		return true
+	}
+	for _, allowed := range allowedOrigins {
+		if allowed == "*" || strings.EqualFold(allowed, origin) {
+			return true
+		}
+	}
+	return false
+}
+
+// tokenFromRequest はAuthorizationヘッダーまたはtokenクエリパラメータからトークンを取得します
+// どちらも指定されていない場合は空文字を返し、接続後のauthイベントで認証します
+func tokenFromRequest(r *http.Request) string {
+	if authHeader := r.Header.Get("Authorization"); authHeader != "" {
+		return strings.TrimPrefix(authHeader, "Bearer ")
+	}
+	return r.URL.Query().Get("token")
+}
+
+// verifyAccessToken はアクセストークンを検証し、発行元のセッションが有効であることを確認します
+func verifyAccessToken(ctx context.Context, jwtService authuc.JWTService, sessionRepo repository.SessionRepository, token string) (*authuc.TokenClaims, error) {
+	claims, err := jwtService.VerifyToken(token)
+	if err != nil {
+		return nil, err
+	}
+	if claims.SessionID == "" {
+		return claims, nil
+	}
+	active, err := sessionActive(ctx, sessionRepo, claims.SessionID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check session: %w", err)
+	}
+	if !active {
+		return nil, errSessionRevoked
+	}
+	return claims, nil
+}
+
+// sessionActive はセッションが取り消されておらず、有効期限内であるか確認します
+func sessionActive(ctx context.Context, sessionRepo repository.SessionRepository, sessionID string) (bool, error) {
+	session, err := sessionRepo.FindByID(ctx, sessionID)
+	if err != nil {
+		return false, err
+	}
+	if session == nil || session.RevokedAt != nil || time.Now().After(session.ExpiresAt) {
+		return false, nil
+	}
+	return true, nil
+}
+
+// readAuthHandshake は接続直後のauthイベントを読み取り、トークンを検証します
+func readAuthHandshake(conn *websocket.Conn, jwtService authuc.JWTService, sessionRepo repository.SessionRepository) (*authuc.TokenClaims, error) {
+	if err := conn.SetReadDeadline(time.Now().Add(authHandshakeTimeout)); err != nil {
+		return nil, err
+	}
+	// 認証前の接続から大きなメッセージを読み込まないよう、トークンが収まる大きさに制限する
+	conn.SetReadLimit(maxAuthMessageSize)
+	_, data, err := conn.ReadMessage()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read auth event: %w", err)
+	}
+
+	msg, err := ParseClientMessage(data)
+	if err != nil {
+		return nil, err
+	}
+	if msg.Type != EventTypeAuth {
+		return nil, fmt.Errorf("expected auth event but got %s", msg.Type)
+	}
+	var payload AuthPayload
+	if err := json.Unmarshal(msg.Payload, &payload); err != nil {
+		return nil, fmt.Errorf("failed to parse auth payload: %w", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), sessionCheckTimeout)
+	defer cancel()
+	return verifyAccessToken(ctx, jwtService, sessionRepo, payload.Token)
+}
+
+// closeConn はクライアントを登録する前の接続をクローズコード付きで閉じます
+func closeConn(conn *websocket.Conn, code int, reason string) {
+	if err := conn.WriteControl(websocket.CloseMessage, closeFrame(code, reason), time.Now().Add(writeWait)); err != nil {
+		_ = err // クローズメッセージの送信エラーは無視
+	}
+	if err := conn.Close(); err != nil {
+		_ = err // WebSocket接続のクローズエラーは無視
+	}
+}
+
+// setAuth は接続の認証情報を更新し、有効期限の監視に通知します
+func (c *Client) setAuth(claims *authuc.TokenClaims) {
+	c.mu.Lock()
+	c.sessionID = claims.SessionID
+	c.tokenExpiresAt = claims.ExpiresAt
+	c.mu.Unlock()
+
+	select {
+	case c.authRenewed <- struct{}{}:
+	default:
+	}
+}
+
+// authState は接続の認証情報を返します
+func (c *Client) authState() (string, time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.sessionID, c.tokenExpiresAt
+}
+
+// watchAuth はアクセストークンの有効期限とセッションの取り消しを監視し、無効になった接続を閉じます
+// reauthで新しいトークンに切り替えた場合は、新しい有効期限で監視を続けます
+func (c *Client) watchAuth() {
+	sessionTicker := time.NewTicker(sessionCheckInterval)
+	defer sessionTicker.Stop()
+
+	for {
+		var expiry <-chan time.Time
+		var timer *time.Timer
+		if _, expiresAt := c.authState(); !expiresAt.IsZero() {
+			timer = time.NewTimer(time.Until(expiresAt))
+			expiry = timer.C
+		}
+
+		closed := c.waitAuthEvent(expiry, sessionTicker.C)
+		if timer != nil {
+			timer.Stop()
+		}
+		if closed {
+			return
+		}
+	}
+}
+
+// waitAuthEvent は認証情報の変化を1件待ち、接続を閉じた場合はtrueを返します
+func (c *Client) waitAuthEvent(expiry <-chan time.Time, sessionCheck <-chan time.Time) bool {
+	select {
+	case <-c.queue.done:
+		return true
+
+	case <-c.authRenewed:
+		return false
+
+	case <-expiry:
+		// reauthと競合した場合は新しい有効期限で監視を続ける
+		if _, expiresAt := c.authState(); time.Now().Before(expiresAt) {
+			return false
+		}
+		c.closeForAuth(CloseCodeTokenExpired, closeReasonTokenExpired)
+		return true
+
+	case <-sessionCheck:
+		sessionID, _ := c.authState()
+		if sessionID == "" {
+			return false
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), sessionCheckTimeout)
+		defer cancel()
+		active, err := sessionActive(ctx, c.sessionRepo, sessionID)
+		if err != nil {
+			// 一時的な障害で切断しないよう、次回の確認まで接続を維持する
+			log.Printf("[WebSocket] セッションの確認に失敗しました: user=%s session=%s err=%v", c.userID, sessionID, err)
+			return false
+		}
+		if active {
+			return false
+		}
+		c.closeForAuth(CloseCodeSessionRevoked, closeReasonSessionRevoked)
+		return true
+	}
+}
+
+// closeForAuth は認証が無効になった接続をクローズコード付きで閉じます
+func (c *Client) closeForAuth(code int, reason string) {
+	if !c.queue.close(code, reason) {
+		return
+	}
+	log.Printf("[WebSocket] 認証が無効になったため切断します: user=%s workspace=%s code=%d reason=%s",
+		c.userID, c.workspaceID, code, reason)
+}
+
+// handleReauth はreauthイベントを処理し、接続のアクセストークンを新しいものに切り替えます
+// 検証に失敗した場合は現在のトークンの有効期限まで接続を維持します
+func (c *Client) handleReauth(payload json.RawMessage) {
+	var authPayload AuthPayload
+	if err := json.Unmarshal(payload, &authPayload); err != nil {
+		log.Printf("reauthペイロードの解析に失敗しました: %v", err)
+		c.sendError("INVALID_PAYLOAD", "無効なペイロードです")
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), sessionCheckTimeout)
+	defer cancel()
+
+	claims, err := verifyAccessToken(ctx, c.jwtService, c.sessionRepo, authPayload.Token)
+	if err != nil {
+		log.Printf("[WebSocket] 再認証に失敗しました: user=%s err=%v", c.userID, err)
+		c.sendAckError(EventTypeReauth, ErrorCodeUnauthorized, "トークンが無効または期限切れです")
+		return
+	}
+	if claims.UserID != c.userID {
+		log.Printf("[WebSocket] 別のユーザーのトークンによる再認証を拒否しました: user=%s token_user=%s", c.userID, claims.UserID)
+		c.sendAckError(EventTypeReauth, ErrorCodeUnauthorized, "別のユーザーのトークンには切り替えられません")
+		return
+	}
+
+	c.setAuth(claims)
+	log.Printf("[WebSocket] 再認証しました: user=%s expires_at=%s", c.userID, claims.ExpiresAt.Format(time.RFC3339))
+	c.sendAck(EventTypeReauth, true, "")
+}
@@ -0,0 +1,333 @@
+package websocket
+
+import (
+	"fmt"
+	"log"
+	"sync"
+
+	"github.com/gorilla/websocket"
+)
+
+// SlowConsumerPolicy は送信キューが溢れたクライアントの扱いを表します
+type SlowConsumerPolicy string
+
+const (
+	// SlowConsumerDropOldest は最も古いイベントを破棄して新しいイベントを追加します
+	// クライアントはseqの欠落を検知してresumeで再送を要求します
+	SlowConsumerDropOldest SlowConsumerPolicy = "drop_oldest"
+
+	// SlowConsumerDisconnect はresync_requiredを送信したうえで接続を切断します
+	SlowConsumerDisconnect SlowConsumerPolicy = "disconnect"
+)
+
+const (
+	// defaultSendQueueSize はクライアントごとの送信キューの既定の上限です
+	defaultSendQueueSize = 256
+
+	// CloseCodeSlowConsumer は送信が追いつかないクライアントを切断する際のクローズコードです
+	CloseCodeSlowConsumer = 4008
+
+	// closeReasonSlowConsumer はクローズフレームとresync_requiredに設定する理由です
+	closeReasonSlowConsumer = "slow_consumer"
+
+	// MultiWorkspaceStatsKey は複数Workspaceを購読する接続の統計を集計するキーです
+	MultiWorkspaceStatsKey = "*"
+)
+
+// BackpressureConfig は送信キューの上限と溢れた場合の方針を表します
+type BackpressureConfig struct {
+	Policy    SlowConsumerPolicy
+	QueueSize int
+}
+
+// ParseSlowConsumerPolicy は文字列からSlowConsumerPolicyを返します
+func ParseSlowConsumerPolicy(s string) (SlowConsumerPolicy, error) {
+	switch p := SlowConsumerPolicy(s); p {
+	case SlowConsumerDropOldest, SlowConsumerDisconnect:
+		return p, nil
+	default:
+		return "", fmt.Errorf("unknown slow consumer policy: %q", s)
+	}
+}
+
+// BackpressureStats はWorkspaceごとの送信キューの統計を表します
+// 複数Workspaceを購読する接続の統計はMultiWorkspaceStatsKeyに集計します
+type BackpressureStats struct {
+	// Dropped はキューが溢れて破棄したイベント数です
+	Dropped uint64 `json:"dropped"`
+	// Coalesced は未送信の同種イベントに置き換えたイベント数です
+	Coalesced uint64 `json:"coalesced"`
+	// Disconnected は送信が追いつかず切断したクライアント数です
+	Disconnected uint64 `json:"disconnected"`
+}
+
+// backpressureCounters はWorkspaceごとの統計を集計します
+// 複数のゴルーチンから更新されるためmuで保護します
+type backpressureCounters struct {
+	mu          sync.Mutex
+	byWorkspace map[string]*BackpressureStats
+}
+
+func newBackpressureCounters() *backpressureCounters {
+	return &backpressureCounters{byWorkspace: make(map[string]*BackpressureStats)}
+}
+
+// add はWorkspaceの統計を更新します
+func (c *backpressureCounters) add(workspaceID string, update func(*BackpressureStats)) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	stats, ok := c.byWorkspace[workspaceID]
+	if !ok {
+		stats = &BackpressureStats{}
+		c.byWorkspace[workspaceID] = stats
+	}
+	update(stats)
+}
+
+// snapshot は現在の統計のコピーを返します
+func (c *backpressureCounters) snapshot() map[string]BackpressureStats {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	result := make(map[string]BackpressureStats, len(c.byWorkspace))
+	for workspaceID, stats := range c.byWorkspace {
+		result[workspaceID] = *stats
+	}
+	return result
+}
+
+// pushResult は送信キューへの追加結果を表します
+type pushResult int
+
+const (
+	pushQueued pushResult = iota
+	pushCoalesced
+	pushDroppedOldest
+	pushOverflow
+	pushClosed
+)
+
+// outboundMessage は送信キュー内のメッセージを表します
+type outboundMessage struct {
+	data []byte
+	// coalesceKey が同じ未送信のメッセージは新しいもので置き換えます
+	coalesceKey string
+}
+
+// sendQueue はクライアントごとの上限付き送信キューです
+// Hub・readPump・writePumpの各ゴルーチンからアクセスされるためmuで保護します
+type sendQueue struct {
+	mu    sync.Mutex
+	items []outboundMessage
+	limit int
+
+	// ready はwritePumpに送信すべきメッセージがあることを通知します
+	ready chan struct{}
+	// done はキューが閉じられたことを通知します
+	done chan struct{}
+
+	closed      bool
+	closeCode   int
+	closeReason string
+}
+
+func newSendQueue(limit int) *sendQueue {
+	if limit <= 0 {
+		limit = defaultSendQueueSize
+	}
+	return &sendQueue{
+		items: make([]outboundMessage, 0, limit),
+		limit: limit,
+		ready: make(chan struct{}, 1),
+		done:  make(chan struct{}),
+	}
+}
+
+// push はメッセージをキューに追加します
+// force がtrueの場合は上限を超えても追加します（切断直前のresync_required用）
+func (q *sendQueue) push(msg outboundMessage, policy SlowConsumerPolicy, force bool) pushResult {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if q.closed {
+		return pushClosed
+	}
+
+	result := pushQueued
+	if msg.coalesceKey != "" {
+		for i := range q.items {
+			if q.items[i].coalesceKey == msg.coalesceKey {
+				q.items[i].data = msg.data
+				return pushCoalesced
+			}
+		}
+	}
+	if len(q.items) >= q.limit && !force {
+		if policy != SlowConsumerDropOldest {
+			return pushOverflow
+		}
+		q.items = append(q.items[:0], q.items[1:]...)
+		result = pushDroppedOldest
+	}
+	q.items = append(q.items, msg)
+
+	select {
+	case q.ready <- struct{}{}:
+	default:
+	}
+	return result
+}
+
+// drain はキュー内のメッセージをすべて取り出します
+func (q *sendQueue) drain() [][]byte {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if len(q.items) == 0 {
+		return nil
+	}
+	result := make([][]byte, len(q.items))
+	for i, item := range q.items {
+		result[i] = item.data
+	}
+	q.items = q.items[:0]
+	return result
+}
+
+// free はキューに追加できる残りの件数を返します
+func (q *sendQueue) free() int {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return q.limit - len(q.items)
+}
+
+// close はキューを閉じます。既に閉じられている場合は何もせずfalseを返します
+// writePumpは残りのメッセージを送信した後、指定したクローズコードで接続を閉じます
+func (q *sendQueue) close(code int, reason string) bool {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if q.closed {
+		return false
+	}
+	q.closed = true
+	q.closeCode = code
+	q.closeReason = reason
+	close(q.done)
+	return true
+}
+
+// isClosed はキューが閉じられているか判定します
+func (q *sendQueue) isClosed() bool {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return q.closed
+}
+
+// closeStatus はキューを閉じた際のクローズコードと理由を返します
+func (q *sendQueue) closeStatus() (int, string) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return q.closeCode, q.closeReason
+}
+
+// enqueue はメッセージをクライアントの送信キューに追加し、溢れた場合はバックプレッシャーの方針に従います
+func (c *Client) enqueue(data []byte, coalesceKey string) {
+	policy := c.hub.backpressure.Policy
+	switch c.queue.push(outboundMessage{data: data, coalesceKey: coalesceKey}, policy, false) {
+	case pushCoalesced:
+		c.hub.stats.add(c.statsKey(), func(s *BackpressureStats) { s.Coalesced++ })
+	case pushDroppedOldest:
+		c.hub.stats.add(c.statsKey(), func(s *BackpressureStats) { s.Dropped++ })
+		c.markLagging()
+	case pushOverflow:
+		c.hub.stats.add(c.statsKey(), func(s *BackpressureStats) { s.Dropped++ })
+		c.disconnectSlowConsumer()
+	}
+}
+
+// statsKey はバックプレッシャーの統計を集計するキーを返します
+func (c *Client) statsKey() string {
+	if c.multiWorkspace {
+		return MultiWorkspaceStatsKey
+	}
+	return c.workspaceID
+}
+
+// markLagging は送信が追いついていないクライアントを最初の1回だけ記録します
+func (c *Client) markLagging() {
+	c.mu.Lock()
+	first := !c.lagging
+	c.lagging = true
+	c.mu.Unlock()
+	if first {
+		log.Printf("[WebSocket] 送信が追いついていないため古いイベントを破棄しました: user=%s workspace=%s",
+			c.userID, c.workspaceID)
+	}
+}
+
+// disconnectSlowConsumer はresync_requiredを送信したうえで接続を切断します
+// 再接続後にresumeで欠落分を取得するか、全件を再取得するようクライアントに促します
+func (c *Client) disconnectSlowConsumer() {
+	data, err := SendServerMessage(EventTypeResyncRequired, ResyncRequiredPayload{
+		Reason: closeReasonSlowConsumer,
+		Epoch:  c.hub.epoch,
+	})
+	if err == nil {
+		c.queue.push(outboundMessage{data: data}, c.hub.backpressure.Policy, true)
+	}
+	if !c.queue.close(CloseCodeSlowConsumer, closeReasonSlowConsumer) {
+		return
+	}
+	c.hub.stats.add(c.statsKey(), func(s *BackpressureStats) { s.Disconnected++ })
+	log.Printf("[WebSocket] 送信が追いつかないため切断します: user=%s workspace=%s code=%d",
+		c.userID, c.workspaceID, CloseCodeSlowConsumer)
+}
+
+// closeFrame はクローズフレームのデータを返します
+func closeFrame(code int, reason string) []byte {
+	if code == 0 {
+		return []byte{}
+	}
+	return websocket.FormatCloseMessage(code, reason)
+}
+
+// coalesceKeyTypingUsers はtyping_usersイベントの集約キーを返します
+func coalesceKeyTypingUsers(channelID, threadID string) string {
+	return "typing_users:" + channelID + ":" + threadID
+}
+
+// coalesceKeyChannelActivity はchannel_activityイベントの集約キーを返します
+func coalesceKeyChannelActivity(channelID string) string {
+	return "channel_activity:" + channelID
+}
+
+// ThreadUpdatedCoalesceKey はthread_updatedイベントの集約キーを返します
+func ThreadUpdatedCoalesceKey(threadID string) string {
+	return "thread_updated:" + threadID
+}
+
+// ThreadUnreadCoalesceKey はthread_unreadイベントの集約キーを返します
+func ThreadUnreadCoalesceKey(threadID string) string {
+	return "thread_unread:" + threadID
+}
+
+// ReadReceiptCoalesceKey はread_receiptイベントの集約キーを返します
+func ReadReceiptCoalesceKey(channelID, userID string) string {
+	return "read_receipt:" + channelID + ":" + userID
+}
+
+// DraftUpdatedCoalesceKey はdraft_updatedイベントの集約キーを返します
+func DraftUpdatedCoalesceKey(channelID string, parentID *string) string {
+	if parentID == nil {
+		return "draft_updated:" + channelID
+	}
+	return "draft_updated:" + channelID + ":" + *parentID
+}
+
+// PollUpdatedCoalesceKey はpoll_updatedイベントの集約キーを返します
+func PollUpdatedCoalesceKey(pollID string) string {
+	return "poll_updated:" + pollID
+}
+
+// UnreadCountCoalesceKey はunread_countイベントの集約キーを返します
+func UnreadCountCoalesceKey(channelID string) string {
+	return "unread_count:" + channelID
+}
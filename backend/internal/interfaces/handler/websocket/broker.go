@@ -0,0 +1,43 @@
+package websocket
+
+// Broker はブロードキャストメッセージを全サーバーインスタンスへ中継します
+// 各インスタンスはSubscribeで受け取ったメッセージを自身に接続しているクライアントへ配信します
+type Broker interface {
+	// Publish はメッセージを全インスタンス（自身を含む）へ送信します
+	Publish(msg *BroadcastMessage) error
+
+	// Subscribe は他インスタンスを含む全ての配信メッセージを受け取るハンドラーを登録します
+	Subscribe(handler func(*BroadcastMessage)) error
+
+	// Close はブローカーとの接続を終了します
+	Close() error
+}
+
+// localBroker は単一プロセス内でのみメッセージを配信するブローカーです
+type localBroker struct {
+	handler func(*BroadcastMessage)
+}
+
+// NewLocalBroker は単一プロセス用のブローカーを作成します
+func NewLocalBroker() Broker {
+	return &localBroker{}
+}
+
+// Publish はハンドラーへメッセージを直接渡します
+func (b *localBroker) Publish(msg *BroadcastMessage) error {
+	if b.handler != nil {
+		b.handler(msg)
+	}
+	return nil
+}
+
+// Subscribe はハンドラーを登録します
+func (b *localBroker) Subscribe(handler func(*BroadcastMessage)) error {
+	b.handler = handler
+	return nil
+}
+
+// Close は何もしません
+func (b *localBroker) Close() error {
+	return nil
+}
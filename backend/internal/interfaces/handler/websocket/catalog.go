@@ -0,0 +1,105 @@
+package websocket
+
+import (
+	"fmt"
+	"strconv"
+)
+
+const (
+	// ProtocolVersion はサーバーが実装している最新のプロトコルバージョンです
+	// イベントやペイロードに互換性のない変更を加えた場合に上げます
+	ProtocolVersion = 1
+
+	// MinProtocolVersion はサーバーが受け付ける最も古いプロトコルバージョンです
+	MinProtocolVersion = 1
+)
+
+// EventDirection はイベントの送信方向を表します
+type EventDirection string
+
+const (
+	// DirectionClient はクライアントからサーバーへ送信するイベントです
+	DirectionClient EventDirection = "client"
+	// DirectionServer はサーバーからクライアントへ送信するイベントです
+	DirectionServer EventDirection = "server"
+)
+
+// EventDefinition はイベントカタログの1件を表します
+// Payloadにはペイロード型のゼロ値を設定し、ペイロードを持たないイベントはnilにします
+// Broadcastがtrueのイベントにはシーケンス番号（seq）が付与されます
+type EventDefinition struct {
+	Type      EventType
+	Direction EventDirection
+	Summary   string
+	Payload   interface{}
+	Broadcast bool
+}
+
+// EventCatalog はWebSocketで送受信する全イベントの定義です
+// AsyncAPIドキュメントはこの定義から生成します
+var EventCatalog = []EventDefinition{
+	// クライアント→サーバー
+	{Type: EventTypeJoinChannel, Direction: DirectionClient, Summary: "チャンネルを購読します", Payload: JoinChannelPayload{}},
+	{Type: EventTypeLeaveChannel, Direction: DirectionClient, Summary: "チャンネルの購読を解除します", Payload: LeaveChannelPayload{}},
+	{Type: EventTypePostMessage, Direction: DirectionClient, Summary: "メッセージを投稿します", Payload: PostMessagePayload{}},
+	{Type: EventTypeEditMessage, Direction: DirectionClient, Summary: "メッセージを編集します", Payload: EditMessagePayload{}},
+	{Type: EventTypeDeleteMessage, Direction: DirectionClient, Summary: "メッセージを削除します", Payload: DeleteMessagePayload{}},
+	{Type: EventTypeAddReaction, Direction: DirectionClient, Summary: "メッセージにリアクションを追加します", Payload: AddReactionPayload{}},
+	{Type: EventTypeTyping, Direction: DirectionClient, Summary: "入力中であることを通知します", Payload: TypingPayload{}},
+	{Type: EventTypeUpdateReadState, Direction: DirectionClient, Summary: "チャンネルの既読位置を更新します", Payload: UpdateReadStatePayload{}},
+	{Type: EventTypeResume, Direction: DirectionClient, Summary: "欠落したイベントの再送を要求します", Payload: ResumePayload{}},
+	{Type: EventTypeActivity, Direction: DirectionClient, Summary: "操作中であることを通知します"},
+	{Type: EventTypeAuth, Direction: DirectionClient, Summary: "トークンを指定せずに接続した場合に最初に送信して認証します", Payload: AuthPayload{}},
+	{Type: EventTypeReauth, Direction: DirectionClient, Summary: "接続中のアクセストークンを新しいものに切り替えます", Payload: AuthPayload{}},
+	{Type: EventTypeJoinThread, Direction: DirectionClient, Summary: "スレッドを購読します", Payload: JoinThreadPayload{}},
+	{Type: EventTypeLeaveThread, Direction: DirectionClient, Summary: "スレッドの購読を解除します", Payload: LeaveThreadPayload{}},
+
+	// サーバー→クライアント
+	{Type: EventTypeNewMessage, Direction: DirectionServer, Summary: "メッセージが投稿されました", Payload: NewMessagePayload{}, Broadcast: true},
+	{Type: EventTypeThreadReplyCreated, Direction: DirectionServer, Summary: "購読中のスレッドに返信が投稿されました", Payload: ThreadReplyCreatedPayload{}, Broadcast: true},
+	{Type: EventTypeThreadUpdated, Direction: DirectionServer, Summary: "スレッドの返信数・最終返信者・参加者が更新されました", Payload: ThreadUpdatedPayload{}},
+	{Type: EventTypeThreadUnread, Direction: DirectionServer, Summary: "フォローしているスレッドの未読数が更新されました", Payload: ThreadUnreadPayload{}},
+	{Type: EventTypeMessageUpdated, Direction: DirectionServer, Summary: "メッセージが編集されました", Payload: MessageUpdatedPayload{}, Broadcast: true},
+	{Type: EventTypeMessageDeleted, Direction: DirectionServer, Summary: "メッセージが削除されました", Payload: MessageDeletedPayload{}, Broadcast: true},
+	{Type: EventTypeReactionAdded, Direction: DirectionServer, Summary: "リアクションが追加されました", Payload: ReactionAddedPayload{}, Broadcast: true},
+	{Type: EventTypeUnreadCount, Direction: DirectionServer, Summary: "未読数が更新されました", Payload: UnreadCountPayload{}},
+	{Type: EventTypeReadReceipt, Direction: DirectionServer, Summary: "DM・グループDMのメンバーが既読位置を進めました", Payload: ReadReceiptPayload{}},
+	{Type: EventTypeDraftUpdated, Direction: DirectionServer, Summary: "下書きが保存・削除されました", Payload: DraftUpdatedPayload{}},
+	{Type: EventTypeReminderDue, Direction: DirectionServer, Summary: "リマインダーの通知日時になりました", Payload: ReminderDuePayload{}},
+	{Type: EventTypeEphemeralMessage, Direction: DirectionServer, Summary: "自分のみに表示する一時的なメッセージが送信されました", Payload: EphemeralMessagePayload{}},
+	{Type: EventTypePollUpdated, Direction: DirectionServer, Summary: "投票の集計が更新されました", Payload: PollUpdatedPayload{}},
+	{Type: EventTypeChannelActivity, Direction: DirectionServer, Summary: "サイドバーに表示しているチャンネルに新しいメッセージが投稿されました", Payload: ChannelActivityPayload{}},
+	{Type: EventTypePinCreated, Direction: DirectionServer, Summary: "メッセージがピン留めされました", Payload: PinPayload{}, Broadcast: true},
+	{Type: EventTypePinDeleted, Direction: DirectionServer, Summary: "メッセージのピン留めが解除されました", Payload: PinPayload{}, Broadcast: true},
+	{Type: EventTypeSystemMessageCreated, Direction: DirectionServer, Summary: "システムメッセージが作成されました", Payload: SystemMessageCreatedPayload{}, Broadcast: true},
+	{Type: EventTypeTypingUsers, Direction: DirectionServer, Summary: "チャンネル・スレッドで入力中のユーザーが変化しました", Payload: TypingUsersPayload{}},
+	{Type: EventTypePresenceChanged, Direction: DirectionServer, Summary: "ユーザーのプレゼンスが変化しました", Payload: PresenceChangedPayload{}, Broadcast: true},
+	{Type: EventTypeChannelAccessRevoked, Direction: DirectionServer, Summary: "チャンネルの購読が取り消されました", Payload: ChannelAccessRevokedPayload{}},
+	{Type: EventTypeWorkspaceJoined, Direction: DirectionServer, Summary: "接続にWorkspaceが追加されました", Payload: WorkspaceMembershipPayload{}},
+	{Type: EventTypeWorkspaceRemoved, Direction: DirectionServer, Summary: "Workspaceから削除されたため接続から外されました", Payload: WorkspaceMembershipPayload{}},
+	{Type: EventTypeAck, Direction: DirectionServer, Summary: "クライアントイベントの処理結果です", Payload: AckPayload{}},
+	{Type: EventTypeError, Direction: DirectionServer, Summary: "クライアントイベントを処理できませんでした", Payload: ErrorPayload{}},
+	{Type: EventTypeConnected, Direction: DirectionServer, Summary: "接続が確立しました", Payload: ConnectedPayload{}},
+	{Type: EventTypeResumed, Direction: DirectionServer, Summary: "欠落したイベントの再送が完了しました", Payload: ResumedPayload{}},
+	{Type: EventTypeResyncRequired, Direction: DirectionServer, Summary: "再送できないため全件の再取得が必要です", Payload: ResyncRequiredPayload{}},
+}
+
+// NegotiateProtocolVersion はクライアントが指定したバージョンから使用するプロトコルバージョンを決定します
+// クライアントは対応している最新のバージョンを指定し、サーバーはそれ以下で最新のバージョンを選びます
+// 未指定の場合は最新のバージョンを使用します
+func NegotiateProtocolVersion(requested string) (int, error) {
+	if requested == "" {
+		return ProtocolVersion, nil
+	}
+	v, err := strconv.Atoi(requested)
+	if err != nil {
+		return 0, fmt.Errorf("プロトコルバージョンが不正です: %q", requested)
+	}
+	if v < MinProtocolVersion {
+		return 0, fmt.Errorf("プロトコルバージョン%dはサポートされていません（対応バージョン: %d〜%d）", v, MinProtocolVersion, ProtocolVersion)
+	}
+	if v > ProtocolVersion {
+		return ProtocolVersion, nil
+	}
+	return v, nil
+}
@@ -0,0 +1,136 @@
+package websocket
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/google/uuid"
+
+	domainerrors "github.com/newt239/chat/internal/domain/errors"
+)
+
+// channelAccessTimeout はチャンネルのアクセス権確認のタイムアウトです
+const channelAccessTimeout = 5 * time.Second
+
+// SubscriptionRevocation はチャンネル購読の取り消しを表します
+type SubscriptionRevocation struct {
+	ChannelID string
+
+	// UserIDs は購読を取り消すユーザーです
+	// Restrictがtrueの場合は購読を維持するユーザーを表し、それ以外の全員の購読を取り消します
+	UserIDs  []string
+	Restrict bool
+}
+
+// revokes はユーザーの購読を取り消す対象か判定します
+func (r *SubscriptionRevocation) revokes(userID string) bool {
+	for _, id := range r.UserIDs {
+		if id == userID {
+			return !r.Restrict
+		}
+	}
+	return r.Restrict
+}
+
+// channelAccessError はチャンネルへのアクセスを拒否した理由を表します
+type channelAccessError struct {
+	code    string
+	message string
+}
+
+// RevokeChannelAccess は指定したユーザーのチャンネル購読を取り消し、本人に通知します
+func (h *Hub) RevokeChannelAccess(workspaceID string, channelID string, userIDs []string, reason string) {
+	h.publishRevocation(workspaceID, &SubscriptionRevocation{
+		ChannelID: channelID,
+		UserIDs:   userIDs,
+	}, reason)
+}
+
+// RestrictChannelSubscribers はチャンネルの購読者を指定したユーザーに制限し、それ以外の購読を取り消します
+// チャンネルがプライベートに変更された場合などに使用します
+func (h *Hub) RestrictChannelSubscribers(workspaceID string, channelID string, allowedUserIDs []string, reason string) {
+	h.publishRevocation(workspaceID, &SubscriptionRevocation{
+		ChannelID: channelID,
+		UserIDs:   allowedUserIDs,
+		Restrict:  true,
+	}, reason)
+}
+
+// publishRevocation は購読の取り消しをブローカー経由で全インスタンスに送信します
+func (h *Hub) publishRevocation(workspaceID string, revocation *SubscriptionRevocation, reason string) {
+	data, err := SendServerMessage(EventTypeChannelAccessRevoked, ChannelAccessRevokedPayload{
+		ChannelID: revocation.ChannelID,
+		Reason:    reason,
+	})
+	if err != nil {
+		log.Printf("[WebSocket] channel_access_revokedイベントのエンコードに失敗しました: %v", err)
+		return
+	}
+
+	h.publish(&BroadcastMessage{
+		WorkspaceID: workspaceID,
+		ChannelID:   &revocation.ChannelID,
+		Revocation:  revocation,
+		Data:        data,
+	})
+	log.Printf("[WebSocket] チャンネル購読取り消し: workspace=%s channel=%s reason=%s",
+		workspaceID, revocation.ChannelID, reason)
+}
+
+// revoke は自インスタンスの購読者から対象ユーザーを削除し、取り消しを通知します
+// 取り消しはシーケンス番号を採番せず、再送対象にもなりません
+func (s *workspaceShard) revoke(msg *BroadcastMessage) {
+	rev := msg.Revocation
+	s.revokeThreads(rev)
+
+	subscribers, ok := s.channelSubscribers[rev.ChannelID]
+	if !ok {
+		return
+	}
+
+	for userID := range subscribers {
+		if !rev.revokes(userID) {
+			continue
+		}
+		delete(subscribers, userID)
+		for _, client := range s.clients[userID] {
+			client.removeSubscription(rev.ChannelID)
+			client.enqueue(msg.Data, "")
+		}
+		log.Printf("[WebSocket] チャンネル購読者解除（権限喪失）: user=%s workspace=%s channel=%s",
+			userID, s.workspaceID, rev.ChannelID)
+	}
+	if len(subscribers) == 0 {
+		delete(s.channelSubscribers, rev.ChannelID)
+	}
+}
+
+// authorizeChannel はクライアントのユーザーがチャンネルにアクセスできるか確認します
+// アクセスできる場合はチャンネルが属するWorkspaceのIDを、できない場合は拒否理由を返します
+func (c *Client) authorizeChannel(channelID string) (string, *channelAccessError) {
+	if _, err := uuid.Parse(channelID); err != nil {
+		return "", &channelAccessError{code: ErrorCodeChannelNotFound, message: "チャンネルが見つかりません"}
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), channelAccessTimeout)
+	defer cancel()
+
+	ch, err := c.channelAccess.EnsureChannelAccess(ctx, channelID, c.userID)
+	switch {
+	case err == nil && c.inWorkspace(ch.WorkspaceID):
+		return ch.WorkspaceID, nil
+	case err == nil, errors.Is(err, domainerrors.ErrChannelNotFound):
+		// 接続が登録されていないWorkspaceのチャンネルは存在しないものとして扱う
+		return "", &channelAccessError{code: ErrorCodeChannelNotFound, message: "チャンネルが見つかりません"}
+	case errors.Is(err, domainerrors.ErrUnauthorized):
+		log.Printf("[WebSocket] チャンネルへのアクセスを拒否しました: user=%s workspace=%s channel=%s",
+			c.userID, c.workspaceID, channelID)
+		return "", &channelAccessError{code: ErrorCodeChannelAccessDenied, message: "このチャンネルへのアクセス権がありません"}
+	default:
+		log.Printf("[WebSocket] チャンネルのアクセス権確認に失敗しました: user=%s channel=%s err=%v",
+			c.userID, channelID, err)
+		return "", &channelAccessError{code: ErrorCodeInternal, message: "チャンネルのアクセス権を確認できませんでした"}
+	}
+}
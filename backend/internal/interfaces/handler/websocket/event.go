@@ -3,6 +3,10 @@ package websocket
 import (
 	"encoding/json"
 	"fmt"
+	"time"
+
+	messageuc "github.com/newt239/chat/internal/usecase/message"
+	reactionuc "github.com/newt239/chat/internal/usecase/reaction"
 )
 
 // EventType はWebSocketイベントのタイプを表します
@@ -13,19 +17,65 @@ const (
 	EventTypeJoinChannel     EventType = "join_channel"
 	EventTypeLeaveChannel    EventType = "leave_channel"
 	EventTypePostMessage     EventType = "post_message"
+	EventTypeEditMessage     EventType = "edit_message"
+	EventTypeDeleteMessage   EventType = "delete_message"
+	EventTypeAddReaction     EventType = "add_reaction"
 	EventTypeTyping          EventType = "typing"
 	EventTypeUpdateReadState EventType = "update_read_state"
+	EventTypeResume          EventType = "resume"
+	EventTypeActivity        EventType = "activity"
+	EventTypeAuth            EventType = "auth"
+	EventTypeReauth          EventType = "reauth"
+	EventTypeJoinThread      EventType = "join_thread"
+	EventTypeLeaveThread     EventType = "leave_thread"
 
 	// サーバー→クライアント
-	EventTypeNewMessage     EventType = "new_message"
-	EventTypeMessageUpdated EventType = "message_updated"
-	EventTypeMessageDeleted EventType = "message_deleted"
-	EventTypeUnreadCount    EventType = "unread_count"
-	EventTypePinCreated     EventType = "pin_created"
-	EventTypePinDeleted     EventType = "pin_deleted"
-		EventTypeSystemMessageCreated EventType = "system_message_created"
-	EventTypeAck            EventType = "ack"
-	EventTypeError          EventType = "error"
+	EventTypeNewMessage           EventType = "new_message"
+	EventTypeMessageUpdated       EventType = "message_updated"
+	EventTypeMessageDeleted       EventType = "message_deleted"
+	EventTypeReactionAdded        EventType = "reaction_added"
+	EventTypeUnreadCount          EventType = "unread_count"
+	EventTypePinCreated           EventType = "pin_created"
+	EventTypePinDeleted           EventType = "pin_deleted"
+	EventTypeSystemMessageCreated EventType = "system_message_created"
+	EventTypeAck                  EventType = "ack"
+	EventTypeError                EventType = "error"
+	EventTypeConnected            EventType = "connected"
+	EventTypeResumed              EventType = "resumed"
+	EventTypeResyncRequired       EventType = "resync_required"
+	EventTypePresenceChanged      EventType = "presence_changed"
+	EventTypeChannelAccessRevoked EventType = "channel_access_revoked"
+	EventTypeWorkspaceJoined      EventType = "workspace_joined"
+	EventTypeWorkspaceRemoved     EventType = "workspace_removed"
+	EventTypeTypingUsers          EventType = "typing_users"
+	EventTypeChannelActivity      EventType = "channel_activity"
+	EventTypeThreadReplyCreated   EventType = "thread_reply_created"
+	EventTypeThreadUpdated        EventType = "thread_updated"
+	EventTypeThreadUnread         EventType = "thread_unread"
+	EventTypeReadReceipt          EventType = "read_receipt"
+	EventTypeDraftUpdated         EventType = "draft_updated"
+	EventTypeReminderDue          EventType = "reminder_due"
+	EventTypePollUpdated          EventType = "poll_updated"
+	EventTypeEphemeralMessage     EventType = "ephemeral_message"
+)
+
+// エラーコード（ack/errorイベントのcodeに設定され、クライアントが分岐に使用します）
+const (
+	ErrorCodeChannelAccessDenied = "CHANNEL_ACCESS_DENIED"
+	ErrorCodeChannelNotFound     = "CHANNEL_NOT_FOUND"
+	ErrorCodeMessageNotFound     = "MESSAGE_NOT_FOUND"
+	ErrorCodeForbidden           = "FORBIDDEN"
+	ErrorCodeValidation          = "VALIDATION_ERROR"
+	ErrorCodeConflict            = "CONFLICT"
+	ErrorCodeUnauthorized        = "UNAUTHORIZED"
+	ErrorCodeInternal            = "INTERNAL_ERROR"
+)
+
+// チャンネル購読が取り消された理由
+const (
+	RevokeReasonRemoved        = "removed"
+	RevokeReasonLeft           = "left"
+	RevokeReasonChannelPrivate = "channel_private"
 )
 
 // ClientMessage はクライアントから受信するメッセージを表します
@@ -35,9 +85,13 @@ type ClientMessage struct {
 }
 
 // ServerMessage はサーバーからクライアントに送信するメッセージを表します
+// WorkspaceIDはWorkspaceで発生したイベントに付与され、複数Workspaceを購読する接続で振り分けに使用します
+// Seqはブロードキャストされたイベントにのみ付与されるWorkspace単位の連番です
 type ServerMessage struct {
-	Type    EventType   `json:"type"`
-	Payload interface{} `json:"payload,omitempty"`
+	Type        EventType   `json:"type"`
+	WorkspaceID string      `json:"workspace_id,omitempty"`
+	Seq         uint64      `json:"seq,omitempty"`
+	Payload     interface{} `json:"payload,omitempty"`
 }
 
 // JoinChannelPayload はjoin_channelイベントのペイロードを表します
@@ -50,47 +104,156 @@ type LeaveChannelPayload struct {
 	ChannelID string `json:"channel_id"`
 }
 
+// JoinThreadPayload はjoin_threadイベントのペイロードを表します
+// ThreadIDはスレッドの親メッセージのIDです
+type JoinThreadPayload struct {
+	ChannelID string `json:"channel_id"`
+	ThreadID  string `json:"thread_id"`
+}
+
+// LeaveThreadPayload はleave_threadイベントのペイロードを表します
+type LeaveThreadPayload struct {
+	ChannelID string `json:"channel_id"`
+	ThreadID  string `json:"thread_id"`
+}
+
 // PostMessagePayload はpost_messageイベントのペイロードを表します
+// ClientMsgIDを指定すると、再接続後に再送しても同じメッセージが重複して作成されません
 type PostMessagePayload struct {
-	ChannelID string `json:"channel_id"`
+	ChannelID     string   `json:"channel_id"`
+	Body          string   `json:"body"`
+	ParentID      *string  `json:"parent_id,omitempty"`
+	AttachmentIDs []string `json:"attachment_ids,omitempty"`
+	ClientMsgID   string   `json:"client_msg_id,omitempty"`
+}
+
+// EditMessagePayload はedit_messageイベントのペイロードを表します
+type EditMessagePayload struct {
+	MessageID string `json:"message_id"`
 	Body      string `json:"body"`
 }
 
+// DeleteMessagePayload はdelete_messageイベントのペイロードを表します
+type DeleteMessagePayload struct {
+	MessageID string `json:"message_id"`
+}
+
+// AddReactionPayload はadd_reactionイベントのペイロードを表します
+type AddReactionPayload struct {
+	MessageID string `json:"message_id"`
+	Emoji     string `json:"emoji"`
+}
+
 // TypingPayload はtypingイベントのペイロードを表します
+// ThreadIDを指定するとスレッドでの入力として扱い、Typingにfalseを指定すると入力中を解除します
 type TypingPayload struct {
-	ChannelID string `json:"channel_id"`
+	ChannelID string  `json:"channel_id"`
+	ThreadID  *string `json:"thread_id,omitempty"`
+	Typing    *bool   `json:"typing,omitempty"`
 }
 
 // UpdateReadStatePayload はupdate_read_stateイベントのペイロードを表します
+// LastReadAtを省略した場合はサーバーの現在時刻で既読にします
 type UpdateReadStatePayload struct {
-	ChannelID string `json:"channel_id"`
-	MessageID string `json:"message_id"`
+	ChannelID  string     `json:"channel_id"`
+	MessageID  string     `json:"message_id"`
+	LastReadAt *time.Time `json:"last_read_at,omitempty"`
+}
+
+// AuthPayload はauth/reauthイベントのペイロードを表します
+type AuthPayload struct {
+	Token string `json:"token"`
+}
+
+// ResumePayload はresumeイベントのペイロードを表します
+// 複数Workspaceを購読する接続ではWorkspaceIDでシーケンス番号の系列を指定します
+type ResumePayload struct {
+	WorkspaceID string   `json:"workspace_id,omitempty"`
+	Since       uint64   `json:"since"`
+	Epoch       string   `json:"epoch"`
+	ChannelIDs  []string `json:"channel_ids,omitempty"`
 }
 
 // NewMessagePayload はnew_messageイベントのペイロードを表します
 type NewMessagePayload struct {
-	ChannelID string                 `json:"channel_id"`
-	Message   map[string]interface{} `json:"message"`
+	ChannelID string                  `json:"channel_id"`
+	Message   messageuc.MessageOutput `json:"message"`
+}
+
+// ThreadReplyCreatedPayload はthread_reply_createdイベントのペイロードを表します
+type ThreadReplyCreatedPayload struct {
+	ChannelID string                  `json:"channel_id"`
+	ThreadID  string                  `json:"thread_id"`
+	Message   messageuc.MessageOutput `json:"message"`
+}
+
+// ThreadUpdatedPayload はthread_updatedイベントのペイロードを表します
+// スレッドを開いていないチャンネルの購読者が返信数などの表示を更新するため、返信の本文は含みません
+type ThreadUpdatedPayload struct {
+	ChannelID          string     `json:"channel_id"`
+	ThreadID           string     `json:"thread_id"`
+	ReplyCount         int        `json:"reply_count"`
+	LastReplyAt        *time.Time `json:"last_reply_at,omitempty"`
+	LastReplyUserID    *string    `json:"last_reply_user_id,omitempty"`
+	ParticipantUserIDs []string   `json:"participant_user_ids"`
+}
+
+// ThreadUnreadPayload はthread_unreadイベントのペイロードを表します
+type ThreadUnreadPayload struct {
+	ChannelID   string `json:"channel_id"`
+	ThreadID    string `json:"thread_id"`
+	UnreadCount int    `json:"unread_count"`
 }
 
 // MessageUpdatedPayload はmessage_updatedイベントのペイロードを表します
 type MessageUpdatedPayload struct {
-	ChannelID string                 `json:"channel_id"`
-	Message   map[string]interface{} `json:"message"`
+	ChannelID string                  `json:"channel_id"`
+	Message   messageuc.MessageOutput `json:"message"`
 }
 
 // MessageDeletedPayload はmessage_deletedイベントのペイロードを表します
 type MessageDeletedPayload struct {
-	ChannelID  string                 `json:"channel_id"`
-	DeleteData map[string]interface{} `json:"deleteData"`
+	ChannelID  string                         `json:"channel_id"`
+	DeleteData messageuc.DeletedMessageOutput `json:"deleteData"`
+}
+
+// ReactionAddedPayload はreaction_addedイベントのペイロードを表します
+type ReactionAddedPayload struct {
+	ChannelID string                    `json:"channel_id"`
+	Reaction  reactionuc.ReactionOutput `json:"reaction"`
 }
 
 // PinPayload はpin_created/pin_deletedイベントのペイロードを表します
 type PinPayload struct {
-	ChannelID string                 `json:"channel_id"`
-	Message   map[string]interface{} `json:"message"`
-	PinnedBy  string                 `json:"pinned_by"`
-	PinnedAt  string                 `json:"pinned_at"`
+	ChannelID string    `json:"channel_id"`
+	MessageID string    `json:"message_id"`
+	PinnedBy  string    `json:"pinned_by"`
+	PinnedAt  time.Time `json:"pinned_at"`
+}
+
+// SystemMessageCreatedPayload はsystem_message_createdイベントのペイロードを表します
+type SystemMessageCreatedPayload struct {
+	ChannelID string            `json:"channel_id"`
+	Message   SystemMessageData `json:"message"`
+}
+
+// SystemMessageData はシステムメッセージを表します
+// Payloadの内容はKindごとに異なります
+type SystemMessageData struct {
+	ID        string         `json:"id"`
+	ChannelID string         `json:"channelId"`
+	Kind      string         `json:"kind"`
+	Payload   map[string]any `json:"payload"`
+	ActorID   *string        `json:"actorId"`
+	CreatedAt time.Time      `json:"createdAt"`
+}
+
+// TypingUsersPayload はtyping_usersイベントのペイロードを表します
+// UserIDsはチャンネル（ThreadIDが設定されている場合はスレッド）で入力中のユーザーで、受信者自身は含みません
+type TypingUsersPayload struct {
+	ChannelID string   `json:"channel_id"`
+	ThreadID  *string  `json:"thread_id,omitempty"`
+	UserIDs   []string `json:"user_ids"`
 }
 
 // UnreadCountPayload はunread_countイベントのペイロードを表します
@@ -100,11 +263,107 @@ type UnreadCountPayload struct {
 	HasMention  bool   `json:"has_mention"`
 }
 
+// ReadReceiptPayload はread_receiptイベントのペイロードを表します
+// DM・グループDMのメンバーが既読位置を進めたことを他のメンバーに通知します
+type ReadReceiptPayload struct {
+	ChannelID  string    `json:"channel_id"`
+	UserID     string    `json:"user_id"`
+	LastReadAt time.Time `json:"last_read_at"`
+}
+
+// DraftUpdatedPayload はdraft_updatedイベントのペイロードを表します
+// 下書きの保存・削除をユーザーの接続に通知します。Deletedがtrueの場合、BodyとAttachmentIDsは空です
+type DraftUpdatedPayload struct {
+	ChannelID     string    `json:"channel_id"`
+	ParentID      *string   `json:"parent_id,omitempty"`
+	Body          string    `json:"body"`
+	AttachmentIDs []string  `json:"attachment_ids"`
+	Deleted       bool      `json:"deleted"`
+	UpdatedAt     time.Time `json:"updated_at"`
+}
+
+// ReminderDuePayload はreminder_dueイベントのペイロードを表します
+// SystemMessageは通知したユーザーのみに表示するシステムメッセージです
+type ReminderDuePayload struct {
+	ReminderID    string            `json:"reminder_id"`
+	MessageID     string            `json:"message_id"`
+	ChannelID     string            `json:"channel_id"`
+	RemindAt      time.Time         `json:"remind_at"`
+	SystemMessage SystemMessageData `json:"system_message"`
+}
+
+// EphemeralMessagePayload はephemeral_messageイベントのペイロードを表します
+// 宛先のユーザーの接続のみに送信します
+type EphemeralMessagePayload struct {
+	ChannelID string               `json:"channel_id"`
+	Message   EphemeralMessageData `json:"message"`
+}
+
+// EphemeralMessageData は1人のユーザーのみに表示する一時的なメッセージを表します
+// ExpiresAtが未設定の場合は保存されていないため、再読込後は表示されません
+type EphemeralMessageData struct {
+	ID        string         `json:"id"`
+	ChannelID string         `json:"channelId"`
+	Kind      string         `json:"kind"`
+	Body      string         `json:"body"`
+	Payload   map[string]any `json:"payload,omitempty"`
+	CreatedAt time.Time      `json:"createdAt"`
+	ExpiresAt *time.Time     `json:"expiresAt,omitempty"`
+}
+
+// PollUpdatedPayload はpoll_updatedイベントのペイロードを表します
+// 受信者ごとの投票状況は含まないため、クライアントは自身の投票を投票・取り消しの応答から反映します
+type PollUpdatedPayload struct {
+	ChannelID  string           `json:"channel_id"`
+	MessageID  string           `json:"message_id"`
+	PollID     string           `json:"poll_id"`
+	ClosesAt   *time.Time       `json:"closes_at,omitempty"`
+	VoterCount int              `json:"voter_count"`
+	Options    []PollOptionData `json:"options"`
+}
+
+// PollOptionData は投票の選択肢の集計を表します
+// 匿名の投票ではVoterIDsを含みません
+type PollOptionData struct {
+	ID        string   `json:"id"`
+	VoteCount int      `json:"vote_count"`
+	VoterIDs  []string `json:"voter_ids,omitempty"`
+}
+
+// ChannelActivityPayload はchannel_activityイベントのペイロードを表します
+// 購読していないチャンネルのサイドバー表示を更新するため、メッセージ本文は含みません
+type ChannelActivityPayload struct {
+	ChannelID       string    `json:"channel_id"`
+	LatestMessageID string    `json:"latest_message_id"`
+	LatestMessageAt time.Time `json:"latest_message_at"`
+	UnreadCount     int       `json:"unread_count"`
+	MentionCount    int       `json:"mention_count"`
+	HasMention      bool      `json:"has_mention"`
+}
+
+// PresenceChangedPayload はpresence_changedイベントのペイロードを表します
+type PresenceChangedPayload struct {
+	UserID string    `json:"user_id"`
+	Status string    `json:"status"`
+	At     time.Time `json:"at"`
+}
+
+// ChannelAccessRevokedPayload はchannel_access_revokedイベントのペイロードを表します
+type ChannelAccessRevokedPayload struct {
+	ChannelID string `json:"channel_id"`
+	Reason    string `json:"reason"`
+}
+
 // AckPayload はackイベントのペイロードを表します
+// 失敗時はCodeにエラーコードを設定します
+// メッセージ操作の場合は対象のメッセージIDとクライアントが指定したClientMsgIDを返します
 type AckPayload struct {
-	Type    EventType `json:"type"`
-	Success bool      `json:"success"`
-	Message string    `json:"message,omitempty"`
+	Type        EventType `json:"type"`
+	Success     bool      `json:"success"`
+	Code        string    `json:"code,omitempty"`
+	Message     string    `json:"message,omitempty"`
+	MessageID   string    `json:"message_id,omitempty"`
+	ClientMsgID string    `json:"client_msg_id,omitempty"`
 }
 
 // ErrorPayload はerrorイベントのペイロードを表します
@@ -113,6 +372,34 @@ type ErrorPayload struct {
 	Message string `json:"message"`
 }
 
+// ConnectedPayload はconnectedイベントのペイロードを表します
+// ProtocolVersionは接続時にネゴシエーションしたプロトコルバージョンです
+type ConnectedPayload struct {
+	ProtocolVersion int    `json:"protocol_version"`
+	Epoch           string `json:"epoch"`
+	Seq             uint64 `json:"seq"`
+}
+
+// ResumedPayload はresumedイベントのペイロードを表します
+type ResumedPayload struct {
+	Epoch    string `json:"epoch"`
+	FromSeq  uint64 `json:"from_seq"`
+	ToSeq    uint64 `json:"to_seq"`
+	Replayed int    `json:"replayed"`
+}
+
+// ResyncRequiredPayload はresync_requiredイベントのペイロードを表します
+type ResyncRequiredPayload struct {
+	Reason string `json:"reason"`
+	Epoch  string `json:"epoch"`
+	Seq    uint64 `json:"seq"`
+}
+
+// WorkspaceMembershipPayload はworkspace_joined/workspace_removedイベントのペイロードを表します
+type WorkspaceMembershipPayload struct {
+	WorkspaceID string `json:"workspace_id"`
+}
+
 // SendServerMessage はサーバーメッセージをJSON形式にエンコードします
 func SendServerMessage(eventType EventType, payload interface{}) ([]byte, error) {
 	msg := ServerMessage{
@@ -122,6 +409,37 @@ func SendServerMessage(eventType EventType, payload interface{}) ([]byte, error)
 	return json.Marshal(msg)
 }
 
+// sendWorkspaceMessage はWorkspaceのIDを付与したサーバーメッセージをJSON形式にエンコードします
+func sendWorkspaceMessage(workspaceID string, eventType EventType, payload interface{}) ([]byte, error) {
+	msg := ServerMessage{
+		Type:        eventType,
+		WorkspaceID: workspaceID,
+		Payload:     payload,
+	}
+	return json.Marshal(msg)
+}
+
+// withSequence はエンコード済みのサーバーメッセージにWorkspaceのIDとシーケンス番号を付与します
+// seqが0の場合はシーケンス番号を付与しません
+func withSequence(data []byte, workspaceID string, seq uint64) ([]byte, error) {
+	var raw struct {
+		Type    EventType       `json:"type"`
+		Payload json.RawMessage `json:"payload,omitempty"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return nil, fmt.Errorf("failed to parse server message: %w", err)
+	}
+	msg := ServerMessage{
+		Type:        raw.Type,
+		WorkspaceID: workspaceID,
+		Seq:         seq,
+	}
+	if len(raw.Payload) > 0 {
+		msg.Payload = raw.Payload
+	}
+	return json.Marshal(msg)
+}
+
 // ParseClientMessage はクライアントメッセージをパースします
 func ParseClientMessage(data []byte) (*ClientMessage, error) {
 	var msg ClientMessage
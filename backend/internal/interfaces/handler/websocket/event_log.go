@@ -0,0 +1,76 @@
+package websocket
+
+import "time"
+
+const (
+	// eventLogCapacity はWorkspaceごとに保持するイベント数の上限です
+	eventLogCapacity = 1000
+
+	// eventLogRetention はイベントを再送可能な状態で保持する期間です
+	eventLogRetention = 10 * time.Minute
+)
+
+// loggedEvent は再送用に保持しているイベントを表します
+type loggedEvent struct {
+	msg        *BroadcastMessage
+	recordedAt time.Time
+}
+
+// eventLog はWorkspace単位のシーケンス番号と直近のイベントを管理します
+// Workspaceを担当するworkspaceShardのゴルーチンからのみアクセスされます
+type eventLog struct {
+	lastSeq uint64
+	events  []loggedEvent
+}
+
+// newEventLog は新しいeventLogを作成します
+func newEventLog() *eventLog {
+	return &eventLog{
+		events: make([]loggedEvent, 0),
+	}
+}
+
+// append はメッセージにシーケンス番号を採番して記録します
+func (l *eventLog) append(msg *BroadcastMessage, now time.Time) {
+	l.lastSeq++
+	msg.Seq = l.lastSeq
+
+	l.events = append(l.events, loggedEvent{msg: msg, recordedAt: now})
+	if len(l.events) > eventLogCapacity {
+		l.events = l.events[len(l.events)-eventLogCapacity:]
+	}
+	l.prune(now)
+}
+
+// prune は保持期間を過ぎたイベントを削除します
+func (l *eventLog) prune(now time.Time) {
+	i := 0
+	for i < len(l.events) && now.Sub(l.events[i].recordedAt) > eventLogRetention {
+		i++
+	}
+	if i > 0 {
+		l.events = append(l.events[:0:0], l.events[i:]...)
+	}
+}
+
+// since は指定したシーケンス番号より後のイベントを返します
+// 欠落したイベントが既に破棄されている場合はfalseを返します
+func (l *eventLog) since(seq uint64) ([]*BroadcastMessage, bool) {
+	if seq > l.lastSeq {
+		return nil, false
+	}
+	if seq == l.lastSeq {
+		return []*BroadcastMessage{}, true
+	}
+	if len(l.events) == 0 || l.events[0].msg.Seq > seq+1 {
+		return nil, false
+	}
+
+	result := make([]*BroadcastMessage, 0, l.lastSeq-seq)
+	for _, e := range l.events {
+		if e.msg.Seq > seq {
+			result = append(result, e.msg)
+		}
+	}
+	return result, true
+}
@@ -1,110 +1,191 @@
 package websocket
 
 import (
+	"context"
+	"errors"
 	"log"
 	"net/http"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/gorilla/websocket"
 	"github.com/labstack/echo/v4"
 	"github.com/newt239/chat/internal/domain/repository"
+	"github.com/newt239/chat/internal/domain/service"
 	authuc "github.com/newt239/chat/internal/usecase/auth"
+	messageuc "github.com/newt239/chat/internal/usecase/message"
+	reactionuc "github.com/newt239/chat/internal/usecase/reaction"
+	readstateuc "github.com/newt239/chat/internal/usecase/readstate"
 )
 
-// MessageUseCase はメッセージユースケースのインターフェースです
+// MessageUseCase はWebSocketから利用するメッセージユースケースのインターフェースです
 type MessageUseCase interface {
-	// メッセージ関連の操作（必要に応じて定義）
+	CreateMessage(ctx context.Context, input messageuc.CreateMessageInput) (*messageuc.MessageOutput, error)
+	UpdateMessage(ctx context.Context, input messageuc.UpdateMessageInput) (*messageuc.MessageOutput, error)
+	DeleteMessage(ctx context.Context, input messageuc.DeleteMessageInput) error
 }
 
-// ReadStateUseCase は既読状態ユースケースのインターフェースです
-type ReadStateUseCase interface {
-	// 既読状態関連の操作（必要に応じて定義）
+// ReactionUseCase はWebSocketから利用するリアクションユースケースのインターフェースです
+type ReactionUseCase interface {
+	AddReaction(ctx context.Context, input reactionuc.AddReactionInput) error
 }
 
-var upgrader = websocket.Upgrader{
-	CheckOrigin: func(r *http.Request) bool {
-		// TODO: 本番環境では適切なオリジンチェックを実装
-		return true
-	},
+// ReadStateUseCase はWebSocketから利用する既読状態ユースケースのインターフェースです
+type ReadStateUseCase interface {
+	UpdateReadState(ctx context.Context, input readstateuc.UpdateReadStateInput) error
 }
 
 // Handler はWebSocketハンドラーを返します
-func Handler(hub *Hub, jwtService authuc.JWTService, workspaceRepo repository.WorkspaceRepository, messageUseCase MessageUseCase, readStateUseCase ReadStateUseCase) echo.HandlerFunc {
+// オリジンはCORSで許可したものに制限します
+func Handler(hub *Hub, jwtService authuc.JWTService, sessionRepo repository.SessionRepository, workspaceRepo repository.WorkspaceRepository, channelAccess service.ChannelAccessService, allowedOrigins []string, messageUseCase MessageUseCase, reactionUseCase ReactionUseCase, readStateUseCase ReadStateUseCase) echo.HandlerFunc {
+	upgrader := newUpgrader(allowedOrigins)
+
 	return func(c echo.Context) error {
 		log.Printf("[WebSocket] 接続リクエスト受信: RemoteAddr=%s", c.Request().RemoteAddr)
 
-		// 認証トークンの取得
-		// WebSocketではAuthorizationヘッダーを設定できないため、クエリパラメータからも取得を試みる
-		var token string
-		authHeader := c.Request().Header.Get("Authorization")
-		if authHeader != "" {
-			token = authHeader
-			if len(token) > 7 && token[:7] == "Bearer " {
-				token = token[7:]
-			}
-		} else {
-			// クエリパラメータからトークンを取得
-			token = c.QueryParam("token")
-			if token == "" {
-				log.Printf("[WebSocket] 認証トークンが指定されていません: RemoteAddr=%s", c.Request().RemoteAddr)
-				return echo.NewHTTPError(http.StatusUnauthorized, "認証トークンが指定されていません")
-			}
-		}
-
-		// JWTトークンの検証
-		claims, err := jwtService.VerifyToken(token)
+		// プロトコルバージョンのネゴシエーション（?v=<version>）
+		protocolVersion, err := NegotiateProtocolVersion(c.QueryParam("v"))
 		if err != nil {
-			log.Printf("[WebSocket] トークン検証失敗: err=%v RemoteAddr=%s", err, c.Request().RemoteAddr)
-			return echo.NewHTTPError(http.StatusUnauthorized, "トークンが無効または期限切れです")
-		}
-
-		// WorkspaceIDの取得
-		workspaceID := c.QueryParam("workspaceId")
-		if workspaceID == "" {
-			log.Printf("[WebSocket] workspaceIdが指定されていません: userID=%s RemoteAddr=%s", claims.UserID, c.Request().RemoteAddr)
-			return echo.NewHTTPError(http.StatusBadRequest, "workspaceIdクエリパラメータは必須です")
+			log.Printf("[WebSocket] プロトコルバージョンのネゴシエーション失敗: err=%v RemoteAddr=%s", err, c.Request().RemoteAddr)
+			return echo.NewHTTPError(http.StatusBadRequest, err.Error())
 		}
 
-		// Workspace所属確認
+		// 認証トークンの取得
+		// WebSocketではAuthorizationヘッダーを設定できないため、クエリパラメータからも取得を試みる
+		// どちらも指定されていない場合は接続後の最初のメッセージ（authイベント）で認証する
 		ctx := c.Request().Context()
-		member, err := workspaceRepo.FindMember(ctx, workspaceID, claims.UserID)
-		if err != nil {
-			log.Printf("[WebSocket] FindMember error: userID=%s workspaceID=%s err=%v", claims.UserID, workspaceID, err)
-			return echo.NewHTTPError(http.StatusForbidden, "ユーザーはこのワークスペースのメンバーではありません")
-		}
-		if member == nil {
-			log.Printf("[WebSocket] Member not found: userID=%s workspaceID=%s", claims.UserID, workspaceID)
-			return echo.NewHTTPError(http.StatusForbidden, "ユーザーはこのワークスペースのメンバーではありません")
+		workspaceID := c.QueryParam("workspaceId")
+		var claims *authuc.TokenClaims
+		var workspaceIDs []string
+		if token := tokenFromRequest(c.Request()); token != "" {
+			// JWTトークンの検証
+			claims, err = verifyAccessToken(ctx, jwtService, sessionRepo, token)
+			if err != nil {
+				log.Printf("[WebSocket] トークン検証失敗: err=%v RemoteAddr=%s", err, c.Request().RemoteAddr)
+				return echo.NewHTTPError(http.StatusUnauthorized, "トークンが無効または期限切れです")
+			}
+			workspaceIDs, err = resolveWorkspaces(ctx, workspaceRepo, claims.UserID, workspaceID)
+			if err != nil {
+				return err
+			}
+			log.Printf("[WebSocket] 認証成功、アップグレード開始: userID=%s workspaceIDs=%v", claims.UserID, workspaceIDs)
 		}
 
-		log.Printf("[WebSocket] 認証成功、アップグレード開始: userID=%s workspaceID=%s", claims.UserID, workspaceID)
-
 		// WebSocket接続のアップグレード
 		conn, err := upgrader.Upgrade(c.Response(), c.Request(), nil)
 		if err != nil {
-			log.Printf("[WebSocket] アップグレード失敗: userID=%s workspaceID=%s err=%v", claims.UserID, workspaceID, err)
+			log.Printf("[WebSocket] アップグレード失敗: err=%v RemoteAddr=%s", err, c.Request().RemoteAddr)
 			return err
 		}
 
-		log.Printf("[WebSocket] アップグレード成功: userID=%s workspaceID=%s", claims.UserID, workspaceID)
+		if claims == nil {
+			claims, err = readAuthHandshake(conn, jwtService, sessionRepo)
+			if err != nil {
+				log.Printf("[WebSocket] authイベントによる認証失敗: err=%v RemoteAddr=%s", err, c.Request().RemoteAddr)
+				closeConn(conn, CloseCodeUnauthorized, closeReasonUnauthorized)
+				return nil
+			}
+			workspaceIDs, err = resolveWorkspaces(ctx, workspaceRepo, claims.UserID, workspaceID)
+			if err != nil {
+				var httpErr *echo.HTTPError
+				if errors.As(err, &httpErr) && httpErr.Code == http.StatusForbidden {
+					closeConn(conn, CloseCodeForbidden, closeReasonForbidden)
+				} else {
+					closeConn(conn, websocket.CloseInternalServerErr, "")
+				}
+				return nil
+			}
+		}
+
+		log.Printf("[WebSocket] アップグレード成功: userID=%s workspaceIDs=%v", claims.UserID, workspaceIDs)
 
 		// クライアントを作成してハブに登録
-		client := &Client{
-			hub:                hub,
-			conn:               conn,
-			send:               make(chan []byte, 256),
-			userID:             claims.UserID,
-			workspaceID:        workspaceID,
-			subscribedChannels: make(map[string]bool),
-			messageUseCase:     messageUseCase,
-			readStateUseCase:   readStateUseCase,
+		client := newClient(hub, claims.UserID, workspaceID, workspaceIDs, protocolVersion, jwtService, sessionRepo, channelAccess)
+		client.conn = conn
+		client.messageUseCase = messageUseCase
+		client.reactionUseCase = reactionUseCase
+		client.readStateUseCase = readStateUseCase
+		client.setAuth(claims)
+
+		client.hub.register(client)
+
+		// 再接続の場合は欠落したイベントを再送
+		// ?since=<seq>&epoch=<epoch>&channel_ids=<id,id,...>
+		// 複数Workspaceの接続ではシーケンス番号がWorkspaceごとのため、resumeイベントで要求する
+		if sinceParam := c.QueryParam("since"); sinceParam != "" && !client.multiWorkspace {
+			since, err := strconv.ParseUint(sinceParam, 10, 64)
+			if err != nil {
+				client.sendError("INVALID_PARAM", "sinceパラメータが不正です")
+			} else {
+				var channelIDs []string
+				if ids := c.QueryParam("channel_ids"); ids != "" {
+					channelIDs = strings.Split(ids, ",")
+				}
+				client.resume(workspaceID, since, c.QueryParam("epoch"), channelIDs)
+			}
 		}
 
-		client.hub.register <- client
-
 		// ゴルーチンを開始
 		go client.writePump()
 		go client.readPump()
+		go client.watchAuth()
 
 		return nil
 	}
 }
+
+// newClient はハブに登録する前のクライアントを作成します
+// workspaceIDが空文字の場合はworkspaceIDsの全Workspaceを購読する接続になります
+func newClient(hub *Hub, userID string, workspaceID string, workspaceIDs []string, protocolVersion int, jwtService authuc.JWTService, sessionRepo repository.SessionRepository, channelAccess service.ChannelAccessService) *Client {
+	workspaces := make(map[string]bool, len(workspaceIDs))
+	for _, id := range workspaceIDs {
+		workspaces[id] = true
+	}
+	return &Client{
+		hub:                hub,
+		queue:              newSendQueue(hub.backpressure.QueueSize),
+		userID:             userID,
+		workspaceID:        workspaceID,
+		multiWorkspace:     workspaceID == "",
+		workspaces:         workspaces,
+		protocolVersion:    protocolVersion,
+		subscribedChannels: make(map[string]string),
+		subscribedThreads:  make(map[threadKey]string),
+		sidebarChannels:    make(map[string]string),
+		typingAcceptedAt:   make(map[typingScope]time.Time),
+		channelAccess:      channelAccess,
+		jwtService:         jwtService,
+		sessionRepo:        sessionRepo,
+		authRenewed:        make(chan struct{}, 1),
+	}
+}
+
+// resolveWorkspaces は接続を登録するWorkspaceのID一覧を返します
+// workspaceIDを省略した場合は参加している全Workspaceを返します
+func resolveWorkspaces(ctx context.Context, workspaceRepo repository.WorkspaceRepository, userID string, workspaceID string) ([]string, error) {
+	if workspaceID == "" {
+		workspaces, err := workspaceRepo.FindByUserID(ctx, userID)
+		if err != nil {
+			log.Printf("[WebSocket] FindByUserID error: userID=%s err=%v", userID, err)
+			return nil, echo.NewHTTPError(http.StatusInternalServerError, "ワークスペースの取得に失敗しました")
+		}
+		workspaceIDs := make([]string, 0, len(workspaces))
+		for _, w := range workspaces {
+			workspaceIDs = append(workspaceIDs, w.ID)
+		}
+		return workspaceIDs, nil
+	}
+
+	// Workspace所属確認
+	member, err := workspaceRepo.FindMember(ctx, workspaceID, userID)
+	if err != nil {
+		log.Printf("[WebSocket] FindMember error: userID=%s workspaceID=%s err=%v", userID, workspaceID, err)
+		return nil, echo.NewHTTPError(http.StatusForbidden, "ユーザーはこのワークスペースのメンバーではありません")
+	}
+	if member == nil {
+		log.Printf("[WebSocket] Member not found: userID=%s workspaceID=%s", userID, workspaceID)
+		return nil, echo.NewHTTPError(http.StatusForbidden, "ユーザーはこのワークスペースのメンバーではありません")
+	}
+	return []string{workspaceID}, nil
+}
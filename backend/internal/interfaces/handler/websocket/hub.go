@@ -4,33 +4,49 @@ import (
 	"encoding/json"
 	"fmt"
 	"log"
+	"sync"
 	"time"
 
+	"github.com/google/uuid"
 	"github.com/gorilla/websocket"
+
+	"github.com/newt239/chat/internal/domain/entity"
+	"github.com/newt239/chat/internal/domain/repository"
+	"github.com/newt239/chat/internal/domain/service"
+	authuc "github.com/newt239/chat/internal/usecase/auth"
 )
 
+// eventLogPruneInterval はイベントログから期限切れのイベントを削除する間隔です
+const eventLogPruneInterval = time.Minute
+
 // Hub はWebSocket接続を管理します
+// 接続・購読・イベントログはWorkspaceごとのシャードが管理し、Workspace間の配信は並行して行われます
 type Hub struct {
-	// Workspace単位でクライアントを管理
-	// workspaceID -> userID -> []*Client (同一ユーザーの複数接続をサポート)
-	workspaces map[string]map[string][]*Client
+	// Workspace単位のシャード
+	// workspaceID -> *workspaceShard
+	shards map[string]*workspaceShard
+
+	// ユーザーごとの接続（Workspaceへの参加・削除を接続に反映するために使用）
+	// userID -> []*Client
+	users map[string][]*Client
+	mu    sync.RWMutex
 
-	// チャンネルごとの購読者を管理
-	// workspaceID -> channelID -> userID -> bool
-	channelSubscribers map[string]map[string]map[string]bool
+	// インスタンス間でブロードキャストを中継するブローカー
+	broker Broker
 
-	// クライアントからの登録要求
-	register chan *Client
+	// このハブのシーケンス番号の系列を識別するID（起動ごとに変わる）
+	epoch string
 
-	// クライアントからの登録解除要求
-	unregister chan *Client
+	// ユーザーのプレゼンス状態
+	presence *PresenceTracker
 
-	// ブロードキャスト用のチャンネル
-	broadcast chan *BroadcastMessage
+	// 送信キューが溢れた場合の方針と統計
+	backpressure BackpressureConfig
+	stats        *backpressureCounters
 
-	// チャンネル購読管理用チャンネル
-	subscribe   chan *SubscribeRequest
-	unsubscribe chan *UnsubscribeRequest
+	// サイドバーのチャンネル一覧と未読数の取得先（muで保護）
+	sidebarChannels SidebarChannelFinder
+	unreadCounter   UnreadCounter
 }
 
 // SubscribeRequest はチャンネル購読リクエストを表します
@@ -47,12 +63,41 @@ type UnsubscribeRequest struct {
 	UserID      string
 }
 
+// ResumeRequest は再接続したクライアントへのイベント再送要求を表します
+type ResumeRequest struct {
+	Client *Client
+	Since  uint64
+	Epoch  string
+}
+
 // BroadcastMessage はブロードキャストメッセージを表します
 type BroadcastMessage struct {
 	WorkspaceID string
 	ChannelID   *string // nilの場合はWorkspace全体にブロードキャスト
+	ThreadID    *string // 設定されている場合はChannelIDのスレッドの購読者にのみ送信
+	UserID      *string // 特定ユーザーのみに送信する場合
 	ExcludeUser *string // 特定ユーザーを除外する場合
 	Data        []byte
+	Seq         uint64 // Hubが配信時に採番するシーケンス番号
+
+	// CoalesceKeyが設定されたメッセージは、同じキーの未送信メッセージを置き換えます
+	// 最新の状態だけが意味を持つイベント（typing_users/unread_count）に使用し、シーケンス番号の採番・再送の対象外とします
+	CoalesceKey string
+
+	// チャンネル購読の取り消し（設定されている場合は取り消されたユーザーにのみDataを送信）
+	Revocation *SubscriptionRevocation
+
+	// Workspaceへの参加・削除（設定されている場合はUserIDの接続にのみ反映します）
+	Membership *WorkspaceMembershipChange
+
+	// 入力状態の変化（設定されている場合はシャードが入力中のユーザー一覧を更新して配信します）
+	Typing *TypingChange
+
+	// サイドバーの購読の変化（設定されている場合はUserIDsの接続にのみ反映します）
+	Sidebar *SidebarChange
+
+	// チャンネルへの新しいメッセージの投稿（設定されている場合はサイドバーの購読者にchannel_activityを送信します）
+	Activity *ChannelActivity
 }
 
 // Client はWebSocket接続を表します
@@ -60,244 +105,331 @@ type Client struct {
 	// WebSocketハブ
 	hub *Hub
 
-	// WebSocket接続
+	// WebSocket接続（Server-Sent Eventsの接続ではnil）
 	conn *websocket.Conn
 
-	// 送信用のバッファードチャンネル
-	send chan []byte
+	// 上限付きの送信キュー
+	queue *sendQueue
 
 	// ユーザーID
 	userID string
 
-	// ワークスペースID
+	// ワークスペースID（複数Workspaceを購読する接続では空文字）
 	workspaceID string
 
-	// 購読中のチャンネルID一覧
-	subscribedChannels map[string]bool
+	// 複数Workspaceを購読する接続か
+	multiWorkspace bool
+
+	// 接続時にネゴシエーションしたプロトコルバージョン
+	protocolVersion int
+
+	// 登録されているWorkspaceのID一覧（muで保護）
+	workspaces map[string]bool
+
+	// 購読中のチャンネルID -> チャンネルが属するWorkspaceのID
+	// 購読の取り消しはシャードのゴルーチンから行われるためmuで保護します
+	subscribedChannels map[string]string
+	mu                 sync.Mutex
+
+	// 購読中のスレッド -> スレッドが属するWorkspaceのID（muで保護）
+	subscribedThreads map[threadKey]string
+
+	// サイドバーに表示するチャンネルID -> チャンネルが属するWorkspaceのID（muで保護）
+	// 購読していないチャンネルにもchannel_activityイベントを送信します
+	sidebarChannels map[string]string
+
+	// 送信が追いつかずイベントを破棄したことがあるか（muで保護）
+	lagging bool
+
+	// typingイベントを最後に受け付けた時刻（muで保護）
+	typingAcceptedAt map[typingScope]time.Time
+
+	// 認証情報（muで保護）
+	// セッションが取り消された場合やトークンの有効期限が切れた場合は接続を閉じます
+	sessionID      string
+	tokenExpiresAt time.Time
+
+	// reauthで認証情報が更新されたことをwatchAuthに通知します
+	authRenewed chan struct{}
+
+	// トークンの検証とセッションの確認
+	jwtService  authuc.JWTService
+	sessionRepo repository.SessionRepository
+
+	// チャンネルのアクセス権確認
+	channelAccess service.ChannelAccessService
 
 	// ユースケース
 	messageUseCase   MessageUseCase
+	reactionUseCase  ReactionUseCase
 	readStateUseCase ReadStateUseCase
 }
 
 // NewHub は新しいHubを作成します
+// デフォルトでは単一プロセス内でのみ配信するローカルブローカーを使用します
 func NewHub() *Hub {
-	return &Hub{
-		workspaces:         make(map[string]map[string][]*Client),
-		channelSubscribers: make(map[string]map[string]map[string]bool),
-		register:           make(chan *Client),
-		unregister:         make(chan *Client),
-		broadcast:          make(chan *BroadcastMessage, 256),
-		subscribe:          make(chan *SubscribeRequest),
-		unsubscribe:        make(chan *UnsubscribeRequest),
+	h := &Hub{
+		shards: make(map[string]*workspaceShard),
+		users:  make(map[string][]*Client),
+		epoch:  uuid.NewString(),
+		backpressure: BackpressureConfig{
+			Policy:    SlowConsumerDisconnect,
+			QueueSize: defaultSendQueueSize,
+		},
+		stats: newBackpressureCounters(),
+	}
+	h.presence = NewPresenceTracker(h.broadcastPresence)
+	// ローカルブローカーの購読は失敗しない
+	_ = h.SetBroker(NewLocalBroker())
+	return h
+}
+
+// Presence はプレゼンス状態を管理するトラッカーを返します
+func (h *Hub) Presence() *PresenceTracker {
+	return h.presence
+}
+
+// broadcastPresence はプレゼンス状態の変化をWorkspace全体に通知します
+func (h *Hub) broadcastPresence(workspaceID, userID string, status entity.PresenceStatus, at time.Time) {
+	data, err := SendServerMessage(EventTypePresenceChanged, PresenceChangedPayload{
+		UserID: userID,
+		Status: string(status),
+		At:     at,
+	})
+	if err != nil {
+		log.Printf("[WebSocket] presence_changedイベントのエンコードに失敗しました: %v", err)
+		return
 	}
+	h.BroadcastToWorkspace(workspaceID, data)
 }
 
-// Run はハブを開始します
-func (h *Hub) Run() {
-	for {
-		select {
-		case client := <-h.register:
-			// Workspaceが存在しない場合は作成
-			if h.workspaces[client.workspaceID] == nil {
-				h.workspaces[client.workspaceID] = make(map[string][]*Client)
-			}
-			// ユーザーのクライアントリストに追加（複数接続をサポート）
-			h.workspaces[client.workspaceID][client.userID] = append(
-				h.workspaces[client.workspaceID][client.userID],
-				client,
-			)
-			log.Printf("[WebSocket] クライアント登録: user=%s workspace=%s 接続数=%d",
-				client.userID, client.workspaceID, len(h.workspaces[client.workspaceID][client.userID]))
-
-		case client := <-h.unregister:
-			if workspace, ok := h.workspaces[client.workspaceID]; ok {
-				if clients, ok := workspace[client.userID]; ok {
-					// クライアントリストから削除
-					for i, c := range clients {
-						if c == client {
-							workspace[client.userID] = append(clients[:i], clients[i+1:]...)
-							close(client.send)
-							break
-						}
-					}
-					// クライアントがいなくなったらユーザーを削除
-					if len(workspace[client.userID]) == 0 {
-						delete(workspace, client.userID)
-						// このユーザーが購読していた全チャンネルから削除
-						h.removeUserFromAllChannels(client.workspaceID, client.userID)
-					}
-					// Workspaceにユーザーがいなくなったら削除
-					if len(workspace) == 0 {
-						delete(h.workspaces, client.workspaceID)
-					}
-					log.Printf("[WebSocket] クライアント登録解除: user=%s workspace=%s 残接続数=%d",
-						client.userID, client.workspaceID, len(workspace[client.userID]))
-				}
-			}
+// SetBackpressure は送信キューの上限と溢れた場合の方針を設定します
+// Runを開始する前に呼び出してください
+func (h *Hub) SetBackpressure(cfg BackpressureConfig) {
+	if cfg.QueueSize <= 0 {
+		cfg.QueueSize = defaultSendQueueSize
+	}
+	h.backpressure = cfg
+}
 
-		case req := <-h.subscribe:
-			// チャンネル購読者リストに追加
-			if h.channelSubscribers[req.WorkspaceID] == nil {
-				h.channelSubscribers[req.WorkspaceID] = make(map[string]map[string]bool)
-			}
-			if h.channelSubscribers[req.WorkspaceID][req.ChannelID] == nil {
-				h.channelSubscribers[req.WorkspaceID][req.ChannelID] = make(map[string]bool)
-			}
-			h.channelSubscribers[req.WorkspaceID][req.ChannelID][req.UserID] = true
-			log.Printf("[WebSocket] チャンネル購読者登録: user=%s workspace=%s channel=%s",
-				req.UserID, req.WorkspaceID, req.ChannelID)
-
-		case req := <-h.unsubscribe:
-			// チャンネル購読者リストから削除
-			if wsChannels, ok := h.channelSubscribers[req.WorkspaceID]; ok {
-				if subscribers, ok := wsChannels[req.ChannelID]; ok {
-					delete(subscribers, req.UserID)
-					if len(subscribers) == 0 {
-						delete(wsChannels, req.ChannelID)
-					}
-					log.Printf("[WebSocket] チャンネル購読者解除: user=%s workspace=%s channel=%s",
-						req.UserID, req.WorkspaceID, req.ChannelID)
-				}
-			}
+// BackpressureStats はWorkspaceごとの破棄・集約・切断の件数を返します
+func (h *Hub) BackpressureStats() map[string]BackpressureStats {
+	return h.stats.snapshot()
+}
 
-		case msg := <-h.broadcast:
-			if workspace, ok := h.workspaces[msg.WorkspaceID]; ok {
-				for userID, clients := range workspace {
-					// ExcludeUserが設定されている場合はスキップ
-					if msg.ExcludeUser != nil && userID == *msg.ExcludeUser {
-						continue
-					}
-
-					// ChannelIDが指定されている場合は購読チェック
-					if msg.ChannelID != nil {
-						// そのチャンネルを購読しているかチェック
-						if !h.isUserSubscribedToChannel(msg.WorkspaceID, *msg.ChannelID, userID) {
-							continue
-						}
-					}
-
-					for _, client := range clients {
-						select {
-						case client.send <- msg.Data:
-						default:
-							close(client.send)
-						}
-					}
-				}
-			}
-		}
+// SetBroker はインスタンス間の配信に使用するブローカーを設定します
+// Runを開始する前に呼び出してください
+func (h *Hub) SetBroker(broker Broker) error {
+	if err := broker.Subscribe(h.enqueue); err != nil {
+		return fmt.Errorf("failed to subscribe broker: %w", err)
 	}
+	h.broker = broker
+	return nil
 }
 
-// removeUserFromAllChannels はユーザーが購読している全チャンネルから削除します
-func (h *Hub) removeUserFromAllChannels(workspaceID, userID string) {
-	if wsChannels, ok := h.channelSubscribers[workspaceID]; ok {
-		for channelID, subscribers := range wsChannels {
-			delete(subscribers, userID)
-			if len(subscribers) == 0 {
-				delete(wsChannels, channelID)
-			}
+// Close はブローカーとの接続を終了します
+func (h *Hub) Close() error {
+	return h.broker.Close()
+}
+
+// shard はWorkspaceのシャードを返します（存在しない場合は作成）
+// シャードはイベントログを保持するため、接続中のクライアントがいなくなっても削除しません
+func (h *Hub) shard(workspaceID string) *workspaceShard {
+	h.mu.RLock()
+	s, ok := h.shards[workspaceID]
+	h.mu.RUnlock()
+	if ok {
+		return s
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if s, ok := h.shards[workspaceID]; ok {
+		return s
+	}
+	s = newWorkspaceShard(h, workspaceID)
+	h.shards[workspaceID] = s
+	return s
+}
+
+// allShards は現在の全シャードを返します
+func (h *Hub) allShards() []*workspaceShard {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	shards := make([]*workspaceShard, 0, len(h.shards))
+	for _, s := range h.shards {
+		shards = append(shards, s)
+	}
+	return shards
+}
+
+// register はクライアントを参加している全Workspaceのシャードに登録し、プレゼンスに接続を通知します
+func (h *Hub) register(client *Client) {
+	h.mu.Lock()
+	h.users[client.userID] = append(h.users[client.userID], client)
+	h.mu.Unlock()
+
+	for _, workspaceID := range client.workspaceList() {
+		s := h.shard(workspaceID)
+		s.do(func() { s.register(client) })
+		h.presence.Connect(workspaceID, client.userID)
+		go h.loadSidebar(client, workspaceID)
+	}
+}
+
+// unregister はクライアントの送信キューを閉じ、全Workspaceのシャードから登録を解除します
+func (h *Hub) unregister(client *Client) {
+	h.mu.Lock()
+	clients := h.users[client.userID]
+	for i, c := range clients {
+		if c == client {
+			h.users[client.userID] = append(clients[:i], clients[i+1:]...)
+			break
 		}
 	}
+	if len(h.users[client.userID]) == 0 {
+		delete(h.users, client.userID)
+	}
+	h.mu.Unlock()
+
+	// 送信が追いつかず既に閉じている場合は何もしない
+	workspaceIDs := client.detachWorkspaces()
+	client.queue.close(0, "")
+
+	for _, workspaceID := range workspaceIDs {
+		s := h.shard(workspaceID)
+		s.do(func() { s.unregister(client) })
+		h.presence.Disconnect(workspaceID, client.userID)
+		h.clearTyping(workspaceID, client.userID)
+	}
+}
+
+// userClients はユーザーの全接続を返します
+func (h *Hub) userClients(userID string) []*Client {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	return append([]*Client(nil), h.users[userID]...)
+}
+
+// subscribe はチャンネル購読者リストにユーザーを追加します
+func (h *Hub) subscribe(req *SubscribeRequest) {
+	s := h.shard(req.WorkspaceID)
+	s.do(func() { s.subscribe(req.ChannelID, req.UserID) })
+}
+
+// unsubscribe はチャンネル購読者リストからユーザーを削除します
+func (h *Hub) unsubscribe(req *UnsubscribeRequest) {
+	s := h.shard(req.WorkspaceID)
+	s.do(func() { s.unsubscribe(req.ChannelID, req.UserID) })
+}
+
+// requestResume は再接続したクライアントへのイベント再送を要求します
+// 同じシャードで処理するため、先に送信した購読要求が反映された状態で再送されます
+func (h *Hub) requestResume(workspaceID string, req *ResumeRequest) {
+	s := h.shard(workspaceID)
+	s.do(func() { s.replay(req.Client, req.Since, req.Epoch) })
+}
+
+// enqueue はブローカーから受け取ったメッセージをWorkspaceのシャードに渡します
+// Workspaceへの参加・削除はユーザーの接続に直接反映します
+func (h *Hub) enqueue(msg *BroadcastMessage) {
+	if msg.Membership != nil {
+		h.applyMembership(msg)
+		return
+	}
+	s := h.shard(msg.WorkspaceID)
+	s.do(func() { s.handleBroadcast(msg) })
+}
+
+// publish はブローカー経由で全インスタンスにメッセージを送信します
+// ブローカーへの送信に失敗した場合は、少なくとも自インスタンスのクライアントには配信します
+func (h *Hub) publish(msg *BroadcastMessage) {
+	if err := h.broker.Publish(msg); err != nil {
+		log.Printf("[WebSocket] ブローカーへの送信に失敗したためローカル配信のみ行います: workspace=%s err=%v",
+			msg.WorkspaceID, err)
+		h.enqueue(msg)
+	}
 }
 
-// isUserSubscribedToChannel はユーザーが指定されたチャンネルを購読しているかチェックします
-func (h *Hub) isUserSubscribedToChannel(workspaceID, channelID, userID string) bool {
-	if wsChannels, ok := h.channelSubscribers[workspaceID]; ok {
-		if subscribers, ok := wsChannels[channelID]; ok {
-			return subscribers[userID]
+// Run はハブを開始します
+// 配信は各シャードのゴルーチンが行うため、ここでは期限切れのイベントの削除のみを行います
+func (h *Hub) Run() {
+	pruneTicker := time.NewTicker(eventLogPruneInterval)
+	defer pruneTicker.Stop()
+
+	for now := range pruneTicker.C {
+		for _, s := range h.allShards() {
+			s.do(func() { s.eventLog.prune(now) })
 		}
 	}
-	return false
 }
 
 // BroadcastToWorkspace はWorkspace内の全クライアントにメッセージを送信します
 func (h *Hub) BroadcastToWorkspace(workspaceID string, message []byte) {
-	h.broadcast <- &BroadcastMessage{
+	h.publish(&BroadcastMessage{
 		WorkspaceID: workspaceID,
 		Data:        message,
-	}
+	})
 	log.Printf("[WebSocket] Workspaceブロードキャスト: workspace=%s サイズ=%d bytes", workspaceID, len(message))
 }
 
 // BroadcastToChannel はChannel内の全クライアントにメッセージを送信します
 func (h *Hub) BroadcastToChannel(workspaceID string, channelID string, message []byte) {
-	h.broadcast <- &BroadcastMessage{
+	h.publish(&BroadcastMessage{
 		WorkspaceID: workspaceID,
 		ChannelID:   &channelID,
 		Data:        message,
-	}
+	})
 	log.Printf("[WebSocket] Channelブロードキャスト: workspace=%s channel=%s サイズ=%d bytes",
 		workspaceID, channelID, len(message))
 }
 
 // BroadcastToUser は特定のユーザーにメッセージを送信します
 func (h *Hub) BroadcastToUser(workspaceID string, userID string, message []byte) {
-	if workspace, ok := h.workspaces[workspaceID]; ok {
-		if clients, ok := workspace[userID]; ok {
-			for _, client := range clients {
-				select {
-				case client.send <- message:
-				default:
-					close(client.send)
-				}
-			}
-			log.Printf("[WebSocket] ユーザー宛送信: workspace=%s user=%s 接続数=%d サイズ=%d bytes",
-				workspaceID, userID, len(clients), len(message))
-		}
-	}
+	h.publish(&BroadcastMessage{
+		WorkspaceID: workspaceID,
+		UserID:      &userID,
+		Data:        message,
+	})
+	log.Printf("[WebSocket] ユーザー宛送信: workspace=%s user=%s サイズ=%d bytes",
+		workspaceID, userID, len(message))
+}
+
+// BroadcastToUserCoalesced は特定のユーザーに最新の状態だけが意味を持つメッセージを送信します
+// 同じcoalesceKeyの未送信メッセージは置き換えられ、シーケンス番号は付与されません
+func (h *Hub) BroadcastToUserCoalesced(workspaceID string, userID string, coalesceKey string, message []byte) {
+	h.publish(&BroadcastMessage{
+		WorkspaceID: workspaceID,
+		UserID:      &userID,
+		Data:        message,
+		CoalesceKey: coalesceKey,
+	})
 }
 
 // BroadcastToChannelSubscribers はチャンネルを購読している全ユーザーにメッセージを送信します
 // メッセージイベント(新着/編集/削除)の配信に使用します
 func (h *Hub) BroadcastToChannelSubscribers(workspaceID string, channelID string, message []byte) {
-	// チャンネルの購読者を取得
-	var subscribers []string
-	if wsChannels, ok := h.channelSubscribers[workspaceID]; ok {
-		if subs, ok := wsChannels[channelID]; ok {
-			subscribers = make([]string, 0, len(subs))
-			for userID := range subs {
-				subscribers = append(subscribers, userID)
-			}
-		}
-	}
-
-	if len(subscribers) == 0 {
-		log.Printf("[WebSocket] 購読者なし: workspace=%s channel=%s", workspaceID, channelID)
-		return
-	}
-
-	// 購読者全員にメッセージを送信
-	if workspace, ok := h.workspaces[workspaceID]; ok {
-		sentCount := 0
-		for _, userID := range subscribers {
-			if clients, ok := workspace[userID]; ok {
-				for _, client := range clients {
-					select {
-					case client.send <- message:
-						sentCount++
-					default:
-						close(client.send)
-					}
-				}
-			}
-		}
-		log.Printf("[WebSocket] 購読者向けブロードキャスト: workspace=%s channel=%s 購読者数=%d 送信数=%d サイズ=%d bytes",
-			workspaceID, channelID, len(subscribers), sentCount, len(message))
-	}
+	h.publish(&BroadcastMessage{
+		WorkspaceID: workspaceID,
+		ChannelID:   &channelID,
+		Data:        message,
+	})
+	log.Printf("[WebSocket] 購読者向けブロードキャスト: workspace=%s channel=%s サイズ=%d bytes",
+		workspaceID, channelID, len(message))
 }
 
 // GetConnectedUsers は指定されたWorkspace内の接続中のユーザーIDリストを返します
 func (h *Hub) GetConnectedUsers(workspaceID string) []string {
-	if workspace, ok := h.workspaces[workspaceID]; ok {
-		users := make([]string, 0, len(workspace))
-		for userID := range workspace {
-			users = append(users, userID)
-		}
-		return users
+	h.mu.RLock()
+	s, ok := h.shards[workspaceID]
+	h.mu.RUnlock()
+	if !ok {
+		return []string{}
 	}
-	return []string{}
+
+	result := make(chan []string, 1)
+	s.do(func() { result <- s.connectedUsers() })
+	return <-result
 }
 
 const (
@@ -310,14 +442,14 @@ const (
 	// pingを送信する間隔（pongWaitより短くする必要がある）
 	pingPeriod = (pongWait * 9) / 10
 
-	// メッセージの最大サイズ
-	maxMessageSize = 512
+	// メッセージの最大サイズ（post_message/edit_messageの本文を含む）
+	maxMessageSize = 64 * 1024
 )
 
 // readPump はWebSocketからのメッセージを読み取ります
 func (c *Client) readPump() {
 	defer func() {
-		c.hub.unregister <- c
+		c.hub.unregister(c)
 		if err := c.conn.Close(); err != nil {
 			_ = err // WebSocket接続のクローズエラーは無視
 		}
@@ -366,18 +498,41 @@ func (c *Client) handleMessage(data []byte) {
 	log.Printf("[WebSocket] イベント処理開始: type=%s user=%s workspace=%s",
 		msg.Type, c.userID, c.workspaceID)
 
+	// クライアントからのイベントはすべて操作として扱う
+	for _, workspaceID := range c.workspaceList() {
+		c.hub.presence.Touch(workspaceID, c.userID)
+	}
+
 	// イベントタイプに応じた処理
 	switch msg.Type {
 	case EventTypeJoinChannel:
 		c.handleJoinChannel(msg.Payload)
 	case EventTypeLeaveChannel:
 		c.handleLeaveChannel(msg.Payload)
+	case EventTypeJoinThread:
+		c.handleJoinThread(msg.Payload)
+	case EventTypeLeaveThread:
+		c.handleLeaveThread(msg.Payload)
 	case EventTypePostMessage:
 		c.handlePostMessage(msg.Payload)
+	case EventTypeEditMessage:
+		c.handleEditMessage(msg.Payload)
+	case EventTypeDeleteMessage:
+		c.handleDeleteMessage(msg.Payload)
+	case EventTypeAddReaction:
+		c.handleAddReaction(msg.Payload)
 	case EventTypeTyping:
 		c.handleTyping(msg.Payload)
 	case EventTypeUpdateReadState:
 		c.handleUpdateReadState(msg.Payload)
+	case EventTypeResume:
+		c.handleResume(msg.Payload)
+	case EventTypeReauth:
+		c.handleReauth(msg.Payload)
+	case EventTypeAuth:
+		c.sendAckError(EventTypeAuth, ErrorCodeValidation, "既に認証されています。トークンの更新にはreauthを使用してください")
+	case EventTypeActivity:
+		// プレゼンスの更新のみ（上記Touchで処理済み）
 	default:
 		log.Printf("[WebSocket] 未知のイベントタイプ: type=%s user=%s", msg.Type, c.userID)
 		c.sendError("UNKNOWN_EVENT", fmt.Sprintf("未知のイベントタイプです: %s", msg.Type))
@@ -394,18 +549,25 @@ func (c *Client) handleJoinChannel(payload json.RawMessage) {
 		return
 	}
 
+	// プライベートチャンネルやDMはメンバーのみ購読できる
+	workspaceID, denial := c.authorizeChannel(joinPayload.ChannelID)
+	if denial != nil {
+		c.sendAckError(EventTypeJoinChannel, denial.code, denial.message)
+		return
+	}
+
 	// 購読チャンネルリストに追加
-	c.subscribedChannels[joinPayload.ChannelID] = true
+	count := c.addSubscription(joinPayload.ChannelID, workspaceID)
 
 	// Hubに購読情報を通知
-	c.hub.subscribe <- &SubscribeRequest{
-		WorkspaceID: c.workspaceID,
+	c.hub.subscribe(&SubscribeRequest{
+		WorkspaceID: workspaceID,
 		ChannelID:   joinPayload.ChannelID,
 		UserID:      c.userID,
-	}
+	})
 
 	log.Printf("[WebSocket] チャンネル購読追加: user=%s workspace=%s channel=%s 購読数=%d",
-		c.userID, c.workspaceID, joinPayload.ChannelID, len(c.subscribedChannels))
+		c.userID, workspaceID, joinPayload.ChannelID, count)
 
 	c.sendAck(EventTypeJoinChannel, true, "")
 }
@@ -420,43 +582,28 @@ func (c *Client) handleLeaveChannel(payload json.RawMessage) {
 	}
 
 	// 購読チャンネルリストから削除
-	delete(c.subscribedChannels, leavePayload.ChannelID)
+	workspaceID, count := c.removeSubscription(leavePayload.ChannelID)
+	if workspaceID == "" {
+		workspaceID = c.workspaceID
+	}
 
-	// Hubに購読解除情報を通知
-	c.hub.unsubscribe <- &UnsubscribeRequest{
-		WorkspaceID: c.workspaceID,
-		ChannelID:   leavePayload.ChannelID,
-		UserID:      c.userID,
+	// Hubに購読解除情報を通知（複数Workspaceの接続で未購読のチャンネルは通知不要）
+	if workspaceID != "" {
+		c.hub.unsubscribe(&UnsubscribeRequest{
+			WorkspaceID: workspaceID,
+			ChannelID:   leavePayload.ChannelID,
+			UserID:      c.userID,
+		})
 	}
 
 	log.Printf("[WebSocket] チャンネル購読解除: user=%s workspace=%s channel=%s 購読数=%d",
-		c.userID, c.workspaceID, leavePayload.ChannelID, len(c.subscribedChannels))
+		c.userID, workspaceID, leavePayload.ChannelID, count)
 
 	c.sendAck(EventTypeLeaveChannel, true, "")
 }
 
-// handlePostMessage はpost_messageイベントを処理します
-func (c *Client) handlePostMessage(payload json.RawMessage) {
-	var postPayload PostMessagePayload
-	if err := json.Unmarshal(payload, &postPayload); err != nil {
-		log.Printf("post_messageペイロードの解析に失敗しました: %v", err)
-		c.sendError("INVALID_PAYLOAD", "無効なペイロードです")
-		return
-	}
-
-	log.Printf("ユーザー%sがチャンネル%sへメッセージを投稿しました", c.userID, postPayload.ChannelID)
-
-	// メッセージ投稿処理（UseCase層との連携）
-	// 実際のメッセージ投稿はHTTP APIで行い、ここではWebSocket通知のみ処理
-	// メッセージ投稿後の通知は、HTTP API側でWebSocket通知を送信する
-
-	// 入力中状態を停止
-	c.stopTyping(postPayload.ChannelID)
-
-	c.sendAck(EventTypePostMessage, true, "")
-}
-
 // handleTyping はtypingイベントを処理します
+// 同じチャンネル・スレッドへの短い間隔での繰り返しは、アクセス権を確認せずに破棄します
 func (c *Client) handleTyping(payload json.RawMessage) {
 	var typingPayload TypingPayload
 	if err := json.Unmarshal(payload, &typingPayload); err != nil {
@@ -465,29 +612,90 @@ func (c *Client) handleTyping(payload json.RawMessage) {
 		return
 	}
 
-	log.Printf("ユーザー%sがチャンネル%sで入力中です", c.userID, typingPayload.ChannelID)
+	scope := typingScope{channelID: typingPayload.ChannelID}
+	if typingPayload.ThreadID != nil {
+		if _, err := uuid.Parse(*typingPayload.ThreadID); err != nil {
+			c.sendAckError(EventTypeTyping, "INVALID_PAYLOAD", "スレッドIDが不正です")
+			return
+		}
+		scope.threadID = *typingPayload.ThreadID
+	}
+	typing := typingPayload.Typing == nil || *typingPayload.Typing
+	if c.throttleTyping(scope, typing, time.Now()) {
+		return
+	}
+
+	// アクセスできないチャンネルには入力中通知を配信しない
+	workspaceID, denial := c.authorizeChannel(typingPayload.ChannelID)
+	if denial != nil {
+		c.sendAckError(EventTypeTyping, denial.code, denial.message)
+		return
+	}
 
-	// 入力中状態の通知処理
-	c.startTyping(typingPayload.ChannelID)
+	c.hub.PublishTyping(workspaceID, TypingChange{
+		UserID:    c.userID,
+		ChannelID: scope.channelID,
+		ThreadID:  scope.threadID,
+		Typing:    typing,
+	})
 }
 
-// handleUpdateReadState はupdate_read_stateイベントを処理します
-func (c *Client) handleUpdateReadState(payload json.RawMessage) {
-	var readStatePayload UpdateReadStatePayload
-	if err := json.Unmarshal(payload, &readStatePayload); err != nil {
-		log.Printf("update_read_stateペイロードの解析に失敗しました: %v", err)
+// handleResume はresumeイベントを処理します
+func (c *Client) handleResume(payload json.RawMessage) {
+	var resumePayload ResumePayload
+	if err := json.Unmarshal(payload, &resumePayload); err != nil {
+		log.Printf("resumeペイロードの解析に失敗しました: %v", err)
 		c.sendError("INVALID_PAYLOAD", "無効なペイロードです")
 		return
 	}
 
-	log.Printf("ユーザー%sがチャンネル%sのメッセージ%sを既読更新しました",
-		c.userID, readStatePayload.ChannelID, readStatePayload.MessageID)
+	workspaceID := resumePayload.WorkspaceID
+	if workspaceID == "" {
+		workspaceID = c.workspaceID
+	}
+	// シーケンス番号はWorkspace単位のため、複数Workspaceの接続ではWorkspaceの指定が必要
+	if workspaceID == "" || !c.inWorkspace(workspaceID) {
+		c.sendAckError(EventTypeResume, ErrorCodeValidation, "再送するWorkspaceを指定してください")
+		return
+	}
 
-	// 既読状態更新処理（UseCase層との連携）
-	// 実際の既読状態更新はHTTP APIで行い、ここではWebSocket通知のみ処理
-	// 既読状態更新後の通知は、HTTP API側でWebSocket通知を送信する
+	c.resume(workspaceID, resumePayload.Since, resumePayload.Epoch, resumePayload.ChannelIDs)
+}
 
-	c.sendAck(EventTypeUpdateReadState, true, "")
+// resume は指定したチャンネルを購読したうえで、欠落したイベントの再送をHubに要求します
+// アクセスできないチャンネルや他のWorkspaceのチャンネルは購読せず、そのチャンネルのイベントは再送しません
+func (c *Client) resume(workspaceID string, since uint64, epoch string, channelIDs []string) {
+	c.joinChannels(workspaceID, channelIDs, EventTypeResume)
+
+	c.hub.requestResume(workspaceID, &ResumeRequest{
+		Client: c,
+		Since:  since,
+		Epoch:  epoch,
+	})
+}
+
+// joinChannels はWorkspaceのチャンネルをまとめて購読します
+// アクセスできないチャンネルや他のWorkspaceのチャンネルは購読せず、eventTypeの失敗を表すACKを送信します
+func (c *Client) joinChannels(workspaceID string, channelIDs []string, eventType EventType) {
+	for _, channelID := range channelIDs {
+		if channelID == "" {
+			continue
+		}
+		channelWorkspaceID, denial := c.authorizeChannel(channelID)
+		if denial == nil && channelWorkspaceID != workspaceID {
+			denial = &channelAccessError{code: ErrorCodeChannelNotFound, message: "チャンネルが見つかりません"}
+		}
+		if denial != nil {
+			c.sendAckError(eventType, denial.code, denial.message)
+			continue
+		}
+		c.addSubscription(channelID, workspaceID)
+		c.hub.subscribe(&SubscribeRequest{
+			WorkspaceID: workspaceID,
+			ChannelID:   channelID,
+			UserID:      c.userID,
+		})
+	}
 }
 
 // sendAck はACK応答を送信します
@@ -502,10 +710,41 @@ func (c *Client) sendAck(eventType EventType, success bool, message string) {
 		log.Printf("ACKの送信に失敗しました: %v", err)
 		return
 	}
-	select {
-	case c.send <- data:
-	default:
+	c.enqueue(data, "")
+}
+
+// sendAckError は失敗を表すACK応答をエラーコード付きで送信します
+func (c *Client) sendAckError(eventType EventType, code string, message string) {
+	payload := AckPayload{
+		Type:    eventType,
+		Success: false,
+		Code:    code,
+		Message: message,
+	}
+	data, err := SendServerMessage(EventTypeAck, payload)
+	if err != nil {
+		log.Printf("ACKの送信に失敗しました: %v", err)
+		return
 	}
+	c.enqueue(data, "")
+}
+
+// addSubscription は購読チャンネルを追加し、購読数を返します
+func (c *Client) addSubscription(channelID string, workspaceID string) int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.subscribedChannels[channelID] = workspaceID
+	return len(c.subscribedChannels)
+}
+
+// removeSubscription は購読チャンネルを削除し、チャンネルが属していたWorkspaceのIDと購読数を返します
+// 購読していなかった場合、WorkspaceのIDは空文字です
+func (c *Client) removeSubscription(channelID string) (string, int) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	workspaceID := c.subscribedChannels[channelID]
+	delete(c.subscribedChannels, channelID)
+	return workspaceID, len(c.subscribedChannels)
 }
 
 // sendError はエラー応答を送信します
@@ -519,13 +758,11 @@ func (c *Client) sendError(code string, message string) {
 		log.Printf("エラー送信に失敗しました: %v", err)
 		return
 	}
-	select {
-	case c.send <- data:
-	default:
-	}
+	c.enqueue(data, "")
 }
 
 // writePump はWebSocketにメッセージを書き込みます
+// 送信キューが閉じられた場合は残りのメッセージを送信してからクローズフレームを送ります
 func (c *Client) writePump() {
 	ticker := time.NewTicker(pingPeriod)
 	defer func() {
@@ -537,39 +774,23 @@ func (c *Client) writePump() {
 
 	for {
 		select {
-		case message, ok := <-c.send:
-			if err := c.conn.SetWriteDeadline(time.Now().Add(writeWait)); err != nil {
-				return
-			}
-			if !ok {
-				if err := c.conn.WriteMessage(websocket.CloseMessage, []byte{}); err != nil {
-					_ = err // クローズメッセージの送信エラーは無視
-				}
+		case <-c.queue.ready:
+			if !c.writeQueued() {
 				return
 			}
 
-			w, err := c.conn.NextWriter(websocket.TextMessage)
-			if err != nil {
+		case <-c.queue.done:
+			if !c.writeQueued() {
 				return
 			}
-			if _, err := w.Write(message); err != nil {
+			if err := c.conn.SetWriteDeadline(time.Now().Add(writeWait)); err != nil {
 				return
 			}
-
-			// キューされたメッセージを追加
-			n := len(c.send)
-			for i := 0; i < n; i++ {
-				if _, err := w.Write([]byte{'\n'}); err != nil {
-					return
-				}
-				if _, err := w.Write(<-c.send); err != nil {
-					return
-				}
-			}
-
-			if err := w.Close(); err != nil {
-				return
+			code, reason := c.queue.closeStatus()
+			if err := c.conn.WriteMessage(websocket.CloseMessage, closeFrame(code, reason)); err != nil {
+				_ = err // クローズメッセージの送信エラーは無視
 			}
+			return
 
 		case <-ticker.C:
 			if err := c.conn.SetWriteDeadline(time.Now().Add(writeWait)); err != nil {
@@ -582,40 +803,29 @@ func (c *Client) writePump() {
 	}
 }
 
-// startTyping は入力中状態を開始します
-func (c *Client) startTyping(channelID string) {
-	// 入力中状態の通知を他のクライアントに送信
-	typingData := map[string]interface{}{
-		"user_id":    c.userID,
-		"channel_id": channelID,
-		"typing":     true,
+// writeQueued は送信キューのメッセージを改行区切りで1フレームにまとめて書き込みます
+func (c *Client) writeQueued() bool {
+	messages := c.queue.drain()
+	if len(messages) == 0 {
+		return true
 	}
-
-	message, err := SendServerMessage(EventTypeTyping, typingData)
-	if err != nil {
-		log.Printf("typingメッセージの生成に失敗しました: %v", err)
-		return
-	}
-
-	// チャンネル内の他のユーザーに通知（自分は除外）
-	c.hub.BroadcastToChannel(c.workspaceID, channelID, message)
-}
-
-// stopTyping は入力中状態を停止します
-func (c *Client) stopTyping(channelID string) {
-	// 入力中状態停止の通知を他のクライアントに送信
-	typingData := map[string]interface{}{
-		"user_id":    c.userID,
-		"channel_id": channelID,
-		"typing":     false,
+	if err := c.conn.SetWriteDeadline(time.Now().Add(writeWait)); err != nil {
+		return false
 	}
 
-	message, err := SendServerMessage(EventTypeTyping, typingData)
+	w, err := c.conn.NextWriter(websocket.TextMessage)
 	if err != nil {
-		log.Printf("typing停止メッセージの生成に失敗しました: %v", err)
-		return
+		return false
 	}
-
-	// チャンネル内の他のユーザーに通知（自分は除外）
-	c.hub.BroadcastToChannel(c.workspaceID, channelID, message)
+	for i, message := range messages {
+		if i > 0 {
+			if _, err := w.Write([]byte{'\n'}); err != nil {
+				return false
+			}
+		}
+		if _, err := w.Write(message); err != nil {
+			return false
+		}
+	}
+	return w.Close() == nil
 }
@@ -0,0 +1,184 @@
+package websocket
+
+import (
+	"fmt"
+	"io"
+	"log"
+	"math/rand"
+	"sync"
+	"sync/atomic"
+	"testing"
+)
+
+const (
+	// ベンチマークの接続数・チャンネル数
+	benchConnections = 10000
+	benchChannels    = 1000
+	benchWorkspaces  = 100
+
+	benchConnectionsPerWorkspace = benchConnections / benchWorkspaces
+	benchChannelsPerWorkspace    = benchChannels / benchWorkspaces
+)
+
+// newTestHub はログを出力せず、送信キューが溢れても切断しないハブを作成します
+func newTestHub(tb testing.TB) *Hub {
+	tb.Helper()
+
+	output := log.Writer()
+	log.SetOutput(io.Discard)
+	tb.Cleanup(func() { log.SetOutput(output) })
+
+	hub := NewHub()
+	hub.SetBackpressure(BackpressureConfig{Policy: SlowConsumerDropOldest})
+	return hub
+}
+
+// connectFakeClient はWebSocket接続を持たないクライアントをハブに登録します
+// 送信キューはwritePumpの代わりにゴルーチンで読み捨て、受信件数をdeliveredに加算します
+func connectFakeClient(hub *Hub, userID, workspaceID string, delivered *atomic.Int64) *Client {
+	client := newClient(hub, userID, workspaceID, []string{workspaceID}, ProtocolVersion, nil, nil, nil)
+	go func() {
+		for {
+			select {
+			case <-client.queue.ready:
+				delivered.Add(int64(len(client.queue.drain())))
+			case <-client.queue.done:
+				delivered.Add(int64(len(client.queue.drain())))
+				return
+			}
+		}
+	}()
+	hub.register(client)
+	return client
+}
+
+// waitShards は全シャードがそれまでに受け付けた処理を終えるまで待機します
+func waitShards(hub *Hub) {
+	for _, s := range hub.allShards() {
+		done := make(chan struct{})
+		s.do(func() { close(done) })
+		<-done
+	}
+}
+
+func benchWorkspaceID(w int) string {
+	return fmt.Sprintf("workspace-%d", w)
+}
+
+func benchChannelID(w, c int) string {
+	return fmt.Sprintf("channel-%d-%d", w, c)
+}
+
+// BenchmarkHubChannelFanOut は10,000接続・1,000チャンネルでのチャンネル宛メッセージの配信性能を計測します
+// 各Workspaceの接続は全チャンネルを購読するため、1件のメッセージは100接続に配信されます
+func BenchmarkHubChannelFanOut(b *testing.B) {
+	hub := newTestHub(b)
+	var delivered atomic.Int64
+
+	clients := make([]*Client, 0, benchConnections)
+	for w := 0; w < benchWorkspaces; w++ {
+		workspaceID := benchWorkspaceID(w)
+		for u := 0; u < benchConnectionsPerWorkspace; u++ {
+			userID := fmt.Sprintf("user-%d-%d", w, u)
+			clients = append(clients, connectFakeClient(hub, userID, workspaceID, &delivered))
+			for c := 0; c < benchChannelsPerWorkspace; c++ {
+				hub.subscribe(&SubscribeRequest{WorkspaceID: workspaceID, ChannelID: benchChannelID(w, c), UserID: userID})
+			}
+		}
+	}
+	waitShards(hub)
+	b.Cleanup(func() {
+		for _, client := range clients {
+			hub.unregister(client)
+		}
+		waitShards(hub)
+	})
+
+	data, err := SendServerMessage(EventTypeNewMessage, map[string]string{"body": "benchmark"})
+	if err != nil {
+		b.Fatalf("failed to encode message: %v", err)
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	b.RunParallel(func(pb *testing.PB) {
+		r := rand.New(rand.NewSource(rand.Int63()))
+		for pb.Next() {
+			w := r.Intn(benchWorkspaces)
+			hub.BroadcastToChannelSubscribers(benchWorkspaceID(w), benchChannelID(w, r.Intn(benchChannelsPerWorkspace)), data)
+		}
+	})
+	waitShards(hub)
+	b.StopTimer()
+
+	deliveries := float64(b.N) * benchConnectionsPerWorkspace
+	b.ReportMetric(deliveries/b.Elapsed().Seconds(), "deliveries/s")
+}
+
+// TestHubConcurrentBroadcastAndRegistration は接続の登録・解除と配信・接続中ユーザーの取得を並行して行います
+// go test -race で実行し、シャードとハブの状態へのアクセスが競合しないことを確認します
+func TestHubConcurrentBroadcastAndRegistration(t *testing.T) {
+	const (
+		workspaces = 4
+		channels   = 8
+		users      = 16
+		iterations = 50
+	)
+
+	hub := newTestHub(t)
+	var delivered atomic.Int64
+
+	data, err := SendServerMessage(EventTypeNewMessage, map[string]string{"body": "test"})
+	if err != nil {
+		t.Fatalf("failed to encode message: %v", err)
+	}
+
+	var wg sync.WaitGroup
+	for w := 0; w < workspaces; w++ {
+		workspaceID := benchWorkspaceID(w)
+
+		for u := 0; u < users; u++ {
+			userID := fmt.Sprintf("user-%d-%d", w, u)
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				for i := 0; i < iterations; i++ {
+					client := connectFakeClient(hub, userID, workspaceID, &delivered)
+					hub.subscribe(&SubscribeRequest{WorkspaceID: workspaceID, ChannelID: benchChannelID(w, i%channels), UserID: userID})
+					hub.unregister(client)
+				}
+			}()
+		}
+
+		wg.Add(3)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < iterations*users; i++ {
+				hub.BroadcastToUser(workspaceID, fmt.Sprintf("user-%d-%d", w, i%users), data)
+			}
+		}()
+		go func() {
+			defer wg.Done()
+			for i := 0; i < iterations*users; i++ {
+				hub.BroadcastToChannelSubscribers(workspaceID, benchChannelID(w, i%channels), data)
+			}
+		}()
+		go func() {
+			defer wg.Done()
+			for i := 0; i < iterations*users; i++ {
+				if connected := hub.GetConnectedUsers(workspaceID); len(connected) > users {
+					t.Errorf("workspace %s: got %d connected users, want at most %d", workspaceID, len(connected), users)
+					return
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	waitShards(hub)
+
+	for w := 0; w < workspaces; w++ {
+		if connected := hub.GetConnectedUsers(benchWorkspaceID(w)); len(connected) != 0 {
+			t.Errorf("workspace %s: got %d connected users after all clients unregistered, want 0", benchWorkspaceID(w), len(connected))
+		}
+	}
+}
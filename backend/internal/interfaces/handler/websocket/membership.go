@@ -0,0 +1,157 @@
+package websocket
+
+import (
+	"log"
+)
+
+const (
+	// CloseCodeWorkspaceRemoved はWorkspaceから削除されたユーザーの接続を閉じる際のクローズコードです
+	// 単一Workspaceの接続は、そのWorkspaceから削除されると購読できるイベントがなくなるため切断します
+	CloseCodeWorkspaceRemoved = 4009
+
+	// closeReasonWorkspaceRemoved はクローズフレームに設定する理由です
+	closeReasonWorkspaceRemoved = "workspace_removed"
+)
+
+// WorkspaceMembershipChange はユーザーのWorkspaceへの参加・削除を表します
+type WorkspaceMembershipChange struct {
+	// Joined がtrueの場合は参加、falseの場合は削除です
+	Joined bool
+}
+
+// AddWorkspaceMember はWorkspaceに参加したユーザーの複数Workspace接続を、そのWorkspaceに登録します
+func (h *Hub) AddWorkspaceMember(workspaceID string, userID string) {
+	h.publishMembership(workspaceID, userID, EventTypeWorkspaceJoined, true)
+}
+
+// RemoveWorkspaceMember はWorkspaceから削除されたユーザーの接続を、そのWorkspaceから外します
+func (h *Hub) RemoveWorkspaceMember(workspaceID string, userID string) {
+	h.publishMembership(workspaceID, userID, EventTypeWorkspaceRemoved, false)
+}
+
+// publishMembership はWorkspaceへの参加・削除をブローカー経由で全インスタンスに送信します
+func (h *Hub) publishMembership(workspaceID string, userID string, eventType EventType, joined bool) {
+	data, err := sendWorkspaceMessage(workspaceID, eventType, WorkspaceMembershipPayload{WorkspaceID: workspaceID})
+	if err != nil {
+		log.Printf("[WebSocket] %sイベントのエンコードに失敗しました: %v", eventType, err)
+		return
+	}
+
+	h.publish(&BroadcastMessage{
+		WorkspaceID: workspaceID,
+		UserID:      &userID,
+		Data:        data,
+		Membership:  &WorkspaceMembershipChange{Joined: joined},
+	})
+	log.Printf("[WebSocket] Workspace所属変更: workspace=%s user=%s joined=%t", workspaceID, userID, joined)
+}
+
+// applyMembership は自インスタンスにあるユーザーの接続にWorkspaceへの参加・削除を反映します
+// 参加は複数Workspaceの接続にのみ反映し、削除は全ての接続に反映します
+func (h *Hub) applyMembership(msg *BroadcastMessage) {
+	if msg.UserID == nil {
+		return
+	}
+	workspaceID := msg.WorkspaceID
+	userID := *msg.UserID
+
+	for _, client := range h.userClients(userID) {
+		if msg.Membership.Joined {
+			if !client.multiWorkspace {
+				continue
+			}
+			// 登録解除と競合した場合に接続数がずれないよう、参加を記録する前に接続を通知する
+			h.presence.Connect(workspaceID, userID)
+			if !client.joinWorkspace(workspaceID) {
+				h.presence.Disconnect(workspaceID, userID)
+				continue
+			}
+			client.enqueue(msg.Data, "")
+			s := h.shard(workspaceID)
+			s.do(func() { s.register(client) })
+			go h.loadSidebar(client, workspaceID)
+			continue
+		}
+
+		if !client.leaveWorkspace(workspaceID) {
+			continue
+		}
+		s := h.shard(workspaceID)
+		s.do(func() { s.unregister(client) })
+		h.presence.Disconnect(workspaceID, userID)
+		client.enqueue(msg.Data, "")
+		if !client.multiWorkspace {
+			client.queue.close(CloseCodeWorkspaceRemoved, closeReasonWorkspaceRemoved)
+		}
+	}
+}
+
+// workspaceList は接続が登録されているWorkspaceのID一覧を返します
+func (c *Client) workspaceList() []string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	workspaceIDs := make([]string, 0, len(c.workspaces))
+	for workspaceID := range c.workspaces {
+		workspaceIDs = append(workspaceIDs, workspaceID)
+	}
+	return workspaceIDs
+}
+
+// inWorkspace は接続がWorkspaceに登録されているか判定します
+func (c *Client) inWorkspace(workspaceID string) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.workspaces[workspaceID]
+}
+
+// joinWorkspace は接続にWorkspaceを追加します
+// 既に登録されている場合や、接続の登録が解除されている場合はfalseを返します
+func (c *Client) joinWorkspace(workspaceID string) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.workspaces == nil || c.workspaces[workspaceID] {
+		return false
+	}
+	c.workspaces[workspaceID] = true
+	return true
+}
+
+// leaveWorkspace は接続からWorkspaceと、そのWorkspaceのチャンネル・スレッドの購読とサイドバーを削除します
+// 登録されていない場合はfalseを返します
+func (c *Client) leaveWorkspace(workspaceID string) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if !c.workspaces[workspaceID] {
+		return false
+	}
+	delete(c.workspaces, workspaceID)
+	for channelID, channelWorkspaceID := range c.subscribedChannels {
+		if channelWorkspaceID == workspaceID {
+			delete(c.subscribedChannels, channelID)
+		}
+	}
+	for key, threadWorkspaceID := range c.subscribedThreads {
+		if threadWorkspaceID == workspaceID {
+			delete(c.subscribedThreads, key)
+		}
+	}
+	for channelID, channelWorkspaceID := range c.sidebarChannels {
+		if channelWorkspaceID == workspaceID {
+			delete(c.sidebarChannels, channelID)
+		}
+	}
+	return true
+}
+
+// detachWorkspaces は接続から全Workspaceを削除し、削除したWorkspaceのID一覧を返します
+// 以降はjoinWorkspaceでWorkspaceを追加できません
+func (c *Client) detachWorkspaces() []string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	workspaceIDs := make([]string, 0, len(c.workspaces))
+	for workspaceID := range c.workspaces {
+		workspaceIDs = append(workspaceIDs, workspaceID)
+	}
+	c.workspaces = nil
+	return workspaceIDs
+}
@@ -0,0 +1,247 @@
+package websocket
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log"
+	"time"
+
+	domainerrors "github.com/newt239/chat/internal/domain/errors"
+	messageuc "github.com/newt239/chat/internal/usecase/message"
+	reactionuc "github.com/newt239/chat/internal/usecase/reaction"
+	readstateuc "github.com/newt239/chat/internal/usecase/readstate"
+)
+
+const (
+	// operationTimeout はWebSocket経由のメッセージ操作のタイムアウトです
+	operationTimeout = 10 * time.Second
+
+	// maxClientMsgIDLength はclient_msg_idの最大文字数です
+	maxClientMsgIDLength = 64
+)
+
+// handlePostMessage はpost_messageイベントを処理します
+// client_msg_idが同じ再送は新規作成せず、既存のメッセージIDをACKで返します
+func (c *Client) handlePostMessage(payload json.RawMessage) {
+	var postPayload PostMessagePayload
+	if err := json.Unmarshal(payload, &postPayload); err != nil {
+		log.Printf("post_messageペイロードの解析に失敗しました: %v", err)
+		c.sendError("INVALID_PAYLOAD", "無効なペイロードです")
+		return
+	}
+
+	if len(postPayload.ClientMsgID) > maxClientMsgIDLength {
+		c.sendMessageAck(EventTypePostMessage, false, ErrorCodeValidation, "client_msg_idは64文字以内で指定してください", "", postPayload.ClientMsgID)
+		return
+	}
+
+	workspaceID, denial := c.authorizeChannel(postPayload.ChannelID)
+	if denial != nil {
+		c.sendMessageAck(EventTypePostMessage, false, denial.code, denial.message, "", postPayload.ClientMsgID)
+		return
+	}
+
+	input := messageuc.CreateMessageInput{
+		ChannelID:     postPayload.ChannelID,
+		UserID:        c.userID,
+		Body:          postPayload.Body,
+		ParentID:      postPayload.ParentID,
+		AttachmentIDs: postPayload.AttachmentIDs,
+	}
+	if postPayload.ClientMsgID != "" {
+		input.ClientMsgID = &postPayload.ClientMsgID
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), operationTimeout)
+	defer cancel()
+
+	message, err := c.messageUseCase.CreateMessage(ctx, input)
+	if err != nil {
+		code, msg := operationErrorCode(err)
+		log.Printf("[WebSocket] メッセージの投稿に失敗しました: user=%s channel=%s err=%v", c.userID, postPayload.ChannelID, err)
+		c.sendMessageAck(EventTypePostMessage, false, code, msg, "", postPayload.ClientMsgID)
+		return
+	}
+
+	log.Printf("ユーザー%sがチャンネル%sへメッセージ%sを投稿しました", c.userID, postPayload.ChannelID, message.ID)
+
+	// 入力中状態を停止
+	scope := typingScope{channelID: postPayload.ChannelID}
+	if postPayload.ParentID != nil {
+		scope.threadID = *postPayload.ParentID
+	}
+	c.stopTyping(workspaceID, scope)
+
+	c.sendMessageAck(EventTypePostMessage, true, "", "", message.ID, postPayload.ClientMsgID)
+}
+
+// handleEditMessage はedit_messageイベントを処理します
+func (c *Client) handleEditMessage(payload json.RawMessage) {
+	var editPayload EditMessagePayload
+	if err := json.Unmarshal(payload, &editPayload); err != nil {
+		log.Printf("edit_messageペイロードの解析に失敗しました: %v", err)
+		c.sendError("INVALID_PAYLOAD", "無効なペイロードです")
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), operationTimeout)
+	defer cancel()
+
+	_, err := c.messageUseCase.UpdateMessage(ctx, messageuc.UpdateMessageInput{
+		MessageID: editPayload.MessageID,
+		EditorID:  c.userID,
+		Body:      editPayload.Body,
+	})
+	if err != nil {
+		code, msg := operationErrorCode(err)
+		log.Printf("[WebSocket] メッセージの編集に失敗しました: user=%s message=%s err=%v", c.userID, editPayload.MessageID, err)
+		c.sendMessageAck(EventTypeEditMessage, false, code, msg, editPayload.MessageID, "")
+		return
+	}
+
+	c.sendMessageAck(EventTypeEditMessage, true, "", "", editPayload.MessageID, "")
+}
+
+// handleDeleteMessage はdelete_messageイベントを処理します
+func (c *Client) handleDeleteMessage(payload json.RawMessage) {
+	var deletePayload DeleteMessagePayload
+	if err := json.Unmarshal(payload, &deletePayload); err != nil {
+		log.Printf("delete_messageペイロードの解析に失敗しました: %v", err)
+		c.sendError("INVALID_PAYLOAD", "無効なペイロードです")
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), operationTimeout)
+	defer cancel()
+
+	err := c.messageUseCase.DeleteMessage(ctx, messageuc.DeleteMessageInput{
+		MessageID:  deletePayload.MessageID,
+		ExecutorID: c.userID,
+	})
+	if err != nil {
+		code, msg := operationErrorCode(err)
+		log.Printf("[WebSocket] メッセージの削除に失敗しました: user=%s message=%s err=%v", c.userID, deletePayload.MessageID, err)
+		c.sendMessageAck(EventTypeDeleteMessage, false, code, msg, deletePayload.MessageID, "")
+		return
+	}
+
+	c.sendMessageAck(EventTypeDeleteMessage, true, "", "", deletePayload.MessageID, "")
+}
+
+// handleAddReaction はadd_reactionイベントを処理します
+func (c *Client) handleAddReaction(payload json.RawMessage) {
+	var reactionPayload AddReactionPayload
+	if err := json.Unmarshal(payload, &reactionPayload); err != nil {
+		log.Printf("add_reactionペイロードの解析に失敗しました: %v", err)
+		c.sendError("INVALID_PAYLOAD", "無効なペイロードです")
+		return
+	}
+	if reactionPayload.Emoji == "" {
+		c.sendMessageAck(EventTypeAddReaction, false, ErrorCodeValidation, "絵文字を指定してください", reactionPayload.MessageID, "")
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), operationTimeout)
+	defer cancel()
+
+	err := c.reactionUseCase.AddReaction(ctx, reactionuc.AddReactionInput{
+		MessageID: reactionPayload.MessageID,
+		UserID:    c.userID,
+		Emoji:     reactionPayload.Emoji,
+	})
+	if err != nil {
+		code, msg := operationErrorCode(err)
+		log.Printf("[WebSocket] リアクションの追加に失敗しました: user=%s message=%s err=%v", c.userID, reactionPayload.MessageID, err)
+		c.sendMessageAck(EventTypeAddReaction, false, code, msg, reactionPayload.MessageID, "")
+		return
+	}
+
+	c.sendMessageAck(EventTypeAddReaction, true, "", "", reactionPayload.MessageID, "")
+}
+
+// handleUpdateReadState はupdate_read_stateイベントを処理します
+func (c *Client) handleUpdateReadState(payload json.RawMessage) {
+	var readStatePayload UpdateReadStatePayload
+	if err := json.Unmarshal(payload, &readStatePayload); err != nil {
+		log.Printf("update_read_stateペイロードの解析に失敗しました: %v", err)
+		c.sendError("INVALID_PAYLOAD", "無効なペイロードです")
+		return
+	}
+
+	lastReadAt := time.Now()
+	if readStatePayload.LastReadAt != nil {
+		lastReadAt = *readStatePayload.LastReadAt
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), operationTimeout)
+	defer cancel()
+
+	err := c.readStateUseCase.UpdateReadState(ctx, readstateuc.UpdateReadStateInput{
+		ChannelID:  readStatePayload.ChannelID,
+		UserID:     c.userID,
+		LastReadAt: lastReadAt,
+	})
+	if err != nil {
+		code, msg := operationErrorCode(err)
+		log.Printf("[WebSocket] 既読状態の更新に失敗しました: user=%s channel=%s err=%v", c.userID, readStatePayload.ChannelID, err)
+		c.sendMessageAck(EventTypeUpdateReadState, false, code, msg, readStatePayload.MessageID, "")
+		return
+	}
+
+	log.Printf("ユーザー%sがチャンネル%sのメッセージ%sを既読更新しました",
+		c.userID, readStatePayload.ChannelID, readStatePayload.MessageID)
+
+	c.sendMessageAck(EventTypeUpdateReadState, true, "", "", readStatePayload.MessageID, "")
+}
+
+// sendMessageAck はメッセージ操作の結果をメッセージID付きのACKで送信します
+func (c *Client) sendMessageAck(eventType EventType, success bool, code string, message string, messageID string, clientMsgID string) {
+	payload := AckPayload{
+		Type:        eventType,
+		Success:     success,
+		Code:        code,
+		Message:     message,
+		MessageID:   messageID,
+		ClientMsgID: clientMsgID,
+	}
+	data, err := SendServerMessage(EventTypeAck, payload)
+	if err != nil {
+		log.Printf("ACKの送信に失敗しました: %v", err)
+		return
+	}
+	c.enqueue(data, "")
+}
+
+// operationErrorCode はユースケースのエラーをACKのエラーコードとメッセージに変換します
+// 内部エラーの詳細はクライアントに返しません
+func operationErrorCode(err error) (string, string) {
+	switch {
+	case errors.Is(err, messageuc.ErrMessageNotFound),
+		errors.Is(err, reactionuc.ErrMessageNotFound),
+		errors.Is(err, messageuc.ErrParentMessageNotFound),
+		errors.Is(err, domainerrors.ErrMessageNotFound):
+		return ErrorCodeMessageNotFound, err.Error()
+	case errors.Is(err, messageuc.ErrChannelNotFound),
+		errors.Is(err, readstateuc.ErrChannelNotFound),
+		errors.Is(err, domainerrors.ErrChannelNotFound):
+		return ErrorCodeChannelNotFound, err.Error()
+	case errors.Is(err, messageuc.ErrUnauthorized),
+		errors.Is(err, reactionuc.ErrUnauthorized),
+		errors.Is(err, readstateuc.ErrUnauthorized),
+		errors.Is(err, domainerrors.ErrUnauthorized),
+		errors.Is(err, domainerrors.ErrForbidden):
+		return ErrorCodeForbidden, err.Error()
+	case errors.Is(err, messageuc.ErrClientMsgIDConflict),
+		errors.Is(err, reactionuc.ErrReactionExists),
+		errors.Is(err, domainerrors.ErrConflict):
+		return ErrorCodeConflict, err.Error()
+	case errors.Is(err, messageuc.ErrMessageAlreadyDeleted),
+		errors.Is(err, messageuc.ErrCannotEditDeleted),
+		errors.Is(err, domainerrors.ErrValidation),
+		errors.Is(err, domainerrors.ErrInvalidInput):
+		return ErrorCodeValidation, err.Error()
+	default:
+		return ErrorCodeInternal, "処理に失敗しました"
+	}
+}
@@ -0,0 +1,232 @@
+package websocket
+
+import (
+	"context"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/newt239/chat/internal/domain/entity"
+)
+
+const (
+	// presenceAwayAfter は操作がない場合にawayへ移行するまでの時間です
+	presenceAwayAfter = 5 * time.Minute
+
+	// presenceOfflineDelay は最後の接続が切れてからofflineへ移行するまでの猶予です
+	// ページのリロードなどで短時間に再接続した場合に状態がばたつかないようにします
+	presenceOfflineDelay = 10 * time.Second
+
+	// lastSeenSaveTimeout は最終オンライン日時の保存のタイムアウトです
+	lastSeenSaveTimeout = 5 * time.Second
+)
+
+// LastSeenRecorder はユーザーの最終オンライン日時を永続化します
+type LastSeenRecorder interface {
+	UpdateLastSeenAt(ctx context.Context, id string, lastSeenAt time.Time) error
+}
+
+// userPresence はWorkspace内の1ユーザーのプレゼンス状態を表します
+type userPresence struct {
+	connections  int
+	status       entity.PresenceStatus
+	lastActiveAt time.Time
+	awayTimer    *time.Timer
+	offlineTimer *time.Timer
+}
+
+// presenceChange はプレゼンス状態の変化を表します
+type presenceChange struct {
+	workspaceID string
+	userID      string
+	status      entity.PresenceStatus
+	at          time.Time
+}
+
+// PresenceTracker はユーザーごとの接続数と操作時刻からプレゼンス状態を管理します
+// 同一ユーザーの複数接続は1人として扱い、全接続が切れた場合にのみofflineになります
+type PresenceTracker struct {
+	mu sync.Mutex
+
+	// workspaceID -> userID -> *userPresence
+	users map[string]map[string]*userPresence
+
+	onChange func(workspaceID, userID string, status entity.PresenceStatus, at time.Time)
+	recorder LastSeenRecorder
+}
+
+// NewPresenceTracker は新しいPresenceTrackerを作成します
+func NewPresenceTracker(onChange func(workspaceID, userID string, status entity.PresenceStatus, at time.Time)) *PresenceTracker {
+	return &PresenceTracker{
+		users:    make(map[string]map[string]*userPresence),
+		onChange: onChange,
+	}
+}
+
+// SetLastSeenRecorder は最終オンライン日時の保存先を設定します
+func (t *PresenceTracker) SetLastSeenRecorder(recorder LastSeenRecorder) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.recorder = recorder
+}
+
+// Connect はユーザーの接続を記録し、必要であればonlineへ移行します
+func (t *PresenceTracker) Connect(workspaceID, userID string) {
+	now := time.Now()
+
+	t.mu.Lock()
+	p := t.get(workspaceID, userID)
+	p.connections++
+	if p.offlineTimer != nil {
+		p.offlineTimer.Stop()
+		p.offlineTimer = nil
+	}
+	change := t.markActive(workspaceID, userID, p, now)
+	t.mu.Unlock()
+
+	t.emit(change)
+}
+
+// Touch はユーザーの操作を記録し、awayであればonlineへ戻します
+func (t *PresenceTracker) Touch(workspaceID, userID string) {
+	now := time.Now()
+
+	t.mu.Lock()
+	p, ok := t.lookup(workspaceID, userID)
+	if !ok || p.connections == 0 {
+		t.mu.Unlock()
+		return
+	}
+	change := t.markActive(workspaceID, userID, p, now)
+	t.mu.Unlock()
+
+	t.emit(change)
+}
+
+// Disconnect はユーザーの切断を記録し、最後の接続であれば猶予後にofflineへ移行します
+func (t *PresenceTracker) Disconnect(workspaceID, userID string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	p, ok := t.lookup(workspaceID, userID)
+	if !ok {
+		return
+	}
+	if p.connections > 0 {
+		p.connections--
+	}
+	if p.connections > 0 {
+		return
+	}
+
+	if p.offlineTimer != nil {
+		p.offlineTimer.Stop()
+	}
+	p.offlineTimer = time.AfterFunc(presenceOfflineDelay, func() {
+		t.expire(workspaceID, userID)
+	})
+}
+
+// GetWorkspacePresence はWorkspace内で接続中のユーザーのプレゼンス状態を返します
+func (t *PresenceTracker) GetWorkspacePresence(workspaceID string) map[string]entity.PresenceStatus {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	result := make(map[string]entity.PresenceStatus, len(t.users[workspaceID]))
+	for userID, p := range t.users[workspaceID] {
+		result[userID] = p.status
+	}
+	return result
+}
+
+// markActive は操作時刻を更新してawayタイマーを張り直します
+// 状態がonlineに変化した場合はその変化を返します（ロック取得中に呼び出すこと）
+func (t *PresenceTracker) markActive(workspaceID, userID string, p *userPresence, now time.Time) *presenceChange {
+	p.lastActiveAt = now
+	if p.awayTimer != nil {
+		p.awayTimer.Stop()
+	}
+	p.awayTimer = time.AfterFunc(presenceAwayAfter, func() {
+		t.idle(workspaceID, userID)
+	})
+
+	if p.status == entity.PresenceStatusOnline {
+		return nil
+	}
+	p.status = entity.PresenceStatusOnline
+	return &presenceChange{workspaceID: workspaceID, userID: userID, status: p.status, at: now}
+}
+
+// idle は一定時間操作がなかったユーザーをawayへ移行します
+func (t *PresenceTracker) idle(workspaceID, userID string) {
+	t.mu.Lock()
+	p, ok := t.lookup(workspaceID, userID)
+	if !ok || p.status != entity.PresenceStatusOnline || time.Since(p.lastActiveAt) < presenceAwayAfter {
+		t.mu.Unlock()
+		return
+	}
+	p.status = entity.PresenceStatusAway
+	change := &presenceChange{workspaceID: workspaceID, userID: userID, status: p.status, at: p.lastActiveAt}
+	t.mu.Unlock()
+
+	t.emit(change)
+}
+
+// expire は猶予期間内に再接続しなかったユーザーをofflineへ移行します
+func (t *PresenceTracker) expire(workspaceID, userID string) {
+	now := time.Now()
+
+	t.mu.Lock()
+	p, ok := t.lookup(workspaceID, userID)
+	if !ok || p.connections > 0 {
+		t.mu.Unlock()
+		return
+	}
+	if p.awayTimer != nil {
+		p.awayTimer.Stop()
+	}
+	delete(t.users[workspaceID], userID)
+	if len(t.users[workspaceID]) == 0 {
+		delete(t.users, workspaceID)
+	}
+	recorder := t.recorder
+	t.mu.Unlock()
+
+	if recorder != nil {
+		ctx, cancel := context.WithTimeout(context.Background(), lastSeenSaveTimeout)
+		defer cancel()
+		if err := recorder.UpdateLastSeenAt(ctx, userID, now); err != nil {
+			log.Printf("[Presence] 最終オンライン日時の保存に失敗しました: user=%s err=%v", userID, err)
+		}
+	}
+
+	t.emit(&presenceChange{workspaceID: workspaceID, userID: userID, status: entity.PresenceStatusOffline, at: now})
+}
+
+// get はユーザーのプレゼンス状態を返します（存在しない場合は作成）
+func (t *PresenceTracker) get(workspaceID, userID string) *userPresence {
+	if t.users[workspaceID] == nil {
+		t.users[workspaceID] = make(map[string]*userPresence)
+	}
+	p, ok := t.users[workspaceID][userID]
+	if !ok {
+		p = &userPresence{status: entity.PresenceStatusOffline}
+		t.users[workspaceID][userID] = p
+	}
+	return p
+}
+
+// lookup はユーザーのプレゼンス状態を返します
+func (t *PresenceTracker) lookup(workspaceID, userID string) (*userPresence, bool) {
+	p, ok := t.users[workspaceID][userID]
+	return p, ok
+}
+
+// emit は状態の変化を通知します
+func (t *PresenceTracker) emit(change *presenceChange) {
+	if change == nil || t.onChange == nil {
+		return
+	}
+	log.Printf("[Presence] 状態変化: user=%s workspace=%s status=%s", change.userID, change.workspaceID, change.status)
+	t.onChange(change.workspaceID, change.userID, change.status, change.at)
+}
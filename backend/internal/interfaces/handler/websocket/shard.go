@@ -0,0 +1,317 @@
+package websocket
+
+import (
+	"log"
+	"time"
+)
+
+// shardInboxSize はWorkspaceシャードの受信キューの大きさです
+const shardInboxSize = 1024
+
+// workspaceShard はWorkspace単位で接続・購読・イベントログを管理するアクターです
+// 状態はシャードのゴルーチンからのみ変更され、他のWorkspaceとは並行して配信されます
+type workspaceShard struct {
+	hub         *Hub
+	workspaceID string
+
+	// userID -> []*Client (同一ユーザーの複数接続をサポート)
+	clients map[string][]*Client
+
+	// channelID -> userID -> bool
+	// チャンネル宛のイベントは購読者のみを走査して配信します
+	channelSubscribers map[string]map[string]bool
+
+	// threadKey -> userID -> bool
+	// スレッドへの返信は購読者のみに配信します
+	threadSubscribers map[threadKey]map[string]bool
+
+	// channelID -> userID -> bool
+	// チャンネルをサイドバーに表示しているユーザー（channel_activityイベントの配信先）
+	sidebarSubscribers map[string]map[string]bool
+
+	// シーケンス番号と再送用イベントログ
+	eventLog *eventLog
+
+	// チャンネル・スレッドごとの入力中のユーザー
+	typing *typingTracker
+
+	// シャードで実行する処理の受信キュー（到着順に1つずつ実行されます）
+	inbox chan func()
+}
+
+// newWorkspaceShard は新しいシャードを作成し、ゴルーチンを開始します
+func newWorkspaceShard(hub *Hub, workspaceID string) *workspaceShard {
+	s := &workspaceShard{
+		hub:                hub,
+		workspaceID:        workspaceID,
+		clients:            make(map[string][]*Client),
+		channelSubscribers: make(map[string]map[string]bool),
+		threadSubscribers:  make(map[threadKey]map[string]bool),
+		sidebarSubscribers: make(map[string]map[string]bool),
+		eventLog:           newEventLog(),
+		typing:             newTypingTracker(),
+		inbox:              make(chan func(), shardInboxSize),
+	}
+	go s.run()
+	return s
+}
+
+// run はシャードの受信キューを処理します
+func (s *workspaceShard) run() {
+	for fn := range s.inbox {
+		fn()
+	}
+}
+
+// do は処理をシャードのゴルーチンで実行するよう依頼します
+func (s *workspaceShard) do(fn func()) {
+	s.inbox <- fn
+}
+
+// register はクライアントを登録し、connectedイベントを送信します
+// 登録までの間に接続が閉じられた場合は何もしません
+func (s *workspaceShard) register(client *Client) {
+	if client.queue.isClosed() {
+		return
+	}
+	s.clients[client.userID] = append(s.clients[client.userID], client)
+	log.Printf("[WebSocket] クライアント登録: user=%s workspace=%s 接続数=%d",
+		client.userID, s.workspaceID, len(s.clients[client.userID]))
+
+	// 再接続時に使用するepochと現在のシーケンス番号を通知
+	s.send(client, EventTypeConnected, ConnectedPayload{
+		ProtocolVersion: client.protocolVersion,
+		Epoch:           s.hub.epoch,
+		Seq:             s.eventLog.lastSeq,
+	})
+}
+
+// unregister はクライアントの登録を解除し、最後の接続であれば購読も削除します
+// 送信キューは閉じないため、Workspaceから外れた後も他のWorkspaceのイベントは引き続き送信できます
+func (s *workspaceShard) unregister(client *Client) {
+	clients, ok := s.clients[client.userID]
+	if !ok {
+		return
+	}
+	for i, c := range clients {
+		if c == client {
+			s.clients[client.userID] = append(clients[:i], clients[i+1:]...)
+			break
+		}
+	}
+	// クライアントがいなくなったらユーザーを削除し、購読していた全チャンネル・スレッドとサイドバーから削除
+	if len(s.clients[client.userID]) == 0 {
+		delete(s.clients, client.userID)
+		s.removeUserFromAllChannels(client.userID)
+		s.removeUserFromAllThreads(client.userID)
+		s.removeUserFromSidebars(client.userID)
+	}
+	log.Printf("[WebSocket] クライアント登録解除: user=%s workspace=%s 残接続数=%d",
+		client.userID, s.workspaceID, len(s.clients[client.userID]))
+}
+
+// subscribe はチャンネル購読者リストにユーザーを追加します
+func (s *workspaceShard) subscribe(channelID, userID string) {
+	if s.channelSubscribers[channelID] == nil {
+		s.channelSubscribers[channelID] = make(map[string]bool)
+	}
+	s.channelSubscribers[channelID][userID] = true
+	log.Printf("[WebSocket] チャンネル購読者登録: user=%s workspace=%s channel=%s",
+		userID, s.workspaceID, channelID)
+}
+
+// unsubscribe はチャンネル購読者リストからユーザーを削除します
+func (s *workspaceShard) unsubscribe(channelID, userID string) {
+	subscribers, ok := s.channelSubscribers[channelID]
+	if !ok {
+		return
+	}
+	delete(subscribers, userID)
+	if len(subscribers) == 0 {
+		delete(s.channelSubscribers, channelID)
+	}
+	log.Printf("[WebSocket] チャンネル購読者解除: user=%s workspace=%s channel=%s",
+		userID, s.workspaceID, channelID)
+}
+
+// handleBroadcast はブローカーから受け取ったメッセージを記録して配信します
+// 接続中のクライアントがいないWorkspaceのイベントも、再接続に備えて記録します
+func (s *workspaceShard) handleBroadcast(msg *BroadcastMessage) {
+	switch {
+	case msg.Typing != nil:
+		s.applyTyping(msg.Typing)
+		return
+	case msg.Sidebar != nil:
+		s.applySidebar(msg.Sidebar)
+		return
+	case msg.Activity != nil:
+		s.dispatchActivity(msg.Activity)
+		return
+	}
+
+	// 集約対象のイベントと購読の取り消しはシーケンス番号を採番せず、再送対象にもしない
+	if msg.Revocation == nil && msg.CoalesceKey == "" {
+		s.eventLog.append(msg, time.Now())
+	}
+	s.stamp(msg)
+
+	if msg.Revocation != nil {
+		s.revoke(msg)
+		return
+	}
+	s.deliver(msg)
+}
+
+// stamp はメッセージにWorkspaceのIDと採番したシーケンス番号を付与します
+func (s *workspaceShard) stamp(msg *BroadcastMessage) {
+	data, err := withSequence(msg.Data, s.workspaceID, msg.Seq)
+	if err != nil {
+		log.Printf("[WebSocket] シーケンス番号の付与に失敗しました: workspace=%s seq=%d err=%v",
+			s.workspaceID, msg.Seq, err)
+		return
+	}
+	msg.Data = data
+}
+
+// deliver は自インスタンスに接続しているクライアントへメッセージを配信します
+// 宛先に応じて走査対象を絞り、Workspace全体を走査するのはWorkspace宛のメッセージのみです
+func (s *workspaceShard) deliver(msg *BroadcastMessage) {
+	switch {
+	case msg.UserID != nil:
+		if s.shouldDeliver(msg, *msg.UserID) {
+			s.deliverToUser(msg, *msg.UserID)
+		}
+	case msg.ThreadID != nil && msg.ChannelID != nil:
+		for userID := range s.threadSubscribers[threadKey{channelID: *msg.ChannelID, threadID: *msg.ThreadID}] {
+			if msg.ExcludeUser != nil && userID == *msg.ExcludeUser {
+				continue
+			}
+			s.deliverToUser(msg, userID)
+		}
+	case msg.ChannelID != nil:
+		for userID := range s.channelSubscribers[*msg.ChannelID] {
+			if msg.ExcludeUser != nil && userID == *msg.ExcludeUser {
+				continue
+			}
+			s.deliverToUser(msg, userID)
+		}
+	default:
+		for userID := range s.clients {
+			if msg.ExcludeUser != nil && userID == *msg.ExcludeUser {
+				continue
+			}
+			s.deliverToUser(msg, userID)
+		}
+	}
+}
+
+// deliverToUser はユーザーの全接続にメッセージを送信します
+func (s *workspaceShard) deliverToUser(msg *BroadcastMessage, userID string) {
+	for _, client := range s.clients[userID] {
+		client.enqueue(msg.Data, msg.CoalesceKey)
+	}
+}
+
+// shouldDeliver はメッセージを指定したユーザーに配信すべきか判定します
+func (s *workspaceShard) shouldDeliver(msg *BroadcastMessage, userID string) bool {
+	// UserIDが設定されている場合は対象ユーザー以外をスキップ
+	if msg.UserID != nil && userID != *msg.UserID {
+		return false
+	}
+
+	// ExcludeUserが設定されている場合はスキップ
+	if msg.ExcludeUser != nil && userID == *msg.ExcludeUser {
+		return false
+	}
+
+	// ThreadIDが指定されている場合はスレッドの購読チェック
+	if msg.ThreadID != nil && msg.ChannelID != nil {
+		return s.threadSubscribers[threadKey{channelID: *msg.ChannelID, threadID: *msg.ThreadID}][userID]
+	}
+
+	// ChannelIDが指定されている場合は購読チェック
+	if msg.ChannelID != nil && !s.channelSubscribers[*msg.ChannelID][userID] {
+		return false
+	}
+
+	return true
+}
+
+// replay は再接続したクライアントに欠落したイベントを再送します
+// 再送できない場合はresync_requiredを送信し、クライアントに全件再取得を促します
+func (s *workspaceShard) replay(client *Client, since uint64, epoch string) {
+	if epoch != s.hub.epoch {
+		s.sendResyncRequired(client, "epoch_mismatch", s.eventLog.lastSeq)
+		return
+	}
+
+	events, ok := s.eventLog.since(since)
+	if !ok {
+		s.sendResyncRequired(client, "gap_too_large", s.eventLog.lastSeq)
+		return
+	}
+
+	missed := make([][]byte, 0, len(events))
+	for _, msg := range events {
+		if s.shouldDeliver(msg, client.userID) {
+			missed = append(missed, msg.Data)
+		}
+	}
+
+	// 送信キューに収まらない場合は再送を諦める（resumed通知分を含めて判定）
+	if len(missed)+1 > client.queue.free() {
+		s.sendResyncRequired(client, "gap_too_large", s.eventLog.lastSeq)
+		return
+	}
+
+	for _, data := range missed {
+		client.enqueue(data, "")
+	}
+	s.send(client, EventTypeResumed, ResumedPayload{
+		Epoch:    s.hub.epoch,
+		FromSeq:  since,
+		ToSeq:    s.eventLog.lastSeq,
+		Replayed: len(missed),
+	})
+	log.Printf("[WebSocket] イベント再送: user=%s workspace=%s since=%d to=%d 件数=%d",
+		client.userID, s.workspaceID, since, s.eventLog.lastSeq, len(missed))
+}
+
+// sendResyncRequired はクライアントに全件再取得が必要であることを通知します
+func (s *workspaceShard) sendResyncRequired(client *Client, reason string, seq uint64) {
+	s.send(client, EventTypeResyncRequired, ResyncRequiredPayload{
+		Reason: reason,
+		Epoch:  s.hub.epoch,
+		Seq:    seq,
+	})
+	log.Printf("[WebSocket] 再同期要求: user=%s workspace=%s reason=%s", client.userID, s.workspaceID, reason)
+}
+
+// send はWorkspaceのIDを付与したイベントを特定のクライアントに送信します
+func (s *workspaceShard) send(client *Client, eventType EventType, payload interface{}) {
+	data, err := sendWorkspaceMessage(s.workspaceID, eventType, payload)
+	if err != nil {
+		log.Printf("[WebSocket] %sイベントのエンコードに失敗しました: %v", eventType, err)
+		return
+	}
+	client.enqueue(data, "")
+}
+
+// removeUserFromAllChannels はユーザーが購読している全チャンネルから削除します
+func (s *workspaceShard) removeUserFromAllChannels(userID string) {
+	for channelID, subscribers := range s.channelSubscribers {
+		delete(subscribers, userID)
+		if len(subscribers) == 0 {
+			delete(s.channelSubscribers, channelID)
+		}
+	}
+}
+
+// connectedUsers は接続中のユーザーIDリストを返します
+func (s *workspaceShard) connectedUsers() []string {
+	users := make([]string, 0, len(s.clients))
+	for userID := range s.clients {
+		users = append(users, userID)
+	}
+	return users
+}
@@ -0,0 +1,270 @@
+package websocket
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/newt239/chat/internal/domain/entity"
+)
+
+// sidebarTimeout はサイドバーのチャンネル一覧や未読数の取得のタイムアウトです
+const sidebarTimeout = 5 * time.Second
+
+// SidebarChannelFinder は接続のサイドバーに表示するチャンネルを取得します
+type SidebarChannelFinder interface {
+	FindAccessibleChannels(ctx context.Context, workspaceID string, userID string) ([]*entity.Channel, error)
+	FindUserDMs(ctx context.Context, workspaceID string, userID string) ([]*entity.Channel, error)
+}
+
+// UnreadCounter はチャンネルの未読数と未読のメンション数を取得します
+type UnreadCounter interface {
+	GetChannelUnreadCountsByUsers(ctx context.Context, channelID string, userIDs []string) (map[string]entity.ChannelUnreadCount, error)
+}
+
+// SidebarChange はチャンネルのメンバーの追加・削除によるサイドバーの購読の変化を表します
+type SidebarChange struct {
+	ChannelID string
+	UserIDs   []string
+	// Added がtrueの場合は追加、falseの場合は削除です
+	Added bool
+}
+
+// ChannelActivity はチャンネルに新しいメッセージが投稿されたことを表します
+type ChannelActivity struct {
+	ChannelID string
+	MessageID string
+	At        time.Time
+}
+
+// SetSidebarSource はサイドバーのチャンネル一覧と未読数の取得先を設定します
+// 設定しない場合、接続はサイドバーを購読せずchannel_activityイベントも送信されません
+func (h *Hub) SetSidebarSource(channels SidebarChannelFinder, unread UnreadCounter) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.sidebarChannels = channels
+	h.unreadCounter = unread
+}
+
+// sidebarSource はサイドバーのチャンネル一覧と未読数の取得先を返します
+func (h *Hub) sidebarSource() (SidebarChannelFinder, UnreadCounter) {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	return h.sidebarChannels, h.unreadCounter
+}
+
+// AddSidebarChannel はチャンネルに追加されたユーザーの接続のサイドバーにチャンネルを追加します
+func (h *Hub) AddSidebarChannel(workspaceID string, channelID string, userIDs []string) {
+	h.publish(&BroadcastMessage{
+		WorkspaceID: workspaceID,
+		ChannelID:   &channelID,
+		Sidebar:     &SidebarChange{ChannelID: channelID, UserIDs: userIDs, Added: true},
+	})
+}
+
+// RemoveSidebarChannel はチャンネルから外れたユーザーの接続のサイドバーからチャンネルを削除します
+func (h *Hub) RemoveSidebarChannel(workspaceID string, channelID string, userIDs []string) {
+	h.publish(&BroadcastMessage{
+		WorkspaceID: workspaceID,
+		ChannelID:   &channelID,
+		Sidebar:     &SidebarChange{ChannelID: channelID, UserIDs: userIDs},
+	})
+}
+
+// PublishChannelActivity はチャンネルへの新しいメッセージの投稿をサイドバーの購読者に通知します
+// 未読数は受信者ごとに異なるため、各インスタンスが自インスタンスの購読者について取得して送信します
+func (h *Hub) PublishChannelActivity(workspaceID string, activity ChannelActivity) {
+	h.publish(&BroadcastMessage{
+		WorkspaceID: workspaceID,
+		ChannelID:   &activity.ChannelID,
+		Activity:    &activity,
+	})
+}
+
+// loadSidebar はユーザーが参加しているチャンネルとDMを取得し、接続のサイドバーに登録します
+func (h *Hub) loadSidebar(client *Client, workspaceID string) {
+	channels, _ := h.sidebarSource()
+	if channels == nil {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), sidebarTimeout)
+	defer cancel()
+
+	accessible, err := channels.FindAccessibleChannels(ctx, workspaceID, client.userID)
+	if err != nil {
+		log.Printf("[WebSocket] サイドバーのチャンネル一覧の取得に失敗しました: user=%s workspace=%s err=%v",
+			client.userID, workspaceID, err)
+		return
+	}
+	dms, err := channels.FindUserDMs(ctx, workspaceID, client.userID)
+	if err != nil {
+		log.Printf("[WebSocket] サイドバーのDM一覧の取得に失敗しました: user=%s workspace=%s err=%v",
+			client.userID, workspaceID, err)
+		return
+	}
+
+	channelIDs := make([]string, 0, len(accessible)+len(dms))
+	for _, ch := range append(accessible, dms...) {
+		channelIDs = append(channelIDs, ch.ID)
+	}
+
+	s := h.shard(workspaceID)
+	s.do(func() { s.addSidebar(client, channelIDs) })
+}
+
+// addSidebar は接続のサイドバーにチャンネルを登録します
+// 取得までの間に接続の登録が解除された場合は何もしません
+func (s *workspaceShard) addSidebar(client *Client, channelIDs []string) {
+	if !s.registered(client) {
+		return
+	}
+	for _, channelID := range channelIDs {
+		client.addSidebarChannel(channelID, s.workspaceID)
+		s.addSidebarSubscriber(channelID, client.userID)
+	}
+	log.Printf("[WebSocket] サイドバー購読登録: user=%s workspace=%s チャンネル数=%d",
+		client.userID, s.workspaceID, len(channelIDs))
+}
+
+// applySidebar はチャンネルのメンバーの追加・削除を自インスタンスの接続のサイドバーに反映します
+func (s *workspaceShard) applySidebar(change *SidebarChange) {
+	for _, userID := range change.UserIDs {
+		clients := s.clients[userID]
+		if len(clients) == 0 {
+			continue
+		}
+		if change.Added {
+			for _, client := range clients {
+				client.addSidebarChannel(change.ChannelID, s.workspaceID)
+			}
+			s.addSidebarSubscriber(change.ChannelID, userID)
+			continue
+		}
+		for _, client := range clients {
+			client.removeSidebarChannel(change.ChannelID)
+		}
+		s.removeSidebarSubscriber(change.ChannelID, userID)
+	}
+}
+
+// addSidebarSubscriber はチャンネルのサイドバーの購読者にユーザーを追加します
+func (s *workspaceShard) addSidebarSubscriber(channelID, userID string) {
+	if s.sidebarSubscribers[channelID] == nil {
+		s.sidebarSubscribers[channelID] = make(map[string]bool)
+	}
+	s.sidebarSubscribers[channelID][userID] = true
+}
+
+// removeSidebarSubscriber はチャンネルのサイドバーの購読者からユーザーを削除します
+func (s *workspaceShard) removeSidebarSubscriber(channelID, userID string) {
+	subscribers, ok := s.sidebarSubscribers[channelID]
+	if !ok {
+		return
+	}
+	delete(subscribers, userID)
+	if len(subscribers) == 0 {
+		delete(s.sidebarSubscribers, channelID)
+	}
+}
+
+// removeUserFromSidebars はユーザーを全チャンネルのサイドバーの購読者から削除します
+func (s *workspaceShard) removeUserFromSidebars(userID string) {
+	for channelID := range s.sidebarSubscribers {
+		s.removeSidebarSubscriber(channelID, userID)
+	}
+}
+
+// registered はクライアントがシャードに登録されているか判定します
+func (s *workspaceShard) registered(client *Client) bool {
+	for _, c := range s.clients[client.userID] {
+		if c == client {
+			return true
+		}
+	}
+	return false
+}
+
+// dispatchActivity はチャンネルをサイドバーに表示している接続を集め、channel_activityイベントの送信を依頼します
+// 未読数の取得はデータベースへの問い合わせを伴うため、シャードのゴルーチンの外で行います
+func (s *workspaceShard) dispatchActivity(activity *ChannelActivity) {
+	recipients := make(map[string][]*Client)
+	for userID := range s.sidebarSubscribers[activity.ChannelID] {
+		for _, client := range s.clients[userID] {
+			if client.inSidebar(activity.ChannelID) {
+				recipients[userID] = append(recipients[userID], client)
+			}
+		}
+	}
+	if len(recipients) == 0 {
+		return
+	}
+	go s.hub.sendChannelActivity(s.workspaceID, activity, recipients)
+}
+
+// sendChannelActivity は受信者ごとの未読数を取得し、channel_activityイベントを送信します
+func (h *Hub) sendChannelActivity(workspaceID string, activity *ChannelActivity, recipients map[string][]*Client) {
+	_, unread := h.sidebarSource()
+	if unread == nil {
+		return
+	}
+
+	userIDs := make([]string, 0, len(recipients))
+	for userID := range recipients {
+		userIDs = append(userIDs, userID)
+	}
+
+	// 受信者全員の未読数を1回の取得でまとめて求める
+	ctx, cancel := context.WithTimeout(context.Background(), sidebarTimeout)
+	defer cancel()
+	counts, err := unread.GetChannelUnreadCountsByUsers(ctx, activity.ChannelID, userIDs)
+	if err != nil {
+		log.Printf("[WebSocket] channel_activityの未読数の取得に失敗しました: channel=%s 受信者数=%d err=%v",
+			activity.ChannelID, len(userIDs), err)
+		return
+	}
+
+	coalesceKey := coalesceKeyChannelActivity(activity.ChannelID)
+	for userID, clients := range recipients {
+		unreadCount := counts[userID].UnreadCount
+		mentionCount := counts[userID].MentionCount
+
+		data, err := sendWorkspaceMessage(workspaceID, EventTypeChannelActivity, ChannelActivityPayload{
+			ChannelID:       activity.ChannelID,
+			LatestMessageID: activity.MessageID,
+			LatestMessageAt: activity.At,
+			UnreadCount:     unreadCount,
+			MentionCount:    mentionCount,
+			HasMention:      mentionCount > 0,
+		})
+		if err != nil {
+			log.Printf("[WebSocket] channel_activityイベントのエンコードに失敗しました: %v", err)
+			return
+		}
+		for _, client := range clients {
+			client.enqueue(data, coalesceKey)
+		}
+	}
+}
+
+// addSidebarChannel は接続のサイドバーにチャンネルを追加します
+func (c *Client) addSidebarChannel(channelID string, workspaceID string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.sidebarChannels[channelID] = workspaceID
+}
+
+// removeSidebarChannel は接続のサイドバーからチャンネルを削除します
+func (c *Client) removeSidebarChannel(channelID string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.sidebarChannels, channelID)
+}
+
+// inSidebar はチャンネルが接続のサイドバーに登録されているか判定します
+func (c *Client) inSidebar(channelID string) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	_, ok := c.sidebarChannels[channelID]
+	return ok
+}
@@ -0,0 +1,210 @@
+package websocket
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/newt239/chat/internal/domain/repository"
+	"github.com/newt239/chat/internal/domain/service"
+	authuc "github.com/newt239/chat/internal/usecase/auth"
+)
+
+const (
+	// sseHeartbeatInterval はSSE接続にコメント行を送信する間隔です
+	// アイドル状態の接続をプロキシが切断しないようにします
+	sseHeartbeatInterval = 30 * time.Second
+
+	// sseRetryMillis はSSE接続が切れた場合にEventSourceが再接続するまでの待機時間（ミリ秒）です
+	sseRetryMillis = 3000
+
+	// sseEventClose はサーバーが接続を閉じる理由を通知するSSEのイベント名です
+	// WebSocketのクローズフレームに相当し、codeにはクローズコードを設定します
+	sseEventClose = "close"
+)
+
+// sseClosePayload はSSEのcloseイベントのデータを表します
+type sseClosePayload struct {
+	Code   int    `json:"code"`
+	Reason string `json:"reason"`
+}
+
+// EventStreamHandler はServer-Sent Eventsでイベントを配信するハンドラーを返します
+// WebSocketへのアップグレードができない環境向けの代替手段で、/wsと同じHubの配信を受け取ります
+// 認証はcustommw.Authで行い、クライアントからの操作はREST APIで行います
+func EventStreamHandler(hub *Hub, jwtService authuc.JWTService, sessionRepo repository.SessionRepository, workspaceRepo repository.WorkspaceRepository, channelAccess service.ChannelAccessService) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		userID, ok := c.Get("userID").(string)
+		if !ok || userID == "" {
+			return echo.NewHTTPError(http.StatusUnauthorized, "認証が必要です")
+		}
+
+		protocolVersion, err := NegotiateProtocolVersion(c.QueryParam("v"))
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+		}
+
+		// シーケンス番号はWorkspace単位のため、SSEの接続は1つのWorkspaceのみを購読する
+		workspaceID := c.QueryParam("workspaceId")
+		if workspaceID == "" {
+			return echo.NewHTTPError(http.StatusBadRequest, "workspaceIdを指定してください")
+		}
+
+		// 再開位置（Last-Event-IDヘッダー、またはlast_event_idクエリパラメータ）
+		lastEventID := c.Request().Header.Get("Last-Event-ID")
+		if lastEventID == "" {
+			lastEventID = c.QueryParam("last_event_id")
+		}
+		epoch, since, err := parseEventID(lastEventID)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+		}
+
+		// custommw.Authはトークンの署名と有効期限のみを確認するため、セッションの確認と接続中の監視のために改めて検証する
+		ctx := c.Request().Context()
+		claims, err := verifyAccessToken(ctx, jwtService, sessionRepo, tokenFromRequest(c.Request()))
+		if err != nil || claims.UserID != userID {
+			log.Printf("[SSE] トークン検証失敗: user=%s err=%v RemoteAddr=%s", userID, err, c.Request().RemoteAddr)
+			return echo.NewHTTPError(http.StatusUnauthorized, "トークンが無効または期限切れです")
+		}
+		workspaceIDs, err := resolveWorkspaces(ctx, workspaceRepo, userID, workspaceID)
+		if err != nil {
+			return err
+		}
+
+		res := c.Response()
+		res.Header().Set(echo.HeaderContentType, "text/event-stream")
+		res.Header().Set(echo.HeaderCacheControl, "no-cache")
+		res.Header().Set(echo.HeaderConnection, "keep-alive")
+		// リバースプロキシによるバッファリングを無効にする
+		res.Header().Set("X-Accel-Buffering", "no")
+		res.WriteHeader(http.StatusOK)
+
+		log.Printf("[SSE] 接続開始: user=%s workspace=%s", userID, workspaceID)
+
+		client := newClient(hub, userID, workspaceID, workspaceIDs, protocolVersion, jwtService, sessionRepo, channelAccess)
+		client.setAuth(claims)
+		client.hub.register(client)
+
+		// WebSocketのjoin_channelに相当する購読は接続時のchannel_idsで指定する
+		var channelIDs []string
+		if ids := c.QueryParam("channel_ids"); ids != "" {
+			channelIDs = strings.Split(ids, ",")
+		}
+		if lastEventID != "" {
+			client.resume(workspaceID, since, epoch, channelIDs)
+		} else {
+			client.joinChannels(workspaceID, channelIDs, EventTypeJoinChannel)
+		}
+
+		go client.watchAuth()
+		client.streamEvents(ctx, res)
+
+		client.hub.unregister(client)
+		log.Printf("[SSE] 接続終了: user=%s workspace=%s", userID, workspaceID)
+		return nil
+	}
+}
+
+// parseEventID はSSEのイベントID（"<epoch>:<seq>"）を解析します
+// 空文字の場合は再開位置なしとしてゼロ値を返します
+func parseEventID(id string) (string, uint64, error) {
+	if id == "" {
+		return "", 0, nil
+	}
+	epoch, seqStr, ok := strings.Cut(id, ":")
+	if !ok || epoch == "" {
+		return "", 0, fmt.Errorf("Last-Event-IDの形式が不正です: %q", id)
+	}
+	seq, err := strconv.ParseUint(seqStr, 10, 64)
+	if err != nil {
+		return "", 0, fmt.Errorf("Last-Event-IDの形式が不正です: %q", id)
+	}
+	return epoch, seq, nil
+}
+
+// streamEvents は送信キューのメッセージをSSEとして書き込みます
+// リクエストが終了するか送信キューが閉じられるまで戻りません
+func (c *Client) streamEvents(ctx context.Context, w *echo.Response) {
+	rc := http.NewResponseController(w)
+	heartbeat := time.NewTicker(sseHeartbeatInterval)
+	defer heartbeat.Stop()
+
+	if !c.writeSSE(w, rc, fmt.Sprintf("retry: %d\n\n", sseRetryMillis)) {
+		return
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+
+		case <-c.queue.ready:
+			if !c.writeQueuedSSE(w, rc) {
+				return
+			}
+
+		case <-c.queue.done:
+			if !c.writeQueuedSSE(w, rc) {
+				return
+			}
+			if code, reason := c.queue.closeStatus(); code != 0 {
+				data, err := json.Marshal(sseClosePayload{Code: code, Reason: reason})
+				if err == nil {
+					c.writeSSE(w, rc, fmt.Sprintf("event: %s\ndata: %s\n\n", sseEventClose, data))
+				}
+			}
+			return
+
+		case <-heartbeat.C:
+			if !c.writeSSE(w, rc, ": ping\n\n") {
+				return
+			}
+		}
+	}
+}
+
+// writeQueuedSSE は送信キューのメッセージをSSEのイベントとしてまとめて書き込みます
+// シーケンス番号が付与されたイベントには、再開位置としてidを設定します
+func (c *Client) writeQueuedSSE(w *echo.Response, rc *http.ResponseController) bool {
+	messages := c.queue.drain()
+	if len(messages) == 0 {
+		return true
+	}
+
+	var b strings.Builder
+	for _, message := range messages {
+		var head struct {
+			Type EventType `json:"type"`
+			Seq  uint64    `json:"seq"`
+		}
+		if err := json.Unmarshal(message, &head); err != nil {
+			log.Printf("[SSE] イベントの解析に失敗しました: user=%s err=%v", c.userID, err)
+			continue
+		}
+		if head.Seq > 0 {
+			fmt.Fprintf(&b, "id: %s:%d\n", c.hub.epoch, head.Seq)
+		}
+		fmt.Fprintf(&b, "event: %s\ndata: %s\n\n", head.Type, message)
+	}
+	return c.writeSSE(w, rc, b.String())
+}
+
+// writeSSE はSSEのデータを書き込んでフラッシュします
+func (c *Client) writeSSE(w *echo.Response, rc *http.ResponseController, data string) bool {
+	if err := rc.SetWriteDeadline(time.Now().Add(writeWait)); err != nil && !errors.Is(err, http.ErrNotSupported) {
+		return false
+	}
+	if _, err := w.Write([]byte(data)); err != nil {
+		return false
+	}
+	return rc.Flush() == nil
+}
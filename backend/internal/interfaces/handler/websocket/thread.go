@@ -0,0 +1,170 @@
+package websocket
+
+import (
+	"encoding/json"
+	"log"
+
+	"github.com/google/uuid"
+)
+
+// threadKey はスレッドの購読を管理する単位です
+// スレッドIDに加えてチャンネルIDを持ち、アクセス権を確認したチャンネルのスレッドにのみ配信します
+type threadKey struct {
+	channelID string
+	threadID  string
+}
+
+// BroadcastToThreadSubscribers はスレッドを購読している全ユーザーにメッセージを送信します
+// スレッドへの返信（thread_reply_created）の配信に使用します
+func (h *Hub) BroadcastToThreadSubscribers(workspaceID string, channelID string, threadID string, message []byte) {
+	h.publish(&BroadcastMessage{
+		WorkspaceID: workspaceID,
+		ChannelID:   &channelID,
+		ThreadID:    &threadID,
+		Data:        message,
+	})
+	log.Printf("[WebSocket] スレッド購読者向けブロードキャスト: workspace=%s channel=%s thread=%s サイズ=%d bytes",
+		workspaceID, channelID, threadID, len(message))
+}
+
+// BroadcastToChannelCoalesced はチャンネルの購読者にメッセージを送信します
+// 同じキーの未送信メッセージは置き換えられ、シーケンス番号は付与されません
+func (h *Hub) BroadcastToChannelCoalesced(workspaceID string, channelID string, coalesceKey string, message []byte) {
+	h.publish(&BroadcastMessage{
+		WorkspaceID: workspaceID,
+		ChannelID:   &channelID,
+		Data:        message,
+		CoalesceKey: coalesceKey,
+	})
+}
+
+// subscribeThread はスレッド購読者リストにユーザーを追加します
+func (h *Hub) subscribeThread(workspaceID string, key threadKey, userID string) {
+	s := h.shard(workspaceID)
+	s.do(func() { s.subscribeThread(key, userID) })
+}
+
+// unsubscribeThread はスレッド購読者リストからユーザーを削除します
+func (h *Hub) unsubscribeThread(workspaceID string, key threadKey, userID string) {
+	s := h.shard(workspaceID)
+	s.do(func() { s.unsubscribeThread(key, userID) })
+}
+
+// subscribeThread はスレッド購読者リストにユーザーを追加します
+func (s *workspaceShard) subscribeThread(key threadKey, userID string) {
+	if s.threadSubscribers[key] == nil {
+		s.threadSubscribers[key] = make(map[string]bool)
+	}
+	s.threadSubscribers[key][userID] = true
+	log.Printf("[WebSocket] スレッド購読者登録: user=%s workspace=%s channel=%s thread=%s",
+		userID, s.workspaceID, key.channelID, key.threadID)
+}
+
+// unsubscribeThread はスレッド購読者リストからユーザーを削除します
+func (s *workspaceShard) unsubscribeThread(key threadKey, userID string) {
+	subscribers, ok := s.threadSubscribers[key]
+	if !ok {
+		return
+	}
+	delete(subscribers, userID)
+	if len(subscribers) == 0 {
+		delete(s.threadSubscribers, key)
+	}
+}
+
+// removeUserFromAllThreads はユーザーが購読している全スレッドから削除します
+func (s *workspaceShard) removeUserFromAllThreads(userID string) {
+	for key := range s.threadSubscribers {
+		s.unsubscribeThread(key, userID)
+	}
+}
+
+// revokeThreads はチャンネルの購読を取り消すユーザーを、そのチャンネルのスレッドの購読者からも削除します
+func (s *workspaceShard) revokeThreads(rev *SubscriptionRevocation) {
+	for key, subscribers := range s.threadSubscribers {
+		if key.channelID != rev.ChannelID {
+			continue
+		}
+		for userID := range subscribers {
+			if !rev.revokes(userID) {
+				continue
+			}
+			s.unsubscribeThread(key, userID)
+			for _, client := range s.clients[userID] {
+				client.removeThreadSubscriptions(rev.ChannelID)
+			}
+		}
+	}
+}
+
+// handleJoinThread はjoin_threadイベントを処理します
+func (c *Client) handleJoinThread(payload json.RawMessage) {
+	var joinPayload JoinThreadPayload
+	if err := json.Unmarshal(payload, &joinPayload); err != nil {
+		log.Printf("join_threadペイロードの解析に失敗しました: %v", err)
+		c.sendError("INVALID_PAYLOAD", "無効なペイロードです")
+		return
+	}
+	if _, err := uuid.Parse(joinPayload.ThreadID); err != nil {
+		c.sendAckError(EventTypeJoinThread, "INVALID_PAYLOAD", "スレッドIDが不正です")
+		return
+	}
+
+	// スレッドのイベントはチャンネルにアクセスできるユーザーのみ購読できる
+	workspaceID, denial := c.authorizeChannel(joinPayload.ChannelID)
+	if denial != nil {
+		c.sendAckError(EventTypeJoinThread, denial.code, denial.message)
+		return
+	}
+
+	key := threadKey{channelID: joinPayload.ChannelID, threadID: joinPayload.ThreadID}
+	c.addThreadSubscription(key, workspaceID)
+	c.hub.subscribeThread(workspaceID, key, c.userID)
+
+	c.sendAck(EventTypeJoinThread, true, "")
+}
+
+// handleLeaveThread はleave_threadイベントを処理します
+func (c *Client) handleLeaveThread(payload json.RawMessage) {
+	var leavePayload LeaveThreadPayload
+	if err := json.Unmarshal(payload, &leavePayload); err != nil {
+		log.Printf("leave_threadペイロードの解析に失敗しました: %v", err)
+		c.sendError("INVALID_PAYLOAD", "無効なペイロードです")
+		return
+	}
+
+	key := threadKey{channelID: leavePayload.ChannelID, threadID: leavePayload.ThreadID}
+	if workspaceID := c.removeThreadSubscription(key); workspaceID != "" {
+		c.hub.unsubscribeThread(workspaceID, key, c.userID)
+	}
+
+	c.sendAck(EventTypeLeaveThread, true, "")
+}
+
+// addThreadSubscription は購読スレッドを追加します
+func (c *Client) addThreadSubscription(key threadKey, workspaceID string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.subscribedThreads[key] = workspaceID
+}
+
+// removeThreadSubscription は購読スレッドを削除し、スレッドが属していたWorkspaceのIDを返します
+// 購読していなかった場合は空文字を返します
+func (c *Client) removeThreadSubscription(key threadKey) string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	workspaceID := c.subscribedThreads[key]
+	delete(c.subscribedThreads, key)
+	return workspaceID
+}
+
+// removeThreadSubscriptions はチャンネルのスレッドの購読を全て削除します
+func (c *Client) removeThreadSubscriptions(channelID string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for key := range c.subscribedThreads {
+		if key.channelID == channelID {
+			delete(c.subscribedThreads, key)
+		}
+	}
+}
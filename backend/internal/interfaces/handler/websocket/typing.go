@@ -0,0 +1,231 @@
+package websocket
+
+import (
+	"log"
+	"sort"
+	"time"
+)
+
+const (
+	// typingTTL はtypingイベントを受け取ってから入力中とみなす時間です
+	// クライアントは入力を続けている間、この時間より短い間隔でtypingイベントを送信します
+	typingTTL = 6 * time.Second
+
+	// typingMinInterval は同じチャンネル・スレッドでtypingイベントを受け付ける最小間隔です
+	// 間隔内に届いたtypingイベントは、アクセス権の確認やブローカーへの送信を行わずに破棄します
+	typingMinInterval = 2 * time.Second
+)
+
+// TypingChange はユーザーの入力状態の変化を表します
+type TypingChange struct {
+	UserID    string
+	ChannelID string // 空文字の場合はユーザーの全ての入力状態を解除します
+	ThreadID  string // 空文字の場合はチャンネルでの入力です
+	Typing    bool
+}
+
+// typingScope は入力状態を管理する単位（チャンネル、またはチャンネル内のスレッド）です
+type typingScope struct {
+	channelID string
+	threadID  string
+}
+
+// typingTracker はWorkspace内のチャンネル・スレッドごとに入力中のユーザーを管理します
+// シャードのゴルーチンからのみ使用するためロックは持ちません
+type typingTracker struct {
+	// scope -> userID -> 入力状態の世代
+	// 期限切れのタイマーが更新後の入力状態を削除しないよう、世代が一致する場合のみ削除します
+	scopes     map[typingScope]map[string]uint64
+	generation uint64
+}
+
+// newTypingTracker は新しいtypingTrackerを作成します
+func newTypingTracker() *typingTracker {
+	return &typingTracker{
+		scopes: make(map[typingScope]map[string]uint64),
+	}
+}
+
+// start はユーザーを入力中にし、新しい世代を返します
+// 既に入力中だった場合は有効期限の延長のみのため、changedはfalseになります
+func (t *typingTracker) start(scope typingScope, userID string) (generation uint64, changed bool) {
+	users, ok := t.scopes[scope]
+	if !ok {
+		users = make(map[string]uint64)
+		t.scopes[scope] = users
+	}
+	_, exists := users[userID]
+	t.generation++
+	users[userID] = t.generation
+	return t.generation, !exists
+}
+
+// stop はユーザーの入力状態を解除し、解除した場合はtrueを返します
+func (t *typingTracker) stop(scope typingScope, userID string) bool {
+	users, ok := t.scopes[scope]
+	if !ok {
+		return false
+	}
+	if _, exists := users[userID]; !exists {
+		return false
+	}
+	delete(users, userID)
+	if len(users) == 0 {
+		delete(t.scopes, scope)
+	}
+	return true
+}
+
+// expire は世代が一致する場合に限りユーザーの入力状態を解除します
+func (t *typingTracker) expire(scope typingScope, userID string, generation uint64) bool {
+	if t.scopes[scope][userID] != generation {
+		return false
+	}
+	return t.stop(scope, userID)
+}
+
+// clearUser はユーザーの全ての入力状態を解除し、変化したスコープを返します
+func (t *typingTracker) clearUser(userID string) []typingScope {
+	var cleared []typingScope
+	for scope := range t.scopes {
+		if t.stop(scope, userID) {
+			cleared = append(cleared, scope)
+		}
+	}
+	return cleared
+}
+
+// users はスコープで入力中のユーザーIDを並べ替えて返します
+func (t *typingTracker) users(scope typingScope) []string {
+	users := make([]string, 0, len(t.scopes[scope]))
+	for userID := range t.scopes[scope] {
+		users = append(users, userID)
+	}
+	sort.Strings(users)
+	return users
+}
+
+// PublishTyping はユーザーの入力状態の変化をブローカー経由で全インスタンスに送信します
+// 入力状態は各インスタンスのシャードが同じ変化を適用して管理します
+func (h *Hub) PublishTyping(workspaceID string, change TypingChange) {
+	msg := &BroadcastMessage{
+		WorkspaceID: workspaceID,
+		Typing:      &change,
+	}
+	if change.ChannelID != "" {
+		msg.ChannelID = &change.ChannelID
+	}
+	h.publish(msg)
+}
+
+// clearTyping は自インスタンスにWorkspaceの接続が残っていないユーザーの入力状態を解除します
+func (h *Hub) clearTyping(workspaceID string, userID string) {
+	for _, client := range h.userClients(userID) {
+		if client.inWorkspace(workspaceID) {
+			return
+		}
+	}
+	h.PublishTyping(workspaceID, TypingChange{UserID: userID})
+}
+
+// applyTyping は入力状態の変化を反映し、入力中のユーザーが変わった場合はtyping_usersイベントを配信します
+func (s *workspaceShard) applyTyping(change *TypingChange) {
+	if change.ChannelID == "" {
+		for _, scope := range s.typing.clearUser(change.UserID) {
+			s.sendTypingUsers(scope, change.UserID)
+		}
+		return
+	}
+
+	scope := typingScope{channelID: change.ChannelID, threadID: change.ThreadID}
+	if !change.Typing {
+		if s.typing.stop(scope, change.UserID) {
+			s.sendTypingUsers(scope, change.UserID)
+		}
+		return
+	}
+
+	generation, changed := s.typing.start(scope, change.UserID)
+	userID := change.UserID
+	time.AfterFunc(typingTTL, func() {
+		s.do(func() {
+			if s.typing.expire(scope, userID, generation) {
+				s.sendTypingUsers(scope, "")
+			}
+		})
+	})
+	if changed {
+		s.sendTypingUsers(scope, change.UserID)
+	}
+}
+
+// sendTypingUsers はチャンネルの購読者に、スコープで入力中のユーザー一覧を送信します
+// 一覧には受信者自身を含めず、変化させたユーザー（actor）には送信しません
+func (s *workspaceShard) sendTypingUsers(scope typingScope, actor string) {
+	typingUsers := s.typing.users(scope)
+	coalesceKey := coalesceKeyTypingUsers(scope.channelID, scope.threadID)
+
+	for userID := range s.channelSubscribers[scope.channelID] {
+		if userID == actor {
+			continue
+		}
+		payload := TypingUsersPayload{
+			ChannelID: scope.channelID,
+			UserIDs:   excludeUser(typingUsers, userID),
+		}
+		if scope.threadID != "" {
+			payload.ThreadID = &scope.threadID
+		}
+		data, err := sendWorkspaceMessage(s.workspaceID, EventTypeTypingUsers, payload)
+		if err != nil {
+			log.Printf("[WebSocket] typing_usersイベントのエンコードに失敗しました: %v", err)
+			return
+		}
+		for _, client := range s.clients[userID] {
+			client.enqueue(data, coalesceKey)
+		}
+	}
+}
+
+// excludeUser はユーザーIDの一覧から指定したユーザーを除いた一覧を返します
+func excludeUser(userIDs []string, userID string) []string {
+	result := make([]string, 0, len(userIDs))
+	for _, id := range userIDs {
+		if id != userID {
+			result = append(result, id)
+		}
+	}
+	return result
+}
+
+// throttleTyping はtypingイベントを受け付けるか判定し、受け付けた時刻を記録します
+// 入力の停止を通知する場合は記録を削除し、次のtypingイベントをすぐに受け付けます
+func (c *Client) throttleTyping(scope typingScope, typing bool, now time.Time) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if !typing {
+		delete(c.typingAcceptedAt, scope)
+		return false
+	}
+	if last, ok := c.typingAcceptedAt[scope]; ok && now.Sub(last) < typingMinInterval {
+		return true
+	}
+	// 入力をやめたまま残っている古い記録を削除する
+	for s, last := range c.typingAcceptedAt {
+		if now.Sub(last) >= typingTTL {
+			delete(c.typingAcceptedAt, s)
+		}
+	}
+	c.typingAcceptedAt[scope] = now
+	return false
+}
+
+// stopTyping はメッセージを投稿したチャンネル・スレッドでの入力状態を解除します
+func (c *Client) stopTyping(workspaceID string, scope typingScope) {
+	c.throttleTyping(scope, false, time.Now())
+	c.hub.PublishTyping(workspaceID, TypingChange{
+		UserID:    c.userID,
+		ChannelID: scope.channelID,
+		ThreadID:  scope.threadID,
+	})
+}
@@ -68,6 +68,13 @@ const (
 	UpdateMemberRoleRequestRoleMember UpdateMemberRoleRequestRole = "member"
 )
 
+// Defines values for UserPresenceStatus.
+const (
+	Away    UserPresenceStatus = "away"
+	Offline UserPresenceStatus = "offline"
+	Online  UserPresenceStatus = "online"
+)
+
 // Defines values for WorkspaceRole.
 const (
 	Admin  WorkspaceRole = "admin"
@@ -156,6 +163,20 @@ type ChannelMemberInfo struct {
 // ChannelMemberInfoRole defines model for ChannelMemberInfo.Role.
 type ChannelMemberInfoRole string
 
+// ChannelRetentionPolicy defines model for ChannelRetentionPolicy.
+type ChannelRetentionPolicy struct {
+	ChannelId openapi_types.UUID `json:"channelId"`
+
+	// EffectiveRetentionDays チャンネルに適用する保存日数（0の場合は無期限に保存）
+	EffectiveRetentionDays int `json:"effectiveRetentionDays"`
+
+	// RetentionDays チャンネル個別の保存日数（nullの場合はワークスペースの設定に従う）
+	RetentionDays *int `json:"retentionDays"`
+
+	// WorkspaceRetentionDays ワークスペースの保存日数
+	WorkspaceRetentionDays int `json:"workspaceRetentionDays"`
+}
+
 // CreateChannelRequest defines model for CreateChannelRequest.
 type CreateChannelRequest struct {
 	Description *string `json:"description,omitempty"`
@@ -178,7 +199,61 @@ type CreateGroupDMRequest struct {
 type CreateMessageRequest struct {
 	AttachmentIds *[]openapi_types.UUID `json:"attachmentIds,omitempty"`
 	Body          string                `json:"body"`
+
+	// ClientMsgId 再送時の重複作成を防ぐためにクライアントが採番する冪等キー
+	ClientMsgId *string             `json:"clientMsgId,omitempty"`
+	ParentId    *openapi_types.UUID `json:"parentId,omitempty"`
+}
+
+// CreatePollRequest defines model for CreatePollRequest.
+type CreatePollRequest struct {
+	// Anonymous trueの場合は投票者を公開しません（既定値はfalse）
+	Anonymous *bool `json:"anonymous,omitempty"`
+
+	// ClientMsgId 再送時の重複作成を防ぐためにクライアントが採番する冪等キー
+	ClientMsgId *string `json:"clientMsgId,omitempty"`
+
+	// ClosesAt 投票を締め切る日時（現在より後の日時）。省略した場合は締め切りません
+	ClosesAt *time.Time `json:"closesAt,omitempty"`
+
+	// MultipleChoice trueの場合は複数の選択肢に投票できます（既定値はfalse）
+	MultipleChoice *bool               `json:"multipleChoice,omitempty"`
+	Options        []string            `json:"options"`
+	ParentId       *openapi_types.UUID `json:"parentId,omitempty"`
+
+	// Question 投票の質問。メッセージの本文として投稿されます
+	Question string `json:"question"`
+}
+
+// CreateReminderRequest defines model for CreateReminderRequest.
+type CreateReminderRequest struct {
+	MessageId openapi_types.UUID `json:"messageId"`
+
+	// Preset in_20_minutes | in_1_hour | in_3_hours | tomorrow（翌日9:00）| next_week（翌週月曜日9:00）
+	Preset *string `json:"preset,omitempty"`
+
+	// RemindAt 通知する日時（現在より後の日時）。presetと同時には指定できません
+	RemindAt *time.Time `json:"remindAt,omitempty"`
+
+	// Timezone プリセットの日付を計算するタイムゾーン（IANA形式）。省略した場合はUTC
+	Timezone *string `json:"timezone,omitempty"`
+}
+
+// CreateScheduledMessageRequest defines model for CreateScheduledMessageRequest.
+type CreateScheduledMessageRequest struct {
+	AttachmentIds *[]openapi_types.UUID `json:"attachmentIds,omitempty"`
+	Body          string                `json:"body"`
+	ChannelId     openapi_types.UUID    `json:"channelId"`
 	ParentId      *openapi_types.UUID   `json:"parentId,omitempty"`
+
+	// Recurrence 繰り返しの規則（daily | weekdays | weekly）。省略した場合は1回のみ投稿します
+	Recurrence *string `json:"recurrence,omitempty"`
+
+	// SendAt 投稿する日時（現在より後の日時）
+	SendAt time.Time `json:"sendAt"`
+
+	// Timezone 繰り返しの日付・曜日を計算するタイムゾーン（IANA形式）。省略した場合はUTC
+	Timezone *string `json:"timezone,omitempty"`
 }
 
 // CreateUserGroupRequest defines model for CreateUserGroupRequest.
@@ -219,6 +294,19 @@ type DMOutput struct {
 // DMOutputType defines model for DMOutput.Type.
 type DMOutputType string
 
+// Draft defines model for Draft.
+type Draft struct {
+	// AttachmentIds アップロード済みで未投稿の添付ファイルのID
+	AttachmentIds []openapi_types.UUID `json:"attachmentIds"`
+	Body          string               `json:"body"`
+	ChannelId     openapi_types.UUID   `json:"channelId"`
+	Id            openapi_types.UUID   `json:"id"`
+
+	// ParentId スレッドの下書きの場合は返信先のメッセージID
+	ParentId  *openapi_types.UUID `json:"parentId"`
+	UpdatedAt time.Time           `json:"updatedAt"`
+}
+
 // Error defines model for Error.
 type Error struct {
 	Error string `json:"error"`
@@ -259,11 +347,25 @@ type ListChannelMembersResponse struct {
 	Members []ChannelMemberInfo `json:"members"`
 }
 
+// ListDraftsResponse defines model for ListDraftsResponse.
+type ListDraftsResponse struct {
+	// Drafts 下書き（更新日時の新しい順）
+	Drafts []Draft `json:"drafts"`
+}
+
 // ListMembersResponse defines model for ListMembersResponse.
 type ListMembersResponse struct {
 	Members []MemberInfo `json:"members"`
 }
 
+// ListMessageRevisionsResponse defines model for ListMessageRevisionsResponse.
+type ListMessageRevisionsResponse struct {
+	MessageId openapi_types.UUID `json:"messageId"`
+
+	// Revisions 編集履歴（古い順）
+	Revisions []MessageRevision `json:"revisions"`
+}
+
 // ListPinsResponse defines model for ListPinsResponse.
 type ListPinsResponse struct {
 	NextCursor *string         `json:"nextCursor"`
@@ -280,6 +382,18 @@ type ListReactionsResponse struct {
 	Reactions []ReactionWithUser `json:"reactions"`
 }
 
+// ListRemindersResponse defines model for ListRemindersResponse.
+type ListRemindersResponse struct {
+	// Reminders リマインダー（通知日時の早い順）
+	Reminders []Reminder `json:"reminders"`
+}
+
+// ListScheduledMessagesResponse defines model for ListScheduledMessagesResponse.
+type ListScheduledMessagesResponse struct {
+	// ScheduledMessages 予約メッセージ（投稿日時の早い順）
+	ScheduledMessages []ScheduledMessage `json:"scheduledMessages"`
+}
+
 // LoginRequest defines model for LoginRequest.
 type LoginRequest struct {
 	Email    openapi_types.Email `json:"email"`
@@ -301,9 +415,13 @@ type MemberInfoRole string
 
 // Message defines model for Message.
 type Message struct {
-	Attachments *[]Attachment      `json:"attachments,omitempty"`
-	Body        string             `json:"body"`
+	Attachments *[]Attachment `json:"attachments,omitempty"`
+	Body        string        `json:"body"`
+
+	// BodyAst 本文を解析した書式付きテキスト。クライアントはbodyの代わりにこれを表示します
+	BodyAst     *[]MessageNode     `json:"bodyAst,omitempty"`
 	ChannelId   openapi_types.UUID `json:"channelId"`
+	ClientMsgId *string            `json:"clientMsgId"`
 	CreatedAt   time.Time          `json:"createdAt"`
 	DeletedAt   *time.Time         `json:"deletedAt"`
 	DeletedBy   *struct {
@@ -315,13 +433,77 @@ type Message struct {
 	Id        openapi_types.UUID  `json:"id"`
 	IsDeleted bool                `json:"isDeleted"`
 	ParentId  *openapi_types.UUID `json:"parentId"`
-	UserId    openapi_types.UUID  `json:"userId"`
+
+	// Poll メッセージに紐づく投票と集計
+	Poll *Poll `json:"poll,omitempty"`
+
+	// ReadBy DM・グループDMで、このメッセージまで既読にしたメンバーのユーザーID（既読を無効にしているユーザーは含みません）
+	ReadBy *[]openapi_types.UUID `json:"readBy,omitempty"`
+
+	// RevisionCount 保存している編集履歴の件数（編集履歴を無効にしているワークスペースでは増えません）
+	RevisionCount *int `json:"revisionCount,omitempty"`
+
+	// ShareCount このメッセージが他のチャンネルに共有された回数
+	ShareCount *int `json:"shareCount,omitempty"`
+
+	// SharedMessage 転送・共有したメッセージの共有元
+	SharedMessage *SharedMessage     `json:"sharedMessage,omitempty"`
+	UserId        openapi_types.UUID `json:"userId"`
+}
+
+// MessageNode 本文を解析した書式付きテキストの要素。typeによって使用しないフィールドは省略されます
+type MessageNode struct {
+	Children *[]MessageNode `json:"children,omitempty"`
+
+	// Language code_blockに指定された言語
+	Language *string `json:"language,omitempty"`
+
+	// Name mentionのユーザー名・グループ名、channelのチャンネル名、emojiのショートコード
+	Name *string `json:"name,omitempty"`
+
+	// Ordered listが番号付きリストかどうか
+	Ordered *bool `json:"ordered,omitempty"`
+
+	// Text text・code・code_blockの文字列
+	Text *string `json:"text,omitempty"`
+
+	// Type paragraph | code_block | quote | list | list_item（ブロック要素）、text | code | link | mention | channel | emoji（インライン要素）
+	Type string `json:"type"`
+
+	// Url linkのリンク先（http・https・mailtoのみ）
+	Url *string `json:"url,omitempty"`
+}
+
+// MessageRevision defines model for MessageRevision.
+type MessageRevision struct {
+	// Body 編集によって置き換えられる前の本文
+	Body     string    `json:"body"`
+	EditedAt time.Time `json:"editedAt"`
+	Editor   struct {
+		AvatarUrl   *string            `json:"avatarUrl"`
+		DisplayName string             `json:"displayName"`
+		Id          openapi_types.UUID `json:"id"`
+	} `json:"editor"`
+	Id openapi_types.UUID `json:"id"`
 }
 
 // MessagesResponse defines model for MessagesResponse.
 type MessagesResponse struct {
-	HasMore  bool      `json:"hasMore"`
-	Messages []Message `json:"messages"`
+	// AfterCursor afterに指定して、より新しいメッセージを取得するためのカーソル
+	AfterCursor *string `json:"afterCursor"`
+
+	// AnchorId aroundを指定した場合の基準のメッセージのID
+	AnchorId *openapi_types.UUID `json:"anchorId,omitempty"`
+
+	// BeforeCursor beforeに指定して、より古いメッセージを取得するためのカーソル
+	BeforeCursor *string `json:"beforeCursor"`
+
+	// HasMore より古いメッセージが残っているか（afterを指定した場合は判定しない）
+	HasMore bool `json:"hasMore"`
+
+	// HasMoreAfter より新しいメッセージが残っているか（after・aroundを指定した場合のみ判定する）
+	HasMoreAfter bool      `json:"hasMoreAfter"`
+	Messages     []Message `json:"messages"`
 }
 
 // PaginatedChannels defines model for PaginatedChannels.
@@ -374,6 +556,29 @@ type PinnedMessage struct {
 	PinnedBy openapi_types.UUID `json:"pinnedBy"`
 }
 
+// Poll メッセージに紐づく投票と集計
+type Poll struct {
+	Anonymous      bool               `json:"anonymous"`
+	ClosesAt       *time.Time         `json:"closesAt"`
+	Id             openapi_types.UUID `json:"id"`
+	IsClosed       bool               `json:"isClosed"`
+	MultipleChoice bool               `json:"multipleChoice"`
+	Options        []struct {
+		Id        openapi_types.UUID `json:"id"`
+		Text      string             `json:"text"`
+		VoteCount int                `json:"voteCount"`
+
+		// Voted 閲覧者がこの選択肢に投票しているか
+		Voted bool `json:"voted"`
+
+		// VoterIds 投票したユーザーのID。匿名の投票では省略されます
+		VoterIds *[]openapi_types.UUID `json:"voterIds,omitempty"`
+	} `json:"options"`
+
+	// VoterCount 1つ以上の選択肢に投票したユーザーの人数
+	VoterCount int `json:"voterCount"`
+}
+
 // PresignRequest defines model for PresignRequest.
 type PresignRequest struct {
 	ChannelId   openapi_types.UUID `json:"channelId"`
@@ -423,6 +628,126 @@ type RegisterRequest struct {
 	Password    string              `json:"password"`
 }
 
+// Reminder defines model for Reminder.
+type Reminder struct {
+	ChannelId   openapi_types.UUID `json:"channelId"`
+	CompletedAt *time.Time         `json:"completedAt"`
+	CreatedAt   time.Time          `json:"createdAt"`
+	FiredAt     *time.Time         `json:"firedAt"`
+	Id          openapi_types.UUID `json:"id"`
+	MessageId   openapi_types.UUID `json:"messageId"`
+
+	// RemindAt 通知する日時
+	RemindAt time.Time `json:"remindAt"`
+
+	// Status pending（通知待ち）| sending（通知処理中）| fired（通知済み）| completed（完了）
+	Status    string    `json:"status"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// RetentionReport 保存期間を過ぎて次回の削除処理で削除されるメッセージの集計
+type RetentionReport struct {
+	// Channels 保存期間を設定しているチャンネルごとの集計
+	Channels         []RetentionReportChannel `json:"channels"`
+	GeneratedAt      time.Time                `json:"generatedAt"`
+	TotalAttachments int                      `json:"totalAttachments"`
+	TotalMessages    int                      `json:"totalMessages"`
+	WorkspaceId      string                   `json:"workspaceId"`
+}
+
+// RetentionReportChannel defines model for RetentionReportChannel.
+type RetentionReportChannel struct {
+	AttachmentCount int                `json:"attachmentCount"`
+	ChannelId       openapi_types.UUID `json:"channelId"`
+	ChannelName     string             `json:"channelName"`
+
+	// Cutoff この日時より前に投稿されたメッセージを削除する
+	Cutoff          time.Time  `json:"cutoff"`
+	MessageCount    int        `json:"messageCount"`
+	OldestMessageAt *time.Time `json:"oldestMessageAt"`
+
+	// RetentionDays チャンネルに適用する保存日数
+	RetentionDays int `json:"retentionDays"`
+}
+
+// SaveDraftRequest defines model for SaveDraftRequest.
+type SaveDraftRequest struct {
+	AttachmentIds *[]openapi_types.UUID `json:"attachmentIds,omitempty"`
+	Body          string                `json:"body"`
+}
+
+// ScheduledMessage defines model for ScheduledMessage.
+type ScheduledMessage struct {
+	AttachmentIds []openapi_types.UUID `json:"attachmentIds"`
+	Body          string               `json:"body"`
+	ChannelId     openapi_types.UUID   `json:"channelId"`
+	CreatedAt     time.Time            `json:"createdAt"`
+	Id            openapi_types.UUID   `json:"id"`
+
+	// LastError 直近の投稿に失敗した理由
+	LastError *string             `json:"lastError"`
+	ParentId  *openapi_types.UUID `json:"parentId"`
+
+	// Recurrence 繰り返しの規則（daily | weekdays | weekly）。繰り返さない場合はnull
+	Recurrence *string `json:"recurrence"`
+
+	// SendAt 次に投稿する日時
+	SendAt time.Time `json:"sendAt"`
+
+	// Status pending（投稿待ち）| sending（投稿処理中）| failed（投稿失敗）
+	Status string `json:"status"`
+
+	// Timezone 繰り返しの日付・曜日を計算するタイムゾーン（IANA形式）
+	Timezone  string    `json:"timezone"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// ShareMessageRequest defines model for ShareMessageRequest.
+type ShareMessageRequest struct {
+	// Body 共有に添えるコメント。省略できます
+	Body *string `json:"body,omitempty"`
+
+	// ChannelId 共有先のチャンネル（DMを含む）。共有元と同じワークスペースのチャンネルのみ指定できます
+	ChannelId openapi_types.UUID `json:"channelId"`
+
+	// ClientMsgId 再送時の重複作成を防ぐためにクライアントが採番する冪等キー
+	ClientMsgId *string `json:"clientMsgId,omitempty"`
+
+	// ParentId 共有先のスレッドの親メッセージ
+	ParentId *openapi_types.UUID `json:"parentId,omitempty"`
+}
+
+// SharedMessage 転送・共有したメッセージの共有元
+type SharedMessage struct {
+	MessageId openapi_types.UUID `json:"messageId"`
+
+	// Preview 共有元のプレビュー。閲覧者が共有元のチャンネルにアクセスできない場合や、共有元が削除された場合はnull
+	Preview *struct {
+		Body        string             `json:"body"`
+		ChannelId   openapi_types.UUID `json:"channelId"`
+		ChannelName string             `json:"channelName"`
+		CreatedAt   time.Time          `json:"createdAt"`
+		EditedAt    *time.Time         `json:"editedAt"`
+		User        struct {
+			AvatarUrl   *string            `json:"avatarUrl"`
+			DisplayName string             `json:"displayName"`
+			Id          openapi_types.UUID `json:"id"`
+		} `json:"user"`
+	} `json:"preview"`
+}
+
+// SnoozeReminderRequest defines model for SnoozeReminderRequest.
+type SnoozeReminderRequest struct {
+	// Preset in_20_minutes | in_1_hour | in_3_hours | tomorrow（翌日9:00）| next_week（翌週月曜日9:00）
+	Preset *string `json:"preset,omitempty"`
+
+	// RemindAt 次に通知する日時（現在より後の日時）。presetと同時には指定できません
+	RemindAt *time.Time `json:"remindAt,omitempty"`
+
+	// Timezone プリセットの日付を計算するタイムゾーン（IANA形式）。省略した場合はUTC
+	Timezone *string `json:"timezone,omitempty"`
+}
+
 // SuccessResponse defines model for SuccessResponse.
 type SuccessResponse struct {
 	Success bool `json:"success"`
@@ -474,11 +799,20 @@ type UpdateChannelRequest struct {
 	Name        *string `json:"name,omitempty"`
 }
 
+// UpdateChannelRetentionPolicyRequest defines model for UpdateChannelRetentionPolicyRequest.
+type UpdateChannelRetentionPolicyRequest struct {
+	// RetentionDays チャンネル個別の保存日数（0の場合は無期限に保存、nullの場合はワークスペースの設定に従う）
+	RetentionDays *int `json:"retentionDays"`
+}
+
 // UpdateMeRequest defines model for UpdateMeRequest.
 type UpdateMeRequest struct {
 	AvatarUrl   *string `json:"avatar_url,omitempty"`
 	Bio         *string `json:"bio,omitempty"`
 	DisplayName *string `json:"display_name,omitempty"`
+
+	// ReadReceiptsEnabled falseにするとDMの既読を送信せず、他のメンバーの既読も受け取りません
+	ReadReceiptsEnabled *bool `json:"read_receipts_enabled,omitempty"`
 }
 
 // UpdateMemberRoleRequest defines model for UpdateMemberRoleRequest.
@@ -499,6 +833,21 @@ type UpdateReadStateRequest struct {
 	LastReadAt time.Time `json:"lastReadAt"`
 }
 
+// UpdateScheduledMessageRequest defines model for UpdateScheduledMessageRequest.
+type UpdateScheduledMessageRequest struct {
+	AttachmentIds *[]openapi_types.UUID `json:"attachmentIds,omitempty"`
+	Body          *string               `json:"body,omitempty"`
+
+	// Recurrence 繰り返しの規則（daily | weekdays | weekly）。空文字を指定すると繰り返しを解除します
+	Recurrence *string `json:"recurrence,omitempty"`
+
+	// SendAt 投稿する日時（現在より後の日時）
+	SendAt *time.Time `json:"sendAt,omitempty"`
+
+	// Timezone 繰り返しの日付・曜日を計算するタイムゾーン（IANA形式）
+	Timezone *string `json:"timezone,omitempty"`
+}
+
 // UpdateUserGroupRequest defines model for UpdateUserGroupRequest.
 type UpdateUserGroupRequest struct {
 	Description *string `json:"description,omitempty"`
@@ -510,7 +859,16 @@ type UpdateWorkspaceRequest struct {
 	Description *string `json:"description,omitempty"`
 	IconUrl     *string `json:"iconUrl,omitempty"`
 	IsPublic    *bool   `json:"isPublic,omitempty"`
-	Name        *string `json:"name,omitempty"`
+
+	// MessageHistoryEnabled falseにすると以降の編集でメッセージの編集履歴を保存せず、保存済みの編集履歴も削除します
+	MessageHistoryEnabled *bool   `json:"messageHistoryEnabled,omitempty"`
+	Name                  *string `json:"name,omitempty"`
+}
+
+// UpdateWorkspaceRetentionPolicyRequest defines model for UpdateWorkspaceRetentionPolicyRequest.
+type UpdateWorkspaceRetentionPolicyRequest struct {
+	// RetentionDays メッセージを保存する日数（0の場合は無期限に保存）
+	RetentionDays int `json:"retentionDays"`
 }
 
 // User defines model for User.
@@ -540,6 +898,21 @@ type UserGroupMember struct {
 	UserId   openapi_types.UUID `json:"userId"`
 }
 
+// UserPresence defines model for UserPresence.
+type UserPresence struct {
+	LastSeenAt *time.Time         `json:"lastSeenAt"`
+	Status     UserPresenceStatus `json:"status"`
+	UserId     openapi_types.UUID `json:"userId"`
+}
+
+// UserPresenceStatus defines model for UserPresence.Status.
+type UserPresenceStatus string
+
+// VotePollRequest defines model for VotePollRequest.
+type VotePollRequest struct {
+	OptionId openapi_types.UUID `json:"optionId"`
+}
+
 // Workspace defines model for Workspace.
 type Workspace struct {
 	CreatedAt   time.Time          `json:"createdAt"`
@@ -548,15 +921,30 @@ type Workspace struct {
 	IconUrl     *string            `json:"iconUrl"`
 
 	// Id slug identifier (3-12 chars, lowercase, digits, hyphen)
-	Id       *string        `json:"id,omitempty"`
-	IsPublic *bool          `json:"isPublic,omitempty"`
-	Name     string         `json:"name"`
-	Role     *WorkspaceRole `json:"role,omitempty"`
+	Id       *string `json:"id,omitempty"`
+	IsPublic *bool   `json:"isPublic,omitempty"`
+
+	// MessageHistoryEnabled メッセージの編集履歴を保存するかどうか
+	MessageHistoryEnabled *bool          `json:"messageHistoryEnabled,omitempty"`
+	Name                  string         `json:"name"`
+	Role                  *WorkspaceRole `json:"role,omitempty"`
 }
 
 // WorkspaceRole defines model for Workspace.Role.
 type WorkspaceRole string
 
+// WorkspacePresenceResponse defines model for WorkspacePresenceResponse.
+type WorkspacePresenceResponse struct {
+	Presences []UserPresence `json:"presences"`
+}
+
+// WorkspaceRetentionPolicy defines model for WorkspaceRetentionPolicy.
+type WorkspaceRetentionPolicy struct {
+	// RetentionDays メッセージを保存する日数（0の場合は無期限に保存）
+	RetentionDays int    `json:"retentionDays"`
+	WorkspaceId   string `json:"workspaceId"`
+}
+
 // WorkspaceSearchResponse defines model for WorkspaceSearchResponse.
 type WorkspaceSearchResponse struct {
 	Channels PaginatedChannels `json:"channels"`
@@ -564,11 +952,39 @@ type WorkspaceSearchResponse struct {
 	Users    PaginatedUsers    `json:"users"`
 }
 
+// DeleteDraftParams defines parameters for DeleteDraft.
+type DeleteDraftParams struct {
+	// ParentId スレッドの下書きの場合は返信先のメッセージID
+	ParentId *openapi_types.UUID `form:"parentId,omitempty" json:"parentId,omitempty"`
+}
+
+// GetDraftParams defines parameters for GetDraft.
+type GetDraftParams struct {
+	// ParentId スレッドの下書きの場合は返信先のメッセージID
+	ParentId *openapi_types.UUID `form:"parentId,omitempty" json:"parentId,omitempty"`
+}
+
+// SaveDraftParams defines parameters for SaveDraft.
+type SaveDraftParams struct {
+	// ParentId スレッドの下書きの場合は返信先のメッセージID
+	ParentId *openapi_types.UUID `form:"parentId,omitempty" json:"parentId,omitempty"`
+}
+
 // ListMessagesParams defines parameters for ListMessages.
 type ListMessagesParams struct {
+	// Limit 取得する件数（aroundを指定した場合は前後それぞれの件数）
 	Limit *int       `form:"limit,omitempty" json:"limit,omitempty"`
 	Since *time.Time `form:"since,omitempty" json:"since,omitempty"`
 	Until *time.Time `form:"until,omitempty" json:"until,omitempty"`
+
+	// Around このメッセージの前後のメッセージを取得する（スレッドの返信を指定した場合は親メッセージが基準）
+	Around *openapi_types.UUID `form:"around,omitempty" json:"around,omitempty"`
+
+	// Before beforeCursorを指定すると、その位置より古いメッセージを取得する
+	Before *string `form:"before,omitempty" json:"before,omitempty"`
+
+	// After afterCursorを指定すると、その位置より新しいメッセージを取得する
+	After *string `form:"after,omitempty" json:"after,omitempty"`
 }
 
 // ListMessagesWithThreadParams defines parameters for ListMessagesWithThread.
@@ -594,6 +1010,17 @@ type GetThreadRepliesParams struct {
 	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
 }
 
+// ListRemindersParams defines parameters for ListReminders.
+type ListRemindersParams struct {
+	// IncludeCompleted trueの場合は完了したリマインダーも含めます
+	IncludeCompleted *bool `form:"includeCompleted,omitempty" json:"includeCompleted,omitempty"`
+}
+
+// ListScheduledMessagesParams defines parameters for ListScheduledMessages.
+type ListScheduledMessagesParams struct {
+	ChannelId *openapi_types.UUID `form:"channelId,omitempty" json:"channelId,omitempty"`
+}
+
 // ListUserGroupsParams defines parameters for ListUserGroups.
 type ListUserGroupsParams struct {
 	WorkspaceId string `form:"workspace_id" json:"workspace_id"`
@@ -637,6 +1064,9 @@ type RegisterJSONRequestBody = RegisterRequest
 // UpdateChannelJSONRequestBody defines body for UpdateChannel for application/json ContentType.
 type UpdateChannelJSONRequestBody = UpdateChannelRequest
 
+// SaveDraftJSONRequestBody defines body for SaveDraft for application/json ContentType.
+type SaveDraftJSONRequestBody = SaveDraftRequest
+
 // InviteChannelMemberJSONRequestBody defines body for InviteChannelMember for application/json ContentType.
 type InviteChannelMemberJSONRequestBody = InviteChannelMemberRequest
 
@@ -649,9 +1079,15 @@ type CreateMessageJSONRequestBody = CreateMessageRequest
 // CreatePinJSONRequestBody defines body for CreatePin for application/json ContentType.
 type CreatePinJSONRequestBody CreatePinJSONBody
 
+// CreatePollJSONRequestBody defines body for CreatePoll for application/json ContentType.
+type CreatePollJSONRequestBody = CreatePollRequest
+
 // UpdateReadStateJSONRequestBody defines body for UpdateReadState for application/json ContentType.
 type UpdateReadStateJSONRequestBody = UpdateReadStateRequest
 
+// UpdateChannelRetentionPolicyJSONRequestBody defines body for UpdateChannelRetentionPolicy for application/json ContentType.
+type UpdateChannelRetentionPolicyJSONRequestBody = UpdateChannelRetentionPolicyRequest
+
 // FetchOGPJSONRequestBody defines body for FetchOGP for application/json ContentType.
 type FetchOGPJSONRequestBody = FetchOGPRequest
 
@@ -661,6 +1097,24 @@ type UpdateMessageJSONRequestBody = UpdateMessageRequest
 // AddReactionJSONRequestBody defines body for AddReaction for application/json ContentType.
 type AddReactionJSONRequestBody = AddReactionRequest
 
+// ShareMessageJSONRequestBody defines body for ShareMessage for application/json ContentType.
+type ShareMessageJSONRequestBody = ShareMessageRequest
+
+// VotePollJSONRequestBody defines body for VotePoll for application/json ContentType.
+type VotePollJSONRequestBody = VotePollRequest
+
+// CreateReminderJSONRequestBody defines body for CreateReminder for application/json ContentType.
+type CreateReminderJSONRequestBody = CreateReminderRequest
+
+// SnoozeReminderJSONRequestBody defines body for SnoozeReminder for application/json ContentType.
+type SnoozeReminderJSONRequestBody = SnoozeReminderRequest
+
+// CreateScheduledMessageJSONRequestBody defines body for CreateScheduledMessage for application/json ContentType.
+type CreateScheduledMessageJSONRequestBody = CreateScheduledMessageRequest
+
+// UpdateScheduledMessageJSONRequestBody defines body for UpdateScheduledMessage for application/json ContentType.
+type UpdateScheduledMessageJSONRequestBody = UpdateScheduledMessageRequest
+
 // CreateUserGroupJSONRequestBody defines body for CreateUserGroup for application/json ContentType.
 type CreateUserGroupJSONRequestBody = CreateUserGroupRequest
 
@@ -694,6 +1148,9 @@ type AddMemberByEmailJSONRequestBody = AddMemberRequest
 // UpdateMemberRoleJSONRequestBody defines body for UpdateMemberRole for application/json ContentType.
 type UpdateMemberRoleJSONRequestBody = UpdateMemberRoleRequest
 
+// UpdateWorkspaceRetentionPolicyJSONRequestBody defines body for UpdateWorkspaceRetentionPolicy for application/json ContentType.
+type UpdateWorkspaceRetentionPolicyJSONRequestBody = UpdateWorkspaceRetentionPolicyRequest
+
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
 	// Get presigned upload URL
@@ -723,6 +1180,15 @@ type ServerInterface interface {
 	// Update channel
 	// (PATCH /api/channels/{channelId})
 	UpdateChannel(ctx echo.Context, channelId openapi_types.UUID) error
+	// Delete the current user's draft in a channel or thread
+	// (DELETE /api/channels/{channelId}/draft)
+	DeleteDraft(ctx echo.Context, channelId openapi_types.UUID, params DeleteDraftParams) error
+	// Get the current user's draft in a channel or thread
+	// (GET /api/channels/{channelId}/draft)
+	GetDraft(ctx echo.Context, channelId openapi_types.UUID, params GetDraftParams) error
+	// Save the current user's draft in a channel or thread
+	// (PUT /api/channels/{channelId}/draft)
+	SaveDraft(ctx echo.Context, channelId openapi_types.UUID, params SaveDraftParams) error
 	// List channel members
 	// (GET /api/channels/{channelId}/members)
 	ListChannelMembers(ctx echo.Context, channelId openapi_types.UUID) error
@@ -759,9 +1225,18 @@ type ServerInterface interface {
 	// Unpin a message in a channel
 	// (DELETE /api/channels/{channelId}/pins/{messageId})
 	DeletePin(ctx echo.Context, channelId openapi_types.UUID, messageId openapi_types.UUID) error
+	// Create a poll message
+	// (POST /api/channels/{channelId}/polls)
+	CreatePoll(ctx echo.Context, channelId openapi_types.UUID) error
 	// Update channel read state
 	// (POST /api/channels/{channelId}/reads)
 	UpdateReadState(ctx echo.Context, channelId openapi_types.UUID) error
+	// Get the message retention policy of a channel
+	// (GET /api/channels/{channelId}/retention-policy)
+	GetChannelRetentionPolicy(ctx echo.Context, channelId openapi_types.UUID) error
+	// Override the message retention policy of a channel (workspace owner/admin only)
+	// (PUT /api/channels/{channelId}/retention-policy)
+	UpdateChannelRetentionPolicy(ctx echo.Context, channelId openapi_types.UUID) error
 	// Get unread message count
 	// (GET /api/channels/{channelId}/unread_count)
 	GetUnreadCount(ctx echo.Context, channelId openapi_types.UUID) error
@@ -789,12 +1264,51 @@ type ServerInterface interface {
 	// Remove a reaction from a message
 	// (DELETE /api/messages/{messageId}/reactions/{emoji})
 	RemoveReaction(ctx echo.Context, messageId openapi_types.UUID, emoji string) error
+	// List edit history of a message
+	// (GET /api/messages/{messageId}/revisions)
+	ListMessageRevisions(ctx echo.Context, messageId openapi_types.UUID) error
+	// Share a message into another channel
+	// (POST /api/messages/{messageId}/share)
+	ShareMessage(ctx echo.Context, messageId openapi_types.UUID) error
 	// Get thread replies for a message
 	// (GET /api/messages/{messageId}/thread)
 	GetThreadReplies(ctx echo.Context, messageId openapi_types.UUID, params GetThreadRepliesParams) error
 	// Get thread metadata for a message
 	// (GET /api/messages/{messageId}/thread/metadata)
 	GetThreadMetadata(ctx echo.Context, messageId openapi_types.UUID) error
+	// Vote for a poll option
+	// (POST /api/polls/{id}/votes)
+	VotePoll(ctx echo.Context, id openapi_types.UUID) error
+	// Remove a vote from a poll option
+	// (DELETE /api/polls/{id}/votes/{optionId})
+	UnvotePoll(ctx echo.Context, id openapi_types.UUID, optionId openapi_types.UUID) error
+	// List the current user's reminders
+	// (GET /api/reminders)
+	ListReminders(ctx echo.Context, params ListRemindersParams) error
+	// Set a reminder on a message
+	// (POST /api/reminders)
+	CreateReminder(ctx echo.Context) error
+	// Delete a reminder
+	// (DELETE /api/reminders/{id})
+	DeleteReminder(ctx echo.Context, id openapi_types.UUID) error
+	// Mark a reminder as completed
+	// (POST /api/reminders/{id}/complete)
+	CompleteReminder(ctx echo.Context, id openapi_types.UUID) error
+	// Snooze a reminder
+	// (POST /api/reminders/{id}/snooze)
+	SnoozeReminder(ctx echo.Context, id openapi_types.UUID) error
+	// List the current user's scheduled messages
+	// (GET /api/scheduled-messages)
+	ListScheduledMessages(ctx echo.Context, params ListScheduledMessagesParams) error
+	// Schedule a message
+	// (POST /api/scheduled-messages)
+	CreateScheduledMessage(ctx echo.Context) error
+	// Cancel a scheduled message
+	// (DELETE /api/scheduled-messages/{id})
+	DeleteScheduledMessage(ctx echo.Context, id openapi_types.UUID) error
+	// Update a scheduled message
+	// (PATCH /api/scheduled-messages/{id})
+	UpdateScheduledMessage(ctx echo.Context, id openapi_types.UUID) error
 	// Mark thread as read
 	// (POST /api/threads/{threadId}/read)
 	MarkThreadRead(ctx echo.Context, threadId openapi_types.UUID) error
@@ -855,6 +1369,9 @@ type ServerInterface interface {
 	// Create a 1:1 DM
 	// (POST /api/workspaces/{id}/dms)
 	CreateDM(ctx echo.Context, id string) error
+	// List the current user's drafts in a workspace
+	// (GET /api/workspaces/{id}/drafts)
+	ListDrafts(ctx echo.Context, id string) error
 	// Create a group DM
 	// (POST /api/workspaces/{id}/group-dms)
 	CreateGroupDM(ctx echo.Context, id string) error
@@ -873,6 +1390,18 @@ type ServerInterface interface {
 	// Update member role
 	// (PATCH /api/workspaces/{id}/members/{userId})
 	UpdateMemberRole(ctx echo.Context, id string, userId openapi_types.UUID) error
+	// Get presence of workspace members
+	// (GET /api/workspaces/{id}/presence)
+	GetWorkspacePresence(ctx echo.Context, id string) error
+	// Get the message retention policy of a workspace
+	// (GET /api/workspaces/{id}/retention-policy)
+	GetWorkspaceRetentionPolicy(ctx echo.Context, id string) error
+	// Update the message retention policy of a workspace (owner/admin only)
+	// (PUT /api/workspaces/{id}/retention-policy)
+	UpdateWorkspaceRetentionPolicy(ctx echo.Context, id string) error
+	// Report messages that the next purge would delete without deleting them (owner/admin only)
+	// (GET /api/workspaces/{id}/retention-policy/report)
+	GetRetentionReport(ctx echo.Context, id string) error
 	// Search workspace content
 	// (GET /api/workspaces/{workspaceId}/search)
 	SearchWorkspace(ctx echo.Context, workspaceId string, params SearchWorkspaceParams) error
@@ -1003,6 +1532,87 @@ func (w *ServerInterfaceWrapper) UpdateChannel(ctx echo.Context) error {
 	return err
 }
 
+// DeleteDraft converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteDraft(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "channelId" -------------
+	var channelId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "channelId", ctx.Param("channelId"), &channelId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter channelId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteDraftParams
+	// ------------- Optional query parameter "parentId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "parentId", ctx.QueryParams(), &params.ParentId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter parentId: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteDraft(ctx, channelId, params)
+	return err
+}
+
+// GetDraft converts echo context to params.
+func (w *ServerInterfaceWrapper) GetDraft(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "channelId" -------------
+	var channelId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "channelId", ctx.Param("channelId"), &channelId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter channelId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetDraftParams
+	// ------------- Optional query parameter "parentId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "parentId", ctx.QueryParams(), &params.ParentId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter parentId: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetDraft(ctx, channelId, params)
+	return err
+}
+
+// SaveDraft converts echo context to params.
+func (w *ServerInterfaceWrapper) SaveDraft(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "channelId" -------------
+	var channelId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "channelId", ctx.Param("channelId"), &channelId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter channelId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params SaveDraftParams
+	// ------------- Optional query parameter "parentId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "parentId", ctx.QueryParams(), &params.ParentId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter parentId: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.SaveDraft(ctx, channelId, params)
+	return err
+}
+
 // ListChannelMembers converts echo context to params.
 func (w *ServerInterfaceWrapper) ListChannelMembers(ctx echo.Context) error {
 	var err error
@@ -1163,6 +1773,27 @@ func (w *ServerInterfaceWrapper) ListMessages(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter until: %s", err))
 	}
 
+	// ------------- Optional query parameter "around" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "around", ctx.QueryParams(), &params.Around)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter around: %s", err))
+	}
+
+	// ------------- Optional query parameter "before" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "before", ctx.QueryParams(), &params.Before)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter before: %s", err))
+	}
+
+	// ------------- Optional query parameter "after" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "after", ctx.QueryParams(), &params.After)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter after: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshaled arguments
 	err = w.Handler.ListMessages(ctx, channelId, params)
 	return err
@@ -1305,8 +1936,8 @@ func (w *ServerInterfaceWrapper) DeletePin(ctx echo.Context) error {
 	return err
 }
 
-// UpdateReadState converts echo context to params.
-func (w *ServerInterfaceWrapper) UpdateReadState(ctx echo.Context) error {
+// CreatePoll converts echo context to params.
+func (w *ServerInterfaceWrapper) CreatePoll(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "channelId" -------------
 	var channelId openapi_types.UUID
@@ -1319,12 +1950,12 @@ func (w *ServerInterfaceWrapper) UpdateReadState(ctx echo.Context) error {
 	ctx.Set(BearerAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.UpdateReadState(ctx, channelId)
+	err = w.Handler.CreatePoll(ctx, channelId)
 	return err
 }
 
-// GetUnreadCount converts echo context to params.
-func (w *ServerInterfaceWrapper) GetUnreadCount(ctx echo.Context) error {
+// UpdateReadState converts echo context to params.
+func (w *ServerInterfaceWrapper) UpdateReadState(ctx echo.Context) error {
 	var err error
 	// ------------- Path parameter "channelId" -------------
 	var channelId openapi_types.UUID
@@ -1337,36 +1968,90 @@ func (w *ServerInterfaceWrapper) GetUnreadCount(ctx echo.Context) error {
 	ctx.Set(BearerAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetUnreadCount(ctx, channelId)
+	err = w.Handler.UpdateReadState(ctx, channelId)
 	return err
 }
 
-// FetchOGP converts echo context to params.
-func (w *ServerInterfaceWrapper) FetchOGP(ctx echo.Context) error {
+// GetChannelRetentionPolicy converts echo context to params.
+func (w *ServerInterfaceWrapper) GetChannelRetentionPolicy(ctx echo.Context) error {
 	var err error
+	// ------------- Path parameter "channelId" -------------
+	var channelId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "channelId", ctx.Param("channelId"), &channelId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter channelId: %s", err))
+	}
 
 	ctx.Set(BearerAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.FetchOGP(ctx)
+	err = w.Handler.GetChannelRetentionPolicy(ctx, channelId)
 	return err
 }
 
-// DeleteMessage converts echo context to params.
-func (w *ServerInterfaceWrapper) DeleteMessage(ctx echo.Context) error {
+// UpdateChannelRetentionPolicy converts echo context to params.
+func (w *ServerInterfaceWrapper) UpdateChannelRetentionPolicy(ctx echo.Context) error {
 	var err error
-	// ------------- Path parameter "messageId" -------------
-	var messageId openapi_types.UUID
+	// ------------- Path parameter "channelId" -------------
+	var channelId openapi_types.UUID
 
-	err = runtime.BindStyledParameterWithOptions("simple", "messageId", ctx.Param("messageId"), &messageId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "channelId", ctx.Param("channelId"), &channelId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter messageId: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter channelId: %s", err))
 	}
 
 	ctx.Set(BearerAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.DeleteMessage(ctx, messageId)
+	err = w.Handler.UpdateChannelRetentionPolicy(ctx, channelId)
+	return err
+}
+
+// GetUnreadCount converts echo context to params.
+func (w *ServerInterfaceWrapper) GetUnreadCount(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "channelId" -------------
+	var channelId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "channelId", ctx.Param("channelId"), &channelId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter channelId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetUnreadCount(ctx, channelId)
+	return err
+}
+
+// FetchOGP converts echo context to params.
+func (w *ServerInterfaceWrapper) FetchOGP(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.FetchOGP(ctx)
+	return err
+}
+
+// DeleteMessage converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteMessage(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "messageId" -------------
+	var messageId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "messageId", ctx.Param("messageId"), &messageId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter messageId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteMessage(ctx, messageId)
 	return err
 }
 
@@ -1486,6 +2171,42 @@ func (w *ServerInterfaceWrapper) RemoveReaction(ctx echo.Context) error {
 	return err
 }
 
+// ListMessageRevisions converts echo context to params.
+func (w *ServerInterfaceWrapper) ListMessageRevisions(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "messageId" -------------
+	var messageId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "messageId", ctx.Param("messageId"), &messageId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter messageId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ListMessageRevisions(ctx, messageId)
+	return err
+}
+
+// ShareMessage converts echo context to params.
+func (w *ServerInterfaceWrapper) ShareMessage(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "messageId" -------------
+	var messageId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "messageId", ctx.Param("messageId"), &messageId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter messageId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ShareMessage(ctx, messageId)
+	return err
+}
+
 // GetThreadReplies converts echo context to params.
 func (w *ServerInterfaceWrapper) GetThreadReplies(ctx echo.Context) error {
 	var err error
@@ -1531,6 +2252,202 @@ func (w *ServerInterfaceWrapper) GetThreadMetadata(ctx echo.Context) error {
 	return err
 }
 
+// VotePoll converts echo context to params.
+func (w *ServerInterfaceWrapper) VotePoll(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.VotePoll(ctx, id)
+	return err
+}
+
+// UnvotePoll converts echo context to params.
+func (w *ServerInterfaceWrapper) UnvotePoll(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	// ------------- Path parameter "optionId" -------------
+	var optionId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "optionId", ctx.Param("optionId"), &optionId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter optionId: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.UnvotePoll(ctx, id, optionId)
+	return err
+}
+
+// ListReminders converts echo context to params.
+func (w *ServerInterfaceWrapper) ListReminders(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListRemindersParams
+	// ------------- Optional query parameter "includeCompleted" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "includeCompleted", ctx.QueryParams(), &params.IncludeCompleted)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter includeCompleted: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ListReminders(ctx, params)
+	return err
+}
+
+// CreateReminder converts echo context to params.
+func (w *ServerInterfaceWrapper) CreateReminder(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.CreateReminder(ctx)
+	return err
+}
+
+// DeleteReminder converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteReminder(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteReminder(ctx, id)
+	return err
+}
+
+// CompleteReminder converts echo context to params.
+func (w *ServerInterfaceWrapper) CompleteReminder(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.CompleteReminder(ctx, id)
+	return err
+}
+
+// SnoozeReminder converts echo context to params.
+func (w *ServerInterfaceWrapper) SnoozeReminder(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.SnoozeReminder(ctx, id)
+	return err
+}
+
+// ListScheduledMessages converts echo context to params.
+func (w *ServerInterfaceWrapper) ListScheduledMessages(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListScheduledMessagesParams
+	// ------------- Optional query parameter "channelId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "channelId", ctx.QueryParams(), &params.ChannelId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter channelId: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ListScheduledMessages(ctx, params)
+	return err
+}
+
+// CreateScheduledMessage converts echo context to params.
+func (w *ServerInterfaceWrapper) CreateScheduledMessage(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.CreateScheduledMessage(ctx)
+	return err
+}
+
+// DeleteScheduledMessage converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteScheduledMessage(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteScheduledMessage(ctx, id)
+	return err
+}
+
+// UpdateScheduledMessage converts echo context to params.
+func (w *ServerInterfaceWrapper) UpdateScheduledMessage(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.UpdateScheduledMessage(ctx, id)
+	return err
+}
+
 // MarkThreadRead converts echo context to params.
 func (w *ServerInterfaceWrapper) MarkThreadRead(ctx echo.Context) error {
 	var err error
@@ -1867,6 +2784,24 @@ func (w *ServerInterfaceWrapper) CreateDM(ctx echo.Context) error {
 	return err
 }
 
+// ListDrafts converts echo context to params.
+func (w *ServerInterfaceWrapper) ListDrafts(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.ListDrafts(ctx, id)
+	return err
+}
+
 // CreateGroupDM converts echo context to params.
 func (w *ServerInterfaceWrapper) CreateGroupDM(ctx echo.Context) error {
 	var err error
@@ -1991,6 +2926,78 @@ func (w *ServerInterfaceWrapper) UpdateMemberRole(ctx echo.Context) error {
 	return err
 }
 
+// GetWorkspacePresence converts echo context to params.
+func (w *ServerInterfaceWrapper) GetWorkspacePresence(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetWorkspacePresence(ctx, id)
+	return err
+}
+
+// GetWorkspaceRetentionPolicy converts echo context to params.
+func (w *ServerInterfaceWrapper) GetWorkspaceRetentionPolicy(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetWorkspaceRetentionPolicy(ctx, id)
+	return err
+}
+
+// UpdateWorkspaceRetentionPolicy converts echo context to params.
+func (w *ServerInterfaceWrapper) UpdateWorkspaceRetentionPolicy(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.UpdateWorkspaceRetentionPolicy(ctx, id)
+	return err
+}
+
+// GetRetentionReport converts echo context to params.
+func (w *ServerInterfaceWrapper) GetRetentionReport(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", ctx.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetRetentionReport(ctx, id)
+	return err
+}
+
 // SearchWorkspace converts echo context to params.
 func (w *ServerInterfaceWrapper) SearchWorkspace(ctx echo.Context) error {
 	var err error
@@ -2126,6 +3133,9 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 	router.POST(baseURL+"/api/auth/register", wrapper.Register)
 	router.GET(baseURL+"/api/bookmarks", wrapper.ListBookmarks)
 	router.PATCH(baseURL+"/api/channels/:channelId", wrapper.UpdateChannel)
+	router.DELETE(baseURL+"/api/channels/:channelId/draft", wrapper.DeleteDraft)
+	router.GET(baseURL+"/api/channels/:channelId/draft", wrapper.GetDraft)
+	router.PUT(baseURL+"/api/channels/:channelId/draft", wrapper.SaveDraft)
 	router.GET(baseURL+"/api/channels/:channelId/members", wrapper.ListChannelMembers)
 	router.POST(baseURL+"/api/channels/:channelId/members", wrapper.InviteChannelMember)
 	router.DELETE(baseURL+"/api/channels/:channelId/members/self", wrapper.LeaveChannel)
@@ -2138,7 +3148,10 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 	router.GET(baseURL+"/api/channels/:channelId/pins", wrapper.ListPins)
 	router.POST(baseURL+"/api/channels/:channelId/pins", wrapper.CreatePin)
 	router.DELETE(baseURL+"/api/channels/:channelId/pins/:messageId", wrapper.DeletePin)
+	router.POST(baseURL+"/api/channels/:channelId/polls", wrapper.CreatePoll)
 	router.POST(baseURL+"/api/channels/:channelId/reads", wrapper.UpdateReadState)
+	router.GET(baseURL+"/api/channels/:channelId/retention-policy", wrapper.GetChannelRetentionPolicy)
+	router.PUT(baseURL+"/api/channels/:channelId/retention-policy", wrapper.UpdateChannelRetentionPolicy)
 	router.GET(baseURL+"/api/channels/:channelId/unread_count", wrapper.GetUnreadCount)
 	router.POST(baseURL+"/api/links/fetch-ogp", wrapper.FetchOGP)
 	router.DELETE(baseURL+"/api/messages/:messageId", wrapper.DeleteMessage)
@@ -2148,8 +3161,21 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 	router.GET(baseURL+"/api/messages/:messageId/reactions", wrapper.ListReactions)
 	router.POST(baseURL+"/api/messages/:messageId/reactions", wrapper.AddReaction)
 	router.DELETE(baseURL+"/api/messages/:messageId/reactions/:emoji", wrapper.RemoveReaction)
+	router.GET(baseURL+"/api/messages/:messageId/revisions", wrapper.ListMessageRevisions)
+	router.POST(baseURL+"/api/messages/:messageId/share", wrapper.ShareMessage)
 	router.GET(baseURL+"/api/messages/:messageId/thread", wrapper.GetThreadReplies)
 	router.GET(baseURL+"/api/messages/:messageId/thread/metadata", wrapper.GetThreadMetadata)
+	router.POST(baseURL+"/api/polls/:id/votes", wrapper.VotePoll)
+	router.DELETE(baseURL+"/api/polls/:id/votes/:optionId", wrapper.UnvotePoll)
+	router.GET(baseURL+"/api/reminders", wrapper.ListReminders)
+	router.POST(baseURL+"/api/reminders", wrapper.CreateReminder)
+	router.DELETE(baseURL+"/api/reminders/:id", wrapper.DeleteReminder)
+	router.POST(baseURL+"/api/reminders/:id/complete", wrapper.CompleteReminder)
+	router.POST(baseURL+"/api/reminders/:id/snooze", wrapper.SnoozeReminder)
+	router.GET(baseURL+"/api/scheduled-messages", wrapper.ListScheduledMessages)
+	router.POST(baseURL+"/api/scheduled-messages", wrapper.CreateScheduledMessage)
+	router.DELETE(baseURL+"/api/scheduled-messages/:id", wrapper.DeleteScheduledMessage)
+	router.PATCH(baseURL+"/api/scheduled-messages/:id", wrapper.UpdateScheduledMessage)
 	router.POST(baseURL+"/api/threads/:threadId/read", wrapper.MarkThreadRead)
 	router.GET(baseURL+"/api/user-groups", wrapper.ListUserGroups)
 	router.POST(baseURL+"/api/user-groups", wrapper.CreateUserGroup)
@@ -2170,12 +3196,17 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 	router.POST(baseURL+"/api/workspaces/:id/channels", wrapper.CreateChannel)
 	router.GET(baseURL+"/api/workspaces/:id/dms", wrapper.ListDMs)
 	router.POST(baseURL+"/api/workspaces/:id/dms", wrapper.CreateDM)
+	router.GET(baseURL+"/api/workspaces/:id/drafts", wrapper.ListDrafts)
 	router.POST(baseURL+"/api/workspaces/:id/group-dms", wrapper.CreateGroupDM)
 	router.POST(baseURL+"/api/workspaces/:id/join", wrapper.JoinPublicWorkspace)
 	router.GET(baseURL+"/api/workspaces/:id/members", wrapper.ListMembers)
 	router.POST(baseURL+"/api/workspaces/:id/members", wrapper.AddMemberByEmail)
 	router.DELETE(baseURL+"/api/workspaces/:id/members/:userId", wrapper.RemoveMember)
 	router.PATCH(baseURL+"/api/workspaces/:id/members/:userId", wrapper.UpdateMemberRole)
+	router.GET(baseURL+"/api/workspaces/:id/presence", wrapper.GetWorkspacePresence)
+	router.GET(baseURL+"/api/workspaces/:id/retention-policy", wrapper.GetWorkspaceRetentionPolicy)
+	router.PUT(baseURL+"/api/workspaces/:id/retention-policy", wrapper.UpdateWorkspaceRetentionPolicy)
+	router.GET(baseURL+"/api/workspaces/:id/retention-policy/report", wrapper.GetRetentionReport)
 	router.GET(baseURL+"/api/workspaces/:workspaceId/search", wrapper.SearchWorkspace)
 	router.GET(baseURL+"/api/workspaces/:workspaceId/threads/participating", wrapper.GetParticipatingThreads)
 	router.GET(baseURL+"/healthz", wrapper.Healthz)
@@ -48,6 +48,10 @@ func (r *DomainRegistry) NewSystemMessageRepository() domainrepository.SystemMes
     return repository.NewSystemMessageRepository(r.client)
 }
 
+func (r *DomainRegistry) NewEphemeralMessageRepository() domainrepository.EphemeralMessageRepository {
+	return repository.NewEphemeralMessageRepository(r.client)
+}
+
 func (r *DomainRegistry) NewReadStateRepository() domainrepository.ReadStateRepository {
 	return repository.NewReadStateRepository(r.client)
 }
@@ -68,6 +72,30 @@ func (r *DomainRegistry) NewMessageLinkRepository() domainrepository.MessageLink
 	return repository.NewLinkRepository(r.client)
 }
 
+func (r *DomainRegistry) NewMessageRevisionRepository() domainrepository.MessageRevisionRepository {
+	return repository.NewMessageRevisionRepository(r.client)
+}
+
+func (r *DomainRegistry) NewMessageShareRepository() domainrepository.MessageShareRepository {
+	return repository.NewMessageShareRepository(r.client)
+}
+
+func (r *DomainRegistry) NewPollRepository() domainrepository.PollRepository {
+	return repository.NewPollRepository(r.client)
+}
+
+func (r *DomainRegistry) NewScheduledMessageRepository() domainrepository.ScheduledMessageRepository {
+	return repository.NewScheduledMessageRepository(r.client)
+}
+
+func (r *DomainRegistry) NewDraftRepository() domainrepository.DraftRepository {
+	return repository.NewDraftRepository(r.client)
+}
+
+func (r *DomainRegistry) NewReminderRepository() domainrepository.ReminderRepository {
+	return repository.NewReminderRepository(r.client)
+}
+
 func (r *DomainRegistry) NewBookmarkRepository() domainrepository.BookmarkRepository {
 	return repository.NewBookmarkRepository(r.client)
 }
@@ -80,6 +108,10 @@ func (r *DomainRegistry) NewThreadRepository() domainrepository.ThreadRepository
 	return repository.NewThreadRepository(r.client)
 }
 
+func (r *DomainRegistry) NewMessageRetentionRepository() domainrepository.MessageRetentionRepository {
+	return repository.NewMessageRetentionRepository(r.client)
+}
+
 func (r *DomainRegistry) NewAttachmentRepository() domainrepository.AttachmentRepository {
 	return repository.NewAttachmentRepository(r.client)
 }
@@ -92,3 +124,11 @@ func (r *DomainRegistry) NewChannelAccessService() domainservice.ChannelAccessSe
         r.NewWorkspaceRepository(),
     )
 }
+
+func (r *DomainRegistry) NewEphemeralMessageService(notificationSvc domainservice.NotificationService) domainservice.EphemeralMessageService {
+	return domainservice.NewEphemeralMessageService(
+		r.NewEphemeralMessageRepository(),
+		r.NewChannelAccessService(),
+		notificationSvc,
+	)
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/newt239/chat/internal/infrastructure/mention"
 	"github.com/newt239/chat/internal/infrastructure/notification"
 	"github.com/newt239/chat/internal/infrastructure/ogp"
+	"github.com/newt239/chat/internal/infrastructure/pubsub"
 	"github.com/newt239/chat/internal/infrastructure/storage/wasabi"
 	"github.com/newt239/chat/internal/infrastructure/transaction"
 	"github.com/newt239/chat/internal/interfaces/handler/websocket"
@@ -50,6 +51,19 @@ func (r *InfrastructureRegistry) NewNotificationService() service.NotificationSe
 	return notification.NewWebSocketNotificationService(r.hub)
 }
 
+// NewBroker は設定に応じたWebSocketブローカーを作成します
+func (r *InfrastructureRegistry) NewBroker() (websocket.Broker, error) {
+	if r.config.Realtime.Broker == config.RealtimeBrokerPostgres {
+		return pubsub.NewPostgresBroker(r.config.Database.URL)
+	}
+	return websocket.NewLocalBroker(), nil
+}
+
+// NewPresenceService はWebSocket接続に基づくプレゼンスサービスを返します
+func (r *InfrastructureRegistry) NewPresenceService() service.PresenceService {
+	return r.hub.Presence()
+}
+
 func (r *InfrastructureRegistry) NewOGPService() service.OGPService {
 	return ogp.NewOGPService()
 }
@@ -119,13 +119,52 @@ func (r *InterfaceRegistry) NewUserHandler() *handler.UserHandler {
 	}
 }
 
+func (r *InterfaceRegistry) NewPresenceHandler() *handler.PresenceHandler {
+	return &handler.PresenceHandler{
+		PresenceUC: r.usecaseRegistry.NewPresenceUseCase(),
+	}
+}
+
+func (r *InterfaceRegistry) NewScheduledMessageHandler() *handler.ScheduledMessageHandler {
+	return &handler.ScheduledMessageHandler{
+		ScheduledMessageUC: r.usecaseRegistry.NewScheduledMessageUseCase(),
+	}
+}
+
+func (r *InterfaceRegistry) NewReminderHandler() *handler.ReminderHandler {
+	return &handler.ReminderHandler{
+		ReminderUC: r.usecaseRegistry.NewReminderUseCase(),
+	}
+}
+
+func (r *InterfaceRegistry) NewDraftHandler() *handler.DraftHandler {
+	return &handler.DraftHandler{
+		DraftUC: r.usecaseRegistry.NewDraftUseCase(),
+	}
+}
+
+func (r *InterfaceRegistry) NewPollHandler() *handler.PollHandler {
+	return &handler.PollHandler{
+		PollUC: r.usecaseRegistry.NewPollUseCase(),
+	}
+}
+
+func (r *InterfaceRegistry) NewRetentionHandler() *handler.RetentionHandler {
+	return &handler.RetentionHandler{
+		RetentionUC: r.usecaseRegistry.NewRetentionUseCase(),
+	}
+}
+
 func (r *InterfaceRegistry) NewRouter() *echo.Echo {
 	routerConfig := http.RouterConfig{
 		JWTService:           r.infrastructureRegistry.NewJWTService(),
 		AllowedOrigins:       r.infrastructureRegistry.config.CORS.AllowedOrigins,
 		WebSocketHub:         r.infrastructureRegistry.hub,
 		WorkspaceRepository:  r.domainRegistry.NewWorkspaceRepository(),
+		SessionRepository:    r.domainRegistry.NewSessionRepository(),
+		ChannelAccessService: r.domainRegistry.NewChannelAccessService(),
 		MessageUseCase:       r.usecaseRegistry.NewMessageUseCase(),
+		ReactionUseCase:      r.usecaseRegistry.NewReactionUseCase(),
 		ReadStateUseCase:     r.usecaseRegistry.NewReadStateUseCase(),
 		AuthHandler:          r.NewAuthHandler(),
 		WorkspaceHandler:     r.NewWorkspaceHandler(),
@@ -143,6 +182,12 @@ func (r *InterfaceRegistry) NewRouter() *echo.Echo {
 		DMHandler:            r.NewDMHandler(),
 		ThreadHandler:        r.NewThreadHandler(),
         UserHandler:          r.NewUserHandler(),
+		PresenceHandler:      r.NewPresenceHandler(),
+		ScheduledMessageHandler: r.NewScheduledMessageHandler(),
+		DraftHandler:            r.NewDraftHandler(),
+		ReminderHandler:         r.NewReminderHandler(),
+		PollHandler:             r.NewPollHandler(),
+		RetentionHandler:        r.NewRetentionHandler(),
 	}
 
 	return http.NewRouter(routerConfig)
@@ -22,6 +22,14 @@ func NewRegistry(client *ent.Client, cfg *config.Config) *Registry {
 
 	// WebSocketハブを作成
 	hub := websocket.NewHub()
+	hub.Presence().SetLastSeenRecorder(domainRegistry.NewUserRepository())
+	hub.SetSidebarSource(domainRegistry.NewChannelRepository(), domainRegistry.NewReadStateRepository())
+	if policy, err := websocket.ParseSlowConsumerPolicy(cfg.Realtime.SlowConsumerPolicy); err == nil {
+		hub.SetBackpressure(websocket.BackpressureConfig{
+			Policy:    policy,
+			QueueSize: cfg.Realtime.SendQueueSize,
+		})
+	}
 
 	// インフラストラクチャ層のRegistryを作成
 	infrastructureRegistry := NewInfrastructureRegistry(client, cfg, hub, domainRegistry)
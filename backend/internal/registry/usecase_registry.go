@@ -7,11 +7,17 @@ import (
 	channeluc "github.com/newt239/chat/internal/usecase/channel"
 	channelmemberuc "github.com/newt239/chat/internal/usecase/channelmember"
 	dmuc "github.com/newt239/chat/internal/usecase/dm"
+	draftuc "github.com/newt239/chat/internal/usecase/draft"
 	linkuc "github.com/newt239/chat/internal/usecase/link"
 	messageuc "github.com/newt239/chat/internal/usecase/message"
 	pinuc "github.com/newt239/chat/internal/usecase/pin"
+	polluc "github.com/newt239/chat/internal/usecase/poll"
+	presenceuc "github.com/newt239/chat/internal/usecase/presence"
 	reactionuc "github.com/newt239/chat/internal/usecase/reaction"
 	readstateuc "github.com/newt239/chat/internal/usecase/readstate"
+	reminderuc "github.com/newt239/chat/internal/usecase/reminder"
+	retentionuc "github.com/newt239/chat/internal/usecase/retention"
+	scheduledmessageuc "github.com/newt239/chat/internal/usecase/scheduledmessage"
 	searchuc "github.com/newt239/chat/internal/usecase/search"
 	systemmsguc "github.com/newt239/chat/internal/usecase/systemmessage"
 	threaduc "github.com/newt239/chat/internal/usecase/thread"
@@ -48,6 +54,9 @@ func (r *UseCaseRegistry) NewWorkspaceUseCase() workspaceuc.WorkspaceUseCase {
 	return workspaceuc.NewWorkspaceInteractor(
 		r.domainRegistry.NewWorkspaceRepository(),
 		r.domainRegistry.NewUserRepository(),
+		r.domainRegistry.NewMessageRevisionRepository(),
+		r.infrastructureRegistry.NewNotificationService(),
+		r.infrastructureRegistry.NewTransactionManager(),
 	)
 }
 
@@ -59,6 +68,7 @@ func (r *UseCaseRegistry) NewChannelUseCase() channeluc.ChannelUseCase {
 		r.domainRegistry.NewReadStateRepository(),
         r.infrastructureRegistry.NewTransactionManager(),
         r.NewSystemMessageUseCase(),
+		r.infrastructureRegistry.NewNotificationService(),
 	)
 }
 
@@ -68,6 +78,7 @@ func (r *UseCaseRegistry) NewChannelMemberUseCase() channelmemberuc.ChannelMembe
 		r.domainRegistry.NewChannelMemberRepository(),
 		r.domainRegistry.NewWorkspaceRepository(),
 		r.domainRegistry.NewUserRepository(),
+		r.infrastructureRegistry.NewNotificationService(),
 	)
 }
 
@@ -75,6 +86,7 @@ func (r *UseCaseRegistry) NewMessageUseCase() messageuc.MessageUseCase {
 	return messageuc.NewMessageUseCase(
 		r.domainRegistry.NewMessageRepository(),
         r.domainRegistry.NewSystemMessageRepository(),
+		r.domainRegistry.NewEphemeralMessageRepository(),
 		r.domainRegistry.NewChannelRepository(),
 		r.domainRegistry.NewChannelMemberRepository(),
 		r.domainRegistry.NewWorkspaceRepository(),
@@ -85,6 +97,11 @@ func (r *UseCaseRegistry) NewMessageUseCase() messageuc.MessageUseCase {
 		r.domainRegistry.NewMessageLinkRepository(),
 		r.domainRegistry.NewThreadRepository(),
 		r.domainRegistry.NewAttachmentRepository(),
+		r.domainRegistry.NewReadStateRepository(),
+		r.domainRegistry.NewMessageRevisionRepository(),
+		r.domainRegistry.NewDraftRepository(),
+		r.domainRegistry.NewMessageShareRepository(),
+		r.domainRegistry.NewPollRepository(),
 		r.infrastructureRegistry.NewOGPService(),
 		r.infrastructureRegistry.NewNotificationService(),
 		r.infrastructureRegistry.NewMentionService(),
@@ -100,6 +117,7 @@ func (r *UseCaseRegistry) NewSystemMessageUseCase() systemmsguc.UseCase {
         r.domainRegistry.NewSystemMessageRepository(),
         r.domainRegistry.NewChannelRepository(),
         r.infrastructureRegistry.NewNotificationService(),
+        r.domainRegistry.NewEphemeralMessageService(r.infrastructureRegistry.NewNotificationService()),
     )
 }
 
@@ -109,6 +127,7 @@ func (r *UseCaseRegistry) NewReadStateUseCase() readstateuc.ReadStateUseCase {
 		r.domainRegistry.NewChannelRepository(),
 		r.domainRegistry.NewChannelMemberRepository(),
 		r.domainRegistry.NewWorkspaceRepository(),
+		r.domainRegistry.NewUserRepository(),
         r.infrastructureRegistry.NewNotificationService(),
         r.domainRegistry.NewChannelAccessService(),
 	)
@@ -169,6 +188,88 @@ func (r *UseCaseRegistry) NewPinUseCase() pinuc.PinUseCase {
 	)
 }
 
+func (r *UseCaseRegistry) NewScheduledMessageUseCase() scheduledmessageuc.ScheduledMessageUseCase {
+	return scheduledmessageuc.NewScheduledMessageInteractor(
+		r.domainRegistry.NewScheduledMessageRepository(),
+		r.domainRegistry.NewMessageRepository(),
+		r.domainRegistry.NewChannelAccessService(),
+	)
+}
+
+func (r *UseCaseRegistry) NewDraftUseCase() draftuc.DraftUseCase {
+	return draftuc.NewDraftInteractor(
+		r.domainRegistry.NewDraftRepository(),
+		r.domainRegistry.NewMessageRepository(),
+		r.domainRegistry.NewAttachmentRepository(),
+		r.domainRegistry.NewWorkspaceRepository(),
+		r.domainRegistry.NewChannelAccessService(),
+		r.infrastructureRegistry.NewNotificationService(),
+	)
+}
+
+// NewScheduledMessageDispatcher は予約メッセージを投稿するDispatcherを作成します
+func (r *UseCaseRegistry) NewScheduledMessageDispatcher() *scheduledmessageuc.Dispatcher {
+	return scheduledmessageuc.NewDispatcher(
+		r.domainRegistry.NewScheduledMessageRepository(),
+		r.NewMessageUseCase(),
+		r.domainRegistry.NewEphemeralMessageService(r.infrastructureRegistry.NewNotificationService()),
+		r.infrastructureRegistry.NewLogger(),
+	)
+}
+
+func (r *UseCaseRegistry) NewReminderUseCase() reminderuc.ReminderUseCase {
+	return reminderuc.NewReminderInteractor(
+		r.domainRegistry.NewReminderRepository(),
+		r.domainRegistry.NewMessageRepository(),
+		r.domainRegistry.NewChannelAccessService(),
+	)
+}
+
+// NewReminderDispatcher はリマインダーを通知するDispatcherを作成します
+func (r *UseCaseRegistry) NewReminderDispatcher() *reminderuc.Dispatcher {
+	return reminderuc.NewDispatcher(
+		r.domainRegistry.NewReminderRepository(),
+		r.domainRegistry.NewMessageRepository(),
+		r.domainRegistry.NewChannelAccessService(),
+		r.NewSystemMessageUseCase(),
+		r.infrastructureRegistry.NewNotificationService(),
+		r.infrastructureRegistry.NewTransactionManager(),
+		r.infrastructureRegistry.NewLogger(),
+	)
+}
+
+func (r *UseCaseRegistry) NewRetentionUseCase() retentionuc.RetentionUseCase {
+	return retentionuc.NewRetentionInteractor(
+		r.domainRegistry.NewMessageRetentionRepository(),
+		r.domainRegistry.NewWorkspaceRepository(),
+		r.domainRegistry.NewChannelAccessService(),
+		r.NewSystemMessageUseCase(),
+		r.infrastructureRegistry.NewLogger(),
+	)
+}
+
+// NewRetentionPurger は保存期間を過ぎたメッセージを削除するPurgerを作成します
+func (r *UseCaseRegistry) NewRetentionPurger() *retentionuc.Purger {
+	return retentionuc.NewPurger(
+		r.domainRegistry.NewMessageRetentionRepository(),
+		r.domainRegistry.NewEphemeralMessageRepository(),
+		r.infrastructureRegistry.NewStorageService(),
+		r.infrastructureRegistry.NewTransactionManager(),
+		r.infrastructureRegistry.NewLogger(),
+	)
+}
+
+func (r *UseCaseRegistry) NewPollUseCase() polluc.PollUseCase {
+	return polluc.NewPollInteractor(
+		r.domainRegistry.NewPollRepository(),
+		r.domainRegistry.NewMessageRepository(),
+		r.NewMessageUseCase(),
+		r.domainRegistry.NewChannelAccessService(),
+		r.infrastructureRegistry.NewNotificationService(),
+		r.infrastructureRegistry.NewTransactionManager(),
+	)
+}
+
 func (r *UseCaseRegistry) NewAttachmentUseCase() *attachmentuc.Interactor {
 	return attachmentuc.NewInteractor(
 		r.domainRegistry.NewAttachmentRepository(),
@@ -191,6 +292,9 @@ func (r *UseCaseRegistry) NewSearchUseCase() searchuc.SearchUseCase {
 		r.domainRegistry.NewMessageGroupMentionRepository(),
 		r.domainRegistry.NewMessageLinkRepository(),
 		r.domainRegistry.NewAttachmentRepository(),
+		r.domainRegistry.NewMessageShareRepository(),
+		r.domainRegistry.NewPollRepository(),
+		r.domainRegistry.NewChannelAccessService(),
 	)
 }
 
@@ -199,6 +303,7 @@ func (r *UseCaseRegistry) NewDMInteractor() *dmuc.Interactor {
 		r.domainRegistry.NewChannelRepository(),
 		r.domainRegistry.NewChannelMemberRepository(),
 		r.domainRegistry.NewUserRepository(),
+		r.infrastructureRegistry.NewNotificationService(),
 	)
 }
 
@@ -219,3 +324,11 @@ func (r *UseCaseRegistry) NewUserUseCase() useruc.UseCase {
         r.domainRegistry.NewUserRepository(),
     )
 }
+
+func (r *UseCaseRegistry) NewPresenceUseCase() presenceuc.PresenceUseCase {
+	return presenceuc.NewPresenceInteractor(
+		r.domainRegistry.NewWorkspaceRepository(),
+		r.domainRegistry.NewUserRepository(),
+		r.infrastructureRegistry.NewPresenceService(),
+	)
+}
@@ -15,10 +15,14 @@ import (
 type TokenClaims struct {
 	UserID string
 	Email  string
+	// SessionID はアクセストークンを発行したセッションのIDです（セッション導入前のトークンでは空）
+	SessionID string
+	// ExpiresAt はトークンの有効期限です（期限のないトークンではゼロ値）
+	ExpiresAt time.Time
 }
 
 type JWTService interface {
-	GenerateToken(userID string, duration time.Duration) (string, error)
+	GenerateToken(userID string, sessionID string, duration time.Duration) (string, error)
 	VerifyToken(token string) (*TokenClaims, error)
 }
 
@@ -181,12 +185,6 @@ func (i *authInteractor) Logout(ctx context.Context, input LogoutInput) (*Logout
 
 // Helper function to generate auth output with tokens
 func (i *authInteractor) generateAuthOutput(ctx context.Context, user *entity.User) (*AuthOutput, error) {
-	// Generate access token
-	accessToken, err := i.jwtService.GenerateToken(user.ID, i.accessTokenDuration)
-	if err != nil {
-		return nil, err
-	}
-
 	// Generate refresh token (random secure string)
 	refreshToken, err := generateSecureToken()
 	if err != nil {
@@ -210,6 +208,12 @@ func (i *authInteractor) generateAuthOutput(ctx context.Context, user *entity.Us
 		return nil, err
 	}
 
+	// Generate access token bound to the session so that revoking the session invalidates it
+	accessToken, err := i.jwtService.GenerateToken(user.ID, session.ID, i.accessTokenDuration)
+	if err != nil {
+		return nil, err
+	}
+
 	return &AuthOutput{
 		AccessToken:  accessToken,
 		RefreshToken: refreshToken,
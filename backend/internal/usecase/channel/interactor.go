@@ -10,6 +10,7 @@ import (
 	"github.com/newt239/chat/internal/domain/entity"
 	domerr "github.com/newt239/chat/internal/domain/errors"
 	domainrepository "github.com/newt239/chat/internal/domain/repository"
+	"github.com/newt239/chat/internal/domain/service"
 	domaintransaction "github.com/newt239/chat/internal/domain/transaction"
 	"github.com/newt239/chat/internal/usecase/systemmessage"
 )
@@ -32,6 +33,7 @@ type channelInteractor struct {
 	readStateRepo     domainrepository.ReadStateRepository
 	txManager         domaintransaction.Manager
 	systemMessageUC   systemmessage.UseCase
+	notificationSvc   service.NotificationService
 }
 
 func NewChannelInteractor(
@@ -41,6 +43,7 @@ func NewChannelInteractor(
 	readStateRepo domainrepository.ReadStateRepository,
 	txManager domaintransaction.Manager,
 	systemMessageUC systemmessage.UseCase,
+	notificationSvc service.NotificationService,
 ) ChannelUseCase {
 	return &channelInteractor{
 		channelRepo:       channelRepo,
@@ -49,6 +52,7 @@ func NewChannelInteractor(
 		readStateRepo:     readStateRepo,
 		txManager:         txManager,
 		systemMessageUC:   systemMessageUC,
+		notificationSvc:   notificationSvc,
 	}
 }
 
@@ -162,6 +166,10 @@ func (i *channelInteractor) CreateChannel(ctx context.Context, input CreateChann
 		return nil, err
 	}
 
+	if channel.IsPrivate && i.notificationSvc != nil {
+		i.notificationSvc.NotifyChannelMembersAdded(channel.WorkspaceID, channel.ID, []string{input.UserID})
+	}
+
 	output := toChannelOutputWithUnread(channel, false, 0) // 新規作成時はメンション数0
 	return &output, nil
 }
@@ -225,6 +233,20 @@ func (i *channelInteractor) UpdateChannel(ctx context.Context, input UpdateChann
 		return nil, fmt.Errorf("failed to update channel: %w", err)
 	}
 
+	// プライベート化された場合はメンバー以外の購読を取り消す
+	// 以降のシステムメッセージが非メンバーに届かないよう、作成より先に行う
+	if privChanged && ch.IsPrivate && i.notificationSvc != nil {
+		members, err := i.channelMemberRepo.FindMembers(ctx, ch.ID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to find members: %w", err)
+		}
+		memberIDs := make([]string, 0, len(members))
+		for _, m := range members {
+			memberIDs = append(memberIDs, m.UserID)
+		}
+		i.notificationSvc.NotifyChannelPrivatized(ch.WorkspaceID, ch.ID, memberIDs)
+	}
+
 	// 変更に応じてシステムメッセージ作成
 	actorID := input.UserID
 	if i.systemMessageUC != nil {
@@ -10,6 +10,7 @@ import (
 	"github.com/newt239/chat/internal/domain/entity"
 	domerr "github.com/newt239/chat/internal/domain/errors"
 	domainrepository "github.com/newt239/chat/internal/domain/repository"
+	"github.com/newt239/chat/internal/domain/service"
 )
 
 var (
@@ -37,6 +38,7 @@ type channelMemberInteractor struct {
 	channelMemberRepo domainrepository.ChannelMemberRepository
 	workspaceRepo     domainrepository.WorkspaceRepository
 	userRepo          domainrepository.UserRepository
+	notificationSvc   service.NotificationService
 }
 
 func NewChannelMemberInteractor(
@@ -44,12 +46,14 @@ func NewChannelMemberInteractor(
 	channelMemberRepo domainrepository.ChannelMemberRepository,
 	workspaceRepo domainrepository.WorkspaceRepository,
 	userRepo domainrepository.UserRepository,
+	notificationSvc service.NotificationService,
 ) ChannelMemberUseCase {
 	return &channelMemberInteractor{
 		channelRepo:       channelRepo,
 		channelMemberRepo: channelMemberRepo,
 		workspaceRepo:     workspaceRepo,
 		userRepo:          userRepo,
+		notificationSvc:   notificationSvc,
 	}
 }
 
@@ -209,6 +213,10 @@ func (i *channelMemberInteractor) InviteMember(ctx context.Context, input Invite
 		return fmt.Errorf("failed to add member: %w", err)
 	}
 
+	if i.notificationSvc != nil {
+		i.notificationSvc.NotifyChannelMembersAdded(channel.WorkspaceID, channel.ID, []string{input.TargetUserID})
+	}
+
 	return nil
 }
 
@@ -262,6 +270,10 @@ func (i *channelMemberInteractor) JoinPublicChannel(ctx context.Context, input J
 		return fmt.Errorf("failed to add member: %w", err)
 	}
 
+	if i.notificationSvc != nil {
+		i.notificationSvc.NotifyChannelMembersAdded(channel.WorkspaceID, channel.ID, []string{input.UserID})
+	}
+
 	return nil
 }
 
@@ -437,6 +449,14 @@ func (i *channelMemberInteractor) RemoveMember(ctx context.Context, input Remove
 		return fmt.Errorf("failed to remove member: %w", err)
 	}
 
+	// プライベートチャンネルのイベントが届かないよう購読を即座に取り消す
+	if channel.IsPrivate && i.notificationSvc != nil {
+		i.notificationSvc.NotifyChannelMemberRemoved(channel.WorkspaceID, channel.ID, input.TargetUserID)
+	}
+	if i.notificationSvc != nil {
+		i.notificationSvc.NotifyChannelMembersRemoved(channel.WorkspaceID, channel.ID, []string{input.TargetUserID})
+	}
+
 	return nil
 }
 
@@ -488,6 +508,14 @@ func (i *channelMemberInteractor) LeaveChannel(ctx context.Context, input LeaveC
 		return fmt.Errorf("failed to remove member: %w", err)
 	}
 
+	// プライベートチャンネルのイベントが届かないよう購読を即座に取り消す
+	if channel.IsPrivate && i.notificationSvc != nil {
+		i.notificationSvc.NotifyChannelMemberLeft(channel.WorkspaceID, channel.ID, input.UserID)
+	}
+	if i.notificationSvc != nil {
+		i.notificationSvc.NotifyChannelMembersRemoved(channel.WorkspaceID, channel.ID, []string{input.UserID})
+	}
+
 	return nil
 }
 
@@ -6,23 +6,27 @@ import (
 
 	"github.com/newt239/chat/internal/domain/entity"
 	"github.com/newt239/chat/internal/domain/repository"
+	"github.com/newt239/chat/internal/domain/service"
 )
 
 type Interactor struct {
 	channelRepo       repository.ChannelRepository
 	channelMemberRepo repository.ChannelMemberRepository
 	userRepo          repository.UserRepository
+	notificationSvc   service.NotificationService
 }
 
 func NewInteractor(
 	channelRepo repository.ChannelRepository,
 	channelMemberRepo repository.ChannelMemberRepository,
 	userRepo repository.UserRepository,
+	notificationSvc service.NotificationService,
 ) *Interactor {
 	return &Interactor{
 		channelRepo:       channelRepo,
 		channelMemberRepo: channelMemberRepo,
 		userRepo:          userRepo,
+		notificationSvc:   notificationSvc,
 	}
 }
 
@@ -63,6 +67,7 @@ func (i *Interactor) CreateDM(ctx context.Context, input CreateDMInput) (*DMOutp
 		}); err != nil {
 			return nil, err
 		}
+		i.notifyMembersAdded(channel, []string{input.UserID, input.TargetUserID})
 	}
 
 	return i.buildDMOutput(ctx, channel, input.UserID)
@@ -103,11 +108,19 @@ func (i *Interactor) CreateGroupDM(ctx context.Context, input CreateGroupDMInput
 				return nil, err
 			}
 		}
+		i.notifyMembersAdded(channel, input.MemberIDs)
 	}
 
 	return i.buildDMOutput(ctx, channel, input.CreatorID)
 }
 
+// notifyMembersAdded は作成したDMを参加者の接続のサイドバーに追加します
+func (i *Interactor) notifyMembersAdded(channel *entity.Channel, userIDs []string) {
+	if i.notificationSvc != nil {
+		i.notificationSvc.NotifyChannelMembersAdded(channel.WorkspaceID, channel.ID, userIDs)
+	}
+}
+
 func (i *Interactor) ListDMs(ctx context.Context, input ListDMsInput) ([]*DMOutput, error) {
 	channels, err := i.channelRepo.FindUserDMs(ctx, input.WorkspaceID, input.UserID)
 	if err != nil {
@@ -0,0 +1,57 @@
+package draft
+
+import (
+	"time"
+
+	"github.com/newt239/chat/internal/domain/entity"
+)
+
+// ParentIDがnilの場合はチャンネルの下書き、設定されている場合はスレッドの下書きを対象にします
+type GetDraftInput struct {
+	UserID    string
+	ChannelID string
+	ParentID  *string
+}
+
+type SaveDraftInput struct {
+	UserID        string
+	ChannelID     string
+	ParentID      *string
+	Body          string
+	AttachmentIDs []string
+}
+
+type DeleteDraftInput struct {
+	UserID    string
+	ChannelID string
+	ParentID  *string
+}
+
+type ListDraftsInput struct {
+	UserID      string
+	WorkspaceID string
+}
+
+type DraftOutput struct {
+	ID            string    `json:"id"`
+	ChannelID     string    `json:"channelId"`
+	ParentID      *string   `json:"parentId"`
+	Body          string    `json:"body"`
+	AttachmentIDs []string  `json:"attachmentIds"`
+	UpdatedAt     time.Time `json:"updatedAt"`
+}
+
+type ListDraftsOutput struct {
+	Drafts []DraftOutput `json:"drafts"`
+}
+
+func toOutput(d *entity.Draft) DraftOutput {
+	return DraftOutput{
+		ID:            d.ID,
+		ChannelID:     d.ChannelID,
+		ParentID:      d.ParentID,
+		Body:          d.Body,
+		AttachmentIDs: d.AttachmentIDs,
+		UpdatedAt:     d.UpdatedAt,
+	}
+}
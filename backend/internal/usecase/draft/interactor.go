@@ -0,0 +1,185 @@
+package draft
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/newt239/chat/internal/domain/entity"
+	domainerrors "github.com/newt239/chat/internal/domain/errors"
+	domainrepository "github.com/newt239/chat/internal/domain/repository"
+	"github.com/newt239/chat/internal/domain/service"
+)
+
+var (
+	ErrDraftNotFound         = errors.New("下書きが見つかりません")
+	ErrParentMessageNotFound = errors.New("親メッセージが見つかりません")
+	ErrEmptyDraft            = errors.New("本文または添付ファイルを指定してください")
+)
+
+type DraftUseCase interface {
+	GetDraft(ctx context.Context, input GetDraftInput) (*DraftOutput, error)
+	SaveDraft(ctx context.Context, input SaveDraftInput) (*DraftOutput, error)
+	DeleteDraft(ctx context.Context, input DeleteDraftInput) error
+	ListDrafts(ctx context.Context, input ListDraftsInput) (*ListDraftsOutput, error)
+}
+
+type interactor struct {
+	draftRepo        domainrepository.DraftRepository
+	messageRepo      domainrepository.MessageRepository
+	attachmentRepo   domainrepository.AttachmentRepository
+	workspaceRepo    domainrepository.WorkspaceRepository
+	channelAccessSvc service.ChannelAccessService
+	notificationSvc  service.NotificationService
+}
+
+func NewDraftInteractor(
+	draftRepo domainrepository.DraftRepository,
+	messageRepo domainrepository.MessageRepository,
+	attachmentRepo domainrepository.AttachmentRepository,
+	workspaceRepo domainrepository.WorkspaceRepository,
+	channelAccessSvc service.ChannelAccessService,
+	notificationSvc service.NotificationService,
+) DraftUseCase {
+	return &interactor{
+		draftRepo:        draftRepo,
+		messageRepo:      messageRepo,
+		attachmentRepo:   attachmentRepo,
+		workspaceRepo:    workspaceRepo,
+		channelAccessSvc: channelAccessSvc,
+		notificationSvc:  notificationSvc,
+	}
+}
+
+// GetDraft はチャンネル（またはスレッド）の下書きを取得します
+func (i *interactor) GetDraft(ctx context.Context, input GetDraftInput) (*DraftOutput, error) {
+	if _, err := i.channelAccessSvc.EnsureChannelAccess(ctx, input.ChannelID, input.UserID); err != nil {
+		return nil, err
+	}
+
+	d, err := i.draftRepo.Find(ctx, input.UserID, input.ChannelID, input.ParentID)
+	if err != nil {
+		return nil, fmt.Errorf("下書きの取得に失敗しました: %w", err)
+	}
+	if d == nil {
+		return nil, ErrDraftNotFound
+	}
+
+	output := toOutput(d)
+	return &output, nil
+}
+
+// SaveDraft はチャンネル（またはスレッド）の下書きを保存し、ユーザーの他の接続に通知します
+// 投稿できなくなった添付ファイル（期限切れ・投稿済みなど）は下書きから除きます
+func (i *interactor) SaveDraft(ctx context.Context, input SaveDraftInput) (*DraftOutput, error) {
+	channel, err := i.channelAccessSvc.EnsureChannelAccess(ctx, input.ChannelID, input.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	if input.ParentID != nil {
+		parent, err := i.messageRepo.FindByID(ctx, *input.ParentID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch parent message: %w", err)
+		}
+		if parent == nil || parent.ChannelID != channel.ID {
+			return nil, ErrParentMessageNotFound
+		}
+	}
+
+	attachmentIDs := []string{}
+	if len(input.AttachmentIDs) > 0 {
+		attachments, err := i.attachmentRepo.FindPendingByIDsForUser(ctx, input.UserID, input.AttachmentIDs)
+		if err != nil {
+			return nil, fmt.Errorf("添付ファイルの取得に失敗しました: %w", err)
+		}
+		pending := make(map[string]bool, len(attachments))
+		for _, attachment := range attachments {
+			pending[attachment.ID] = true
+		}
+		for _, id := range input.AttachmentIDs {
+			if pending[id] {
+				attachmentIDs = append(attachmentIDs, id)
+			}
+		}
+	}
+
+	if strings.TrimSpace(input.Body) == "" && len(attachmentIDs) == 0 {
+		return nil, ErrEmptyDraft
+	}
+
+	d := &entity.Draft{
+		UserID:        input.UserID,
+		ChannelID:     channel.ID,
+		ParentID:      input.ParentID,
+		Body:          input.Body,
+		AttachmentIDs: attachmentIDs,
+	}
+	if err := i.draftRepo.Save(ctx, d); err != nil {
+		return nil, fmt.Errorf("下書きの保存に失敗しました: %w", err)
+	}
+
+	if i.notificationSvc != nil {
+		i.notificationSvc.NotifyDraftUpdated(channel.WorkspaceID, input.UserID, channel.ID, input.ParentID, d)
+	}
+
+	output := toOutput(d)
+	return &output, nil
+}
+
+// DeleteDraft はチャンネル（またはスレッド）の下書きを削除し、ユーザーの他の接続に通知します
+func (i *interactor) DeleteDraft(ctx context.Context, input DeleteDraftInput) error {
+	channel, err := i.channelAccessSvc.EnsureChannelAccess(ctx, input.ChannelID, input.UserID)
+	if err != nil {
+		return err
+	}
+
+	deleted, err := i.draftRepo.Delete(ctx, input.UserID, channel.ID, input.ParentID)
+	if err != nil {
+		return fmt.Errorf("下書きの削除に失敗しました: %w", err)
+	}
+	if !deleted {
+		return ErrDraftNotFound
+	}
+
+	if i.notificationSvc != nil {
+		i.notificationSvc.NotifyDraftUpdated(channel.WorkspaceID, input.UserID, channel.ID, input.ParentID, nil)
+	}
+	return nil
+}
+
+// ListDrafts はWorkspace内の下書きを更新日時の新しい順で取得します
+// 退出したチャンネルなど、アクセスできなくなったチャンネルの下書きは含めません
+func (i *interactor) ListDrafts(ctx context.Context, input ListDraftsInput) (*ListDraftsOutput, error) {
+	member, err := i.workspaceRepo.FindMember(ctx, input.WorkspaceID, input.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check membership: %w", err)
+	}
+	if member == nil {
+		return nil, domainerrors.ErrUnauthorized
+	}
+
+	drafts, err := i.draftRepo.FindByWorkspaceID(ctx, input.UserID, input.WorkspaceID)
+	if err != nil {
+		return nil, fmt.Errorf("下書きの取得に失敗しました: %w", err)
+	}
+
+	output := &ListDraftsOutput{Drafts: make([]DraftOutput, 0, len(drafts))}
+	accessible := make(map[string]bool)
+	for _, d := range drafts {
+		ok, checked := accessible[d.ChannelID]
+		if !checked {
+			_, err := i.channelAccessSvc.EnsureChannelAccess(ctx, d.ChannelID, input.UserID)
+			if err != nil && !errors.Is(err, domainerrors.ErrChannelNotFound) && !errors.Is(err, domainerrors.ErrUnauthorized) {
+				return nil, err
+			}
+			ok = err == nil
+			accessible[d.ChannelID] = ok
+		}
+		if ok {
+			output.Drafts = append(output.Drafts, toOutput(d))
+		}
+	}
+	return output, nil
+}
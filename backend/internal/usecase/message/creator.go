@@ -2,10 +2,12 @@ package message
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
 	"github.com/newt239/chat/internal/domain/entity"
+	domainerrors "github.com/newt239/chat/internal/domain/errors"
 	domainrepository "github.com/newt239/chat/internal/domain/repository"
 	"github.com/newt239/chat/internal/domain/service"
 	"github.com/newt239/chat/internal/domain/transaction"
@@ -23,13 +25,17 @@ type MessageCreator struct {
 	linkRepo              domainrepository.MessageLinkRepository
 	threadRepo            domainrepository.ThreadRepository
 	attachmentRepo        domainrepository.AttachmentRepository
+	draftRepo             domainrepository.DraftRepository
+	shareRepo             domainrepository.MessageShareRepository
+	pollRepo              domainrepository.PollRepository
 	ogpService            service.OGPService
 	notificationSvc       service.NotificationService
 	mentionService        service.MentionService
 	linkProcessingService service.LinkProcessingService
 	transactionManager    transaction.Manager
 	assembler             *MessageOutputAssembler
-    channelAccessSvc      service.ChannelAccessService
+	outputBuilder         *MessageOutputBuilder
+	channelAccessSvc      service.ChannelAccessService
 }
 
 func NewMessageCreator(
@@ -44,13 +50,17 @@ func NewMessageCreator(
 	linkRepo domainrepository.MessageLinkRepository,
 	threadRepo domainrepository.ThreadRepository,
 	attachmentRepo domainrepository.AttachmentRepository,
+	draftRepo domainrepository.DraftRepository,
+	shareRepo domainrepository.MessageShareRepository,
+	pollRepo domainrepository.PollRepository,
 	ogpService service.OGPService,
 	notificationSvc service.NotificationService,
 	mentionService service.MentionService,
 	linkProcessingService service.LinkProcessingService,
 	transactionManager transaction.Manager,
-    channelAccessSvc service.ChannelAccessService,
+	channelAccessSvc service.ChannelAccessService,
 ) *MessageCreator {
+	assembler := NewMessageOutputAssembler()
 	return &MessageCreator{
 		messageRepo:           messageRepo,
 		channelRepo:           channelRepo,
@@ -63,24 +73,52 @@ func NewMessageCreator(
 		linkRepo:              linkRepo,
 		threadRepo:            threadRepo,
 		attachmentRepo:        attachmentRepo,
+		draftRepo:             draftRepo,
+		shareRepo:             shareRepo,
+		pollRepo:              pollRepo,
 		ogpService:            ogpService,
 		notificationSvc:       notificationSvc,
 		mentionService:        mentionService,
 		linkProcessingService: linkProcessingService,
 		transactionManager:    transactionManager,
-		assembler:             NewMessageOutputAssembler(),
-        channelAccessSvc:      channelAccessSvc,
+		assembler:             assembler,
+		outputBuilder: NewMessageOutputBuilder(
+			messageRepo,
+			userRepo,
+			userGroupRepo,
+			userMentionRepo,
+			groupMentionRepo,
+			linkRepo,
+			attachmentRepo,
+			shareRepo,
+			pollRepo,
+			channelAccessSvc,
+			assembler,
+		),
+		channelAccessSvc: channelAccessSvc,
 	}
 }
 
 func (c *MessageCreator) CreateMessage(ctx context.Context, input CreateMessageInput) (*MessageOutput, error) {
-    channel, err := c.channelAccessSvc.EnsureChannelAccess(ctx, input.ChannelID, input.UserID)
+	channel, err := c.channelAccessSvc.EnsureChannelAccess(ctx, input.ChannelID, input.UserID)
 	if err != nil {
 		return nil, err
 	}
 
+	// 再送された場合は新規作成せず既存のメッセージを返す
+	if input.ClientMsgID != nil {
+		existing, err := c.findByClientMsgID(ctx, channel.ID, input.UserID, *input.ClientMsgID)
+		if err != nil {
+			return nil, err
+		}
+		if existing != nil {
+			return existing, nil
+		}
+	}
+
+	var parent *entity.Message
 	if input.ParentID != nil {
-		parent, err := c.messageRepo.FindByID(ctx, *input.ParentID)
+		parent, err = c.messageRepo.FindByID(ctx, *input.ParentID)
 		if err != nil {
 			return nil, fmt.Errorf("failed to fetch parent message: %w", err)
 		}
@@ -89,27 +127,80 @@ func (c *MessageCreator) CreateMessage(ctx context.Context, input CreateMessageI
 		}
 	}
 
+	var source *entity.Message
+	var sourceChannel *entity.Channel
+	if input.SharedMessageID != nil {
+		source, sourceChannel, err = c.findSharedMessage(ctx, *input.SharedMessageID, input.UserID, channel)
+		if err != nil {
+			return nil, err
+		}
+	} else if input.Body == "" {
+		return nil, ErrEmptyMessageBody
+	}
+
 	var result *MessageOutput
+	draftDeleted := false
 	err = c.transactionManager.Do(ctx, func(txCtx context.Context) error {
 		message := &entity.Message{
-			ChannelID: channel.ID,
-			UserID:    input.UserID,
-			ParentID:  input.ParentID,
-			Body:      input.Body,
-			CreatedAt: time.Now(),
+			ChannelID:   channel.ID,
+			UserID:      input.UserID,
+			ParentID:    input.ParentID,
+			Body:        input.Body,
+			BodyAST:     entity.ParseMessageBody(input.Body),
+			CreatedAt:   time.Now(),
+			ClientMsgID: input.ClientMsgID,
 		}
 
 		if err := c.messageRepo.Create(txCtx, message); err != nil {
 			return fmt.Errorf("failed to create message: %w", err)
 		}
 
+		if source != nil {
+			share := &entity.MessageShare{
+				MessageID:       message.ID,
+				SourceMessageID: source.ID,
+				CreatedAt:       message.CreatedAt,
+			}
+			if err := c.shareRepo.Create(txCtx, share); err != nil {
+				return fmt.Errorf("failed to create message share: %w", err)
+			}
+		}
+
+		var poll *entity.Poll
+		if input.Poll != nil {
+			poll = &entity.Poll{
+				MessageID:      message.ID,
+				MultipleChoice: input.Poll.MultipleChoice,
+				Anonymous:      input.Poll.Anonymous,
+				ClosesAt:       input.Poll.ClosesAt,
+				Options:        input.Poll.Options,
+			}
+			if err := c.pollRepo.Create(txCtx, poll); err != nil {
+				return fmt.Errorf("failed to create poll: %w", err)
+			}
+		}
+
+		if parent != nil {
+			if err := c.followThread(txCtx, parent, message); err != nil {
+				return err
+			}
+		}
+
 		if len(input.AttachmentIDs) > 0 {
 			if err := c.attachmentRepo.AttachToMessage(txCtx, input.AttachmentIDs, message.ID); err != nil {
 				return fmt.Errorf("failed to attach files: %w", err)
 			}
 		}
 
-		if err := c.extractAndSaveMentionsAndLinks(txCtx, message.ID, input.Body, channel.WorkspaceID); err != nil {
+		// 投稿したチャンネル（またはスレッド）の下書きは不要になるため削除する
+		if !input.KeepDraft {
+			draftDeleted, err = c.draftRepo.Delete(txCtx, input.UserID, channel.ID, input.ParentID)
+			if err != nil {
+				return fmt.Errorf("failed to delete draft: %w", err)
+			}
+		}
+
+		if err := c.extractAndSaveMentionsAndLinks(txCtx, message.ID, message.BodyAST, channel.WorkspaceID); err != nil {
 			return fmt.Errorf("failed to extract mentions and links: %w", err)
 		}
 
@@ -160,24 +251,142 @@ func (c *MessageCreator) CreateMessage(ctx context.Context, input CreateMessageI
 		userMap := map[string]*entity.User{user.ID: user}
 
 		output := c.assembler.AssembleMessageOutput(message, user, userMentions, groupMentions, links, reactions, attachmentList, groups, userMap)
+		if source != nil {
+			author, err := c.userRepo.FindByID(txCtx, source.UserID)
+			if err != nil {
+				return fmt.Errorf("failed to fetch shared message author: %w", err)
+			}
+			output.SharedMessage = &SharedMessageOutput{
+				MessageID: source.ID,
+				Preview:   c.assembler.AssembleSharedMessagePreview(source, sourceChannel, author),
+			}
+		}
+		if poll != nil {
+			output.Poll = c.assembler.AssemblePollOutput(poll, input.UserID, time.Now())
+		}
 		result = &output
 
 		return nil
 	})
 
 	if err != nil {
+		// 同じclient_msg_idで並行して作成された場合は一意制約で失敗するため、先に作成された方を返す
+		if input.ClientMsgID != nil {
+			existing, findErr := c.findByClientMsgID(ctx, channel.ID, input.UserID, *input.ClientMsgID)
+			if findErr == nil && existing != nil {
+				return existing, nil
+			}
+		}
 		return nil, err
 	}
 
 	if c.notificationSvc != nil {
-		c.notificationSvc.NotifyNewMessage(channel.WorkspaceID, channel.ID, *result)
+		broadcast := *result
+		if sourceChannel != nil && (sourceChannel.IsPrivate || sourceChannel.IsDirectMessage()) {
+			// 共有先のメンバー全員が共有元のチャンネルにアクセスできるとは限らないため、通知にはプレビューを含めない
+			broadcast.SharedMessage = &SharedMessageOutput{MessageID: source.ID}
+		}
+		if parent != nil {
+			c.notifyThreadReply(ctx, channel, parent.ID, &broadcast)
+		} else {
+			c.notificationSvc.NotifyNewMessage(channel.WorkspaceID, channel.ID, broadcast)
+		}
+		if draftDeleted {
+			c.notificationSvc.NotifyDraftUpdated(channel.WorkspaceID, input.UserID, channel.ID, input.ParentID, nil)
+		}
 	}
 
 	return result, nil
 }
 
-func (c *MessageCreator) extractAndSaveMentionsAndLinks(ctx context.Context, messageID, body, workspaceID string) error {
-	userMentions, err := c.mentionService.ExtractUserMentions(ctx, body, workspaceID)
+// findSharedMessage は共有するメッセージと、そのメッセージのチャンネルを取得します
+// 投稿者がアクセスできない共有元は存在しないものとして扱います
+func (c *MessageCreator) findSharedMessage(ctx context.Context, messageID, userID string, target *entity.Channel) (*entity.Message, *entity.Channel, error) {
+	source, err := c.messageRepo.FindByID(ctx, messageID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to fetch shared message: %w", err)
+	}
+	if source == nil || source.DeletedAt != nil {
+		return nil, nil, ErrSharedMessageNotFound
+	}
+
+	sourceChannel, err := c.channelAccessSvc.EnsureChannelAccess(ctx, source.ChannelID, userID)
+	if err != nil {
+		if errors.Is(err, domainerrors.ErrChannelNotFound) || errors.Is(err, domainerrors.ErrUnauthorized) {
+			return nil, nil, ErrSharedMessageNotFound
+		}
+		return nil, nil, err
+	}
+	if sourceChannel.WorkspaceID != target.WorkspaceID {
+		return nil, nil, ErrShareAcrossWorkspaces
+	}
+	return source, sourceChannel, nil
+}
+
+// followThread は返信したユーザーと親メッセージの投稿者にスレッドをフォローさせ、返信したユーザーのスレッドを既読にします
+func (c *MessageCreator) followThread(ctx context.Context, parent *entity.Message, reply *entity.Message) error {
+	if err := c.threadRepo.FollowThread(ctx, reply.UserID, parent.ID); err != nil {
+		return fmt.Errorf("failed to follow thread: %w", err)
+	}
+	if parent.UserID != reply.UserID {
+		if err := c.threadRepo.FollowThread(ctx, parent.UserID, parent.ID); err != nil {
+			return fmt.Errorf("failed to follow thread for parent author: %w", err)
+		}
+	}
+	if err := c.threadRepo.UpsertReadState(ctx, reply.UserID, parent.ID, reply.CreatedAt); err != nil {
+		return fmt.Errorf("failed to update thread read state: %w", err)
+	}
+	return nil
+}
+
+// notifyThreadReply はスレッドへの返信をスレッドの購読者に通知し、更新したメタデータをチャンネルの購読者に、
+// 未読数をスレッドをフォローしているユーザーに通知します
+func (c *MessageCreator) notifyThreadReply(ctx context.Context, channel *entity.Channel, threadID string, reply *MessageOutput) {
+	c.notificationSvc.NotifyThreadReply(channel.WorkspaceID, channel.ID, threadID, *reply)
+
+	metadata, err := c.threadRepo.CalculateMetadataByMessageID(ctx, threadID)
+	if err != nil || metadata == nil {
+		fmt.Printf("[WARN] Failed to calculate thread metadata for notification: threadID=%s err=%v\n", threadID, err)
+		return
+	}
+	c.notificationSvc.NotifyThreadUpdated(channel.WorkspaceID, channel.ID, metadata)
+
+	for _, userID := range metadata.ParticipantUserIDs {
+		if userID == reply.UserID {
+			continue
+		}
+		count, err := c.threadRepo.CountUnreadReplies(ctx, userID, threadID)
+		if err != nil {
+			fmt.Printf("[WARN] Failed to count unread thread replies for notification: threadID=%s userID=%s err=%v\n", threadID, userID, err)
+			continue
+		}
+		c.notificationSvc.NotifyThreadUnread(channel.WorkspaceID, userID, channel.ID, threadID, count)
+	}
+}
+
+// findByClientMsgID はclient_msg_idで作成済みのメッセージを検索します
+// 存在しない場合はnilを返します
+func (c *MessageCreator) findByClientMsgID(ctx context.Context, channelID, userID, clientMsgID string) (*MessageOutput, error) {
+	message, err := c.messageRepo.FindByClientMsgID(ctx, userID, clientMsgID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch message by client_msg_id: %w", err)
+	}
+	if message == nil {
+		return nil, nil
+	}
+	if message.ChannelID != channelID {
+		return nil, ErrClientMsgIDConflict
+	}
+
+	outputs, err := c.outputBuilder.Build(ctx, []*entity.Message{message}, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build message output: %w", err)
+	}
+	return &outputs[0], nil
+}
+
+func (c *MessageCreator) extractAndSaveMentionsAndLinks(ctx context.Context, messageID string, bodyAST []entity.MessageNode, workspaceID string) error {
+	userMentions, err := c.mentionService.ExtractUserMentions(ctx, bodyAST, workspaceID)
 	if err != nil {
 		return fmt.Errorf("failed to extract user mentions: %w", err)
 	}
@@ -189,7 +398,7 @@ func (c *MessageCreator) extractAndSaveMentionsAndLinks(ctx context.Context, mes
 		}
 	}
 
-	groupMentions, err := c.mentionService.ExtractGroupMentions(ctx, body, workspaceID)
+	groupMentions, err := c.mentionService.ExtractGroupMentions(ctx, bodyAST, workspaceID)
 	if err != nil {
 		return fmt.Errorf("failed to extract group mentions: %w", err)
 	}
@@ -201,7 +410,7 @@ func (c *MessageCreator) extractAndSaveMentionsAndLinks(ctx context.Context, mes
 		}
 	}
 
-	links, err := c.linkProcessingService.ProcessLinks(ctx, body)
+	links, err := c.linkProcessingService.ProcessLinks(ctx, bodyAST)
 	if err != nil {
 		return fmt.Errorf("failed to process links: %w", err)
 	}
@@ -0,0 +1,53 @@
+package message
+
+import (
+	"encoding/base64"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/newt239/chat/internal/domain/entity"
+)
+
+// encodeTimelineCursor はタイムライン上の位置をクライアントに返す不透明なカーソルに変換します
+func encodeTimelineCursor(cursor entity.TimelineCursor) string {
+	raw := cursor.CreatedAt.UTC().Format(time.RFC3339Nano) + "|" + cursor.ID
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+// decodeTimelineCursor は encodeTimelineCursor で作成したカーソルを解析します
+func decodeTimelineCursor(value string) (entity.TimelineCursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return entity.TimelineCursor{}, ErrInvalidCursor
+	}
+	createdAtStr, id, ok := strings.Cut(string(raw), "|")
+	if !ok {
+		return entity.TimelineCursor{}, ErrInvalidCursor
+	}
+	createdAt, err := time.Parse(time.RFC3339Nano, createdAtStr)
+	if err != nil {
+		return entity.TimelineCursor{}, ErrInvalidCursor
+	}
+	if _, err := uuid.Parse(id); err != nil {
+		return entity.TimelineCursor{}, ErrInvalidCursor
+	}
+	return entity.TimelineCursor{CreatedAt: createdAt, ID: id}, nil
+}
+
+// timelineItemCursor はタイムラインの項目の位置を返します
+func timelineItemCursor(item TimelineItem) entity.TimelineCursor {
+	return entity.TimelineCursor{CreatedAt: item.CreatedAt, ID: timelineItemID(item)}
+}
+
+func timelineItemID(item TimelineItem) string {
+	switch {
+	case item.UserMessage != nil:
+		return item.UserMessage.ID
+	case item.SystemMessage != nil:
+		return item.SystemMessage.ID
+	case item.EphemeralMessage != nil:
+		return item.EphemeralMessage.ID
+	}
+	return ""
+}
@@ -96,10 +96,10 @@ func (d *MessageDeleter) DeleteMessage(ctx context.Context, input DeleteMessageI
 
 	// WebSocket通知を送信
 	if d.notificationSvc != nil {
-		deleteData := map[string]interface{}{
-			"messageId":  message.ID,
-			"channelId":  message.ChannelID,
-			"deletedIds": deleteIDs,
+		deleteData := DeletedMessageOutput{
+			MessageID:  message.ID,
+			ChannelID:  message.ChannelID,
+			DeletedIDs: deleteIDs,
 		}
 		d.notificationSvc.NotifyDeletedMessage(channel.WorkspaceID, channel.ID, deleteData)
 	}
@@ -14,6 +14,12 @@ var (
 	ErrMessageNotFound       = errors.New("メッセージが見つかりません")
 	ErrMessageAlreadyDeleted = errors.New("メッセージは既に削除されています")
 	ErrCannotEditDeleted     = errors.New("削除済みメッセージは編集できません")
+	ErrClientMsgIDConflict   = errors.New("client_msg_idは別のチャンネルのメッセージで使用されています")
+	ErrEmptyMessageBody      = errors.New("本文を入力してください")
+	ErrSharedMessageNotFound = errors.New("共有元のメッセージが見つかりません")
+	ErrShareAcrossWorkspaces = errors.New("別のワークスペースのチャンネルには共有できません")
+	ErrInvalidCursor         = errors.New("カーソルが不正です")
+	ErrConflictingListRange  = errors.New("around・before・after・since・untilは同時に指定できません")
 )
 
 const (
@@ -27,6 +33,11 @@ type ListMessagesInput struct {
 	Limit     int
 	Since     *time.Time
 	Until     *time.Time
+	// Around を指定すると、メッセージの前後それぞれ最大Limit件を取得します
+	Around *string
+	// Before・After には ListMessagesOutput のカーソルを指定し、その位置より古い・新しい項目を取得します
+	Before *string
+	After  *string
 }
 
 type CreateMessageInput struct {
@@ -35,6 +46,26 @@ type CreateMessageInput struct {
 	Body          string
 	ParentID      *string
 	AttachmentIDs []string
+	// ClientMsgID を指定すると、同じ値での再送時に新規作成せず既存のメッセージを返します
+	ClientMsgID *string
+	// KeepDraft がtrueの場合は投稿したチャンネル（またはスレッド）の下書きを削除しません
+	// 予約メッセージの投稿など、ユーザーが入力欄から投稿しない場合に使用します
+	KeepDraft bool
+	// SharedMessageID を指定すると、指定したメッセージを共有するメッセージを作成します
+	// 共有する場合は本文を省略できます
+	SharedMessageID *string
+	// Poll を指定すると、本文を質問とする投票を作成します
+	Poll *entity.Poll
+}
+
+// ShareMessageInput はメッセージを別のチャンネル（またはスレッド）に転送・共有する入力です
+type ShareMessageInput struct {
+	MessageID   string
+	ChannelID   string
+	UserID      string
+	Body        string
+	ParentID    *string
+	ClientMsgID *string
 }
 
 type UpdateMessageInput struct {
@@ -44,12 +75,39 @@ type UpdateMessageInput struct {
 	Body      string
 }
 
+type ListMessageRevisionsInput struct {
+	MessageID string
+	UserID    string
+}
+
 type DeleteMessageInput struct {
 	MessageID  string
 	ChannelID  string
 	ExecutorID string
 }
 
+// DeletedMessageOutput はメッセージ削除の通知内容を表します
+// DeletedIDsには削除したメッセージとその返信のIDが含まれます
+type DeletedMessageOutput struct {
+	MessageID  string   `json:"messageId"`
+	ChannelID  string   `json:"channelId"`
+	DeletedIDs []string `json:"deletedIds"`
+}
+
+// MessageRevisionOutput はメッセージの編集前の本文を表します
+type MessageRevisionOutput struct {
+	ID       string    `json:"id"`
+	Body     string    `json:"body"`
+	Editor   UserInfo  `json:"editor"`
+	EditedAt time.Time `json:"editedAt"`
+}
+
+// ListMessageRevisionsOutput はメッセージの編集履歴を古い順で表します
+type ListMessageRevisionsOutput struct {
+	MessageID string                  `json:"messageId"`
+	Revisions []MessageRevisionOutput `json:"revisions"`
+}
+
 type UserInfo struct {
 	ID          string  `json:"id"`
 	DisplayName string  `json:"displayName"`
@@ -96,6 +154,8 @@ type MessageOutput struct {
 	User        UserInfo         `json:"user"`
 	ParentID    *string          `json:"parentId"`
 	Body        string           `json:"body"`
+	// BodyAST は本文を解析した書式付きテキストです
+	BodyAST     []MessageNodeOutput `json:"bodyAst"`
 	Mentions    []UserMention    `json:"mentions"`
 	Groups      []GroupMention   `json:"groups"`
 	Links       []LinkInfo       `json:"links"`
@@ -106,11 +166,82 @@ type MessageOutput struct {
 	DeletedAt   *time.Time       `json:"deletedAt"`
 	IsDeleted   bool             `json:"isDeleted"`
 	DeletedBy   *UserInfo        `json:"deletedBy,omitempty"`
+	ClientMsgID *string          `json:"clientMsgId,omitempty"`
+	// RevisionCount は保存している編集履歴の件数です
+	RevisionCount int            `json:"revisionCount"`
+	// SharedMessage は転送・共有したメッセージの共有元です
+	SharedMessage *SharedMessageOutput `json:"sharedMessage,omitempty"`
+	// ShareCount はこのメッセージが他のチャンネルに共有された回数です
+	ShareCount int `json:"shareCount"`
+	// Poll は投票のメッセージの投票の状態です
+	Poll *PollOutput `json:"poll,omitempty"`
+	// ReadBy はDM・グループDMでこのメッセージまで既読にしたメンバーのユーザーIDです
+	ReadBy      []string         `json:"readBy,omitempty"`
+}
+
+// SharedMessageOutput は転送・共有したメッセージの共有元を表します
+// 閲覧者が共有元のチャンネルにアクセスできない場合や、共有元が削除された場合はPreviewがnilです
+type SharedMessageOutput struct {
+	MessageID string                `json:"messageId"`
+	Preview   *SharedMessagePreview `json:"preview"`
+}
+
+// SharedMessagePreview は共有元のメッセージのプレビューです
+type SharedMessagePreview struct {
+	ChannelID   string     `json:"channelId"`
+	ChannelName string     `json:"channelName"`
+	User        UserInfo   `json:"user"`
+	Body        string     `json:"body"`
+	CreatedAt   time.Time  `json:"createdAt"`
+	EditedAt    *time.Time `json:"editedAt"`
+}
+
+// PollOutput は投票の状態を表します
+type PollOutput struct {
+	ID             string             `json:"id"`
+	MultipleChoice bool               `json:"multipleChoice"`
+	Anonymous      bool               `json:"anonymous"`
+	ClosesAt       *time.Time         `json:"closesAt"`
+	IsClosed       bool               `json:"isClosed"`
+	// VoterCount はいずれかの選択肢に投票したユーザーの人数です
+	VoterCount int                `json:"voterCount"`
+	Options    []PollOptionOutput `json:"options"`
+}
+
+// PollOptionOutput は投票の選択肢と集計を表します
+// 匿名の投票ではVoterIDsを含みません
+type PollOptionOutput struct {
+	ID        string `json:"id"`
+	Text      string `json:"text"`
+	VoteCount int    `json:"voteCount"`
+	// Voted は閲覧者がこの選択肢に投票しているかを表します
+	Voted    bool     `json:"voted"`
+	VoterIDs []string `json:"voterIds,omitempty"`
+}
+
+// MessageNodeOutput は本文を解析した書式付きテキストの要素を表します
+// 要素の種類（Type）によって使用しないフィールドは省略します
+type MessageNodeOutput struct {
+	Type     string              `json:"type"`
+	Text     string              `json:"text,omitempty"`
+	Name     string              `json:"name,omitempty"`
+	URL      string              `json:"url,omitempty"`
+	Language string              `json:"language,omitempty"`
+	Ordered  bool                `json:"ordered,omitempty"`
+	Children []MessageNodeOutput `json:"children,omitempty"`
 }
 
 type ListMessagesOutput struct {
     Messages []TimelineItem `json:"messages"`
-	HasMore  bool            `json:"hasMore"`
+	// HasMore は取得した範囲より古い項目が残っているかを示します。after を指定した場合は判定しません
+	HasMore bool `json:"hasMore"`
+	// HasMoreAfter は取得した範囲より新しい項目が残っているかを示します。after・around を指定した場合のみ判定します
+	HasMoreAfter bool `json:"hasMoreAfter"`
+	// BeforeCursor・AfterCursor は before・after に指定して、より古い・新しい項目を取得するためのカーソルです
+	BeforeCursor *string `json:"beforeCursor"`
+	AfterCursor  *string `json:"afterCursor"`
+	// AnchorID は around に指定したメッセージのIDです。スレッドの返信を指定した場合は親メッセージのIDです
+	AnchorID *string `json:"anchorId,omitempty"`
 }
 
 type ThreadMetadataOutput struct {
@@ -153,12 +284,24 @@ type SystemMessageOutput struct {
     CreatedAt time.Time              `json:"createdAt"`
 }
 
+// EphemeralMessageOutput は閲覧者のみに表示する一時的なメッセージの出力です
+type EphemeralMessageOutput struct {
+    ID        string         `json:"id"`
+    ChannelID string         `json:"channelId"`
+    Kind      string         `json:"kind"`
+    Body      string         `json:"body"`
+    Payload   map[string]any `json:"payload,omitempty"`
+    CreatedAt time.Time      `json:"createdAt"`
+    ExpiresAt *time.Time     `json:"expiresAt,omitempty"`
+}
+
 // TimelineItem はユーザー/システム両メッセージの統合タイムライン項目です
 type TimelineItem struct {
-    Type          string               `json:"type"` // "user" | "system"
-    UserMessage   *MessageOutput       `json:"userMessage,omitempty"`
-    SystemMessage *SystemMessageOutput `json:"systemMessage,omitempty"`
-    CreatedAt     time.Time            `json:"createdAt"`
+    Type             string                  `json:"type"` // "user" | "system" | "ephemeral"
+    UserMessage      *MessageOutput          `json:"userMessage,omitempty"`
+    SystemMessage    *SystemMessageOutput    `json:"systemMessage,omitempty"`
+    EphemeralMessage *EphemeralMessageOutput `json:"ephemeralMessage,omitempty"`
+    CreatedAt        time.Time               `json:"createdAt"`
 }
 
 // RelatedData はメッセージに関連するデータをまとめた構造体です
@@ -218,6 +361,7 @@ func (a *MessageOutputAssembler) AssembleMessageOutput(
 		User:        userInfo,
 		ParentID:    message.ParentID,
 		Body:        message.Body,
+		BodyAST:     a.buildBodyAST(message),
 		Mentions:    a.buildUserMentions(userMentions),
 		Groups:      a.buildGroupMentions(groupMentions, groups),
 		Links:       a.buildLinks(links),
@@ -228,6 +372,47 @@ func (a *MessageOutputAssembler) AssembleMessageOutput(
 		DeletedAt:   message.DeletedAt,
 		IsDeleted:   isDeleted,
 		DeletedBy:   deletedByInfo,
+		ClientMsgID: message.ClientMsgID,
+		RevisionCount: message.RevisionCount,
+	}
+}
+
+// AssembleSharedMessagePreview は共有元のメッセージと関連データから共有元のプレビューを構築します
+func (a *MessageOutputAssembler) AssembleSharedMessagePreview(source *entity.Message, channel *entity.Channel, author *entity.User) *SharedMessagePreview {
+	return &SharedMessagePreview{
+		ChannelID:   channel.ID,
+		ChannelName: channel.Name,
+		User:        a.buildUserInfo(author),
+		Body:        source.Body,
+		CreatedAt:   source.CreatedAt,
+		EditedAt:    source.EditedAt,
+	}
+}
+
+// AssemblePollOutput は投票からviewerIDのユーザーに返す投票の状態を構築します
+func (a *MessageOutputAssembler) AssemblePollOutput(poll *entity.Poll, viewerID string, now time.Time) *PollOutput {
+	options := make([]PollOptionOutput, 0, len(poll.Options))
+	for _, option := range poll.Options {
+		optionOutput := PollOptionOutput{
+			ID:        option.ID,
+			Text:      option.Text,
+			VoteCount: len(option.VoterIDs),
+			Voted:     viewerID != "" && option.HasVoted(viewerID),
+		}
+		if !poll.Anonymous {
+			optionOutput.VoterIDs = option.VoterIDs
+		}
+		options = append(options, optionOutput)
+	}
+
+	return &PollOutput{
+		ID:             poll.ID,
+		MultipleChoice: poll.MultipleChoice,
+		Anonymous:      poll.Anonymous,
+		ClosesAt:       poll.ClosesAt,
+		IsClosed:       poll.IsClosed(now),
+		VoterCount:     poll.VoterCount(),
+		Options:        options,
 	}
 }
 
@@ -248,6 +433,35 @@ func (a *MessageOutputAssembler) buildUserInfo(user *entity.User) UserInfo {
 	}
 }
 
+// buildBodyAST は本文の書式付きテキストを構築します
+// 書式付きテキストが保存されていない古いメッセージは本文を解析します
+func (a *MessageOutputAssembler) buildBodyAST(message *entity.Message) []MessageNodeOutput {
+	nodes := message.BodyAST
+	if nodes == nil {
+		nodes = entity.ParseMessageBody(message.Body)
+	}
+	return a.buildMessageNodes(nodes)
+}
+
+func (a *MessageOutputAssembler) buildMessageNodes(nodes []entity.MessageNode) []MessageNodeOutput {
+	outputs := make([]MessageNodeOutput, 0, len(nodes))
+	for _, node := range nodes {
+		output := MessageNodeOutput{
+			Type:     string(node.Type),
+			Text:     node.Text,
+			Name:     node.Name,
+			URL:      node.URL,
+			Language: node.Language,
+			Ordered:  node.Ordered,
+		}
+		if len(node.Children) > 0 {
+			output.Children = a.buildMessageNodes(node.Children)
+		}
+		outputs = append(outputs, output)
+	}
+	return outputs
+}
+
 // buildUserMentions はユーザーメンションを構築します
 func (a *MessageOutputAssembler) buildUserMentions(userMentions []*entity.MessageUserMention) []UserMention {
 	mentions := make([]UserMention, 0, len(userMentions))
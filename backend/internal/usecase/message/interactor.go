@@ -12,7 +12,9 @@ import (
 type MessageUseCase interface {
 	ListMessages(ctx context.Context, input ListMessagesInput) (*ListMessagesOutput, error)
 	CreateMessage(ctx context.Context, input CreateMessageInput) (*MessageOutput, error)
+	ShareMessage(ctx context.Context, input ShareMessageInput) (*MessageOutput, error)
 	UpdateMessage(ctx context.Context, input UpdateMessageInput) (*MessageOutput, error)
+	ListMessageRevisions(ctx context.Context, input ListMessageRevisionsInput) (*ListMessageRevisionsOutput, error)
 	DeleteMessage(ctx context.Context, input DeleteMessageInput) error
 	GetThreadReplies(ctx context.Context, input GetThreadRepliesInput) (*GetThreadRepliesOutput, error)
 	GetThreadMetadata(ctx context.Context, input GetThreadMetadataInput) (*ThreadMetadataOutput, error)
@@ -31,6 +33,7 @@ type messageInteractor struct {
 func NewMessageUseCase(
 	messageRepo domainrepository.MessageRepository,
 	systemMsgRepo domainrepository.SystemMessageRepository,
+	ephemeralRepo domainrepository.EphemeralMessageRepository,
 	channelRepo domainrepository.ChannelRepository,
 	channelMemberRepo domainrepository.ChannelMemberRepository,
 	workspaceRepo domainrepository.WorkspaceRepository,
@@ -41,6 +44,11 @@ func NewMessageUseCase(
 	linkRepo domainrepository.MessageLinkRepository,
 	threadRepo domainrepository.ThreadRepository,
 	attachmentRepo domainrepository.AttachmentRepository,
+	readStateRepo domainrepository.ReadStateRepository,
+	revisionRepo domainrepository.MessageRevisionRepository,
+	draftRepo domainrepository.DraftRepository,
+	shareRepo domainrepository.MessageShareRepository,
+	pollRepo domainrepository.PollRepository,
 	ogpService service.OGPService,
 	notificationSvc service.NotificationService,
 	mentionService service.MentionService,
@@ -62,6 +70,9 @@ func NewMessageUseCase(
 		linkRepo,
 		threadRepo,
 		attachmentRepo,
+		draftRepo,
+		shareRepo,
+		pollRepo,
 		ogpService,
 		notificationSvc,
 		mentionService,
@@ -81,6 +92,9 @@ func NewMessageUseCase(
 		groupMentionRepo,
 		linkRepo,
 		attachmentRepo,
+		revisionRepo,
+		shareRepo,
+		pollRepo,
 		notificationSvc,
 		mentionService,
 		linkProcessingService,
@@ -102,6 +116,7 @@ func NewMessageUseCase(
 	lister := NewMessageLister(
 		messageRepo,
 		systemMsgRepo,
+		ephemeralRepo,
 		channelRepo,
 		channelMemberRepo,
 		workspaceRepo,
@@ -112,6 +127,9 @@ func NewMessageUseCase(
 		linkRepo,
 		threadRepo,
 		attachmentRepo,
+		readStateRepo,
+		shareRepo,
+		pollRepo,
 		channelAccessSvc,
 	)
 
@@ -133,11 +151,30 @@ func (i *messageInteractor) CreateMessage(ctx context.Context, input CreateMessa
 	return i.creator.CreateMessage(ctx, input)
 }
 
+// ShareMessage はメッセージを別のチャンネル（またはスレッド）に転送・共有します
+func (i *messageInteractor) ShareMessage(ctx context.Context, input ShareMessageInput) (*MessageOutput, error) {
+	return i.creator.CreateMessage(ctx, CreateMessageInput{
+		ChannelID:       input.ChannelID,
+		UserID:          input.UserID,
+		Body:            input.Body,
+		ParentID:        input.ParentID,
+		ClientMsgID:     input.ClientMsgID,
+		SharedMessageID: &input.MessageID,
+		// 共有は入力欄からの投稿ではないため、共有先の下書きは残す
+		KeepDraft: true,
+	})
+}
+
 // UpdateMessage はメッセージを更新します
 func (i *messageInteractor) UpdateMessage(ctx context.Context, input UpdateMessageInput) (*MessageOutput, error) {
 	return i.updater.UpdateMessage(ctx, input)
 }
 
+// ListMessageRevisions はメッセージの編集履歴を取得します
+func (i *messageInteractor) ListMessageRevisions(ctx context.Context, input ListMessageRevisionsInput) (*ListMessageRevisionsOutput, error) {
+	return i.updater.ListRevisions(ctx, input)
+}
+
 // DeleteMessage はメッセージを削除します
 func (i *messageInteractor) DeleteMessage(ctx context.Context, input DeleteMessageInput) error {
 	return i.deleter.DeleteMessage(ctx, input)
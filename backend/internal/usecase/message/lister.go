@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"sort"
+	"time"
 
 	"github.com/newt239/chat/internal/domain/entity"
 	domainrepository "github.com/newt239/chat/internal/domain/repository"
@@ -14,6 +15,7 @@ import (
 type MessageLister struct {
 	messageRepo       domainrepository.MessageRepository
     systemMsgRepo     domainrepository.SystemMessageRepository
+	ephemeralRepo     domainrepository.EphemeralMessageRepository
 	channelRepo       domainrepository.ChannelRepository
 	channelMemberRepo domainrepository.ChannelMemberRepository
 	workspaceRepo     domainrepository.WorkspaceRepository
@@ -24,6 +26,8 @@ type MessageLister struct {
 	linkRepo          domainrepository.MessageLinkRepository
 	threadRepo        domainrepository.ThreadRepository
 	attachmentRepo    domainrepository.AttachmentRepository
+	readStateRepo     domainrepository.ReadStateRepository
+	shareRepo         domainrepository.MessageShareRepository
 	assembler         *MessageOutputAssembler
 	outputBuilder     *MessageOutputBuilder
     channelAccessSvc  service.ChannelAccessService
@@ -33,6 +37,7 @@ type MessageLister struct {
 func NewMessageLister(
 	messageRepo domainrepository.MessageRepository,
     systemMsgRepo domainrepository.SystemMessageRepository,
+	ephemeralRepo domainrepository.EphemeralMessageRepository,
 	channelRepo domainrepository.ChannelRepository,
 	channelMemberRepo domainrepository.ChannelMemberRepository,
 	workspaceRepo domainrepository.WorkspaceRepository,
@@ -43,12 +48,16 @@ func NewMessageLister(
 	linkRepo domainrepository.MessageLinkRepository,
 	threadRepo domainrepository.ThreadRepository,
 	attachmentRepo domainrepository.AttachmentRepository,
+	readStateRepo domainrepository.ReadStateRepository,
+	shareRepo domainrepository.MessageShareRepository,
+	pollRepo domainrepository.PollRepository,
     channelAccessSvc service.ChannelAccessService,
 ) *MessageLister {
     assembler := NewMessageOutputAssembler()
 	return &MessageLister{
 		messageRepo:       messageRepo,
         systemMsgRepo:     systemMsgRepo,
+		ephemeralRepo:     ephemeralRepo,
 		channelRepo:       channelRepo,
 		channelMemberRepo: channelMemberRepo,
 		workspaceRepo:     workspaceRepo,
@@ -59,6 +68,8 @@ func NewMessageLister(
 		linkRepo:          linkRepo,
 		threadRepo:        threadRepo,
 		attachmentRepo:    attachmentRepo,
+		readStateRepo:     readStateRepo,
+		shareRepo:         shareRepo,
 		assembler:         assembler,
 		outputBuilder: NewMessageOutputBuilder(
 			messageRepo,
@@ -68,12 +79,24 @@ func NewMessageLister(
 			groupMentionRepo,
 			linkRepo,
 			attachmentRepo,
+			shareRepo,
+			pollRepo,
+			channelAccessSvc,
 			assembler,
 		),
         channelAccessSvc: channelAccessSvc,
 	}
 }
 
+// timelineRange はタイムラインを取得する範囲です
+// before・after を指定した場合はその位置より古い・新しい項目を、指定しない場合は since・until の範囲の項目を取得します
+type timelineRange struct {
+	since  *time.Time
+	until  *time.Time
+	before *entity.TimelineCursor
+	after  *entity.TimelineCursor
+}
+
 // ListMessages はメッセージ一覧を取得します
 func (l *MessageLister) ListMessages(ctx context.Context, input ListMessagesInput) (*ListMessagesOutput, error) {
     channel, err := l.channelAccessSvc.EnsureChannelAccess(ctx, input.ChannelID, input.UserID)
@@ -89,26 +112,126 @@ func (l *MessageLister) ListMessages(ctx context.Context, input ListMessagesInpu
 		limit = maxMessageLimit
 	}
 
-    messages, err := l.messageRepo.FindByChannelID(ctx, channel.ID, limit+1, input.Since, input.Until)
+	if err := validateListRange(input); err != nil {
+		return nil, err
+	}
+	if input.Around != nil {
+		return l.listAround(ctx, channel, input.UserID, *input.Around, limit)
+	}
+
+	r := timelineRange{since: input.Since, until: input.Until}
+	if input.Before != nil {
+		cursor, err := decodeTimelineCursor(*input.Before)
+		if err != nil {
+			return nil, err
+		}
+		r.before = &cursor
+	}
+	if input.After != nil {
+		cursor, err := decodeTimelineCursor(*input.After)
+		if err != nil {
+			return nil, err
+		}
+		r.after = &cursor
+	}
+
+	timeline, hasMore, err := l.fetchTimeline(ctx, channel, input.UserID, r, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	output := &ListMessagesOutput{Messages: timeline}
+	position := r.before
+	if r.after != nil {
+		output.HasMoreAfter = hasMore
+		position = r.after
+	} else {
+		output.HasMore = hasMore
+	}
+	setTimelineCursors(output, position)
+	return output, nil
+}
+
+// listAround は anchorID のメッセージを中心に、前後それぞれ最大limit件のタイムラインを取得します
+// スレッドの返信を指定した場合は、タイムラインに表示される親メッセージを中心にします
+func (l *MessageLister) listAround(ctx context.Context, channel *entity.Channel, userID string, anchorID string, limit int) (*ListMessagesOutput, error) {
+	anchor, err := l.messageRepo.FindByID(ctx, anchorID)
 	if err != nil {
-		return nil, fmt.Errorf("failed to fetch messages: %w", err)
+		return nil, fmt.Errorf("failed to fetch anchor message: %w", err)
+	}
+	if anchor != nil && anchor.ParentID != nil {
+		anchor, err = l.messageRepo.FindByID(ctx, *anchor.ParentID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch anchor message: %w", err)
+		}
+	}
+	if anchor == nil || anchor.ChannelID != channel.ID {
+		return nil, ErrMessageNotFound
+	}
+
+	position := entity.TimelineCursor{CreatedAt: anchor.CreatedAt, ID: anchor.ID}
+	older, hasMore, err := l.fetchTimeline(ctx, channel, userID, timelineRange{before: &position}, limit)
+	if err != nil {
+		return nil, err
+	}
+	newer, hasMoreAfter, err := l.fetchTimeline(ctx, channel, userID, timelineRange{after: &position}, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	timeline := make([]TimelineItem, 0, len(newer)+len(older)+1)
+	timeline = append(timeline, newer...)
+	// 削除済みのメッセージはタイムラインに含めず、位置のみ基準にします
+	if anchor.DeletedAt == nil {
+		outputs, err := l.buildUserOutputs(ctx, channel, userID, []*entity.Message{anchor})
+		if err != nil {
+			return nil, err
+		}
+		for _, m := range outputs {
+			timeline = append(timeline, TimelineItem{Type: "user", UserMessage: &m, CreatedAt: m.CreatedAt})
+		}
+	}
+	timeline = append(timeline, older...)
+
+	output := &ListMessagesOutput{
+		Messages:     timeline,
+		HasMore:      hasMore,
+		HasMoreAfter: hasMoreAfter,
+		AnchorID:     &anchor.ID,
+	}
+	setTimelineCursors(output, &position)
+	return output, nil
+}
+
+// fetchTimeline はユーザー・システム・閲覧者宛ての一時的なメッセージを統合したタイムラインを新しい順に最大limit件取得し、
+// 取得した範囲の先にさらに項目が残っているかを返します。after を指定した場合は after に近い古い項目から取得します
+func (l *MessageLister) fetchTimeline(ctx context.Context, channel *entity.Channel, userID string, r timelineRange, limit int) ([]TimelineItem, bool, error) {
+	messages, err := l.findMessages(ctx, channel.ID, r, limit+1)
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to fetch messages: %w", err)
 	}
 
     // システムメッセージ取得
-    systemMessages, err := l.systemMsgRepo.FindByChannelID(ctx, channel.ID, limit+1, input.Since, input.Until)
+	systemMessages, err := l.findSystemMessages(ctx, channel.ID, userID, r, limit+1)
 	if err != nil {
-        return nil, fmt.Errorf("failed to fetch system messages: %w", err)
+		return nil, false, fmt.Errorf("failed to fetch system messages: %w", err)
+	}
+
+	// 閲覧者宛ての一時的なメッセージのうち有効期限内のものを取得
+	ephemeralMessages, err := l.findEphemeralMessages(ctx, channel.ID, userID, r, limit+1)
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to fetch ephemeral messages: %w", err)
 	}
 
     // ユーザーメッセージの出力へ変換
-    messages, hasMoreUser := l.prepareMessageList(messages, limit)
-    userOutputs, err := l.outputBuilder.Build(ctx, messages)
-    if err != nil {
-        return nil, err
-    }
+	messages, hasMoreUser := l.prepareMessageList(messages, limit, r.after != nil)
+	userOutputs, err := l.buildUserOutputs(ctx, channel, userID, messages)
+	if err != nil {
+		return nil, false, err
+	}
 
     // タイムラインへマージ
-    timeline := make([]TimelineItem, 0, len(userOutputs)+len(systemMessages))
+    timeline := make([]TimelineItem, 0, len(userOutputs)+len(systemMessages)+len(ephemeralMessages))
     for _, m := range userOutputs {
         timeline = append(timeline, TimelineItem{Type: "user", UserMessage: &m, CreatedAt: m.CreatedAt})
     }
@@ -122,14 +245,122 @@ func (l *MessageLister) ListMessages(ctx context.Context, input ListMessagesInpu
             CreatedAt: sm.CreatedAt,
         }, CreatedAt: sm.CreatedAt})
     }
-    sort.Slice(timeline, func(i, j int) bool { return timeline[i].CreatedAt.After(timeline[j].CreatedAt) })
-    hasMore := false
-    if len(timeline) > limit {
-        hasMore = true
-        timeline = timeline[:limit]
+    for _, em := range ephemeralMessages {
+        timeline = append(timeline, TimelineItem{Type: "ephemeral", EphemeralMessage: &EphemeralMessageOutput{
+            ID:        em.ID,
+            ChannelID: em.ChannelID,
+            Kind:      string(em.Kind),
+            Body:      em.Body,
+            Payload:   em.Payload,
+            CreatedAt: em.CreatedAt,
+            ExpiresAt: em.ExpiresAt,
+        }, CreatedAt: em.CreatedAt})
     }
+	// 作成日時が同じ項目はIDの順に並べ、カーソルの位置と一致させる
+	sort.Slice(timeline, func(i, j int) bool {
+		if !timeline[i].CreatedAt.Equal(timeline[j].CreatedAt) {
+			return timeline[i].CreatedAt.After(timeline[j].CreatedAt)
+		}
+		return timelineItemID(timeline[i]) > timelineItemID(timeline[j])
+	})
+	hasMore := false
+	if len(timeline) > limit {
+		hasMore = true
+		if r.after != nil {
+			timeline = timeline[len(timeline)-limit:]
+		} else {
+			timeline = timeline[:limit]
+		}
+	}
 
-    return &ListMessagesOutput{Messages: timeline, HasMore: hasMore || hasMoreUser}, nil
+	return timeline, hasMore || hasMoreUser, nil
+}
+
+func (l *MessageLister) findMessages(ctx context.Context, channelID string, r timelineRange, limit int) ([]*entity.Message, error) {
+	switch {
+	case r.before != nil:
+		return l.messageRepo.FindByChannelIDBefore(ctx, channelID, *r.before, limit)
+	case r.after != nil:
+		return l.messageRepo.FindByChannelIDAfter(ctx, channelID, *r.after, limit)
+	default:
+		return l.messageRepo.FindByChannelID(ctx, channelID, limit, r.since, r.until)
+	}
+}
+
+func (l *MessageLister) findSystemMessages(ctx context.Context, channelID string, userID string, r timelineRange, limit int) ([]*entity.SystemMessage, error) {
+	switch {
+	case r.before != nil:
+		return l.systemMsgRepo.FindByChannelIDBefore(ctx, channelID, userID, *r.before, limit)
+	case r.after != nil:
+		return l.systemMsgRepo.FindByChannelIDAfter(ctx, channelID, userID, *r.after, limit)
+	default:
+		return l.systemMsgRepo.FindByChannelID(ctx, channelID, userID, limit, r.since, r.until)
+	}
+}
+
+func (l *MessageLister) findEphemeralMessages(ctx context.Context, channelID string, userID string, r timelineRange, limit int) ([]*entity.EphemeralMessage, error) {
+	now := time.Now()
+	switch {
+	case r.before != nil:
+		return l.ephemeralRepo.FindActiveByChannelIDBefore(ctx, channelID, userID, now, *r.before, limit)
+	case r.after != nil:
+		return l.ephemeralRepo.FindActiveByChannelIDAfter(ctx, channelID, userID, now, *r.after, limit)
+	default:
+		return l.ephemeralRepo.FindActiveByChannelID(ctx, channelID, userID, now, limit, r.since, r.until)
+	}
+}
+
+// buildUserOutputs はユーザーメッセージを出力に変換します
+// DM・グループDMでは各メッセージを既読にしたメンバーを付与します
+func (l *MessageLister) buildUserOutputs(ctx context.Context, channel *entity.Channel, userID string, messages []*entity.Message) ([]MessageOutput, error) {
+	outputs, err := l.outputBuilder.Build(ctx, messages, userID)
+	if err != nil {
+		return nil, err
+	}
+	if channel.IsDirectMessage() {
+		if err := l.attachReadBy(ctx, channel.ID, userID, outputs); err != nil {
+			return nil, err
+		}
+	}
+	return outputs, nil
+}
+
+// validateListRange は around・before・after と since・until のうち、複数の取得方法が指定されていないかを確認します
+func validateListRange(input ListMessagesInput) error {
+	specified := 0
+	for _, set := range []bool{
+		input.Around != nil,
+		input.Before != nil,
+		input.After != nil,
+		input.Since != nil || input.Until != nil,
+	} {
+		if set {
+			specified++
+		}
+	}
+	if specified > 1 {
+		return ErrConflictingListRange
+	}
+	return nil
+}
+
+// setTimelineCursors はタイムラインの最も古い項目と新しい項目の位置をカーソルに設定します
+// 項目がない場合は position をどちらのカーソルにも設定し、同じ位置から続けて取得できるようにします
+func setTimelineCursors(output *ListMessagesOutput, position *entity.TimelineCursor) {
+	before, after := position, position
+	if n := len(output.Messages); n > 0 {
+		newest := timelineItemCursor(output.Messages[0])
+		oldest := timelineItemCursor(output.Messages[n-1])
+		before, after = &oldest, &newest
+	}
+	if before != nil {
+		encoded := encodeTimelineCursor(*before)
+		output.BeforeCursor = &encoded
+	}
+	if after != nil {
+		encoded := encodeTimelineCursor(*after)
+		output.AfterCursor = &encoded
+	}
 }
 
 // ListMessagesWithThread はスレッド情報付きのメッセージ一覧を取得します
@@ -337,9 +568,18 @@ func (l *MessageLister) GetThreadReplies(ctx context.Context, input GetThreadRep
 		))
 	}
 
+	// 転送・共有したメッセージの共有元と共有された回数、投票の状態を付与
+	outputs := append([]MessageOutput{parentOutput}, replyOutputs...)
+	if err := l.outputBuilder.AttachShares(ctx, outputs, input.UserID); err != nil {
+		return nil, err
+	}
+	if err := l.outputBuilder.AttachPolls(ctx, outputs, input.UserID); err != nil {
+		return nil, err
+	}
+
 	return &GetThreadRepliesOutput{
-		ParentMessage: parentOutput,
-		Replies:       replyOutputs,
+		ParentMessage: outputs[0],
+		Replies:       outputs[1:],
 		HasMore:       false,
 	}, nil
 }
@@ -403,8 +643,53 @@ func (l *MessageLister) GetThreadMetadata(ctx context.Context, input GetThreadMe
 // ensureChannelAccess はチャンネルアクセス権限を確認します
 // ensureChannelAccess は ChannelAccessService に委譲済み
 
+// attachReadBy は各メッセージの作成日時以降まで既読にしたメンバーをReadByに設定します
+// 閲覧者自身とメッセージの投稿者は含めず、既読を無効にしているメンバーも含めません
+// 閲覧者が既読を無効にしている場合は何も設定しません
+func (l *MessageLister) attachReadBy(ctx context.Context, channelID string, viewerID string, outputs []MessageOutput) error {
+	members, err := l.channelMemberRepo.FindMembers(ctx, channelID)
+	if err != nil {
+		return fmt.Errorf("failed to fetch channel members: %w", err)
+	}
+	memberIDs := make([]string, 0, len(members))
+	for _, member := range members {
+		memberIDs = append(memberIDs, member.UserID)
+	}
+	users, err := l.userRepo.FindByIDs(ctx, memberIDs)
+	if err != nil {
+		return fmt.Errorf("failed to fetch channel member users: %w", err)
+	}
+
+	enabled := make(map[string]bool, len(users))
+	for _, user := range users {
+		enabled[user.ID] = user.ReadReceiptsEnabled
+	}
+	if !enabled[viewerID] {
+		return nil
+	}
+
+	readStates, err := l.readStateRepo.FindByChannel(ctx, channelID)
+	if err != nil {
+		return fmt.Errorf("failed to fetch read states: %w", err)
+	}
+
+	for idx := range outputs {
+		msg := &outputs[idx]
+		for _, state := range readStates {
+			if state.UserID == viewerID || state.UserID == msg.UserID || !enabled[state.UserID] {
+				continue
+			}
+			if !state.LastReadAt.Before(msg.CreatedAt) {
+				msg.ReadBy = append(msg.ReadBy, state.UserID)
+			}
+		}
+	}
+	return nil
+}
+
 // prepareMessageList はメッセージリストを準備し、リミット処理を行います
-func (l *MessageLister) prepareMessageList(messages []*entity.Message, limit int) ([]*entity.Message, bool) {
+// 新しい順のリストから、keepOldest が true の場合は古い方の、false の場合は新しい方のlimit件を残します
+func (l *MessageLister) prepareMessageList(messages []*entity.Message, limit int, keepOldest bool) ([]*entity.Message, bool) {
 	if limit <= 0 {
 		limit = defaultMessageLimit
 	} else if limit > maxMessageLimit {
@@ -414,7 +699,11 @@ func (l *MessageLister) prepareMessageList(messages []*entity.Message, limit int
 	hasMore := false
 	if len(messages) > limit {
 		hasMore = true
-		messages = messages[:limit]
+		if keepOldest {
+			messages = messages[len(messages)-limit:]
+		} else {
+			messages = messages[:limit]
+		}
 	}
 
 	return messages, hasMore
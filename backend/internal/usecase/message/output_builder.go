@@ -2,10 +2,14 @@ package message
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"time"
 
 	"github.com/newt239/chat/internal/domain/entity"
+	domainerrors "github.com/newt239/chat/internal/domain/errors"
 	domainrepository "github.com/newt239/chat/internal/domain/repository"
+	"github.com/newt239/chat/internal/domain/service"
 )
 
 // MessageOutputBuilder はメッセージエンティティからMessageOutputを構築する補助コンポーネントです
@@ -17,6 +21,9 @@ type MessageOutputBuilder struct {
 	groupMentionRepo domainrepository.MessageGroupMentionRepository
 	linkRepo         domainrepository.MessageLinkRepository
 	attachmentRepo   domainrepository.AttachmentRepository
+	shareRepo        domainrepository.MessageShareRepository
+	pollRepo         domainrepository.PollRepository
+	channelAccessSvc service.ChannelAccessService
 	assembler        *MessageOutputAssembler
 }
 
@@ -29,6 +36,9 @@ func NewMessageOutputBuilder(
 	groupMentionRepo domainrepository.MessageGroupMentionRepository,
 	linkRepo domainrepository.MessageLinkRepository,
 	attachmentRepo domainrepository.AttachmentRepository,
+	shareRepo domainrepository.MessageShareRepository,
+	pollRepo domainrepository.PollRepository,
+	channelAccessSvc service.ChannelAccessService,
 	assembler *MessageOutputAssembler,
 ) *MessageOutputBuilder {
 	return &MessageOutputBuilder{
@@ -39,12 +49,15 @@ func NewMessageOutputBuilder(
 		groupMentionRepo: groupMentionRepo,
 		linkRepo:         linkRepo,
 		attachmentRepo:   attachmentRepo,
+		shareRepo:        shareRepo,
+		pollRepo:         pollRepo,
+		channelAccessSvc: channelAccessSvc,
 		assembler:        assembler,
 	}
 }
 
-// Build はメッセージ配列からMessageOutputスライスを構築します
-func (b *MessageOutputBuilder) Build(ctx context.Context, messages []*entity.Message) ([]MessageOutput, error) {
+// Build はメッセージ配列からviewerIDのユーザーに返すMessageOutputスライスを構築します
+func (b *MessageOutputBuilder) Build(ctx context.Context, messages []*entity.Message, viewerID string) ([]MessageOutput, error) {
 	if len(messages) == 0 {
 		return []MessageOutput{}, nil
 	}
@@ -88,9 +101,135 @@ func (b *MessageOutputBuilder) Build(ctx context.Context, messages []*entity.Mes
 		))
 	}
 
+	if err := b.AttachShares(ctx, outputs, viewerID); err != nil {
+		return nil, err
+	}
+	if err := b.AttachPolls(ctx, outputs, viewerID); err != nil {
+		return nil, err
+	}
+
 	return outputs, nil
 }
 
+// AttachPolls は投票のメッセージにviewerIDのユーザーに返す投票の状態を付与します
+func (b *MessageOutputBuilder) AttachPolls(ctx context.Context, outputs []MessageOutput, viewerID string) error {
+	if len(outputs) == 0 {
+		return nil
+	}
+
+	messageIDs := make([]string, len(outputs))
+	for idx, output := range outputs {
+		messageIDs[idx] = output.ID
+	}
+
+	polls, err := b.pollRepo.FindByMessageIDs(ctx, messageIDs)
+	if err != nil {
+		return fmt.Errorf("failed to fetch polls: %w", err)
+	}
+
+	now := time.Now()
+	for idx := range outputs {
+		if poll, ok := polls[outputs[idx].ID]; ok {
+			outputs[idx].Poll = b.assembler.AssemblePollOutput(poll, viewerID, now)
+		}
+	}
+	return nil
+}
+
+// AttachShares は転送・共有したメッセージに共有元を、共有されたメッセージに共有された回数を付与します
+// 共有元のプレビューはviewerIDのユーザーが共有元のチャンネルにアクセスできる場合のみ付与します
+func (b *MessageOutputBuilder) AttachShares(ctx context.Context, outputs []MessageOutput, viewerID string) error {
+	if len(outputs) == 0 {
+		return nil
+	}
+
+	messageIDs := make([]string, len(outputs))
+	for idx, output := range outputs {
+		messageIDs[idx] = output.ID
+	}
+
+	shares, err := b.shareRepo.FindByMessageIDs(ctx, messageIDs)
+	if err != nil {
+		return fmt.Errorf("failed to fetch message shares: %w", err)
+	}
+	shareCounts, err := b.shareRepo.CountBySourceMessageIDs(ctx, messageIDs)
+	if err != nil {
+		return fmt.Errorf("failed to count message shares: %w", err)
+	}
+
+	previews, err := b.fetchSharedMessagePreviews(ctx, shares, viewerID)
+	if err != nil {
+		return err
+	}
+
+	for idx := range outputs {
+		outputs[idx].ShareCount = shareCounts[outputs[idx].ID]
+		if share, ok := shares[outputs[idx].ID]; ok {
+			outputs[idx].SharedMessage = &SharedMessageOutput{
+				MessageID: share.SourceMessageID,
+				Preview:   previews[share.SourceMessageID],
+			}
+		}
+	}
+	return nil
+}
+
+// fetchSharedMessagePreviews は閲覧者がアクセスできる共有元のメッセージのプレビューを取得します
+// 削除された共有元や、アクセスできないチャンネルの共有元は含めません
+func (b *MessageOutputBuilder) fetchSharedMessagePreviews(ctx context.Context, shares map[string]*entity.MessageShare, viewerID string) (map[string]*SharedMessagePreview, error) {
+	if len(shares) == 0 {
+		return map[string]*SharedMessagePreview{}, nil
+	}
+
+	sourceIDs := make([]string, 0, len(shares))
+	sourceIDSet := make(map[string]bool)
+	for _, share := range shares {
+		if !sourceIDSet[share.SourceMessageID] {
+			sourceIDs = append(sourceIDs, share.SourceMessageID)
+			sourceIDSet[share.SourceMessageID] = true
+		}
+	}
+
+	sources, err := b.messageRepo.FindByIDs(ctx, sourceIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch shared messages: %w", err)
+	}
+
+	// 閲覧者がアクセスできるチャンネルの共有元のみ残す
+	channels := make(map[string]*entity.Channel)
+	visible := make([]*entity.Message, 0, len(sources))
+	for _, source := range sources {
+		if source.DeletedAt != nil {
+			continue
+		}
+		channel, checked := channels[source.ChannelID]
+		if !checked {
+			channel, err = b.channelAccessSvc.EnsureChannelAccess(ctx, source.ChannelID, viewerID)
+			if err != nil && !errors.Is(err, domainerrors.ErrChannelNotFound) && !errors.Is(err, domainerrors.ErrUnauthorized) {
+				return nil, err
+			}
+			if err != nil {
+				channel = nil
+			}
+			channels[source.ChannelID] = channel
+		}
+		if channel != nil {
+			visible = append(visible, source)
+		}
+	}
+
+	userMap, err := b.fetchUserMap(ctx, visible, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	previews := make(map[string]*SharedMessagePreview, len(visible))
+	for _, source := range visible {
+		previews[source.ID] = b.assembler.AssembleSharedMessagePreview(source, channels[source.ChannelID], userMap[source.UserID])
+	}
+	return previews, nil
+}
+
 // fetchRelatedData はメッセージに関連するデータを一括取得します
 func (b *MessageOutputBuilder) fetchRelatedData(ctx context.Context, messageIDs []string) (*RelatedData, error) {
 	if len(messageIDs) == 0 {
@@ -23,11 +23,15 @@ type MessageUpdater struct {
 	groupMentionRepo      domainrepository.MessageGroupMentionRepository
 	linkRepo              domainrepository.MessageLinkRepository
 	attachmentRepo        domainrepository.AttachmentRepository
+	revisionRepo          domainrepository.MessageRevisionRepository
+	shareRepo             domainrepository.MessageShareRepository
+	pollRepo              domainrepository.PollRepository
 	notificationSvc       service.NotificationService
 	mentionService        service.MentionService
 	linkProcessingService service.LinkProcessingService
 	transactionManager    transaction.Manager
 	assembler             *MessageOutputAssembler
+	outputBuilder         *MessageOutputBuilder
     channelAccessSvc      service.ChannelAccessService
 }
 
@@ -43,12 +47,16 @@ func NewMessageUpdater(
 	groupMentionRepo domainrepository.MessageGroupMentionRepository,
 	linkRepo domainrepository.MessageLinkRepository,
 	attachmentRepo domainrepository.AttachmentRepository,
+	revisionRepo domainrepository.MessageRevisionRepository,
+	shareRepo domainrepository.MessageShareRepository,
+	pollRepo domainrepository.PollRepository,
 	notificationSvc service.NotificationService,
 	mentionService service.MentionService,
 	linkProcessingService service.LinkProcessingService,
 	transactionManager transaction.Manager,
     channelAccessSvc service.ChannelAccessService,
 ) *MessageUpdater {
+	assembler := NewMessageOutputAssembler()
 	return &MessageUpdater{
 		messageRepo:           messageRepo,
 		channelRepo:           channelRepo,
@@ -60,11 +68,27 @@ func NewMessageUpdater(
 		groupMentionRepo:      groupMentionRepo,
 		linkRepo:              linkRepo,
 		attachmentRepo:        attachmentRepo,
+		revisionRepo:          revisionRepo,
+		shareRepo:             shareRepo,
+		pollRepo:              pollRepo,
 		notificationSvc:       notificationSvc,
 		mentionService:        mentionService,
 		linkProcessingService: linkProcessingService,
 		transactionManager:    transactionManager,
-		assembler:             NewMessageOutputAssembler(),
+		assembler:             assembler,
+		outputBuilder: NewMessageOutputBuilder(
+			messageRepo,
+			userRepo,
+			userGroupRepo,
+			userMentionRepo,
+			groupMentionRepo,
+			linkRepo,
+			attachmentRepo,
+			shareRepo,
+			pollRepo,
+			channelAccessSvc,
+			assembler,
+		),
         channelAccessSvc:      channelAccessSvc,
 	}
 }
@@ -102,9 +126,51 @@ func (u *MessageUpdater) UpdateMessage(ctx context.Context, input UpdateMessageI
 
 	var result *MessageOutput
 	err = u.transactionManager.Do(ctx, func(txCtx context.Context) error {
+		now := time.Now()
+
+		// 同時に行われた編集で編集履歴や編集回数が失われないよう、メッセージの行をロックして最新の状態を読み直す
+		if err := u.messageRepo.Lock(txCtx, message.ID); err != nil {
+			return fmt.Errorf("メッセージのロックに失敗しました: %w", err)
+		}
+		message, err = u.messageRepo.FindByID(txCtx, message.ID)
+		if err != nil {
+			return fmt.Errorf("メッセージの取得に失敗しました: %w", err)
+		}
+		if message == nil {
+			return ErrMessageNotFound
+		}
+		if message.DeletedAt != nil {
+			return ErrCannotEditDeleted
+		}
+
+		// 編集履歴の保存はWorkspaceの設定で無効にできる
+		// 設定の変更と保存済みの編集履歴の削除が終わるまで待ち、無効にした後に編集履歴が残らないようにする
+		if err := u.workspaceRepo.LockShared(txCtx, channel.WorkspaceID); err != nil {
+			return fmt.Errorf("ワークスペースのロックに失敗しました: %w", err)
+		}
+		workspace, err := u.workspaceRepo.FindByID(txCtx, channel.WorkspaceID)
+		if err != nil {
+			return fmt.Errorf("ワークスペースの取得に失敗しました: %w", err)
+		}
+		historyEnabled := workspace != nil && workspace.MessageHistoryEnabled
+
+		// 本文が変わる場合は編集前の本文を編集履歴として保存
+		if historyEnabled && message.Body != input.Body {
+			revision := &entity.MessageRevision{
+				MessageID: message.ID,
+				EditorID:  input.EditorID,
+				Body:      message.Body,
+				CreatedAt: now,
+			}
+			if err := u.revisionRepo.Create(txCtx, revision); err != nil {
+				return fmt.Errorf("編集履歴の保存に失敗しました: %w", err)
+			}
+			message.RevisionCount++
+		}
+
 		// メッセージ本文を更新
 		message.Body = input.Body
-		now := time.Now()
+		message.BodyAST = entity.ParseMessageBody(input.Body)
 		message.EditedAt = &now
 
 		// データベース更新
@@ -124,7 +190,7 @@ func (u *MessageUpdater) UpdateMessage(ctx context.Context, input UpdateMessageI
 		}
 
 		// 新しいメンション・リンクを抽出・保存
-		if err := u.extractAndSaveMentionsAndLinks(txCtx, message.ID, input.Body, channel.WorkspaceID); err != nil {
+		if err := u.extractAndSaveMentionsAndLinks(txCtx, message.ID, message.BodyAST, channel.WorkspaceID); err != nil {
 			return fmt.Errorf("failed to extract and save mentions/links: %w", err)
 		}
 
@@ -192,14 +258,131 @@ func (u *MessageUpdater) UpdateMessage(ctx context.Context, input UpdateMessageI
 		return nil, err
 	}
 
+	// 共有元のプレビューと共有された回数は作成時・一覧と同じく付与する
+	outputs := []MessageOutput{*result}
+	if err := u.outputBuilder.AttachShares(ctx, outputs, input.EditorID); err != nil {
+		return nil, err
+	}
+	result = &outputs[0]
+
+	// 投票を含むメッセージは編集後も選択肢と集計を返す
+	polls, err := u.pollRepo.FindByMessageIDs(ctx, []string{message.ID})
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch poll: %w", err)
+	}
+	poll := polls[message.ID]
+	now := time.Now()
+	if poll != nil {
+		result.Poll = u.assembler.AssemblePollOutput(poll, input.EditorID, now)
+	}
+
 	// WebSocket通知を送信
 	if u.notificationSvc != nil {
-		u.notificationSvc.NotifyUpdatedMessage(channel.WorkspaceID, channel.ID, *result)
+		broadcast := *result
+		u.stripPrivateSharePreview(ctx, &broadcast)
+		if poll != nil {
+			// 編集者自身の投票状況を他のメンバーに配信しないよう、閲覧者を指定せずに組み立てる
+			broadcast.Poll = u.assembler.AssemblePollOutput(poll, "", now)
+		}
+		u.notificationSvc.NotifyUpdatedMessage(channel.WorkspaceID, channel.ID, broadcast)
 	}
 
 	return result, nil
 }
 
+// stripPrivateSharePreview は共有元がプライベートチャンネル・DMの場合に通知から共有元のプレビューを除きます
+// 共有先のメンバー全員が共有元のチャンネルにアクセスできるとは限らないため、作成時の通知と同じ扱いにします
+func (u *MessageUpdater) stripPrivateSharePreview(ctx context.Context, broadcast *MessageOutput) {
+	shared := broadcast.SharedMessage
+	if shared == nil || shared.Preview == nil {
+		return
+	}
+	sourceChannel, err := u.channelRepo.FindByID(ctx, shared.Preview.ChannelID)
+	if err != nil || sourceChannel == nil || sourceChannel.IsPrivate || sourceChannel.IsDirectMessage() {
+		broadcast.SharedMessage = &SharedMessageOutput{MessageID: shared.MessageID}
+	}
+}
+
+// ListRevisions はメッセージの編集履歴を古い順で取得します
+// 編集履歴を閲覧できるのは投稿者本人とワークスペースの管理者のみです
+func (u *MessageUpdater) ListRevisions(ctx context.Context, input ListMessageRevisionsInput) (*ListMessageRevisionsOutput, error) {
+	message, err := u.messageRepo.FindByID(ctx, input.MessageID)
+	if err != nil {
+		return nil, fmt.Errorf("メッセージの取得に失敗しました: %w", err)
+	}
+	if message == nil {
+		return nil, ErrMessageNotFound
+	}
+
+	channel, err := u.channelAccessSvc.EnsureChannelAccess(ctx, message.ChannelID, input.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	canView, err := u.canModifyMessage(ctx, channel.WorkspaceID, message.UserID, input.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("権限確認に失敗しました: %w", err)
+	}
+	if !canView {
+		return nil, ErrUnauthorized
+	}
+
+	output := &ListMessageRevisionsOutput{
+		MessageID: message.ID,
+		Revisions: []MessageRevisionOutput{},
+	}
+
+	// 編集履歴の保存が無効なWorkspaceでは、無効化と同時に行われた編集の履歴が残っていても返さない
+	workspace, err := u.workspaceRepo.FindByID(ctx, channel.WorkspaceID)
+	if err != nil {
+		return nil, fmt.Errorf("ワークスペースの取得に失敗しました: %w", err)
+	}
+	if workspace == nil || !workspace.MessageHistoryEnabled {
+		return output, nil
+	}
+
+	revisions, err := u.revisionRepo.FindByMessageID(ctx, message.ID)
+	if err != nil {
+		return nil, fmt.Errorf("編集履歴の取得に失敗しました: %w", err)
+	}
+
+	// 編集者の情報を取得
+	editorIDs := make([]string, 0)
+	editorIDSet := make(map[string]bool)
+	for _, revision := range revisions {
+		if !editorIDSet[revision.EditorID] {
+			editorIDs = append(editorIDs, revision.EditorID)
+			editorIDSet[revision.EditorID] = true
+		}
+	}
+
+	userMap := make(map[string]*entity.User)
+	if len(editorIDs) > 0 {
+		users, err := u.userRepo.FindByIDs(ctx, editorIDs)
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch users: %w", err)
+		}
+		for _, user := range users {
+			userMap[user.ID] = user
+		}
+	}
+
+	for _, revision := range revisions {
+		editor := u.assembler.buildUserInfo(userMap[revision.EditorID])
+		if editor.ID == "" {
+			editor.ID = revision.EditorID
+		}
+		output.Revisions = append(output.Revisions, MessageRevisionOutput{
+			ID:       revision.ID,
+			Body:     revision.Body,
+			Editor:   editor,
+			EditedAt: revision.CreatedAt,
+		})
+	}
+
+	return output, nil
+}
+
 // ensureChannelAccess は ChannelAccessService に委譲済み
 
 // canModifyMessage はユーザーがメッセージを編集・削除できるかどうかを確認します
@@ -227,9 +410,9 @@ func (u *MessageUpdater) canModifyMessage(ctx context.Context, workspaceID, mess
 }
 
 // extractAndSaveMentionsAndLinks はメンションとリンクの抽出・保存を行います
-func (u *MessageUpdater) extractAndSaveMentionsAndLinks(ctx context.Context, messageID, body, workspaceID string) error {
+func (u *MessageUpdater) extractAndSaveMentionsAndLinks(ctx context.Context, messageID string, bodyAST []entity.MessageNode, workspaceID string) error {
 	// ユーザーメンションの抽出
-	userMentions, err := u.mentionService.ExtractUserMentions(ctx, body, workspaceID)
+	userMentions, err := u.mentionService.ExtractUserMentions(ctx, bodyAST, workspaceID)
 	if err != nil {
 		return fmt.Errorf("failed to extract user mentions: %w", err)
 	}
@@ -242,7 +425,7 @@ func (u *MessageUpdater) extractAndSaveMentionsAndLinks(ctx context.Context, mes
 	}
 
 	// グループメンションの抽出
-	groupMentions, err := u.mentionService.ExtractGroupMentions(ctx, body, workspaceID)
+	groupMentions, err := u.mentionService.ExtractGroupMentions(ctx, bodyAST, workspaceID)
 	if err != nil {
 		return fmt.Errorf("failed to extract group mentions: %w", err)
 	}
@@ -255,7 +438,7 @@ func (u *MessageUpdater) extractAndSaveMentionsAndLinks(ctx context.Context, mes
 	}
 
 	// リンクの抽出とOGP取得
-	links, err := u.linkProcessingService.ProcessLinks(ctx, body)
+	links, err := u.linkProcessingService.ProcessLinks(ctx, bodyAST)
 	if err != nil {
 		return fmt.Errorf("failed to process links: %w", err)
 	}
@@ -93,6 +93,13 @@ type ListPinsOutput struct {
 	NextCursor *string
 }
 
+// PinEventOutput はピン追加・削除の通知内容を表します
+type PinEventOutput struct {
+	MessageID string
+	PinnedBy  string
+	PinnedAt  time.Time
+}
+
 func (i *interactor) PinMessage(ctx context.Context, input PinMessageInput) error {
 	// メッセージ存在確認
 	msg, err := i.messageRepo.FindByID(ctx, input.MessageID)
@@ -143,10 +150,10 @@ func (i *interactor) PinMessage(ctx context.Context, input PinMessageInput) erro
 				workspaceID = ch.WorkspaceID
 			}
 		}
-		payload := map[string]interface{}{
-			"message":  p.Message.ID,
-			"pinnedBy": p.PinnedBy,
-			"pinnedAt": p.PinnedAt.Format(time.RFC3339),
+		payload := PinEventOutput{
+			MessageID: p.Message.ID,
+			PinnedBy:  p.PinnedBy,
+			PinnedAt:  p.PinnedAt,
 		}
 		if workspaceID != "" {
 			i.notificationSvc.NotifyPinCreated(workspaceID, input.ChannelID, payload)
@@ -176,10 +183,10 @@ func (i *interactor) UnpinMessage(ctx context.Context, input UnpinMessageInput)
 	if i.notificationSvc != nil {
 		ch, _ := i.channelRepo.FindByID(ctx, input.ChannelID)
 		if ch != nil {
-			payload := map[string]interface{}{
-				"message":  input.MessageID,
-				"pinnedBy": input.UserID,
-				"pinnedAt": time.Now().Format(time.RFC3339),
+			payload := PinEventOutput{
+				MessageID: input.MessageID,
+				PinnedBy:  input.UserID,
+				PinnedAt:  time.Now(),
 			}
 			i.notificationSvc.NotifyPinDeleted(ch.WorkspaceID, input.ChannelID, payload)
 		}
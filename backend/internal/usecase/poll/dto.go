@@ -0,0 +1,29 @@
+package poll
+
+import "time"
+
+type CreatePollInput struct {
+	ChannelID string
+	UserID    string
+	// Question は投票の質問で、メッセージの本文として投稿します
+	Question       string
+	Options        []string
+	MultipleChoice bool
+	Anonymous      bool
+	// ClosesAt を指定すると、その日時に投票を締め切ります
+	ClosesAt    *time.Time
+	ParentID    *string
+	ClientMsgID *string
+}
+
+type VoteInput struct {
+	PollID   string
+	OptionID string
+	UserID   string
+}
+
+type UnvoteInput struct {
+	PollID   string
+	OptionID string
+	UserID   string
+}
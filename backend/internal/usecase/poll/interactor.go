@@ -0,0 +1,214 @@
+package poll
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/newt239/chat/internal/domain/entity"
+	domainrepository "github.com/newt239/chat/internal/domain/repository"
+	"github.com/newt239/chat/internal/domain/service"
+	"github.com/newt239/chat/internal/domain/transaction"
+	messageuc "github.com/newt239/chat/internal/usecase/message"
+)
+
+const (
+	minPollOptions      = 2
+	maxPollOptions      = 10
+	maxPollOptionLength = 200
+)
+
+var (
+	ErrPollNotFound       = errors.New("投票が見つかりません")
+	ErrOptionNotFound     = errors.New("選択肢が見つかりません")
+	ErrPollClosed         = errors.New("投票は締め切られています")
+	ErrInvalidQuestion    = errors.New("質問を入力してください")
+	ErrInvalidOptionCount = errors.New("選択肢は2〜10件指定してください")
+	ErrInvalidOptionText  = errors.New("選択肢は1〜200文字で入力してください")
+	ErrDuplicateOption    = errors.New("同じ選択肢は指定できません")
+	ErrInvalidClosesAt    = errors.New("締め切り日時には現在より後の日時を指定してください")
+)
+
+type PollUseCase interface {
+	CreatePoll(ctx context.Context, input CreatePollInput) (*messageuc.MessageOutput, error)
+	Vote(ctx context.Context, input VoteInput) (*messageuc.PollOutput, error)
+	Unvote(ctx context.Context, input UnvoteInput) (*messageuc.PollOutput, error)
+}
+
+type interactor struct {
+	pollRepo           domainrepository.PollRepository
+	messageRepo        domainrepository.MessageRepository
+	messageUC          messageuc.MessageUseCase
+	channelAccessSvc   service.ChannelAccessService
+	notificationSvc    service.NotificationService
+	transactionManager transaction.Manager
+	assembler          *messageuc.MessageOutputAssembler
+}
+
+func NewPollInteractor(
+	pollRepo domainrepository.PollRepository,
+	messageRepo domainrepository.MessageRepository,
+	messageUC messageuc.MessageUseCase,
+	channelAccessSvc service.ChannelAccessService,
+	notificationSvc service.NotificationService,
+	transactionManager transaction.Manager,
+) PollUseCase {
+	return &interactor{
+		pollRepo:           pollRepo,
+		messageRepo:        messageRepo,
+		messageUC:          messageUC,
+		channelAccessSvc:   channelAccessSvc,
+		notificationSvc:    notificationSvc,
+		transactionManager: transactionManager,
+		assembler:          messageuc.NewMessageOutputAssembler(),
+	}
+}
+
+// CreatePoll は質問を本文とする投票のメッセージを投稿します
+func (i *interactor) CreatePoll(ctx context.Context, input CreatePollInput) (*messageuc.MessageOutput, error) {
+	question := strings.TrimSpace(input.Question)
+	if question == "" {
+		return nil, ErrInvalidQuestion
+	}
+
+	options, err := normalizeOptions(input.Options)
+	if err != nil {
+		return nil, err
+	}
+
+	if input.ClosesAt != nil && !input.ClosesAt.After(time.Now()) {
+		return nil, ErrInvalidClosesAt
+	}
+
+	return i.messageUC.CreateMessage(ctx, messageuc.CreateMessageInput{
+		ChannelID:   input.ChannelID,
+		UserID:      input.UserID,
+		Body:        question,
+		ParentID:    input.ParentID,
+		ClientMsgID: input.ClientMsgID,
+		Poll: &entity.Poll{
+			MultipleChoice: input.MultipleChoice,
+			Anonymous:      input.Anonymous,
+			ClosesAt:       input.ClosesAt,
+			Options:        options,
+		},
+	})
+}
+
+// Vote は選択肢に投票し、集計の更新をチャンネルに通知します
+// 単一選択の投票では、他の選択肢への投票を取り消します
+func (i *interactor) Vote(ctx context.Context, input VoteInput) (*messageuc.PollOutput, error) {
+	poll, channel, err := i.findVotable(ctx, input.PollID, input.OptionID, input.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = i.transactionManager.Do(ctx, func(txCtx context.Context) error {
+		// 同じ投票への同時の投票を直列化し、単一選択の投票で複数の選択肢に投票されないようにする
+		if err := i.pollRepo.Lock(txCtx, poll.ID); err != nil {
+			return fmt.Errorf("投票のロックに失敗しました: %w", err)
+		}
+		if !poll.MultipleChoice {
+			if err := i.pollRepo.RemoveVotesByUser(txCtx, poll.ID, input.UserID); err != nil {
+				return fmt.Errorf("投票の取り消しに失敗しました: %w", err)
+			}
+		}
+		if err := i.pollRepo.AddVote(txCtx, poll.ID, input.OptionID, input.UserID); err != nil {
+			return fmt.Errorf("投票に失敗しました: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return i.notifyUpdated(ctx, poll.ID, channel, input.UserID)
+}
+
+// Unvote は選択肢への投票を取り消し、集計の更新をチャンネルに通知します
+func (i *interactor) Unvote(ctx context.Context, input UnvoteInput) (*messageuc.PollOutput, error) {
+	poll, channel, err := i.findVotable(ctx, input.PollID, input.OptionID, input.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := i.pollRepo.RemoveVote(ctx, input.OptionID, input.UserID); err != nil {
+		return nil, fmt.Errorf("投票の取り消しに失敗しました: %w", err)
+	}
+
+	return i.notifyUpdated(ctx, poll.ID, channel, input.UserID)
+}
+
+// findVotable はユーザーがアクセスできる、締め切り前の投票と選択肢のチャンネルを取得します
+func (i *interactor) findVotable(ctx context.Context, pollID, optionID, userID string) (*entity.Poll, *entity.Channel, error) {
+	poll, err := i.pollRepo.FindByID(ctx, pollID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("投票の取得に失敗しました: %w", err)
+	}
+	if poll == nil {
+		return nil, nil, ErrPollNotFound
+	}
+
+	channel, err := i.channelAccessSvc.EnsureChannelAccess(ctx, poll.ChannelID, userID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	message, err := i.messageRepo.FindByID(ctx, poll.MessageID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to fetch message: %w", err)
+	}
+	if message == nil || message.DeletedAt != nil {
+		return nil, nil, ErrPollNotFound
+	}
+
+	if poll.FindOption(optionID) == nil {
+		return nil, nil, ErrOptionNotFound
+	}
+	if poll.IsClosed(time.Now()) {
+		return nil, nil, ErrPollClosed
+	}
+	return poll, channel, nil
+}
+
+// notifyUpdated は更新後の投票をチャンネルに通知し、userIDのユーザーに返す投票の状態を返します
+func (i *interactor) notifyUpdated(ctx context.Context, pollID string, channel *entity.Channel, userID string) (*messageuc.PollOutput, error) {
+	poll, err := i.pollRepo.FindByID(ctx, pollID)
+	if err != nil {
+		return nil, fmt.Errorf("投票の取得に失敗しました: %w", err)
+	}
+	if poll == nil {
+		return nil, ErrPollNotFound
+	}
+
+	if i.notificationSvc != nil {
+		i.notificationSvc.NotifyPollUpdated(channel.WorkspaceID, channel.ID, poll)
+	}
+
+	return i.assembler.AssemblePollOutput(poll, userID, time.Now()), nil
+}
+
+// normalizeOptions は選択肢の前後の空白を除き、件数・文字数・重複を検証します
+func normalizeOptions(texts []string) ([]*entity.PollOption, error) {
+	if len(texts) < minPollOptions || len(texts) > maxPollOptions {
+		return nil, ErrInvalidOptionCount
+	}
+
+	options := make([]*entity.PollOption, 0, len(texts))
+	seen := make(map[string]bool, len(texts))
+	for idx, text := range texts {
+		text = strings.TrimSpace(text)
+		if text == "" || utf8.RuneCountInString(text) > maxPollOptionLength {
+			return nil, ErrInvalidOptionText
+		}
+		if seen[text] {
+			return nil, ErrDuplicateOption
+		}
+		seen[text] = true
+		options = append(options, &entity.PollOption{Text: text, Position: idx})
+	}
+	return options, nil
+}
@@ -0,0 +1,18 @@
+package presence
+
+import "time"
+
+type GetWorkspacePresenceInput struct {
+	WorkspaceID string
+	UserID      string
+}
+
+type UserPresenceOutput struct {
+	UserID     string     `json:"userId"`
+	Status     string     `json:"status"`
+	LastSeenAt *time.Time `json:"lastSeenAt"`
+}
+
+type GetWorkspacePresenceOutput struct {
+	Presences []UserPresenceOutput `json:"presences"`
+}
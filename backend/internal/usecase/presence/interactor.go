@@ -0,0 +1,84 @@
+package presence
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/newt239/chat/internal/domain/entity"
+	domainerrors "github.com/newt239/chat/internal/domain/errors"
+	domainrepository "github.com/newt239/chat/internal/domain/repository"
+	"github.com/newt239/chat/internal/domain/service"
+)
+
+type PresenceUseCase interface {
+	GetWorkspacePresence(ctx context.Context, input GetWorkspacePresenceInput) (*GetWorkspacePresenceOutput, error)
+}
+
+type interactor struct {
+	workspaceRepo   domainrepository.WorkspaceRepository
+	userRepo        domainrepository.UserRepository
+	presenceService service.PresenceService
+}
+
+func NewPresenceInteractor(
+	workspaceRepo domainrepository.WorkspaceRepository,
+	userRepo domainrepository.UserRepository,
+	presenceService service.PresenceService,
+) PresenceUseCase {
+	return &interactor{
+		workspaceRepo:   workspaceRepo,
+		userRepo:        userRepo,
+		presenceService: presenceService,
+	}
+}
+
+// GetWorkspacePresence はWorkspaceメンバー全員のプレゼンス状態を返します
+// 接続していないメンバーはofflineとし、最終オンライン日時を併せて返します
+func (i *interactor) GetWorkspacePresence(ctx context.Context, input GetWorkspacePresenceInput) (*GetWorkspacePresenceOutput, error) {
+	member, err := i.workspaceRepo.FindMember(ctx, input.WorkspaceID, input.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check membership: %w", err)
+	}
+	if member == nil {
+		return nil, domainerrors.ErrUnauthorized
+	}
+
+	members, err := i.workspaceRepo.FindMembersByWorkspaceID(ctx, input.WorkspaceID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list members: %w", err)
+	}
+
+	userIDs := make([]string, 0, len(members))
+	for _, m := range members {
+		userIDs = append(userIDs, m.UserID)
+	}
+
+	users, err := i.userRepo.FindByIDs(ctx, userIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user info: %w", err)
+	}
+	userMap := make(map[string]*entity.User, len(users))
+	for _, u := range users {
+		userMap[u.ID] = u
+	}
+
+	statuses := i.presenceService.GetWorkspacePresence(input.WorkspaceID)
+
+	output := &GetWorkspacePresenceOutput{Presences: make([]UserPresenceOutput, 0, len(members))}
+	for _, m := range members {
+		status, ok := statuses[m.UserID]
+		if !ok {
+			status = entity.PresenceStatusOffline
+		}
+		presence := UserPresenceOutput{
+			UserID: m.UserID,
+			Status: string(status),
+		}
+		if u := userMap[m.UserID]; u != nil {
+			presence.LastSeenAt = u.LastSeenAt
+		}
+		output.Presences = append(output.Presences, presence)
+	}
+
+	return output, nil
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/newt239/chat/internal/domain/entity"
 	domainrepository "github.com/newt239/chat/internal/domain/repository"
@@ -25,6 +26,7 @@ type readStateInteractor struct {
 	channelRepo       domainrepository.ChannelRepository
 	channelMemberRepo domainrepository.ChannelMemberRepository
 	workspaceRepo     domainrepository.WorkspaceRepository
+	userRepo          domainrepository.UserRepository
 	notificationSvc   service.NotificationService
 	channelAccessSvc  service.ChannelAccessService
 }
@@ -34,6 +36,7 @@ func NewReadStateInteractor(
 	channelRepo domainrepository.ChannelRepository,
 	channelMemberRepo domainrepository.ChannelMemberRepository,
 	workspaceRepo domainrepository.WorkspaceRepository,
+	userRepo domainrepository.UserRepository,
 	notificationSvc service.NotificationService,
 	channelAccessSvc service.ChannelAccessService,
 ) ReadStateUseCase {
@@ -42,6 +45,7 @@ func NewReadStateInteractor(
 		channelRepo:       channelRepo,
 		channelMemberRepo: channelMemberRepo,
 		workspaceRepo:     workspaceRepo,
+		userRepo:          userRepo,
 		notificationSvc:   notificationSvc,
 		channelAccessSvc:  channelAccessSvc,
 	}
@@ -67,6 +71,11 @@ func (i *readStateInteractor) UpdateReadState(ctx context.Context, input UpdateR
 		return err
 	}
 
+	previous, err := i.readStateRepo.FindByChannelAndUser(ctx, channel.ID, input.UserID)
+	if err != nil {
+		return fmt.Errorf("failed to get read state: %w", err)
+	}
+
 	readState := &entity.ChannelReadState{
 		ChannelID:  channel.ID,
 		UserID:     input.UserID,
@@ -87,7 +96,53 @@ func (i *readStateInteractor) UpdateReadState(ctx context.Context, input UpdateR
 		}
 	}
 
+	// DM・グループDMでは既読位置が進んだ場合のみ他のメンバーに既読を通知する
+	if channel.IsDirectMessage() && (previous == nil || input.LastReadAt.After(previous.LastReadAt)) {
+		i.notifyReadReceipt(ctx, channel, input.UserID, input.LastReadAt)
+	}
+
 	return nil
 }
 
+// notifyReadReceipt は既読を有効にしている他のメンバーにread_receiptイベントを送信します
+// 既読を無効にしているユーザーは既読を送信せず、他のメンバーの既読も受け取りません
+func (i *readStateInteractor) notifyReadReceipt(ctx context.Context, channel *entity.Channel, userID string, lastReadAt time.Time) {
+	if i.notificationSvc == nil {
+		return
+	}
+
+	members, err := i.channelMemberRepo.FindMembers(ctx, channel.ID)
+	if err != nil {
+		fmt.Printf("[WARN] 既読通知のためのメンバー取得に失敗しました: channel=%s err=%v\n", channel.ID, err)
+		return
+	}
+	memberIDs := make([]string, 0, len(members))
+	for _, member := range members {
+		memberIDs = append(memberIDs, member.UserID)
+	}
+	users, err := i.userRepo.FindByIDs(ctx, memberIDs)
+	if err != nil {
+		fmt.Printf("[WARN] 既読通知のためのユーザー取得に失敗しました: channel=%s err=%v\n", channel.ID, err)
+		return
+	}
+
+	recipientIDs := make([]string, 0, len(users))
+	for _, user := range users {
+		if !user.ReadReceiptsEnabled {
+			if user.ID == userID {
+				return
+			}
+			continue
+		}
+		if user.ID != userID {
+			recipientIDs = append(recipientIDs, user.ID)
+		}
+	}
+	if len(recipientIDs) == 0 {
+		return
+	}
+
+	i.notificationSvc.NotifyReadReceipt(channel.WorkspaceID, channel.ID, userID, lastReadAt, recipientIDs)
+}
+
 // ensureChannelAccess は ChannelAccessService に委譲済み
@@ -0,0 +1,203 @@
+package reminder
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/newt239/chat/internal/domain/entity"
+	domainerrors "github.com/newt239/chat/internal/domain/errors"
+	domainrepository "github.com/newt239/chat/internal/domain/repository"
+	"github.com/newt239/chat/internal/domain/service"
+	"github.com/newt239/chat/internal/domain/transaction"
+	systemmsguc "github.com/newt239/chat/internal/usecase/systemmessage"
+)
+
+const (
+	// dispatchInterval は通知日時を過ぎたリマインダーを確認する間隔です
+	dispatchInterval = 15 * time.Second
+
+	// dispatchBatchSize は1回の確認で通知するリマインダーの最大件数です
+	dispatchBatchSize = 100
+
+	// claimTimeout を過ぎても通知処理が完了しないリマインダーは、処理中のインスタンスが停止したとみなして再取得します
+	claimTimeout = 2 * time.Minute
+)
+
+// Dispatcher は通知日時を過ぎたリマインダーを通知します
+// 複数のインスタンスで実行しても、リマインダーごとに1つのインスタンスのみが通知します
+type Dispatcher struct {
+	reminderRepo       domainrepository.ReminderRepository
+	messageRepo        domainrepository.MessageRepository
+	channelAccessSvc   service.ChannelAccessService
+	systemMessageUC    systemmsguc.UseCase
+	notificationSvc    service.NotificationService
+	transactionManager transaction.Manager
+	logger             service.Logger
+}
+
+// NewDispatcher は新しいDispatcherを作成します
+func NewDispatcher(
+	reminderRepo domainrepository.ReminderRepository,
+	messageRepo domainrepository.MessageRepository,
+	channelAccessSvc service.ChannelAccessService,
+	systemMessageUC systemmsguc.UseCase,
+	notificationSvc service.NotificationService,
+	transactionManager transaction.Manager,
+	logger service.Logger,
+) *Dispatcher {
+	return &Dispatcher{
+		reminderRepo:       reminderRepo,
+		messageRepo:        messageRepo,
+		channelAccessSvc:   channelAccessSvc,
+		systemMessageUC:    systemMessageUC,
+		notificationSvc:    notificationSvc,
+		transactionManager: transactionManager,
+		logger:             logger,
+	}
+}
+
+// Run はctxが終了するまで定期的にリマインダーを通知します
+// 起動時には停止中に通知日時を過ぎたリマインダーをすぐに通知します
+func (d *Dispatcher) Run(ctx context.Context) {
+	ticker := time.NewTicker(dispatchInterval)
+	defer ticker.Stop()
+
+	for {
+		d.DispatchDue(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// DispatchDue は通知日時を過ぎたリマインダーを通知します
+func (d *Dispatcher) DispatchDue(ctx context.Context) {
+	now := time.Now()
+	dueList, err := d.reminderRepo.FindDue(ctx, now, now.Add(-claimTimeout), dispatchBatchSize)
+	if err != nil {
+		d.logger.Error("リマインダーの取得に失敗しました", service.LogField{Key: "error", Value: err})
+		return
+	}
+
+	for _, due := range dueList {
+		if ctx.Err() != nil {
+			return
+		}
+
+		claimedAt := time.Now()
+		claimed, err := d.reminderRepo.Claim(ctx, due.ID, claimedAt, claimedAt.Add(-claimTimeout))
+		if err != nil {
+			d.logger.Error("リマインダーの取得に失敗しました",
+				service.LogField{Key: "reminderId", Value: due.ID},
+				service.LogField{Key: "error", Value: err})
+			continue
+		}
+		if !claimed {
+			// 他のインスタンスが通知処理を開始したか、ユーザーが変更・削除した
+			continue
+		}
+
+		// 取得までの間に変更された内容で通知する
+		r, err := d.reminderRepo.FindByID(ctx, due.ID)
+		if err != nil || r == nil {
+			continue
+		}
+		d.dispatch(ctx, r)
+	}
+}
+
+// dispatch はリマインダーを本人のみに表示するシステムメッセージとreminder_dueイベントで通知します
+// 設定後にメッセージが削除された場合や、チャンネルにアクセスできなくなった場合は通知せずに削除します
+func (d *Dispatcher) dispatch(ctx context.Context, r *entity.Reminder) {
+	message, err := d.messageRepo.FindByID(ctx, r.MessageID)
+	if err != nil {
+		d.release(ctx, r, err)
+		return
+	}
+	if message == nil || message.DeletedAt != nil {
+		d.discard(ctx, r, "メッセージが削除されました")
+		return
+	}
+
+	channel, err := d.channelAccessSvc.EnsureChannelAccess(ctx, message.ChannelID, r.UserID)
+	if err != nil {
+		if errors.Is(err, domainerrors.ErrChannelNotFound) || errors.Is(err, domainerrors.ErrUnauthorized) {
+			d.discard(ctx, r, "チャンネルにアクセスできません")
+			return
+		}
+		d.release(ctx, r, err)
+		return
+	}
+
+	// システムメッセージの作成と通知済みへの更新を同じトランザクションで行い、
+	// 更新に失敗した場合に次の確認で同じリマインダーを重複して通知しないようにする
+	fired := *r
+	firedAt := time.Now()
+	fired.Status = entity.ReminderStatusFired
+	fired.FiredAt = &firedAt
+	fired.ClaimedAt = nil
+
+	var systemMessage *entity.SystemMessage
+	err = d.transactionManager.Do(ctx, func(txCtx context.Context) error {
+		created, err := d.systemMessageUC.Create(txCtx, systemmsguc.CreateInput{
+			ChannelID: channel.ID,
+			Kind:      entity.SystemMessageKindReminder,
+			Payload: map[string]any{
+				"reminderId": r.ID,
+				"messageId":  message.ID,
+			},
+			RecipientID: &r.UserID,
+		})
+		if err != nil {
+			return err
+		}
+		if err := d.reminderRepo.Update(txCtx, &fired); err != nil {
+			return err
+		}
+		systemMessage = created
+		return nil
+	})
+	if err != nil {
+		d.release(ctx, r, err)
+		return
+	}
+
+	if d.notificationSvc != nil {
+		d.notificationSvc.NotifyReminderDue(channel.WorkspaceID, fired.UserID, &fired, systemMessage)
+	}
+}
+
+// release は通知できなかったリマインダーを次の確認時に再試行します
+// 取得後にユーザーが変更・スヌーズした場合はその内容を優先し、通知待ちに戻しません
+func (d *Dispatcher) release(ctx context.Context, r *entity.Reminder, cause error) {
+	d.logger.Warn("リマインダーの通知に失敗しました",
+		service.LogField{Key: "reminderId", Value: r.ID},
+		service.LogField{Key: "error", Value: cause})
+
+	if r.ClaimedAt == nil {
+		return
+	}
+	if _, err := d.reminderRepo.Release(ctx, r.ID, *r.ClaimedAt); err != nil {
+		// 戻せなかった場合も、処理中のまま一定時間が経過すれば再び取得される
+		d.logger.Error("リマインダーの更新に失敗しました",
+			service.LogField{Key: "reminderId", Value: r.ID},
+			service.LogField{Key: "error", Value: err})
+	}
+}
+
+// discard は通知できなくなったリマインダーを削除します
+func (d *Dispatcher) discard(ctx context.Context, r *entity.Reminder, reason string) {
+	d.logger.Info("通知できないリマインダーを削除しました",
+		service.LogField{Key: "reminderId", Value: r.ID},
+		service.LogField{Key: "reason", Value: reason})
+
+	if err := d.reminderRepo.Delete(ctx, r.ID); err != nil {
+		d.logger.Error("リマインダーの削除に失敗しました",
+			service.LogField{Key: "reminderId", Value: r.ID},
+			service.LogField{Key: "error", Value: err})
+	}
+}
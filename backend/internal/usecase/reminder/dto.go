@@ -0,0 +1,72 @@
+package reminder
+
+import (
+	"time"
+
+	"github.com/newt239/chat/internal/domain/entity"
+)
+
+// 通知日時はRemindAtとPresetのいずれかで指定します
+// Presetは in_20_minutes | in_1_hour | in_3_hours | tomorrow | next_week のいずれかです
+type CreateReminderInput struct {
+	UserID    string
+	MessageID string
+	RemindAt  *time.Time
+	Preset    *string
+	// Timezone はプリセットの日付を計算するタイムゾーン（IANA形式）で、空の場合はUTCです
+	Timezone string
+}
+
+type SnoozeReminderInput struct {
+	ID       string
+	UserID   string
+	RemindAt *time.Time
+	Preset   *string
+	Timezone string
+}
+
+type CompleteReminderInput struct {
+	ID     string
+	UserID string
+}
+
+type DeleteReminderInput struct {
+	ID     string
+	UserID string
+}
+
+type ListRemindersInput struct {
+	UserID string
+	// IncludeCompleted がfalseの場合は完了したリマインダーを含めません
+	IncludeCompleted bool
+}
+
+type ReminderOutput struct {
+	ID          string     `json:"id"`
+	MessageID   string     `json:"messageId"`
+	ChannelID   string     `json:"channelId"`
+	RemindAt    time.Time  `json:"remindAt"`
+	Status      string     `json:"status"`
+	FiredAt     *time.Time `json:"firedAt"`
+	CompletedAt *time.Time `json:"completedAt"`
+	CreatedAt   time.Time  `json:"createdAt"`
+	UpdatedAt   time.Time  `json:"updatedAt"`
+}
+
+type ListRemindersOutput struct {
+	Reminders []ReminderOutput `json:"reminders"`
+}
+
+func toOutput(r *entity.Reminder) ReminderOutput {
+	return ReminderOutput{
+		ID:          r.ID,
+		MessageID:   r.MessageID,
+		ChannelID:   r.ChannelID,
+		RemindAt:    r.RemindAt,
+		Status:      string(r.Status),
+		FiredAt:     r.FiredAt,
+		CompletedAt: r.CompletedAt,
+		CreatedAt:   r.CreatedAt,
+		UpdatedAt:   r.UpdatedAt,
+	}
+}
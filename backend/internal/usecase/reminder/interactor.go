@@ -0,0 +1,207 @@
+package reminder
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/newt239/chat/internal/domain/entity"
+	domainrepository "github.com/newt239/chat/internal/domain/repository"
+	"github.com/newt239/chat/internal/domain/service"
+)
+
+var (
+	ErrReminderNotFound   = errors.New("リマインダーが見つかりません")
+	ErrUnauthorized       = errors.New("この操作を行う権限がありません")
+	ErrMessageNotFound    = errors.New("メッセージが見つかりません")
+	ErrRemindAtRequired   = errors.New("通知日時またはプリセットのいずれか一方を指定してください")
+	ErrInvalidRemindAt    = errors.New("通知日時には現在より後の日時を指定してください")
+	ErrInvalidPreset      = errors.New("プリセットには in_20_minutes、in_1_hour、in_3_hours、tomorrow、next_week のいずれかを指定してください")
+	ErrInvalidTimezone    = errors.New("タイムゾーンが不正です")
+	ErrReminderInProgress = errors.New("リマインダーは通知処理中のため変更できません")
+	ErrReminderCompleted  = errors.New("完了したリマインダーは変更できません")
+)
+
+type ReminderUseCase interface {
+	ListReminders(ctx context.Context, input ListRemindersInput) (*ListRemindersOutput, error)
+	CreateReminder(ctx context.Context, input CreateReminderInput) (*ReminderOutput, error)
+	SnoozeReminder(ctx context.Context, input SnoozeReminderInput) (*ReminderOutput, error)
+	CompleteReminder(ctx context.Context, input CompleteReminderInput) (*ReminderOutput, error)
+	DeleteReminder(ctx context.Context, input DeleteReminderInput) error
+}
+
+type interactor struct {
+	reminderRepo     domainrepository.ReminderRepository
+	messageRepo      domainrepository.MessageRepository
+	channelAccessSvc service.ChannelAccessService
+}
+
+func NewReminderInteractor(
+	reminderRepo domainrepository.ReminderRepository,
+	messageRepo domainrepository.MessageRepository,
+	channelAccessSvc service.ChannelAccessService,
+) ReminderUseCase {
+	return &interactor{
+		reminderRepo:     reminderRepo,
+		messageRepo:      messageRepo,
+		channelAccessSvc: channelAccessSvc,
+	}
+}
+
+// ListReminders はユーザーのリマインダーを通知日時の早い順で取得します
+func (i *interactor) ListReminders(ctx context.Context, input ListRemindersInput) (*ListRemindersOutput, error) {
+	reminders, err := i.reminderRepo.FindByUserID(ctx, input.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("リマインダーの取得に失敗しました: %w", err)
+	}
+
+	output := &ListRemindersOutput{Reminders: make([]ReminderOutput, 0, len(reminders))}
+	for _, r := range reminders {
+		if !input.IncludeCompleted && r.Status == entity.ReminderStatusCompleted {
+			continue
+		}
+		output.Reminders = append(output.Reminders, toOutput(r))
+	}
+	return output, nil
+}
+
+// CreateReminder はアクセスできるメッセージにリマインダーを設定します
+func (i *interactor) CreateReminder(ctx context.Context, input CreateReminderInput) (*ReminderOutput, error) {
+	message, err := i.messageRepo.FindByID(ctx, input.MessageID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch message: %w", err)
+	}
+	if message == nil || message.DeletedAt != nil {
+		return nil, ErrMessageNotFound
+	}
+
+	if _, err := i.channelAccessSvc.EnsureChannelAccess(ctx, message.ChannelID, input.UserID); err != nil {
+		return nil, err
+	}
+
+	remindAt, err := resolveRemindAt(time.Now(), input.RemindAt, input.Preset, input.Timezone)
+	if err != nil {
+		return nil, err
+	}
+
+	r := &entity.Reminder{
+		UserID:    input.UserID,
+		MessageID: message.ID,
+		RemindAt:  remindAt,
+		Status:    entity.ReminderStatusPending,
+	}
+	if err := i.reminderRepo.Create(ctx, r); err != nil {
+		return nil, fmt.Errorf("リマインダーの作成に失敗しました: %w", err)
+	}
+
+	output := toOutput(r)
+	return &output, nil
+}
+
+// SnoozeReminder はリマインダーの通知日時を変更します
+// 通知済みのリマインダーは変更すると再び通知されます
+func (i *interactor) SnoozeReminder(ctx context.Context, input SnoozeReminderInput) (*ReminderOutput, error) {
+	r, err := i.findOwned(ctx, input.ID, input.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if r.Status == entity.ReminderStatusCompleted {
+		return nil, ErrReminderCompleted
+	}
+
+	remindAt, err := resolveRemindAt(time.Now(), input.RemindAt, input.Preset, input.Timezone)
+	if err != nil {
+		return nil, err
+	}
+
+	r.RemindAt = remindAt
+	r.Status = entity.ReminderStatusPending
+	r.FiredAt = nil
+	if err := i.reminderRepo.Update(ctx, r); err != nil {
+		return nil, fmt.Errorf("リマインダーの更新に失敗しました: %w", err)
+	}
+
+	output := toOutput(r)
+	return &output, nil
+}
+
+// CompleteReminder はリマインダーを完了にします
+// 通知日時を過ぎていないリマインダーは通知されなくなります
+func (i *interactor) CompleteReminder(ctx context.Context, input CompleteReminderInput) (*ReminderOutput, error) {
+	r, err := i.findOwned(ctx, input.ID, input.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	if r.Status != entity.ReminderStatusCompleted {
+		now := time.Now()
+		r.Status = entity.ReminderStatusCompleted
+		r.CompletedAt = &now
+		if err := i.reminderRepo.Update(ctx, r); err != nil {
+			return nil, fmt.Errorf("リマインダーの更新に失敗しました: %w", err)
+		}
+	}
+
+	output := toOutput(r)
+	return &output, nil
+}
+
+// DeleteReminder はリマインダーを削除します
+func (i *interactor) DeleteReminder(ctx context.Context, input DeleteReminderInput) error {
+	r, err := i.findOwned(ctx, input.ID, input.UserID)
+	if err != nil {
+		return err
+	}
+
+	if err := i.reminderRepo.Delete(ctx, r.ID); err != nil {
+		return fmt.Errorf("リマインダーの削除に失敗しました: %w", err)
+	}
+	return nil
+}
+
+// findOwned はユーザーが設定した、通知処理中でないリマインダーを取得します
+func (i *interactor) findOwned(ctx context.Context, id, userID string) (*entity.Reminder, error) {
+	r, err := i.reminderRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("リマインダーの取得に失敗しました: %w", err)
+	}
+	if r == nil {
+		return nil, ErrReminderNotFound
+	}
+	if r.UserID != userID {
+		return nil, ErrUnauthorized
+	}
+	if r.Status == entity.ReminderStatusSending {
+		return nil, ErrReminderInProgress
+	}
+	return r, nil
+}
+
+// resolveRemindAt は通知日時またはプリセットから通知日時を求めます
+func resolveRemindAt(now time.Time, remindAt *time.Time, preset *string, timezone string) (time.Time, error) {
+	if (remindAt == nil) == (preset == nil) {
+		return time.Time{}, ErrRemindAtRequired
+	}
+
+	if remindAt != nil {
+		if !remindAt.After(now) {
+			return time.Time{}, ErrInvalidRemindAt
+		}
+		return *remindAt, nil
+	}
+
+	if timezone == "" {
+		timezone = "UTC"
+	}
+	loc, err := time.LoadLocation(timezone)
+	if err != nil {
+		return time.Time{}, ErrInvalidTimezone
+	}
+
+	at, ok := entity.ReminderPreset(*preset).RemindAt(now, loc)
+	if !ok {
+		return time.Time{}, ErrInvalidPreset
+	}
+	return at, nil
+}
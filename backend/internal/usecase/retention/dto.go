@@ -0,0 +1,77 @@
+package retention
+
+import (
+	"time"
+
+	"github.com/newt239/chat/internal/domain/entity"
+)
+
+type GetWorkspacePolicyInput struct {
+	WorkspaceID string
+	UserID      string
+}
+
+// RetentionDays はメッセージを保存する日数で、0の場合は無期限に保存します
+type UpdateWorkspacePolicyInput struct {
+	WorkspaceID   string
+	UserID        string
+	RetentionDays int
+}
+
+type GetChannelPolicyInput struct {
+	ChannelID string
+	UserID    string
+}
+
+// RetentionDays がnilの場合はチャンネル個別の設定を解除し、ワークスペースの設定に従います
+type UpdateChannelPolicyInput struct {
+	ChannelID     string
+	UserID        string
+	RetentionDays *int
+}
+
+type GetReportInput struct {
+	WorkspaceID string
+	UserID      string
+}
+
+type WorkspacePolicyOutput struct {
+	WorkspaceID   string `json:"workspaceId"`
+	RetentionDays int    `json:"retentionDays"`
+}
+
+type ChannelPolicyOutput struct {
+	ChannelID string `json:"channelId"`
+	// RetentionDays はチャンネル個別の保存日数で、nilの場合はワークスペースの設定に従います
+	RetentionDays          *int `json:"retentionDays"`
+	WorkspaceRetentionDays int  `json:"workspaceRetentionDays"`
+	EffectiveRetentionDays int  `json:"effectiveRetentionDays"`
+}
+
+// ReportOutput は保存期間を過ぎて次回の削除処理で削除されるメッセージの集計です
+type ReportOutput struct {
+	WorkspaceID      string                `json:"workspaceId"`
+	GeneratedAt      time.Time             `json:"generatedAt"`
+	TotalMessages    int                   `json:"totalMessages"`
+	TotalAttachments int                   `json:"totalAttachments"`
+	Channels         []ChannelReportOutput `json:"channels"`
+}
+
+type ChannelReportOutput struct {
+	ChannelID       string     `json:"channelId"`
+	ChannelName     string     `json:"channelName"`
+	RetentionDays   int        `json:"retentionDays"`
+	Cutoff          time.Time  `json:"cutoff"`
+	MessageCount    int        `json:"messageCount"`
+	AttachmentCount int        `json:"attachmentCount"`
+	OldestMessageAt *time.Time `json:"oldestMessageAt"`
+}
+
+func toChannelPolicyOutput(p *entity.ChannelRetentionPolicy) *ChannelPolicyOutput {
+	return &ChannelPolicyOutput{
+		ChannelID:              p.ChannelID,
+		RetentionDays:          p.ChannelRetentionDays,
+		WorkspaceRetentionDays: p.WorkspaceRetentionDays,
+		EffectiveRetentionDays: p.EffectiveDays(),
+	}
+}
@@ -0,0 +1,261 @@
+package retention
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/newt239/chat/internal/domain/entity"
+	domainerrors "github.com/newt239/chat/internal/domain/errors"
+	domainrepository "github.com/newt239/chat/internal/domain/repository"
+	"github.com/newt239/chat/internal/domain/service"
+	"github.com/newt239/chat/internal/usecase/systemmessage"
+)
+
+var (
+	ErrWorkspaceNotFound = errors.New("ワークスペースが見つかりません")
+	ErrChannelNotFound   = errors.New("チャンネルが見つかりません")
+	ErrUnauthorized      = errors.New("この操作を行う権限がありません")
+)
+
+// 保存期間の変更を知らせるシステムメッセージのpayloadのscopeです
+const (
+	scopeWorkspace = "workspace"
+	scopeChannel   = "channel"
+)
+
+type RetentionUseCase interface {
+	GetWorkspacePolicy(ctx context.Context, input GetWorkspacePolicyInput) (*WorkspacePolicyOutput, error)
+	UpdateWorkspacePolicy(ctx context.Context, input UpdateWorkspacePolicyInput) (*WorkspacePolicyOutput, error)
+	GetChannelPolicy(ctx context.Context, input GetChannelPolicyInput) (*ChannelPolicyOutput, error)
+	UpdateChannelPolicy(ctx context.Context, input UpdateChannelPolicyInput) (*ChannelPolicyOutput, error)
+	// GetReport は保存期間を過ぎたメッセージを削除せずに集計します
+	GetReport(ctx context.Context, input GetReportInput) (*ReportOutput, error)
+}
+
+type interactor struct {
+	retentionRepo    domainrepository.MessageRetentionRepository
+	workspaceRepo    domainrepository.WorkspaceRepository
+	channelAccessSvc service.ChannelAccessService
+	systemMessageUC  systemmessage.UseCase
+	logger           service.Logger
+}
+
+func NewRetentionInteractor(
+	retentionRepo domainrepository.MessageRetentionRepository,
+	workspaceRepo domainrepository.WorkspaceRepository,
+	channelAccessSvc service.ChannelAccessService,
+	systemMessageUC systemmessage.UseCase,
+	logger service.Logger,
+) RetentionUseCase {
+	return &interactor{
+		retentionRepo:    retentionRepo,
+		workspaceRepo:    workspaceRepo,
+		channelAccessSvc: channelAccessSvc,
+		systemMessageUC:  systemMessageUC,
+		logger:           logger,
+	}
+}
+
+// GetWorkspacePolicy はワークスペースの保存期間の設定を取得します
+func (i *interactor) GetWorkspacePolicy(ctx context.Context, input GetWorkspacePolicyInput) (*WorkspacePolicyOutput, error) {
+	member, err := i.workspaceRepo.FindMember(ctx, input.WorkspaceID, input.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check membership: %w", err)
+	}
+	if member == nil {
+		return nil, ErrUnauthorized
+	}
+
+	days, err := i.findWorkspaceRetentionDays(ctx, input.WorkspaceID)
+	if err != nil {
+		return nil, err
+	}
+	return &WorkspacePolicyOutput{WorkspaceID: input.WorkspaceID, RetentionDays: days}, nil
+}
+
+// UpdateWorkspacePolicy はワークスペースの保存期間を更新します
+// 変更した場合は、ワークスペースの設定に従うチャンネルにシステムメッセージで知らせます
+func (i *interactor) UpdateWorkspacePolicy(ctx context.Context, input UpdateWorkspacePolicyInput) (*WorkspacePolicyOutput, error) {
+	if err := i.ensureAdmin(ctx, input.WorkspaceID, input.UserID); err != nil {
+		return nil, err
+	}
+	if err := entity.ValidateMessageRetentionDays(input.RetentionDays); err != nil {
+		return nil, err
+	}
+
+	current, err := i.findWorkspaceRetentionDays(ctx, input.WorkspaceID)
+	if err != nil {
+		return nil, err
+	}
+	output := &WorkspacePolicyOutput{WorkspaceID: input.WorkspaceID, RetentionDays: input.RetentionDays}
+	if current == input.RetentionDays {
+		return output, nil
+	}
+
+	if err := i.retentionRepo.UpdateWorkspaceRetentionDays(ctx, input.WorkspaceID, input.RetentionDays); err != nil {
+		return nil, fmt.Errorf("failed to update workspace retention: %w", err)
+	}
+
+	policies, err := i.retentionRepo.FindChannelPolicies(ctx, input.WorkspaceID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load channel retention: %w", err)
+	}
+	for _, policy := range policies {
+		if policy.ChannelRetentionDays != nil {
+			continue
+		}
+		i.announce(ctx, policy.ChannelID, input.UserID, input.RetentionDays, scopeWorkspace)
+	}
+
+	return output, nil
+}
+
+// GetChannelPolicy はチャンネルの保存期間の設定を取得します
+func (i *interactor) GetChannelPolicy(ctx context.Context, input GetChannelPolicyInput) (*ChannelPolicyOutput, error) {
+	if _, err := i.channelAccessSvc.EnsureChannelAccess(ctx, input.ChannelID, input.UserID); err != nil {
+		switch {
+		case errors.Is(err, domainerrors.ErrChannelNotFound):
+			return nil, ErrChannelNotFound
+		case errors.Is(err, domainerrors.ErrUnauthorized):
+			return nil, ErrUnauthorized
+		}
+		return nil, err
+	}
+
+	policy, err := i.findChannelPolicy(ctx, input.ChannelID)
+	if err != nil {
+		return nil, err
+	}
+	return toChannelPolicyOutput(policy), nil
+}
+
+// UpdateChannelPolicy はチャンネル個別の保存期間を更新します
+// 適用する保存期間が変わる場合は、チャンネルにシステムメッセージで知らせます
+func (i *interactor) UpdateChannelPolicy(ctx context.Context, input UpdateChannelPolicyInput) (*ChannelPolicyOutput, error) {
+	policy, err := i.findChannelPolicy(ctx, input.ChannelID)
+	if err != nil {
+		return nil, err
+	}
+	if err := i.ensureAdmin(ctx, policy.WorkspaceID, input.UserID); err != nil {
+		return nil, err
+	}
+	if input.RetentionDays != nil {
+		if err := entity.ValidateMessageRetentionDays(*input.RetentionDays); err != nil {
+			return nil, err
+		}
+	}
+
+	before := policy.EffectiveDays()
+	beforeScope := channelPolicyScope(policy)
+
+	if err := i.retentionRepo.UpdateChannelRetentionDays(ctx, input.ChannelID, input.RetentionDays); err != nil {
+		return nil, fmt.Errorf("failed to update channel retention: %w", err)
+	}
+	policy.ChannelRetentionDays = input.RetentionDays
+
+	if after := policy.EffectiveDays(); after != before || channelPolicyScope(policy) != beforeScope {
+		i.announce(ctx, policy.ChannelID, input.UserID, after, channelPolicyScope(policy))
+	}
+
+	return toChannelPolicyOutput(policy), nil
+}
+
+// GetReport はワークスペースのチャンネルごとに、保存期間を過ぎて次回の削除処理で削除されるメッセージを集計します
+// 無期限に保存するチャンネルは含みません
+func (i *interactor) GetReport(ctx context.Context, input GetReportInput) (*ReportOutput, error) {
+	if err := i.ensureAdmin(ctx, input.WorkspaceID, input.UserID); err != nil {
+		return nil, err
+	}
+
+	policies, err := i.retentionRepo.FindChannelPolicies(ctx, input.WorkspaceID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load channel retention: %w", err)
+	}
+
+	now := time.Now()
+	output := &ReportOutput{
+		WorkspaceID: input.WorkspaceID,
+		GeneratedAt: now,
+		Channels:    make([]ChannelReportOutput, 0),
+	}
+	for _, policy := range policies {
+		cutoff, ok := policy.Cutoff(now)
+		if !ok {
+			continue
+		}
+
+		count, err := i.retentionRepo.CountExpired(ctx, policy.ChannelID, cutoff)
+		if err != nil {
+			return nil, fmt.Errorf("failed to count expired messages: %w", err)
+		}
+		output.TotalMessages += count.Messages
+		output.TotalAttachments += count.Attachments
+		output.Channels = append(output.Channels, ChannelReportOutput{
+			ChannelID:       policy.ChannelID,
+			ChannelName:     policy.ChannelName,
+			RetentionDays:   policy.EffectiveDays(),
+			Cutoff:          cutoff,
+			MessageCount:    count.Messages,
+			AttachmentCount: count.Attachments,
+			OldestMessageAt: count.OldestMessageAt,
+		})
+	}
+	return output, nil
+}
+
+func (i *interactor) ensureAdmin(ctx context.Context, workspaceID string, userID string) error {
+	member, err := i.workspaceRepo.FindMember(ctx, workspaceID, userID)
+	if err != nil {
+		return fmt.Errorf("failed to check membership: %w", err)
+	}
+	if member == nil || (member.Role != entity.WorkspaceRoleOwner && member.Role != entity.WorkspaceRoleAdmin) {
+		return ErrUnauthorized
+	}
+	return nil
+}
+
+func (i *interactor) findWorkspaceRetentionDays(ctx context.Context, workspaceID string) (int, error) {
+	days, err := i.retentionRepo.FindWorkspaceRetentionDays(ctx, workspaceID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to load workspace retention: %w", err)
+	}
+	if days == nil {
+		return 0, ErrWorkspaceNotFound
+	}
+	return *days, nil
+}
+
+func (i *interactor) findChannelPolicy(ctx context.Context, channelID string) (*entity.ChannelRetentionPolicy, error) {
+	policy, err := i.retentionRepo.FindChannelPolicy(ctx, channelID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load channel retention: %w", err)
+	}
+	if policy == nil {
+		return nil, ErrChannelNotFound
+	}
+	return policy, nil
+}
+
+// announce は保存期間の変更をチャンネルのシステムメッセージで知らせます
+// 設定の変更は完了しているため、失敗した場合はログに記録するのみとします
+func (i *interactor) announce(ctx context.Context, channelID string, actorID string, retentionDays int, scope string) {
+	if _, err := i.systemMessageUC.Create(ctx, systemmessage.CreateInput{
+		ChannelID: channelID,
+		Kind:      entity.SystemMessageKindMessageRetentionChanged,
+		Payload:   map[string]any{"retentionDays": retentionDays, "scope": scope},
+		ActorID:   &actorID,
+	}); err != nil {
+		i.logger.Warn("保存期間の変更のシステムメッセージの作成に失敗しました",
+			service.LogField{Key: "channelId", Value: channelID},
+			service.LogField{Key: "error", Value: err})
+	}
+}
+
+func channelPolicyScope(policy *entity.ChannelRetentionPolicy) string {
+	if policy.ChannelRetentionDays != nil {
+		return scopeChannel
+	}
+	return scopeWorkspace
+}
@@ -0,0 +1,188 @@
+package retention
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/newt239/chat/internal/domain/entity"
+	domainrepository "github.com/newt239/chat/internal/domain/repository"
+	"github.com/newt239/chat/internal/domain/service"
+	"github.com/newt239/chat/internal/domain/transaction"
+)
+
+const (
+	// purgeInterval は保存期間を過ぎたメッセージを確認する間隔です
+	purgeInterval = time.Hour
+
+	// purgeBatchSize は1つのトランザクションで削除するメッセージの最大件数です
+	purgeBatchSize = 200
+)
+
+var errStorageUnavailable = errors.New("ストレージに接続できないため添付ファイルを削除できません")
+
+// Purger は保存期間を過ぎたメッセージを削除します
+//
+// メッセージはリアクション・メンション・リンク・ピン・ブックマーク・添付ファイルなどの関連するデータとともに削除し、
+// 添付ファイルのオブジェクトもストレージから削除します。保存期間内の返信があるスレッドの親メッセージは、
+// 返信を残すため本文と関連するデータのみ削除し、返信がすべて保存期間を過ぎた後に削除します。
+// 有効期限を過ぎた一時的なメッセージもあわせて削除します。
+type Purger struct {
+	retentionRepo      domainrepository.MessageRetentionRepository
+	ephemeralRepo      domainrepository.EphemeralMessageRepository
+	storageSvc         service.StorageService
+	transactionManager transaction.Manager
+	logger             service.Logger
+}
+
+// NewPurger は新しいPurgerを作成します
+func NewPurger(
+	retentionRepo domainrepository.MessageRetentionRepository,
+	ephemeralRepo domainrepository.EphemeralMessageRepository,
+	storageSvc service.StorageService,
+	transactionManager transaction.Manager,
+	logger service.Logger,
+) *Purger {
+	return &Purger{
+		retentionRepo:      retentionRepo,
+		ephemeralRepo:      ephemeralRepo,
+		storageSvc:         storageSvc,
+		transactionManager: transactionManager,
+		logger:             logger,
+	}
+}
+
+// Run はctxが終了するまで定期的に保存期間を過ぎたメッセージを削除します
+func (p *Purger) Run(ctx context.Context) {
+	ticker := time.NewTicker(purgeInterval)
+	defer ticker.Stop()
+
+	for {
+		p.PurgeExpired(ctx)
+		p.PurgeExpiredEphemeral(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// PurgeExpired は保存期間を設定しているチャンネルの、保存期間を過ぎたメッセージを削除します
+func (p *Purger) PurgeExpired(ctx context.Context) {
+	policies, err := p.retentionRepo.FindPurgeTargets(ctx)
+	if err != nil {
+		p.logger.Error("保存期間を設定しているチャンネルの取得に失敗しました", service.LogField{Key: "error", Value: err})
+		return
+	}
+
+	now := time.Now()
+	for _, policy := range policies {
+		if ctx.Err() != nil {
+			return
+		}
+		cutoff, ok := policy.Cutoff(now)
+		if !ok {
+			continue
+		}
+
+		deleted, err := p.purgeChannel(ctx, policy.ChannelID, cutoff)
+		if err != nil {
+			p.logger.Error("保存期間を過ぎたメッセージの削除に失敗しました",
+				service.LogField{Key: "channelId", Value: policy.ChannelID},
+				service.LogField{Key: "error", Value: err})
+		}
+		if deleted > 0 {
+			p.logger.Info("保存期間を過ぎたメッセージを削除しました",
+				service.LogField{Key: "channelId", Value: policy.ChannelID},
+				service.LogField{Key: "retentionDays", Value: policy.EffectiveDays()},
+				service.LogField{Key: "count", Value: deleted})
+		}
+	}
+}
+
+// PurgeExpiredEphemeral は有効期限を過ぎた一時的なメッセージを削除します
+// 一覧では有効期限内のもののみ返すため、削除が遅れても表示には影響しません
+func (p *Purger) PurgeExpiredEphemeral(ctx context.Context) {
+	deleted, err := p.ephemeralRepo.DeleteExpired(ctx, time.Now())
+	if err != nil {
+		p.logger.Error("有効期限を過ぎた一時的なメッセージの削除に失敗しました", service.LogField{Key: "error", Value: err})
+		return
+	}
+	if deleted > 0 {
+		p.logger.Info("有効期限を過ぎた一時的なメッセージを削除しました", service.LogField{Key: "count", Value: deleted})
+	}
+}
+
+// purgeChannel はチャンネルの cutoff より前に投稿されたメッセージを削除し、削除・消去した件数を返します
+func (p *Purger) purgeChannel(ctx context.Context, channelID string, cutoff time.Time) (int, error) {
+	total := 0
+
+	steps := []struct {
+		find  func() ([]*entity.ExpiredMessage, error)
+		purge func(ctx context.Context, ids []string) error
+	}{
+		{
+			find: func() ([]*entity.ExpiredMessage, error) {
+				return p.retentionRepo.FindExpired(ctx, channelID, cutoff, purgeBatchSize)
+			},
+			purge: p.retentionRepo.Delete,
+		},
+		{
+			find: func() ([]*entity.ExpiredMessage, error) {
+				return p.retentionRepo.FindExpiredThreadParents(ctx, channelID, cutoff, purgeBatchSize)
+			},
+			purge: func(ctx context.Context, ids []string) error {
+				return p.retentionRepo.Redact(ctx, ids, time.Now())
+			},
+		},
+	}
+
+	for _, step := range steps {
+		for ctx.Err() == nil {
+			expired, err := step.find()
+			if err != nil {
+				return total, err
+			}
+			if len(expired) == 0 {
+				break
+			}
+
+			// ストレージのオブジェクトを削除できなかった場合は、次回の削除処理で再試行するためメッセージを残す
+			if err := p.deleteObjects(ctx, expired); err != nil {
+				return total, err
+			}
+
+			ids := make([]string, 0, len(expired))
+			for _, msg := range expired {
+				ids = append(ids, msg.ID)
+			}
+			if err := p.transactionManager.Do(ctx, func(txCtx context.Context) error {
+				return step.purge(txCtx, ids)
+			}); err != nil {
+				return total, err
+			}
+			total += len(ids)
+
+			if len(expired) < purgeBatchSize {
+				break
+			}
+		}
+	}
+	return total, nil
+}
+
+func (p *Purger) deleteObjects(ctx context.Context, expired []*entity.ExpiredMessage) error {
+	for _, msg := range expired {
+		for _, key := range msg.AttachmentStorageKeys {
+			if p.storageSvc == nil {
+				return errStorageUnavailable
+			}
+			if err := p.storageSvc.DeleteObject(ctx, key); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
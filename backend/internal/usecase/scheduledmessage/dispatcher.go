@@ -0,0 +1,201 @@
+package scheduledmessage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/newt239/chat/internal/domain/entity"
+	domainerrors "github.com/newt239/chat/internal/domain/errors"
+	domainrepository "github.com/newt239/chat/internal/domain/repository"
+	"github.com/newt239/chat/internal/domain/service"
+	messageuc "github.com/newt239/chat/internal/usecase/message"
+)
+
+const (
+	// dispatchInterval は投稿日時を過ぎた予約メッセージを確認する間隔です
+	dispatchInterval = 15 * time.Second
+
+	// dispatchBatchSize は1回の確認で投稿する予約メッセージの最大件数です
+	dispatchBatchSize = 50
+
+	// claimTimeout を過ぎても投稿処理が完了しない予約メッセージは、処理中のインスタンスが停止したとみなして再取得します
+	claimTimeout = 2 * time.Minute
+
+	// postTimeout は予約メッセージ1件の投稿のタイムアウトです
+	postTimeout = 30 * time.Second
+
+	// maxDispatchAttempts 回続けて投稿に失敗した予約メッセージは失敗として扱います
+	maxDispatchAttempts = 5
+
+	// failureNoticeTTL は投稿に失敗したことを作成者に知らせる一時的なメッセージを保存する期間です
+	failureNoticeTTL = 24 * time.Hour
+)
+
+// Dispatcher は投稿日時を過ぎた予約メッセージを投稿します
+// 複数のインスタンスで実行しても、予約メッセージごとに1つのインスタンスのみが投稿します
+type Dispatcher struct {
+	scheduledRepo domainrepository.ScheduledMessageRepository
+	messageUC     messageuc.MessageUseCase
+	ephemeralSvc  service.EphemeralMessageService
+	logger        service.Logger
+}
+
+// NewDispatcher は新しいDispatcherを作成します
+func NewDispatcher(
+	scheduledRepo domainrepository.ScheduledMessageRepository,
+	messageUC messageuc.MessageUseCase,
+	ephemeralSvc service.EphemeralMessageService,
+	logger service.Logger,
+) *Dispatcher {
+	return &Dispatcher{
+		scheduledRepo: scheduledRepo,
+		messageUC:     messageUC,
+		ephemeralSvc:  ephemeralSvc,
+		logger:        logger,
+	}
+}
+
+// Run はctxが終了するまで定期的に予約メッセージを投稿します
+// 起動時には停止中に投稿日時を過ぎた予約メッセージをすぐに投稿します
+func (d *Dispatcher) Run(ctx context.Context) {
+	ticker := time.NewTicker(dispatchInterval)
+	defer ticker.Stop()
+
+	for {
+		d.DispatchDue(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// DispatchDue は投稿日時を過ぎた予約メッセージを投稿します
+func (d *Dispatcher) DispatchDue(ctx context.Context) {
+	now := time.Now()
+	dueList, err := d.scheduledRepo.FindDue(ctx, now, now.Add(-claimTimeout), dispatchBatchSize)
+	if err != nil {
+		d.logger.Error("予約メッセージの取得に失敗しました", service.LogField{Key: "error", Value: err})
+		return
+	}
+
+	for _, due := range dueList {
+		if ctx.Err() != nil {
+			return
+		}
+
+		claimedAt := time.Now()
+		claimed, err := d.scheduledRepo.Claim(ctx, due.ID, claimedAt, claimedAt.Add(-claimTimeout))
+		if err != nil {
+			d.logger.Error("予約メッセージの取得に失敗しました",
+				service.LogField{Key: "scheduledMessageId", Value: due.ID},
+				service.LogField{Key: "error", Value: err})
+			continue
+		}
+		if !claimed {
+			// 他のインスタンスが投稿処理を開始したか、ユーザーが変更・削除した
+			continue
+		}
+
+		// 取得までの間に変更された内容で投稿する
+		scheduled, err := d.scheduledRepo.FindByID(ctx, due.ID)
+		if err != nil || scheduled == nil {
+			continue
+		}
+		d.dispatch(ctx, scheduled)
+	}
+}
+
+// dispatch は予約メッセージを通常の投稿と同じ処理で投稿し、結果を記録します
+func (d *Dispatcher) dispatch(ctx context.Context, scheduled *entity.ScheduledMessage) {
+	// 同じ投稿日時の投稿には同じclient_msg_idを使用し、再取得した場合も二重に投稿しない
+	clientMsgID := fmt.Sprintf("sched:%s:%d", scheduled.ID, scheduled.SendAt.Unix())
+
+	postCtx, cancel := context.WithTimeout(ctx, postTimeout)
+	_, postErr := d.messageUC.CreateMessage(postCtx, messageuc.CreateMessageInput{
+		ChannelID:     scheduled.ChannelID,
+		UserID:        scheduled.UserID,
+		Body:          scheduled.Body,
+		ParentID:      scheduled.ParentID,
+		AttachmentIDs: scheduled.AttachmentIDs,
+		ClientMsgID:   &clientMsgID,
+		KeepDraft:     true,
+	})
+	cancel()
+
+	if postErr == nil {
+		next, recurring := scheduled.NextSendAt(time.Now())
+		if !recurring {
+			if err := d.scheduledRepo.Delete(ctx, scheduled.ID); err != nil {
+				d.logger.Error("投稿済みの予約メッセージの削除に失敗しました",
+					service.LogField{Key: "scheduledMessageId", Value: scheduled.ID},
+					service.LogField{Key: "error", Value: err})
+			}
+			return
+		}
+		scheduled.SendAt = next
+		scheduled.Status = entity.ScheduledMessageStatusPending
+		scheduled.Attempts = 0
+		scheduled.LastError = nil
+	} else {
+		d.logger.Warn("予約メッセージの投稿に失敗しました",
+			service.LogField{Key: "scheduledMessageId", Value: scheduled.ID},
+			service.LogField{Key: "error", Value: postErr})
+
+		lastError := postErr.Error()
+		scheduled.LastError = &lastError
+		scheduled.Attempts++
+		if isPermanentError(postErr) || scheduled.Attempts >= maxDispatchAttempts {
+			scheduled.Status = entity.ScheduledMessageStatusFailed
+		} else {
+			// 次の確認時に再試行する
+			scheduled.Status = entity.ScheduledMessageStatusPending
+		}
+	}
+
+	scheduled.ClaimedAt = nil
+	if err := d.scheduledRepo.Update(ctx, scheduled); err != nil {
+		d.logger.Error("予約メッセージの更新に失敗しました",
+			service.LogField{Key: "scheduledMessageId", Value: scheduled.ID},
+			service.LogField{Key: "error", Value: err})
+		return
+	}
+
+	if scheduled.Status == entity.ScheduledMessageStatusFailed {
+		d.notifyFailed(ctx, scheduled)
+	}
+}
+
+// notifyFailed は予約メッセージを投稿できなかったことを、投稿先のチャンネルで作成者のみに知らせます
+// 作成者がチャンネルを閲覧できなくなった場合は知らせません
+func (d *Dispatcher) notifyFailed(ctx context.Context, scheduled *entity.ScheduledMessage) {
+	_, err := d.ephemeralSvc.Send(ctx, service.EphemeralMessageInput{
+		ChannelID:   scheduled.ChannelID,
+		RecipientID: scheduled.UserID,
+		Kind:        entity.EphemeralMessageKindScheduledMessageFailed,
+		Body:        "予約メッセージを投稿できませんでした。予約メッセージの一覧から内容を確認してください。",
+		Payload: map[string]any{
+			"scheduledMessageId": scheduled.ID,
+			"sendAt":             scheduled.SendAt,
+		},
+		TTL: failureNoticeTTL,
+	})
+	if err != nil {
+		d.logger.Warn("予約メッセージの投稿失敗の通知に失敗しました",
+			service.LogField{Key: "scheduledMessageId", Value: scheduled.ID},
+			service.LogField{Key: "error", Value: err})
+	}
+}
+
+// isPermanentError は再試行しても投稿できないエラーか判定します
+// チャンネルから退出した場合や、返信先のメッセージが削除された場合などが該当します
+func isPermanentError(err error) bool {
+	return errors.Is(err, domainerrors.ErrChannelNotFound) ||
+		errors.Is(err, domainerrors.ErrUnauthorized) ||
+		errors.Is(err, messageuc.ErrParentMessageNotFound) ||
+		errors.Is(err, messageuc.ErrClientMsgIDConflict)
+}
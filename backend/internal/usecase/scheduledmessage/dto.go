@@ -0,0 +1,83 @@
+package scheduledmessage
+
+import (
+	"time"
+
+	"github.com/newt239/chat/internal/domain/entity"
+)
+
+type CreateScheduledMessageInput struct {
+	UserID        string
+	ChannelID     string
+	ParentID      *string
+	Body          string
+	AttachmentIDs []string
+	SendAt        time.Time
+	// Recurrence は daily | weekdays | weekly のいずれかで、空の場合は1回のみ投稿します
+	Recurrence string
+	// Timezone は繰り返しの日付・曜日を計算するタイムゾーン（IANA形式）で、空の場合はUTCです
+	Timezone string
+}
+
+// UpdateScheduledMessageInput はnilのフィールドを変更しません
+type UpdateScheduledMessageInput struct {
+	ID            string
+	UserID        string
+	Body          *string
+	AttachmentIDs *[]string
+	SendAt        *time.Time
+	Recurrence    *string
+	Timezone      *string
+}
+
+type DeleteScheduledMessageInput struct {
+	ID     string
+	UserID string
+}
+
+type ListScheduledMessagesInput struct {
+	UserID string
+	// ChannelID を指定した場合はそのチャンネルの予約メッセージのみを返します
+	ChannelID *string
+}
+
+type ScheduledMessageOutput struct {
+	ID            string    `json:"id"`
+	ChannelID     string    `json:"channelId"`
+	ParentID      *string   `json:"parentId"`
+	Body          string    `json:"body"`
+	AttachmentIDs []string  `json:"attachmentIds"`
+	SendAt        time.Time `json:"sendAt"`
+	Recurrence    *string   `json:"recurrence"`
+	Timezone      string    `json:"timezone"`
+	Status        string    `json:"status"`
+	LastError     *string   `json:"lastError"`
+	CreatedAt     time.Time `json:"createdAt"`
+	UpdatedAt     time.Time `json:"updatedAt"`
+}
+
+type ListScheduledMessagesOutput struct {
+	ScheduledMessages []ScheduledMessageOutput `json:"scheduledMessages"`
+}
+
+func toOutput(scheduled *entity.ScheduledMessage) ScheduledMessageOutput {
+	var recurrence *string
+	if scheduled.Recurrence != entity.RecurrenceNone {
+		r := string(scheduled.Recurrence)
+		recurrence = &r
+	}
+	return ScheduledMessageOutput{
+		ID:            scheduled.ID,
+		ChannelID:     scheduled.ChannelID,
+		ParentID:      scheduled.ParentID,
+		Body:          scheduled.Body,
+		AttachmentIDs: scheduled.AttachmentIDs,
+		SendAt:        scheduled.SendAt,
+		Recurrence:    recurrence,
+		Timezone:      scheduled.Timezone,
+		Status:        string(scheduled.Status),
+		LastError:     scheduled.LastError,
+		CreatedAt:     scheduled.CreatedAt,
+		UpdatedAt:     scheduled.UpdatedAt,
+	}
+}
@@ -0,0 +1,237 @@
+package scheduledmessage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/newt239/chat/internal/domain/entity"
+	domainrepository "github.com/newt239/chat/internal/domain/repository"
+	"github.com/newt239/chat/internal/domain/service"
+)
+
+var (
+	ErrScheduledMessageNotFound   = errors.New("予約メッセージが見つかりません")
+	ErrUnauthorized               = errors.New("この操作を行う権限がありません")
+	ErrParentMessageNotFound      = errors.New("親メッセージが見つかりません")
+	ErrInvalidBody                = errors.New("本文を入力してください")
+	ErrInvalidSendAt              = errors.New("投稿日時には現在より後の日時を指定してください")
+	ErrInvalidRecurrence          = errors.New("繰り返しには daily、weekdays、weekly のいずれかを指定してください")
+	ErrInvalidTimezone            = errors.New("タイムゾーンが不正です")
+	ErrRecurringAttachments       = errors.New("繰り返しの予約メッセージにはファイルを添付できません")
+	ErrScheduledMessageInProgress = errors.New("予約メッセージは投稿処理中のため変更できません")
+)
+
+type ScheduledMessageUseCase interface {
+	ListScheduledMessages(ctx context.Context, input ListScheduledMessagesInput) (*ListScheduledMessagesOutput, error)
+	CreateScheduledMessage(ctx context.Context, input CreateScheduledMessageInput) (*ScheduledMessageOutput, error)
+	UpdateScheduledMessage(ctx context.Context, input UpdateScheduledMessageInput) (*ScheduledMessageOutput, error)
+	DeleteScheduledMessage(ctx context.Context, input DeleteScheduledMessageInput) error
+}
+
+type interactor struct {
+	scheduledRepo    domainrepository.ScheduledMessageRepository
+	messageRepo      domainrepository.MessageRepository
+	channelAccessSvc service.ChannelAccessService
+}
+
+func NewScheduledMessageInteractor(
+	scheduledRepo domainrepository.ScheduledMessageRepository,
+	messageRepo domainrepository.MessageRepository,
+	channelAccessSvc service.ChannelAccessService,
+) ScheduledMessageUseCase {
+	return &interactor{
+		scheduledRepo:    scheduledRepo,
+		messageRepo:      messageRepo,
+		channelAccessSvc: channelAccessSvc,
+	}
+}
+
+// ListScheduledMessages はユーザーの予約メッセージを投稿日時の早い順で取得します
+func (i *interactor) ListScheduledMessages(ctx context.Context, input ListScheduledMessagesInput) (*ListScheduledMessagesOutput, error) {
+	scheduledList, err := i.scheduledRepo.FindByUserID(ctx, input.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("予約メッセージの取得に失敗しました: %w", err)
+	}
+
+	output := &ListScheduledMessagesOutput{
+		ScheduledMessages: make([]ScheduledMessageOutput, 0, len(scheduledList)),
+	}
+	for _, scheduled := range scheduledList {
+		if input.ChannelID != nil && scheduled.ChannelID != *input.ChannelID {
+			continue
+		}
+		output.ScheduledMessages = append(output.ScheduledMessages, toOutput(scheduled))
+	}
+	return output, nil
+}
+
+// CreateScheduledMessage はメッセージを予約します
+func (i *interactor) CreateScheduledMessage(ctx context.Context, input CreateScheduledMessageInput) (*ScheduledMessageOutput, error) {
+	channel, err := i.channelAccessSvc.EnsureChannelAccess(ctx, input.ChannelID, input.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	if input.ParentID != nil {
+		parent, err := i.messageRepo.FindByID(ctx, *input.ParentID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch parent message: %w", err)
+		}
+		if parent == nil || parent.ChannelID != channel.ID {
+			return nil, ErrParentMessageNotFound
+		}
+	}
+
+	timezone := input.Timezone
+	if timezone == "" {
+		timezone = "UTC"
+	}
+
+	attachmentIDs := input.AttachmentIDs
+	if attachmentIDs == nil {
+		attachmentIDs = []string{}
+	}
+
+	scheduled := &entity.ScheduledMessage{
+		ChannelID:     channel.ID,
+		UserID:        input.UserID,
+		ParentID:      input.ParentID,
+		Body:          input.Body,
+		AttachmentIDs: attachmentIDs,
+		SendAt:        input.SendAt,
+		Recurrence:    entity.Recurrence(input.Recurrence),
+		Timezone:      timezone,
+		Status:        entity.ScheduledMessageStatusPending,
+	}
+	if !scheduled.SendAt.After(time.Now()) {
+		return nil, ErrInvalidSendAt
+	}
+	if err := validate(scheduled); err != nil {
+		return nil, err
+	}
+
+	if err := i.scheduledRepo.Create(ctx, scheduled); err != nil {
+		return nil, fmt.Errorf("予約メッセージの作成に失敗しました: %w", err)
+	}
+
+	output := toOutput(scheduled)
+	return &output, nil
+}
+
+// UpdateScheduledMessage は予約メッセージを変更します
+// 投稿に失敗した予約メッセージは変更すると再び予約されます
+func (i *interactor) UpdateScheduledMessage(ctx context.Context, input UpdateScheduledMessageInput) (*ScheduledMessageOutput, error) {
+	scheduled, err := i.findOwned(ctx, input.ID, input.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	if input.Body != nil {
+		scheduled.Body = *input.Body
+	}
+	if input.AttachmentIDs != nil {
+		scheduled.AttachmentIDs = *input.AttachmentIDs
+	}
+	// 投稿日時を変更しない場合、投稿に失敗して投稿日時を過ぎた予約メッセージは次の確認時に投稿する
+	if input.SendAt != nil {
+		if !input.SendAt.After(time.Now()) {
+			return nil, ErrInvalidSendAt
+		}
+		scheduled.SendAt = *input.SendAt
+	}
+	if input.Recurrence != nil {
+		scheduled.Recurrence = entity.Recurrence(*input.Recurrence)
+	}
+	if input.Timezone != nil {
+		scheduled.Timezone = *input.Timezone
+	}
+
+	if err := validate(scheduled); err != nil {
+		return nil, err
+	}
+
+	scheduled.Status = entity.ScheduledMessageStatusPending
+	scheduled.Attempts = 0
+	scheduled.LastError = nil
+	// 確認後に他のインスタンスが投稿処理を開始した場合は変更しない
+	updated, err := i.scheduledRepo.UpdateUnclaimed(ctx, scheduled)
+	if err != nil {
+		return nil, fmt.Errorf("予約メッセージの更新に失敗しました: %w", err)
+	}
+	if !updated {
+		return nil, i.unclaimedConflict(ctx, scheduled.ID)
+	}
+
+	output := toOutput(scheduled)
+	return &output, nil
+}
+
+// DeleteScheduledMessage は予約メッセージを取り消します
+func (i *interactor) DeleteScheduledMessage(ctx context.Context, input DeleteScheduledMessageInput) error {
+	scheduled, err := i.findOwned(ctx, input.ID, input.UserID)
+	if err != nil {
+		return err
+	}
+
+	// 確認後に他のインスタンスが投稿処理を開始した場合は取り消さない
+	deleted, err := i.scheduledRepo.DeleteUnclaimed(ctx, scheduled.ID)
+	if err != nil {
+		return fmt.Errorf("予約メッセージの削除に失敗しました: %w", err)
+	}
+	if !deleted {
+		return i.unclaimedConflict(ctx, scheduled.ID)
+	}
+	return nil
+}
+
+// unclaimedConflict は投稿処理中でない場合のみ行う変更・取り消しができなかった理由を返します
+// 投稿が完了して削除された場合は見つからないエラーを、それ以外は投稿処理中のエラーを返します
+func (i *interactor) unclaimedConflict(ctx context.Context, id string) error {
+	scheduled, err := i.scheduledRepo.FindByID(ctx, id)
+	if err != nil {
+		return fmt.Errorf("予約メッセージの取得に失敗しました: %w", err)
+	}
+	if scheduled == nil {
+		return ErrScheduledMessageNotFound
+	}
+	return ErrScheduledMessageInProgress
+}
+
+// findOwned はユーザーが作成した、投稿処理中でない予約メッセージを取得します
+func (i *interactor) findOwned(ctx context.Context, id, userID string) (*entity.ScheduledMessage, error) {
+	scheduled, err := i.scheduledRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("予約メッセージの取得に失敗しました: %w", err)
+	}
+	if scheduled == nil {
+		return nil, ErrScheduledMessageNotFound
+	}
+	if scheduled.UserID != userID {
+		return nil, ErrUnauthorized
+	}
+	if scheduled.Status == entity.ScheduledMessageStatusSending {
+		return nil, ErrScheduledMessageInProgress
+	}
+	return scheduled, nil
+}
+
+// validate は予約メッセージの投稿日時以外の内容を検証します
+func validate(scheduled *entity.ScheduledMessage) error {
+	if strings.TrimSpace(scheduled.Body) == "" {
+		return ErrInvalidBody
+	}
+	if !scheduled.Recurrence.IsValid() {
+		return ErrInvalidRecurrence
+	}
+	if _, err := time.LoadLocation(scheduled.Timezone); err != nil || scheduled.Timezone == "" {
+		return ErrInvalidTimezone
+	}
+	// 添付ファイルは投稿したメッセージに紐付けられるため、繰り返し投稿できない
+	if scheduled.Recurrence != entity.RecurrenceNone && len(scheduled.AttachmentIDs) > 0 {
+		return ErrRecurringAttachments
+	}
+	return nil
+}
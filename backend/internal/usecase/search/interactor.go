@@ -4,6 +4,7 @@ import (
 	"context"
 
 	domainrepository "github.com/newt239/chat/internal/domain/repository"
+	"github.com/newt239/chat/internal/domain/service"
 )
 
 // SearchUseCase は検索機能のユースケースインターフェースです
@@ -26,6 +27,9 @@ func NewSearchUseCase(
 	groupMentionRepo domainrepository.MessageGroupMentionRepository,
 	linkRepo domainrepository.MessageLinkRepository,
 	attachmentRepo domainrepository.AttachmentRepository,
+	shareRepo domainrepository.MessageShareRepository,
+	pollRepo domainrepository.PollRepository,
+	channelAccessSvc service.ChannelAccessService,
 ) SearchUseCase {
 	return &searchInteractor{
 		workspaceSearcher: NewWorkspaceSearcher(
@@ -38,6 +42,9 @@ func NewSearchUseCase(
 			groupMentionRepo,
 			linkRepo,
 			attachmentRepo,
+			shareRepo,
+			pollRepo,
+			channelAccessSvc,
 		),
 	}
 }
@@ -7,6 +7,7 @@ import (
 
 	"github.com/newt239/chat/internal/domain/entity"
 	domainrepository "github.com/newt239/chat/internal/domain/repository"
+	"github.com/newt239/chat/internal/domain/service"
 	channeluc "github.com/newt239/chat/internal/usecase/channel"
 	messageuc "github.com/newt239/chat/internal/usecase/message"
 	workspaceuc "github.com/newt239/chat/internal/usecase/workspace"
@@ -40,6 +41,9 @@ func NewWorkspaceSearcher(
 	groupMentionRepo domainrepository.MessageGroupMentionRepository,
 	linkRepo domainrepository.MessageLinkRepository,
 	attachmentRepo domainrepository.AttachmentRepository,
+	shareRepo domainrepository.MessageShareRepository,
+	pollRepo domainrepository.PollRepository,
+	channelAccessSvc service.ChannelAccessService,
 ) *WorkspaceSearcher {
 	assembler := messageuc.NewMessageOutputAssembler()
 	outputBuilder := messageuc.NewMessageOutputBuilder(
@@ -50,6 +54,9 @@ func NewWorkspaceSearcher(
 		groupMentionRepo,
 		linkRepo,
 		attachmentRepo,
+		shareRepo,
+		pollRepo,
+		channelAccessSvc,
 		assembler,
 	)
 
@@ -196,7 +203,7 @@ func (s *WorkspaceSearcher) searchMessages(
 		return PaginatedMessages{}, fmt.Errorf("failed to search messages: %w", err)
 	}
 
-	outputs, err := s.messageOutputBuilder.Build(ctx, messages)
+	outputs, err := s.messageOutputBuilder.Build(ctx, messages, userID)
 	if err != nil {
 		return PaginatedMessages{}, fmt.Errorf("failed to build message outputs: %w", err)
 	}
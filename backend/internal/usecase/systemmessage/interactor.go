@@ -15,23 +15,42 @@ type CreateInput struct {
     Kind      entity.SystemMessageKind
     Payload   map[string]any
     ActorID   *string
+    // RecipientID を指定した場合は、そのユーザーのみに表示するシステムメッセージを作成します
+    // チャンネルへは通知しないため、宛先ユーザーへの通知は呼び出し元で行います
+    RecipientID *string
+}
+
+// EphemeralInput は1人のユーザーのみに表示する一時的なメッセージの送信内容です
+type EphemeralInput struct {
+    ChannelID   string
+    RecipientID string
+    Kind        entity.EphemeralMessageKind
+    Body        string
+    Payload     map[string]any
+    // TTL が正の場合は再読込後も表示できるよう、その期間だけ保存します
+    TTL time.Duration
 }
 
 type UseCase interface {
     Create(ctx context.Context, input CreateInput) (*entity.SystemMessage, error)
+    // SendEphemeral はチャンネル内の宛先ユーザーの接続のみに一時的なメッセージを送信します
+    // 通常のメッセージ・システムメッセージとは異なり、未読数・検索の対象になりません
+    SendEphemeral(ctx context.Context, input EphemeralInput) (*entity.EphemeralMessage, error)
 }
 
 type interactor struct {
     systemMsgRepo domainrepository.SystemMessageRepository
     channelRepo   domainrepository.ChannelRepository
     notification  service.NotificationService
+    ephemeralSvc  service.EphemeralMessageService
 }
 
-func New(systemMsgRepo domainrepository.SystemMessageRepository, channelRepo domainrepository.ChannelRepository, notification service.NotificationService) UseCase {
+func New(systemMsgRepo domainrepository.SystemMessageRepository, channelRepo domainrepository.ChannelRepository, notification service.NotificationService, ephemeralSvc service.EphemeralMessageService) UseCase {
     return &interactor{
         systemMsgRepo: systemMsgRepo,
         channelRepo:   channelRepo,
         notification:  notification,
+        ephemeralSvc:  ephemeralSvc,
     }
 }
 
@@ -48,6 +67,7 @@ func (i *interactor) Create(ctx context.Context, input CreateInput) (*entity.Sys
         Kind:      input.Kind,
         Payload:   input.Payload,
         ActorID:   input.ActorID,
+        RecipientID: input.RecipientID,
         CreatedAt: time.Now(),
     }
 
@@ -55,20 +75,33 @@ func (i *interactor) Create(ctx context.Context, input CreateInput) (*entity.Sys
         return nil, err
     }
 
+    if msg.RecipientID != nil {
+        return msg, nil
+    }
+
     // 通知（workspaceID はチャネルから解決）
     ch, err := i.channelRepo.FindByID(ctx, input.ChannelID)
     if err == nil && ch != nil && i.notification != nil {
-        i.notification.NotifySystemMessageCreated(ch.WorkspaceID, input.ChannelID, map[string]any{
-            "id":        msg.ID,
-            "channelId": msg.ChannelID,
-            "kind":      string(msg.Kind),
-            "payload":   msg.Payload,
-            "actorId":   msg.ActorID,
-            "createdAt": msg.CreatedAt.Format(time.RFC3339),
-        })
+        i.notification.NotifySystemMessageCreated(ch.WorkspaceID, input.ChannelID, msg)
     }
 
     return msg, nil
 }
 
+func (i *interactor) SendEphemeral(ctx context.Context, input EphemeralInput) (*entity.EphemeralMessage, error) {
+    if input.ChannelID == "" {
+        return nil, fmt.Errorf("channel id is required")
+    }
+    if input.RecipientID == "" {
+        return nil, fmt.Errorf("recipient id is required")
+    }
 
+    return i.ephemeralSvc.Send(ctx, service.EphemeralMessageInput{
+        ChannelID:   input.ChannelID,
+        RecipientID: input.RecipientID,
+        Kind:        input.Kind,
+        Body:        input.Body,
+        Payload:     input.Payload,
+        TTL:         input.TTL,
+    })
+}
@@ -5,13 +5,16 @@ type UpdateMeInput struct {
     DisplayName *string
     Bio         *string
     AvatarURL   *string
+    // ReadReceiptsEnabled はDMの既読の送信・受信を有効にするかどうかです
+    ReadReceiptsEnabled *bool
 }
 
 type UpdateMeOutput struct {
-    ID          string
-    DisplayName string
-    Bio         *string
-    AvatarURL   *string
+    ID                  string
+    DisplayName         string
+    Bio                 *string
+    AvatarURL           *string
+    ReadReceiptsEnabled bool
 }
 
 
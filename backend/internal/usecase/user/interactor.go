@@ -46,16 +46,20 @@ func (i *interactor) UpdateMe(ctx context.Context, input UpdateMeInput) (*Update
     if input.AvatarURL != nil {
         u.AvatarURL = input.AvatarURL
     }
+    if input.ReadReceiptsEnabled != nil {
+        u.ReadReceiptsEnabled = *input.ReadReceiptsEnabled
+    }
 
     if err := i.userRepo.Update(ctx, u); err != nil {
         return nil, err
     }
 
     return &UpdateMeOutput{
-        ID:          u.ID,
-        DisplayName: u.DisplayName,
-        Bio:         u.Bio,
-        AvatarURL:   u.AvatarURL,
+        ID:                  u.ID,
+        DisplayName:         u.DisplayName,
+        Bio:                 u.Bio,
+        AvatarURL:           u.AvatarURL,
+        ReadReceiptsEnabled: u.ReadReceiptsEnabled,
     }, nil
 }
 
@@ -19,6 +19,8 @@ type UpdateWorkspaceInput struct {
 	Description *string
 	IconURL     *string
     IsPublic    *bool
+	// MessageHistoryEnabled をfalseにすると以降の編集でメッセージの編集履歴を保存せず、保存済みの編集履歴も削除します
+	MessageHistoryEnabled *bool
 	UserID      string // For authorization check
 }
 
@@ -66,6 +68,7 @@ type WorkspaceOutput struct {
 	Description *string   `json:"description"`
 	IconURL     *string   `json:"iconUrl"`
     IsPublic    bool      `json:"isPublic"`
+	MessageHistoryEnabled bool `json:"messageHistoryEnabled"`
 	Role        string    `json:"role"`
 	CreatedBy   string    `json:"createdBy"`
 	CreatedAt   time.Time `json:"createdAt"`
@@ -8,6 +8,8 @@ import (
 
 	"github.com/newt239/chat/internal/domain/entity"
 	domainrepository "github.com/newt239/chat/internal/domain/repository"
+	"github.com/newt239/chat/internal/domain/service"
+	"github.com/newt239/chat/internal/domain/transaction"
 )
 
 var (
@@ -36,17 +38,40 @@ type WorkspaceUseCase interface {
 }
 
 type workspaceInteractor struct {
-	workspaceRepo domainrepository.WorkspaceRepository
-	userRepo      domainrepository.UserRepository
+	workspaceRepo      domainrepository.WorkspaceRepository
+	userRepo           domainrepository.UserRepository
+	revisionRepo       domainrepository.MessageRevisionRepository
+	notificationSvc    service.NotificationService
+	transactionManager transaction.Manager
 }
 
 func NewWorkspaceInteractor(
 	workspaceRepo domainrepository.WorkspaceRepository,
 	userRepo domainrepository.UserRepository,
+	revisionRepo domainrepository.MessageRevisionRepository,
+	notificationSvc service.NotificationService,
+	transactionManager transaction.Manager,
 ) WorkspaceUseCase {
 	return &workspaceInteractor{
-		workspaceRepo: workspaceRepo,
-		userRepo:      userRepo,
+		workspaceRepo:      workspaceRepo,
+		userRepo:           userRepo,
+		revisionRepo:       revisionRepo,
+		notificationSvc:    notificationSvc,
+		transactionManager: transactionManager,
+	}
+}
+
+// notifyMemberAdded はWorkspaceに参加したユーザーの接続にWorkspaceを追加します
+func (i *workspaceInteractor) notifyMemberAdded(workspaceID string, userID string) {
+	if i.notificationSvc != nil {
+		i.notificationSvc.NotifyWorkspaceMemberAdded(workspaceID, userID)
+	}
+}
+
+// notifyMemberRemoved はWorkspaceから削除されたユーザーの接続からWorkspaceを外します
+func (i *workspaceInteractor) notifyMemberRemoved(workspaceID string, userID string) {
+	if i.notificationSvc != nil {
+		i.notificationSvc.NotifyWorkspaceMemberRemoved(workspaceID, userID)
 	}
 }
 
@@ -75,6 +100,7 @@ func (i *workspaceInteractor) GetWorkspacesByUserID(ctx context.Context, userID
 			Description: ws.Description,
 			IconURL:     ws.IconURL,
             IsPublic:    ws.IsPublic,
+			MessageHistoryEnabled: ws.MessageHistoryEnabled,
 			Role:        string(member.Role),
 			CreatedBy:   ws.CreatedBy,
 			CreatedAt:   ws.CreatedAt,
@@ -109,6 +135,7 @@ func (i *workspaceInteractor) GetWorkspace(ctx context.Context, input GetWorkspa
 			Description: ws.Description,
 			IconURL:     ws.IconURL,
             IsPublic:    ws.IsPublic,
+			MessageHistoryEnabled: ws.MessageHistoryEnabled,
 			Role:        string(member.Role),
 			CreatedBy:   ws.CreatedBy,
 			CreatedAt:   ws.CreatedAt,
@@ -157,6 +184,7 @@ func (i *workspaceInteractor) CreateWorkspace(ctx context.Context, input CreateW
 	if err := i.workspaceRepo.AddMember(ctx, member); err != nil {
 		return nil, fmt.Errorf("failed to add creator as owner: %w", err)
 	}
+	i.notifyMemberAdded(workspace.ID, input.CreatedBy)
 
     return &CreateWorkspaceOutput{
 		Workspace: WorkspaceOutput{
@@ -165,6 +193,7 @@ func (i *workspaceInteractor) CreateWorkspace(ctx context.Context, input CreateW
 			Description: workspace.Description,
 			IconURL:     workspace.IconURL,
             IsPublic:    workspace.IsPublic,
+			MessageHistoryEnabled: workspace.MessageHistoryEnabled,
 			Role:        string(entity.WorkspaceRoleOwner),
 			CreatedBy:   workspace.CreatedBy,
 			CreatedAt:   workspace.CreatedAt,
@@ -201,11 +230,28 @@ func (i *workspaceInteractor) UpdateWorkspace(ctx context.Context, input UpdateW
     }
     if input.IsPublic != nil {
         ws.IsPublic = *input.IsPublic
+    }
+    // 編集履歴の保存を無効にした場合は、保存済みの編集履歴も削除する
+    purgeHistory := false
+    if input.MessageHistoryEnabled != nil {
+        purgeHistory = ws.MessageHistoryEnabled && !*input.MessageHistoryEnabled
+        ws.MessageHistoryEnabled = *input.MessageHistoryEnabled
     }
 	ws.UpdatedAt = time.Now()
 
-	if err := i.workspaceRepo.Update(ctx, ws); err != nil {
-		return nil, fmt.Errorf("failed to update workspace: %w", err)
+	err = i.transactionManager.Do(ctx, func(txCtx context.Context) error {
+		if err := i.workspaceRepo.Update(txCtx, ws); err != nil {
+			return fmt.Errorf("failed to update workspace: %w", err)
+		}
+		if purgeHistory {
+			if err := i.revisionRepo.DeleteByWorkspaceID(txCtx, ws.ID); err != nil {
+				return fmt.Errorf("failed to delete message revisions: %w", err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
     return &UpdateWorkspaceOutput{
@@ -215,6 +261,7 @@ func (i *workspaceInteractor) UpdateWorkspace(ctx context.Context, input UpdateW
 			Description: ws.Description,
 			IconURL:     ws.IconURL,
             IsPublic:    ws.IsPublic,
+			MessageHistoryEnabled: ws.MessageHistoryEnabled,
 			Role:        string(member.Role),
 			CreatedBy:   ws.CreatedBy,
 			CreatedAt:   ws.CreatedAt,
@@ -232,10 +279,20 @@ func (i *workspaceInteractor) DeleteWorkspace(ctx context.Context, input DeleteW
 		return nil, ErrUnauthorized
 	}
 
+	// 削除後はメンバーを取得できないため、接続から外すメンバーを先に取得する
+	members, err := i.workspaceRepo.FindMembersByWorkspaceID(ctx, input.ID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find members: %w", err)
+	}
+
 	if err := i.workspaceRepo.Delete(ctx, input.ID); err != nil {
 		return nil, fmt.Errorf("failed to delete workspace: %w", err)
 	}
 
+	for _, m := range members {
+		i.notifyMemberRemoved(input.ID, m.UserID)
+	}
+
 	return &DeleteWorkspaceOutput{Success: true}, nil
 }
 
@@ -321,6 +378,7 @@ func (i *workspaceInteractor) AddMember(ctx context.Context, input AddMemberInpu
 	if err := i.workspaceRepo.AddMember(ctx, member); err != nil {
 		return nil, fmt.Errorf("failed to add member: %w", err)
 	}
+	i.notifyMemberAdded(input.WorkspaceID, input.UserID)
 
 	return &MemberActionOutput{Success: true}, nil
 }
@@ -372,6 +430,7 @@ func (i *workspaceInteractor) RemoveMember(ctx context.Context, input RemoveMemb
 	if err := i.workspaceRepo.RemoveMember(ctx, input.WorkspaceID, input.UserID); err != nil {
 		return nil, fmt.Errorf("failed to remove member: %w", err)
 	}
+	i.notifyMemberRemoved(input.WorkspaceID, input.UserID)
 
 	return &MemberActionOutput{Success: true}, nil
 }
@@ -450,6 +509,7 @@ func (i *workspaceInteractor) JoinPublicWorkspace(ctx context.Context, input Joi
     if err := i.workspaceRepo.AddMember(ctx, member); err != nil {
         return nil, fmt.Errorf("failed to join workspace: %w", err)
     }
+    i.notifyMemberAdded(input.WorkspaceID, input.UserID)
     return &MemberActionOutput{Success: true}, nil
 }
 
@@ -490,5 +550,6 @@ func (i *workspaceInteractor) AddMemberByEmail(ctx context.Context, input AddMem
     if err := i.workspaceRepo.AddMember(ctx, member); err != nil {
         return nil, fmt.Errorf("failed to add member by email: %w", err)
     }
+    i.notifyMemberAdded(input.WorkspaceID, user.ID)
     return &MemberActionOutput{Success: true}, nil
 }
@@ -40,6 +40,15 @@ export const SystemMessageItem = ({ message }: Props) => {
         const pinnedBy = typeof payload.pinnedBy === "string" ? payload.pinnedBy : "";
         return `メッセージがピン留めされました（by ${pinnedBy}）`;
       }
+      case "message_retention_changed": {
+        const days = Number(payload.retentionDays ?? 0);
+        const period = days > 0 ? `${days}日間` : "無期限";
+        const scope = payload.scope === "channel" ? "このチャンネル" : "ワークスペース";
+        return `${scope}のメッセージの保存期間が${period}に変更されました`;
+      }
+      case "reminder": {
+        return "リマインダー: 設定したメッセージを確認してください（あなたにのみ表示されています）";
+      }
       default:
         return "システムイベントが記録されました";
     }
@@ -97,17 +97,34 @@ const systemMessageSchema = z.object({
   createdAt: z.string(),
 });
 
+// Ephemeral message visible only to the current user
+const ephemeralMessageSchema = z.object({
+  id: z.string(),
+  channelId: z.string(),
+  kind: z.string(),
+  body: z.string(),
+  payload: z.record(z.string(), z.unknown()).optional(),
+  createdAt: z.string(),
+  expiresAt: z.string().optional(),
+});
+
 export const timelineItemSchema = z.object({
-  type: z.enum(["user", "system"]),
+  type: z.enum(["user", "system", "ephemeral"]),
   userMessage: messageWithUserSchema.optional(),
   systemMessage: systemMessageSchema.optional(),
+  ephemeralMessage: ephemeralMessageSchema.optional(),
   createdAt: z.string(),
 });
 
 export const messagesTimelineResponseSchema = z.object({
   messages: z.array(timelineItemSchema),
   hasMore: z.boolean(),
+  hasMoreAfter: z.boolean().optional(),
+  beforeCursor: z.string().nullable().optional(),
+  afterCursor: z.string().nullable().optional(),
+  anchorId: z.string().optional(),
 });
 
 export type SystemMessage = z.infer<typeof systemMessageSchema>;
+export type EphemeralMessage = z.infer<typeof ephemeralMessageSchema>;
 export type TimelineItem = z.infer<typeof timelineItemSchema>;
@@ -157,6 +157,25 @@ export interface paths {
         patch: operations["updateChannel"];
         trace?: never;
     };
+    "/api/channels/{channelId}/draft": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        /** Get the current user's draft in a channel or thread */
+        get: operations["getDraft"];
+        /** Save the current user's draft in a channel or thread */
+        put: operations["saveDraft"];
+        post?: never;
+        /** Delete the current user's draft in a channel or thread */
+        delete: operations["deleteDraft"];
+        options?: never;
+        head?: never;
+        patch?: never;
+        trace?: never;
+    };
     "/api/channels/{channelId}/members": {
         parameters: {
             query?: never;
@@ -297,6 +316,26 @@ export interface paths {
         patch?: never;
         trace?: never;
     };
+    "/api/channels/{channelId}/polls": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        get?: never;
+        put?: never;
+        /**
+         * Create a poll message
+         * @description 質問を本文とする投票のメッセージをチャンネルに作成します
+         */
+        post: operations["createPoll"];
+        delete?: never;
+        options?: never;
+        head?: never;
+        patch?: never;
+        trace?: never;
+    };
     "/api/channels/{channelId}/reads": {
         parameters: {
             query?: never;
@@ -314,6 +353,24 @@ export interface paths {
         patch?: never;
         trace?: never;
     };
+    "/api/channels/{channelId}/retention-policy": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        /** Get the message retention policy of a channel */
+        get: operations["getChannelRetentionPolicy"];
+        /** Override the message retention policy of a channel (workspace owner/admin only) */
+        put: operations["updateChannelRetentionPolicy"];
+        post?: never;
+        delete?: never;
+        options?: never;
+        head?: never;
+        patch?: never;
+        trace?: never;
+    };
     "/api/channels/{channelId}/unread_count": {
         parameters: {
             query?: never;
@@ -425,6 +482,46 @@ export interface paths {
         patch?: never;
         trace?: never;
     };
+    "/api/messages/{messageId}/revisions": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        /**
+         * List edit history of a message
+         * @description 投稿者本人とワークスペースの管理者のみ取得できます。編集履歴の保存が無効なワークスペースでは空の一覧を返します
+         */
+        get: operations["listMessageRevisions"];
+        put?: never;
+        post?: never;
+        delete?: never;
+        options?: never;
+        head?: never;
+        patch?: never;
+        trace?: never;
+    };
+    "/api/messages/{messageId}/share": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        get?: never;
+        put?: never;
+        /**
+         * Share a message into another channel
+         * @description 共有元のメッセージを参照する新しいメッセージを共有先のチャンネルに作成します。本文はコピーせず、閲覧者が共有元のチャンネルにアクセスできる場合のみプレビューを返します
+         */
+        post: operations["shareMessage"];
+        delete?: never;
+        options?: never;
+        head?: never;
+        patch?: never;
+        trace?: never;
+    };
     "/api/messages/{messageId}/thread": {
         parameters: {
             query?: never;
@@ -459,6 +556,148 @@ export interface paths {
         patch?: never;
         trace?: never;
     };
+    "/api/polls/{id}/votes": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        get?: never;
+        put?: never;
+        /**
+         * Vote for a poll option
+         * @description 単一選択の投票では、他の選択肢への投票を取り消してから投票します
+         */
+        post: operations["votePoll"];
+        delete?: never;
+        options?: never;
+        head?: never;
+        patch?: never;
+        trace?: never;
+    };
+    "/api/polls/{id}/votes/{optionId}": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        get?: never;
+        put?: never;
+        post?: never;
+        /** Remove a vote from a poll option */
+        delete: operations["unvotePoll"];
+        options?: never;
+        head?: never;
+        patch?: never;
+        trace?: never;
+    };
+    "/api/reminders": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        /** List the current user's reminders */
+        get: operations["listReminders"];
+        put?: never;
+        /** Set a reminder on a message */
+        post: operations["createReminder"];
+        delete?: never;
+        options?: never;
+        head?: never;
+        patch?: never;
+        trace?: never;
+    };
+    "/api/reminders/{id}": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        get?: never;
+        put?: never;
+        post?: never;
+        /** Delete a reminder */
+        delete: operations["deleteReminder"];
+        options?: never;
+        head?: never;
+        patch?: never;
+        trace?: never;
+    };
+    "/api/reminders/{id}/complete": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        get?: never;
+        put?: never;
+        /** Mark a reminder as completed */
+        post: operations["completeReminder"];
+        delete?: never;
+        options?: never;
+        head?: never;
+        patch?: never;
+        trace?: never;
+    };
+    "/api/reminders/{id}/snooze": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        get?: never;
+        put?: never;
+        /** Snooze a reminder */
+        post: operations["snoozeReminder"];
+        delete?: never;
+        options?: never;
+        head?: never;
+        patch?: never;
+        trace?: never;
+    };
+    "/api/scheduled-messages": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        /** List the current user's scheduled messages */
+        get: operations["listScheduledMessages"];
+        put?: never;
+        /** Schedule a message */
+        post: operations["createScheduledMessage"];
+        delete?: never;
+        options?: never;
+        head?: never;
+        patch?: never;
+        trace?: never;
+    };
+    "/api/scheduled-messages/{id}": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        get?: never;
+        put?: never;
+        post?: never;
+        /** Cancel a scheduled message */
+        delete: operations["deleteScheduledMessage"];
+        options?: never;
+        head?: never;
+        /** Update a scheduled message */
+        patch: operations["updateScheduledMessage"];
+        trace?: never;
+    };
     "/api/threads/{threadId}/read": {
         parameters: {
             query?: never;
@@ -622,6 +861,23 @@ export interface paths {
         patch?: never;
         trace?: never;
     };
+    "/api/workspaces/{id}/drafts": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        /** List the current user's drafts in a workspace */
+        get: operations["listDrafts"];
+        put?: never;
+        post?: never;
+        delete?: never;
+        options?: never;
+        head?: never;
+        patch?: never;
+        trace?: never;
+    };
     "/api/workspaces/{id}/group-dms": {
         parameters: {
             query?: never;
@@ -692,6 +948,58 @@ export interface paths {
         patch: operations["updateMemberRole"];
         trace?: never;
     };
+    "/api/workspaces/{id}/presence": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        /** Get presence of workspace members */
+        get: operations["getWorkspacePresence"];
+        put?: never;
+        post?: never;
+        delete?: never;
+        options?: never;
+        head?: never;
+        patch?: never;
+        trace?: never;
+    };
+    "/api/workspaces/{id}/retention-policy": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        /** Get the message retention policy of a workspace */
+        get: operations["getWorkspaceRetentionPolicy"];
+        /** Update the message retention policy of a workspace (owner/admin only) */
+        put: operations["updateWorkspaceRetentionPolicy"];
+        post?: never;
+        delete?: never;
+        options?: never;
+        head?: never;
+        patch?: never;
+        trace?: never;
+    };
+    "/api/workspaces/{id}/retention-policy/report": {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        /** Report messages that the next purge would delete without deleting them (owner/admin only) */
+        get: operations["getRetentionReport"];
+        put?: never;
+        post?: never;
+        delete?: never;
+        options?: never;
+        head?: never;
+        patch?: never;
+        trace?: never;
+    };
     "/api/workspaces/{workspaceId}/search": {
         parameters: {
             query?: never;
@@ -829,6 +1137,16 @@ export interface components {
             /** Format: date-time */
             joinedAt: string;
         };
+        ChannelRetentionPolicy: {
+            /** Format: uuid */
+            channelId: string;
+            /** @description チャンネル個別の保存日数（nullの場合はワークスペースの設定に従う） */
+            retentionDays: number | null;
+            /** @description ワークスペースの保存日数 */
+            workspaceRetentionDays: number;
+            /** @description チャンネルに適用する保存日数（0の場合は無期限に保存） */
+            effectiveRetentionDays: number;
+        };
         CreateChannelRequest: {
             name: string;
             description?: string;
@@ -848,16 +1166,66 @@ export interface components {
             /** Format: uuid */
             parentId?: string;
             attachmentIds?: string[];
+            /** @description 再送時の重複作成を防ぐためにクライアントが採番する冪等キー */
+            clientMsgId?: string;
+        };
+        CreatePollRequest: {
+            /** @description 投票の質問。メッセージの本文として投稿されます */
+            question: string;
+            options: string[];
+            /** @description trueの場合は複数の選択肢に投票できます（既定値はfalse） */
+            multipleChoice?: boolean;
+            /** @description trueの場合は投票者を公開しません（既定値はfalse） */
+            anonymous?: boolean;
+            /**
+             * Format: date-time
+             * @description 投票を締め切る日時（現在より後の日時）。省略した場合は締め切りません
+             */
+            closesAt?: string;
+            /** Format: uuid */
+            parentId?: string;
+            /** @description 再送時の重複作成を防ぐためにクライアントが採番する冪等キー */
+            clientMsgId?: string;
         };
-        CreateUserGroupRequest: {
+        CreateReminderRequest: {
             /** Format: uuid */
-            workspaceId: string;
-            name: string;
-            description?: string;
+            messageId: string;
+            /**
+             * Format: date-time
+             * @description 通知する日時（現在より後の日時）。presetと同時には指定できません
+             */
+            remindAt?: string;
+            /** @description in_20_minutes | in_1_hour | in_3_hours | tomorrow（翌日9:00）| next_week（翌週月曜日9:00） */
+            preset?: string;
+            /** @description プリセットの日付を計算するタイムゾーン（IANA形式）。省略した場合はUTC */
+            timezone?: string;
         };
-        CreateWorkspaceRequest: {
-            id: string;
-            name: string;
+        CreateScheduledMessageRequest: {
+            /** Format: uuid */
+            channelId: string;
+            body: string;
+            /** Format: uuid */
+            parentId?: string;
+            attachmentIds?: string[];
+            /**
+             * Format: date-time
+             * @description 投稿する日時（現在より後の日時）
+             */
+            sendAt: string;
+            /** @description 繰り返しの規則（daily | weekdays | weekly）。省略した場合は1回のみ投稿します */
+            recurrence?: string;
+            /** @description 繰り返しの日付・曜日を計算するタイムゾーン（IANA形式）。省略した場合はUTC */
+            timezone?: string;
+        };
+        CreateUserGroupRequest: {
+            /** Format: uuid */
+            workspaceId: string;
+            name: string;
+            description?: string;
+        };
+        CreateWorkspaceRequest: {
+            id: string;
+            name: string;
             description?: string;
             /** Format: uri */
             iconUrl?: string;
@@ -884,6 +1252,22 @@ export interface components {
             /** Format: date-time */
             updatedAt: string;
         };
+        Draft: {
+            /** Format: uuid */
+            id: string;
+            /** Format: uuid */
+            channelId: string;
+            /**
+             * Format: uuid
+             * @description スレッドの下書きの場合は返信先のメッセージID
+             */
+            parentId?: string | null;
+            body: string;
+            /** @description アップロード済みで未投稿の添付ファイルのID */
+            attachmentIds: string[];
+            /** Format: date-time */
+            updatedAt: string;
+        };
         Error: {
             error: string;
         };
@@ -915,9 +1299,19 @@ export interface components {
         ListChannelMembersResponse: {
             members: components["schemas"]["ChannelMemberInfo"][];
         };
+        ListDraftsResponse: {
+            /** @description 下書き（更新日時の新しい順） */
+            drafts: components["schemas"]["Draft"][];
+        };
         ListMembersResponse: {
             members: components["schemas"]["MemberInfo"][];
         };
+        ListMessageRevisionsResponse: {
+            /** Format: uuid */
+            messageId: string;
+            /** @description 編集履歴（古い順） */
+            revisions: components["schemas"]["MessageRevision"][];
+        };
         ListPinsResponse: {
             pins: components["schemas"]["PinnedMessage"][];
             nextCursor?: string | null;
@@ -928,6 +1322,14 @@ export interface components {
         ListReactionsResponse: {
             reactions: components["schemas"]["ReactionWithUser"][];
         };
+        ListRemindersResponse: {
+            /** @description リマインダー（通知日時の早い順） */
+            reminders: components["schemas"]["Reminder"][];
+        };
+        ListScheduledMessagesResponse: {
+            /** @description 予約メッセージ（投稿日時の早い順） */
+            scheduledMessages: components["schemas"]["ScheduledMessage"][];
+        };
         LoginRequest: {
             /** Format: email */
             email: string;
@@ -955,6 +1357,8 @@ export interface components {
             /** Format: uuid */
             parentId?: string | null;
             body: string;
+            /** @description 本文を解析した書式付きテキスト。クライアントはbodyの代わりにこれを表示します */
+            bodyAst?: components["schemas"]["MessageNode"][];
             /** Format: date-time */
             createdAt: string;
             /** Format: date-time */
@@ -969,6 +1373,15 @@ export interface components {
                 avatarUrl?: string | null;
             } | null;
             attachments?: components["schemas"]["Attachment"][];
+            clientMsgId?: string | null;
+            /** @description DM・グループDMで、このメッセージまで既読にしたメンバーのユーザーID（既読を無効にしているユーザーは含みません） */
+            readBy?: string[];
+            /** @description 保存している編集履歴の件数（編集履歴を無効にしているワークスペースでは増えません） */
+            revisionCount?: number;
+            sharedMessage?: components["schemas"]["SharedMessage"];
+            /** @description このメッセージが他のチャンネルに共有された回数 */
+            shareCount?: number;
+            poll?: components["schemas"]["Poll"];
         };
         MessageBookmark: {
             /** Format: uuid */
@@ -978,6 +1391,22 @@ export interface components {
             /** Format: date-time */
             createdAt: string;
         };
+        /** @description 本文を解析した書式付きテキストの要素。typeによって使用しないフィールドは省略されます */
+        MessageNode: {
+            /** @description paragraph | code_block | quote | list | list_item（ブロック要素）、text | code | link | mention | channel | emoji（インライン要素） */
+            type: string;
+            /** @description text・code・code_blockの文字列 */
+            text?: string;
+            /** @description mentionのユーザー名・グループ名、channelのチャンネル名、emojiのショートコード */
+            name?: string;
+            /** @description linkのリンク先（http・https・mailtoのみ） */
+            url?: string;
+            /** @description code_blockに指定された言語 */
+            language?: string;
+            /** @description listが番号付きリストかどうか */
+            ordered?: boolean;
+            children?: components["schemas"]["MessageNode"][];
+        };
         MessageReaction: {
             /** Format: uuid */
             messageId: string;
@@ -988,9 +1417,35 @@ export interface components {
             /** Format: date-time */
             createdAt: string;
         };
+        MessageRevision: {
+            /** Format: uuid */
+            id: string;
+            /** @description 編集によって置き換えられる前の本文 */
+            body: string;
+            editor: {
+                /** Format: uuid */
+                id: string;
+                displayName: string;
+                avatarUrl?: string | null;
+            };
+            /** Format: date-time */
+            editedAt: string;
+        };
         MessagesResponse: {
             messages: components["schemas"]["Message"][];
+            /** @description より古いメッセージが残っているか（afterを指定した場合は判定しない） */
             hasMore: boolean;
+            /** @description より新しいメッセージが残っているか（after・aroundを指定した場合のみ判定する） */
+            hasMoreAfter: boolean;
+            /** @description beforeに指定して、より古いメッセージを取得するためのカーソル */
+            beforeCursor: string | null;
+            /** @description afterに指定して、より新しいメッセージを取得するためのカーソル */
+            afterCursor: string | null;
+            /**
+             * Format: uuid
+             * @description aroundを指定した場合の基準のメッセージのID
+             */
+            anchorId?: string;
         };
         PaginatedChannels: {
             items: components["schemas"]["Channel"][];
@@ -1035,6 +1490,28 @@ export interface components {
             /** Format: uuid */
             pinnedBy: string;
         };
+        /** @description メッセージに紐づく投票と集計 */
+        Poll: {
+            /** Format: uuid */
+            id: string;
+            multipleChoice: boolean;
+            anonymous: boolean;
+            /** Format: date-time */
+            closesAt: string | null;
+            isClosed: boolean;
+            /** @description 1つ以上の選択肢に投票したユーザーの人数 */
+            voterCount: number;
+            options: {
+                /** Format: uuid */
+                id: string;
+                text: string;
+                voteCount: number;
+                /** @description 閲覧者がこの選択肢に投票しているか */
+                voted: boolean;
+                /** @description 投票したユーザーのID。匿名の投票では省略されます */
+                voterIds?: string[];
+            }[];
+        };
         PresignRequest: {
             fileName: string;
             contentType: string;
@@ -1080,6 +1557,135 @@ export interface components {
             password: string;
             displayName: string;
         };
+        Reminder: {
+            /** Format: uuid */
+            id: string;
+            /** Format: uuid */
+            messageId: string;
+            /** Format: uuid */
+            channelId: string;
+            /**
+             * Format: date-time
+             * @description 通知する日時
+             */
+            remindAt: string;
+            /** @description pending（通知待ち）| sending（通知処理中）| fired（通知済み）| completed（完了） */
+            status: string;
+            /** Format: date-time */
+            firedAt?: string | null;
+            /** Format: date-time */
+            completedAt?: string | null;
+            /** Format: date-time */
+            createdAt: string;
+            /** Format: date-time */
+            updatedAt: string;
+        };
+        /** @description 保存期間を過ぎて次回の削除処理で削除されるメッセージの集計 */
+        RetentionReport: {
+            workspaceId: string;
+            /** Format: date-time */
+            generatedAt: string;
+            totalMessages: number;
+            totalAttachments: number;
+            /** @description 保存期間を設定しているチャンネルごとの集計 */
+            channels: components["schemas"]["RetentionReportChannel"][];
+        };
+        RetentionReportChannel: {
+            /** Format: uuid */
+            channelId: string;
+            channelName: string;
+            /** @description チャンネルに適用する保存日数 */
+            retentionDays: number;
+            /**
+             * Format: date-time
+             * @description この日時より前に投稿されたメッセージを削除する
+             */
+            cutoff: string;
+            messageCount: number;
+            attachmentCount: number;
+            /** Format: date-time */
+            oldestMessageAt: string | null;
+        };
+        SaveDraftRequest: {
+            body: string;
+            attachmentIds?: string[];
+        };
+        ScheduledMessage: {
+            /** Format: uuid */
+            id: string;
+            /** Format: uuid */
+            channelId: string;
+            /** Format: uuid */
+            parentId?: string | null;
+            body: string;
+            attachmentIds: string[];
+            /**
+             * Format: date-time
+             * @description 次に投稿する日時
+             */
+            sendAt: string;
+            /** @description 繰り返しの規則（daily | weekdays | weekly）。繰り返さない場合はnull */
+            recurrence?: string | null;
+            /** @description 繰り返しの日付・曜日を計算するタイムゾーン（IANA形式） */
+            timezone: string;
+            /** @description pending（投稿待ち）| sending（投稿処理中）| failed（投稿失敗） */
+            status: string;
+            /** @description 直近の投稿に失敗した理由 */
+            lastError?: string | null;
+            /** Format: date-time */
+            createdAt: string;
+            /** Format: date-time */
+            updatedAt: string;
+        };
+        ShareMessageRequest: {
+            /**
+             * Format: uuid
+             * @description 共有先のチャンネル（DMを含む）。共有元と同じワークスペースのチャンネルのみ指定できます
+             */
+            channelId: string;
+            /** @description 共有に添えるコメント。省略できます */
+            body?: string;
+            /**
+             * Format: uuid
+             * @description 共有先のスレッドの親メッセージ
+             */
+            parentId?: string;
+            /** @description 再送時の重複作成を防ぐためにクライアントが採番する冪等キー */
+            clientMsgId?: string;
+        };
+        /** @description 転送・共有したメッセージの共有元 */
+        SharedMessage: {
+            /** Format: uuid */
+            messageId: string;
+            /** @description 共有元のプレビュー。閲覧者が共有元のチャンネルにアクセスできない場合や、共有元が削除された場合はnull */
+            preview: {
+                /** Format: uuid */
+                channelId: string;
+                channelName: string;
+                user: {
+                    /** Format: uuid */
+                    id: string;
+                    displayName: string;
+                    avatarUrl?: string | null;
+                };
+                body: string;
+                /** Format: date-time */
+                createdAt: string;
+                /** Format: date-time */
+                editedAt?: string | null;
+            } | null;
+        };
+        SnoozeReminderRequest: {
+            /**
+             * Format: date-time
+             * @description 次に通知する日時（現在より後の日時）。presetと同時には指定できません
+             */
+            remindAt?: string;
+            /** @description in_20_minutes | in_1_hour | in_3_hours | tomorrow（翌日9:00）| next_week（翌週月曜日9:00） */
+            preset?: string;
+            /** @description プリセットの日付を計算するタイムゾーン（IANA形式）。省略した場合はUTC */
+            timezone?: string;
+        };
         SuccessResponse: {
             success: boolean;
         };
@@ -1126,6 +1732,19 @@ export interface components {
             /** Format: date-time */
             lastReadAt: string;
         };
+        UpdateScheduledMessageRequest: {
+            body?: string;
+            attachmentIds?: string[];
+            /**
+             * Format: date-time
+             * @description 投稿する日時（現在より後の日時）
+             */
+            sendAt?: string;
+            /** @description 繰り返しの規則（daily | weekdays | weekly）。空文字を指定すると繰り返しを解除します */
+            recurrence?: string;
+            /** @description 繰り返しの日付・曜日を計算するタイムゾーン（IANA形式） */
+            timezone?: string;
+        };
         UpdateUserGroupRequest: {
             name: string;
             description?: string;
@@ -1136,17 +1755,29 @@ export interface components {
             /** Format: uri */
             iconUrl?: string;
             isPublic?: boolean;
+            /** @description falseにすると以降の編集でメッセージの編集履歴を保存せず、保存済みの編集履歴も削除します */
+            messageHistoryEnabled?: boolean;
+        };
+        UpdateWorkspaceRetentionPolicyRequest: {
+            /** @description メッセージを保存する日数（0の場合は無期限に保存） */
+            retentionDays: number;
         };
         UpdateChannelRequest: {
             name?: string;
             description?: string;
             isPrivate?: boolean;
         };
+        UpdateChannelRetentionPolicyRequest: {
+            /** @description チャンネル個別の保存日数（0の場合は無期限に保存、nullの場合はワークスペースの設定に従う） */
+            retentionDays: number | null;
+        };
         UpdateMeRequest: {
             display_name?: string;
             bio?: string;
             /** Format: uri */
             avatar_url?: string;
+            /** @description falseにするとDMの既読を送信せず、他のメンバーの既読も受け取りません */
+            read_receipts_enabled?: boolean;
         };
         User: {
             /** Format: uuid */
@@ -1180,6 +1811,18 @@ export interface components {
             /** Format: date-time */
             joinedAt: string;
         };
+        UserPresence: {
+            /** Format: uuid */
+            userId: string;
+            /** @enum {string} */
+            status: "online" | "away" | "offline";
+            /** Format: date-time */
+            lastSeenAt?: string | null;
+        };
+        VotePollRequest: {
+            /** Format: uuid */
+            optionId: string;
+        };
         Workspace: {
             /** @description slug identifier (3-12 chars, lowercase, digits, hyphen) */
             id?: string;
@@ -1187,6 +1830,8 @@ export interface components {
             description?: string | null;
             iconUrl?: string | null;
             isPublic?: boolean;
+            /** @description メッセージの編集履歴を保存するかどうか */
+            messageHistoryEnabled?: boolean;
             /** @enum {string} */
             role?: "owner" | "admin" | "member" | "guest";
             /** Format: uuid */
@@ -1194,6 +1839,14 @@ export interface components {
             /** Format: date-time */
             createdAt: string;
         };
+        WorkspacePresenceResponse: {
+            presences: components["schemas"]["UserPresence"][];
+        };
+        WorkspaceRetentionPolicy: {
+            workspaceId: string;
+            /** @description メッセージを保存する日数（0の場合は無期限に保存） */
+            retentionDays: number;
+        };
         WorkspaceSearchResponse: {
             messages: components["schemas"]["PaginatedMessages"];
             channels: components["schemas"]["PaginatedChannels"];
@@ -1534,6 +2187,146 @@ export interface operations {
             };
         };
     };
+    getDraft: {
+        parameters: {
+            query?: {
+                /** @description スレッドの下書きの場合は返信先のメッセージID */
+                parentId?: string;
+            };
+            header?: never;
+            path: {
+                channelId: string;
+            };
+            cookie?: never;
+        };
+        requestBody?: never;
+        responses: {
+            /** @description Draft retrieved */
+            200: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["Draft"];
+                };
+            };
+            /** @description Unauthorized */
+            401: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["Error"];
+                };
+            };
+            /** @description Draft not found */
+            404: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["Error"];
+                };
+            };
+        };
+    };
+    saveDraft: {
+        parameters: {
+            query?: {
+                /** @description スレッドの下書きの場合は返信先のメッセージID */
+                parentId?: string;
+            };
+            header?: never;
+            path: {
+                channelId: string;
+            };
+            cookie?: never;
+        };
+        requestBody: {
+            content: {
+                "application/json": components["schemas"]["SaveDraftRequest"];
+            };
+        };
+        responses: {
+            /** @description Draft saved */
+            200: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["Draft"];
+                };
+            };
+            /** @description Bad request */
+            400: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["Error"];
+                };
+            };
+            /** @description Unauthorized */
+            401: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["Error"];
+                };
+            };
+            /** @description Forbidden */
+            403: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["Error"];
+                };
+            };
+        };
+    };
+    deleteDraft: {
+        parameters: {
+            query?: {
+                /** @description スレッドの下書きの場合は返信先のメッセージID */
+                parentId?: string;
+            };
+            header?: never;
+            path: {
+                channelId: string;
+            };
+            cookie?: never;
+        };
+        requestBody?: never;
+        responses: {
+            /** @description Draft deleted */
+            204: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content?: never;
+            };
+            /** @description Unauthorized */
+            401: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["Error"];
+                };
+            };
+            /** @description Draft not found */
+            404: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["Error"];
+                };
+            };
+        };
+    };
     listChannelMembers: {
         parameters: {
             query?: never;
@@ -1886,9 +2679,16 @@ export interface operations {
     listMessages: {
         parameters: {
             query?: {
+                /** @description 取得する件数（aroundを指定した場合は前後それぞれの件数） */
                 limit?: number;
                 since?: string;
                 until?: string;
+                /** @description このメッセージの前後のメッセージを取得する（スレッドの返信を指定した場合は親メッセージが基準） */
+                around?: string;
+                /** @description beforeCursorを指定すると、その位置より古いメッセージを取得する */
+                before?: string;
+                /** @description afterCursorを指定すると、その位置より新しいメッセージを取得する */
+                after?: string;
             };
             header?: never;
             path: {
@@ -1907,8 +2707,8 @@ export interface operations {
                     "application/json": components["schemas"]["MessagesResponse"];
                 };
             };
-            /** @description Unauthorized */
-            401: {
+            /** @description Invalid cursor or conflicting range parameters */
+            400: {
                 headers: {
                     [name: string]: unknown;
                 };
@@ -1916,7 +2716,16 @@ export interface operations {
                     "application/json": components["schemas"]["Error"];
                 };
             };
-            /** @description Channel not found */
+            /** @description Unauthorized */
+            401: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["Error"];
+                };
+            };
+            /** @description Channel not found */
             404: {
                 headers: {
                     [name: string]: unknown;
@@ -2165,6 +2974,68 @@ export interface operations {
             };
         };
     };
+    createPoll: {
+        parameters: {
+            query?: never;
+            header?: never;
+            path: {
+                channelId: string;
+            };
+            cookie?: never;
+        };
+        requestBody: {
+            content: {
+                "application/json": components["schemas"]["CreatePollRequest"];
+            };
+        };
+        responses: {
+            /** @description Poll created */
+            201: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["Message"];
+                };
+            };
+            /** @description Bad request */
+            400: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["Error"];
+                };
+            };
+            /** @description Unauthorized */
+            401: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["Error"];
+                };
+            };
+            /** @description Forbidden */
+            403: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["Error"];
+                };
+            };
+            /** @description Channel not found */
+            404: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["Error"];
+                };
+            };
+        };
+    };
     updateReadState: {
         parameters: {
             query?: never;
@@ -2198,6 +3069,117 @@ export interface operations {
             };
         };
     };
+    getChannelRetentionPolicy: {
+        parameters: {
+            query?: never;
+            header?: never;
+            path: {
+                channelId: string;
+            };
+            cookie?: never;
+        };
+        requestBody?: never;
+        responses: {
+            /** @description Retention policy retrieved */
+            200: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["ChannelRetentionPolicy"];
+                };
+            };
+            /** @description Unauthorized */
+            401: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["Error"];
+                };
+            };
+            /** @description Forbidden */
+            403: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["Error"];
+                };
+            };
+            /** @description Channel not found */
+            404: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["Error"];
+                };
+            };
+        };
+    };
+    updateChannelRetentionPolicy: {
+        parameters: {
+            query?: never;
+            header?: never;
+            path: {
+                channelId: string;
+            };
+            cookie?: never;
+        };
+        requestBody: {
+            content: {
+                "application/json": components["schemas"]["UpdateChannelRetentionPolicyRequest"];
+            };
+        };
+        responses: {
+            /** @description Retention policy updated */
+            200: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["ChannelRetentionPolicy"];
+                };
+            };
+            /** @description Bad request */
+            400: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["Error"];
+                };
+            };
+            /** @description Unauthorized */
+            401: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["Error"];
+                };
+            };
+            /** @description Forbidden */
+            403: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["Error"];
+                };
+            };
+            /** @description Channel not found */
+            404: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["Error"];
+                };
+            };
+        };
+    };
     getUnreadCount: {
         parameters: {
             query?: never;
@@ -2602,8 +3584,737 @@ export interface operations {
                     "application/json": components["schemas"]["Error"];
                 };
             };
-            /** @description Reaction not found */
-            404: {
+            /** @description Reaction not found */
+            404: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["Error"];
+                };
+            };
+        };
+    };
+    listMessageRevisions: {
+        parameters: {
+            query?: never;
+            header?: never;
+            path: {
+                messageId: string;
+            };
+            cookie?: never;
+        };
+        requestBody?: never;
+        responses: {
+            /** @description Revisions retrieved */
+            200: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["ListMessageRevisionsResponse"];
+                };
+            };
+            /** @description Unauthorized */
+            401: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["Error"];
+                };
+            };
+            /** @description Forbidden */
+            403: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["Error"];
+                };
+            };
+            /** @description Message not found */
+            404: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["Error"];
+                };
+            };
+        };
+    };
+    shareMessage: {
+        parameters: {
+            query?: never;
+            header?: never;
+            path: {
+                messageId: string;
+            };
+            cookie?: never;
+        };
+        requestBody: {
+            content: {
+                "application/json": components["schemas"]["ShareMessageRequest"];
+            };
+        };
+        responses: {
+            /** @description Message shared */
+            201: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["Message"];
+                };
+            };
+            /** @description Bad request */
+            400: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["Error"];
+                };
+            };
+            /** @description Unauthorized */
+            401: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["Error"];
+                };
+            };
+            /** @description Message or channel not found */
+            404: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["Error"];
+                };
+            };
+        };
+    };
+    getThreadReplies: {
+        parameters: {
+            query?: {
+                limit?: number;
+            };
+            header?: never;
+            path: {
+                messageId: string;
+            };
+            cookie?: never;
+        };
+        requestBody?: never;
+        responses: {
+            /** @description Thread replies retrieved */
+            200: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["ThreadRepliesResponse"];
+                };
+            };
+            /** @description Unauthorized */
+            401: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["Error"];
+                };
+            };
+            /** @description Message not found */
+            404: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["Error"];
+                };
+            };
+        };
+    };
+    getThreadMetadata: {
+        parameters: {
+            query?: never;
+            header?: never;
+            path: {
+                messageId: string;
+            };
+            cookie?: never;
+        };
+        requestBody?: never;
+        responses: {
+            /** @description Thread metadata retrieved */
+            200: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["ThreadMetadata"];
+                };
+            };
+            /** @description Unauthorized */
+            401: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["Error"];
+                };
+            };
+            /** @description Message or metadata not found */
+            404: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["Error"];
+                };
+            };
+        };
+    };
+    votePoll: {
+        parameters: {
+            query?: never;
+            header?: never;
+            path: {
+                id: string;
+            };
+            cookie?: never;
+        };
+        requestBody: {
+            content: {
+                "application/json": components["schemas"]["VotePollRequest"];
+            };
+        };
+        responses: {
+            /** @description Vote recorded */
+            200: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["Poll"];
+                };
+            };
+            /** @description Bad request */
+            400: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["Error"];
+                };
+            };
+            /** @description Unauthorized */
+            401: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["Error"];
+                };
+            };
+            /** @description Forbidden */
+            403: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["Error"];
+                };
+            };
+            /** @description Poll or option not found */
+            404: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["Error"];
+                };
+            };
+            /** @description Poll is closed */
+            409: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["Error"];
+                };
+            };
+        };
+    };
+    unvotePoll: {
+        parameters: {
+            query?: never;
+            header?: never;
+            path: {
+                id: string;
+                optionId: string;
+            };
+            cookie?: never;
+        };
+        requestBody?: never;
+        responses: {
+            /** @description Vote removed */
+            200: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["Poll"];
+                };
+            };
+            /** @description Unauthorized */
+            401: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["Error"];
+                };
+            };
+            /** @description Forbidden */
+            403: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["Error"];
+                };
+            };
+            /** @description Poll or option not found */
+            404: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["Error"];
+                };
+            };
+            /** @description Poll is closed */
+            409: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["Error"];
+                };
+            };
+        };
+    };
+    listReminders: {
+        parameters: {
+            query?: {
+                /** @description trueの場合は完了したリマインダーも含めます */
+                includeCompleted?: boolean;
+            };
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        requestBody?: never;
+        responses: {
+            /** @description Reminders retrieved */
+            200: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["ListRemindersResponse"];
+                };
+            };
+            /** @description Unauthorized */
+            401: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["Error"];
+                };
+            };
+        };
+    };
+    createReminder: {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        requestBody: {
+            content: {
+                "application/json": components["schemas"]["CreateReminderRequest"];
+            };
+        };
+        responses: {
+            /** @description Reminder created */
+            201: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["Reminder"];
+                };
+            };
+            /** @description Bad request */
+            400: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["Error"];
+                };
+            };
+            /** @description Unauthorized */
+            401: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["Error"];
+                };
+            };
+            /** @description Forbidden */
+            403: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["Error"];
+                };
+            };
+            /** @description Message not found */
+            404: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["Error"];
+                };
+            };
+        };
+    };
+    deleteReminder: {
+        parameters: {
+            query?: never;
+            header?: never;
+            path: {
+                id: string;
+            };
+            cookie?: never;
+        };
+        requestBody?: never;
+        responses: {
+            /** @description Reminder deleted */
+            204: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content?: never;
+            };
+            /** @description Unauthorized */
+            401: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["Error"];
+                };
+            };
+            /** @description Forbidden */
+            403: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["Error"];
+                };
+            };
+            /** @description Reminder not found */
+            404: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["Error"];
+                };
+            };
+            /** @description Reminder is being delivered */
+            409: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["Error"];
+                };
+            };
+        };
+    };
+    completeReminder: {
+        parameters: {
+            query?: never;
+            header?: never;
+            path: {
+                id: string;
+            };
+            cookie?: never;
+        };
+        requestBody?: never;
+        responses: {
+            /** @description Reminder completed */
+            200: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["Reminder"];
+                };
+            };
+            /** @description Unauthorized */
+            401: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["Error"];
+                };
+            };
+            /** @description Forbidden */
+            403: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["Error"];
+                };
+            };
+            /** @description Reminder not found */
+            404: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["Error"];
+                };
+            };
+            /** @description Reminder is being delivered */
+            409: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["Error"];
+                };
+            };
+        };
+    };
+    snoozeReminder: {
+        parameters: {
+            query?: never;
+            header?: never;
+            path: {
+                id: string;
+            };
+            cookie?: never;
+        };
+        requestBody: {
+            content: {
+                "application/json": components["schemas"]["SnoozeReminderRequest"];
+            };
+        };
+        responses: {
+            /** @description Reminder snoozed */
+            200: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["Reminder"];
+                };
+            };
+            /** @description Bad request */
+            400: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["Error"];
+                };
+            };
+            /** @description Unauthorized */
+            401: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["Error"];
+                };
+            };
+            /** @description Forbidden */
+            403: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["Error"];
+                };
+            };
+            /** @description Reminder not found */
+            404: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["Error"];
+                };
+            };
+            /** @description Reminder is being delivered or already completed */
+            409: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["Error"];
+                };
+            };
+        };
+    };
+    listScheduledMessages: {
+        parameters: {
+            query?: {
+                channelId?: string;
+            };
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        requestBody?: never;
+        responses: {
+            /** @description Scheduled messages retrieved */
+            200: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["ListScheduledMessagesResponse"];
+                };
+            };
+            /** @description Unauthorized */
+            401: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["Error"];
+                };
+            };
+        };
+    };
+    createScheduledMessage: {
+        parameters: {
+            query?: never;
+            header?: never;
+            path?: never;
+            cookie?: never;
+        };
+        requestBody: {
+            content: {
+                "application/json": components["schemas"]["CreateScheduledMessageRequest"];
+            };
+        };
+        responses: {
+            /** @description Scheduled message created */
+            201: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["ScheduledMessage"];
+                };
+            };
+            /** @description Bad request */
+            400: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["Error"];
+                };
+            };
+            /** @description Unauthorized */
+            401: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["Error"];
+                };
+            };
+            /** @description Forbidden */
+            403: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["Error"];
+                };
+            };
+        };
+    };
+    deleteScheduledMessage: {
+        parameters: {
+            query?: never;
+            header?: never;
+            path: {
+                id: string;
+            };
+            cookie?: never;
+        };
+        requestBody?: never;
+        responses: {
+            /** @description Scheduled message deleted */
+            204: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content?: never;
+            };
+            /** @description Unauthorized */
+            401: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["Error"];
+                };
+            };
+            /** @description Forbidden */
+            403: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["Error"];
+                };
+            };
+            /** @description Scheduled message not found */
+            404: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["Error"];
+                };
+            };
+            /** @description Scheduled message is being sent */
+            409: {
                 headers: {
                     [name: string]: unknown;
                 };
@@ -2613,30 +4324,32 @@ export interface operations {
             };
         };
     };
-    getThreadReplies: {
+    updateScheduledMessage: {
         parameters: {
-            query?: {
-                limit?: number;
-            };
+            query?: never;
             header?: never;
             path: {
-                messageId: string;
+                id: string;
             };
             cookie?: never;
         };
-        requestBody?: never;
+        requestBody: {
+            content: {
+                "application/json": components["schemas"]["UpdateScheduledMessageRequest"];
+            };
+        };
         responses: {
-            /** @description Thread replies retrieved */
+            /** @description Scheduled message updated */
             200: {
                 headers: {
                     [name: string]: unknown;
                 };
                 content: {
-                    "application/json": components["schemas"]["ThreadRepliesResponse"];
+                    "application/json": components["schemas"]["ScheduledMessage"];
                 };
             };
-            /** @description Unauthorized */
-            401: {
+            /** @description Bad request */
+            400: {
                 headers: {
                     [name: string]: unknown;
                 };
@@ -2644,8 +4357,8 @@ export interface operations {
                     "application/json": components["schemas"]["Error"];
                 };
             };
-            /** @description Message not found */
-            404: {
+            /** @description Unauthorized */
+            401: {
                 headers: {
                     [name: string]: unknown;
                 };
@@ -2653,30 +4366,17 @@ export interface operations {
                     "application/json": components["schemas"]["Error"];
                 };
             };
-        };
-    };
-    getThreadMetadata: {
-        parameters: {
-            query?: never;
-            header?: never;
-            path: {
-                messageId: string;
-            };
-            cookie?: never;
-        };
-        requestBody?: never;
-        responses: {
-            /** @description Thread metadata retrieved */
-            200: {
+            /** @description Forbidden */
+            403: {
                 headers: {
                     [name: string]: unknown;
                 };
                 content: {
-                    "application/json": components["schemas"]["ThreadMetadata"];
+                    "application/json": components["schemas"]["Error"];
                 };
             };
-            /** @description Unauthorized */
-            401: {
+            /** @description Scheduled message not found */
+            404: {
                 headers: {
                     [name: string]: unknown;
                 };
@@ -2684,8 +4384,8 @@ export interface operations {
                     "application/json": components["schemas"]["Error"];
                 };
             };
-            /** @description Message or metadata not found */
-            404: {
+            /** @description Scheduled message is being sent */
+            409: {
                 headers: {
                     [name: string]: unknown;
                 };
@@ -3450,6 +5150,46 @@ export interface operations {
             };
         };
     };
+    listDrafts: {
+        parameters: {
+            query?: never;
+            header?: never;
+            path: {
+                id: string;
+            };
+            cookie?: never;
+        };
+        requestBody?: never;
+        responses: {
+            /** @description Drafts retrieved */
+            200: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["ListDraftsResponse"];
+                };
+            };
+            /** @description Unauthorized */
+            401: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["Error"];
+                };
+            };
+            /** @description Forbidden */
+            403: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["Error"];
+                };
+            };
+        };
+    };
     createGroupDM: {
         parameters: {
             query?: never;
@@ -3695,6 +5435,188 @@ export interface operations {
             };
         };
     };
+    getWorkspacePresence: {
+        parameters: {
+            query?: never;
+            header?: never;
+            path: {
+                id: string;
+            };
+            cookie?: never;
+        };
+        requestBody?: never;
+        responses: {
+            /** @description Presence of workspace members */
+            200: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["WorkspacePresenceResponse"];
+                };
+            };
+            /** @description Unauthorized */
+            401: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["Error"];
+                };
+            };
+        };
+    };
+    getWorkspaceRetentionPolicy: {
+        parameters: {
+            query?: never;
+            header?: never;
+            path: {
+                id: string;
+            };
+            cookie?: never;
+        };
+        requestBody?: never;
+        responses: {
+            /** @description Retention policy retrieved */
+            200: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["WorkspaceRetentionPolicy"];
+                };
+            };
+            /** @description Unauthorized */
+            401: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["Error"];
+                };
+            };
+            /** @description Forbidden */
+            403: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["Error"];
+                };
+            };
+            /** @description Workspace not found */
+            404: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["Error"];
+                };
+            };
+        };
+    };
+    updateWorkspaceRetentionPolicy: {
+        parameters: {
+            query?: never;
+            header?: never;
+            path: {
+                id: string;
+            };
+            cookie?: never;
+        };
+        requestBody: {
+            content: {
+                "application/json": components["schemas"]["UpdateWorkspaceRetentionPolicyRequest"];
+            };
+        };
+        responses: {
+            /** @description Retention policy updated */
+            200: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["WorkspaceRetentionPolicy"];
+                };
+            };
+            /** @description Bad request */
+            400: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["Error"];
+                };
+            };
+            /** @description Unauthorized */
+            401: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["Error"];
+                };
+            };
+            /** @description Forbidden */
+            403: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["Error"];
+                };
+            };
+            /** @description Workspace not found */
+            404: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["Error"];
+                };
+            };
+        };
+    };
+    getRetentionReport: {
+        parameters: {
+            query?: never;
+            header?: never;
+            path: {
+                id: string;
+            };
+            cookie?: never;
+        };
+        requestBody?: never;
+        responses: {
+            /** @description Retention report generated */
+            200: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["RetentionReport"];
+                };
+            };
+            /** @description Unauthorized */
+            401: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["Error"];
+                };
+            };
+            /** @description Forbidden */
+            403: {
+                headers: {
+                    [name: string]: unknown;
+                };
+                content: {
+                    "application/json": components["schemas"]["Error"];
+                };
+            };
+        };
+    };
     searchWorkspace: {
         parameters: {
             query: {
@@ -3827,6 +5749,7 @@ export interface operations {
                         displayName: string;
                         bio?: string | null;
                         avatarUrl?: string | null;
+                        readReceiptsEnabled: boolean;
                     };
                 };
             };
@@ -7,14 +7,19 @@ const WS_BC_NAME = "ws-control";
 const WS_RECONNECT_DELAY = 2_000; // 初期遅延: 2秒
 const WS_MAX_RECONNECT_DELAY = 30_000; // 最大遅延: 30秒
 const WS_MAX_RECONNECT_ATTEMPTS = 5; // 最大再接続試行回数
+const WS_PROTOCOL_VERSION = 1; // 対応しているプロトコルバージョン（openapi/asyncapi.yaml の info.version）
+// 認証に関するクローズコード（再接続しても同じトークンでは接続できないため再接続しない）
+// 4001: 認証失敗, 4002: トークン期限切れ, 4003: Workspaceのメンバーでない, 4004: セッション取り消し
+const WS_AUTH_CLOSE_CODES = [4001, 4002, 4003, 4004];
 
 /**
  * サーバWebSocketエンドポイント取得
- * 例: ws://localhost:8080/ws?token=xxxx&workspaceId=xxxx
+ * トークンはアクセスログに残らないよう、URLではなく接続後のauthイベントで送信する
+ * 例: ws://localhost:8080/ws?workspaceId=xxxx&v=1
  */
-function getWsUrl(token: string, workspaceId: string): string {
+function getWsUrl(workspaceId: string): string {
   const base = import.meta.env.VITE_WS_URL || "ws://localhost:8080";
-  return `${base}/ws?token=${encodeURIComponent(token)}&workspaceId=${encodeURIComponent(workspaceId)}`;
+  return `${base}/ws?workspaceId=${encodeURIComponent(workspaceId)}&v=${WS_PROTOCOL_VERSION}`;
 }
 
 export class WsClient {
@@ -188,7 +193,7 @@ export class WsClient {
       return;
     }
 
-    const url = getWsUrl(this.token, this.workspaceId);
+    const url = getWsUrl(this.workspaceId);
     logger.info("WebSocket接続開始:", url);
     try {
       this.ws = new WebSocket(url);
@@ -204,6 +209,8 @@ export class WsClient {
 
   private onOpen = () => {
     logger.info("WebSocket接続が開きました", this.workspaceId);
+    // 最初のメッセージで認証する
+    this.send({ type: "auth", payload: { token: this.token } });
     // 接続成功時は再接続試行回数をリセット
     this.reconnectAttempts = 0;
     this.reconnectDelay = WS_RECONNECT_DELAY;
@@ -224,8 +231,8 @@ export class WsClient {
         logger.info("WebSocket正常終了のため再接続しません", this.workspaceId);
         return;
       }
-      // 認証エラー（1008）の場合は再接続を停止
-      if (event.code === 1008) {
+      // 認証エラー（1008・認証関連のクローズコード）の場合は再接続を停止
+      if (event.code === 1008 || WS_AUTH_CLOSE_CODES.includes(event.code)) {
         logger.error("WebSocket認証エラーのため再接続を停止します", this.workspaceId);
         this.shouldStopReconnecting = true;
         return;
@@ -311,15 +318,40 @@ export class WsClient {
   public leaveChannel(channel_id: string) {
     this.send({ type: "leave_channel", payload: { channel_id } });
   }
-  public postMessage(channel_id: string, body: string) {
-    this.send({ type: "post_message", payload: { channel_id, body } });
+  public joinThread(channel_id: string, thread_id: string) {
+    this.send({ type: "join_thread", payload: { channel_id, thread_id } });
   }
-  public typing(channel_id: string) {
-    this.send({ type: "typing", payload: { channel_id } });
+  public leaveThread(channel_id: string, thread_id: string) {
+    this.send({ type: "leave_thread", payload: { channel_id, thread_id } });
+  }
+  public postMessage(channel_id: string, body: string, client_msg_id?: string) {
+    this.send({ type: "post_message", payload: { channel_id, body, client_msg_id } });
+  }
+  public editMessage(message_id: string, body: string) {
+    this.send({ type: "edit_message", payload: { message_id, body } });
+  }
+  public deleteMessage(message_id: string) {
+    this.send({ type: "delete_message", payload: { message_id } });
+  }
+  public addReaction(message_id: string, emoji: string) {
+    this.send({ type: "add_reaction", payload: { message_id, emoji } });
+  }
+  public typing(channel_id: string, thread_id?: string) {
+    this.send({ type: "typing", payload: { channel_id, thread_id } });
+  }
+  public stopTyping(channel_id: string, thread_id?: string) {
+    this.send({ type: "typing", payload: { channel_id, thread_id, typing: false } });
   }
   public updateReadState(channel_id: string, message_id: string) {
     this.send({ type: "update_read_state", payload: { channel_id, message_id } });
   }
+  /**
+   * 接続を維持したままアクセストークンを新しいものに切り替える
+   */
+  public reauth(token: string) {
+    this.token = token;
+    this.send({ type: "reauth", payload: { token } });
+  }
 
   private send(data: ClientToServerMessage) {
     if (this.ws?.readyState === WebSocket.OPEN) {
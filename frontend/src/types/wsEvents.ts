@@ -1,20 +1,42 @@
-import type { MessageWithThread, SystemMessage } from "@/features/message/schemas";
+import type {
+  EphemeralMessage,
+  MessageWithThread,
+  SystemMessage,
+} from "@/features/message/schemas";
 
 type ClientEventType =
   | "join_channel"
   | "leave_channel"
   | "post_message"
+  | "edit_message"
+  | "delete_message"
+  | "add_reaction"
   | "typing"
-  | "update_read_state";
+  | "update_read_state"
+  | "auth"
+  | "reauth"
+  | "join_thread"
+  | "leave_thread";
 
 type ServerEventType =
   | "new_message"
   | "message_updated"
   | "message_deleted"
+  | "reaction_added"
   | "unread_count"
   | "pin_created"
   | "pin_deleted"
   | "system_message_created"
+  | "typing_users"
+  | "channel_activity"
+  | "thread_reply_created"
+  | "thread_updated"
+  | "thread_unread"
+  | "read_receipt"
+  | "draft_updated"
+  | "reminder_due"
+  | "poll_updated"
+  | "ephemeral_message"
   | "ack"
   | "error";
 
@@ -23,27 +45,107 @@ type WsEventType = ClientEventType | ServerEventType;
 // ペイロード型定義
 type JoinChannelPayload = { channel_id: string };
 type LeaveChannelPayload = { channel_id: string };
-type PostMessagePayload = { channel_id: string; body: string };
-type TypingPayload = { channel_id: string };
-type UpdateReadStatePayload = { channel_id: string; message_id: string };
+type ThreadSubscriptionPayload = { channel_id: string; thread_id: string };
+type PostMessagePayload = {
+  channel_id: string;
+  body: string;
+  parent_id?: string;
+  attachment_ids?: string[];
+  client_msg_id?: string;
+};
+type EditMessagePayload = { message_id: string; body: string };
+type DeleteMessagePayload = { message_id: string };
+type AddReactionPayload = { message_id: string; emoji: string };
+type TypingPayload = { channel_id: string; thread_id?: string; typing?: boolean };
+type UpdateReadStatePayload = { channel_id: string; message_id: string; last_read_at?: string };
+type AuthPayload = { token: string };
 export type NewMessagePayload = { channel_id: string; message: MessageWithThread };
 type MessageUpdatedPayload = { channel_id: string; message: MessageWithThread };
 type MessageDeletedPayload = {
   channel_id: string;
   deleteData: {
-    id: string;
-    deleted_at: string;
+    messageId: string;
+    channelId: string;
+    deletedIds: string[];
+  };
+};
+type ReactionAddedPayload = {
+  channel_id: string;
+  reaction: {
+    messageId: string;
+    user: { id: string; displayName: string; avatarUrl?: string };
+    emoji: string;
+    createdAt: string;
   };
 };
 type PinPayload = {
   channel_id: string;
-  message: MessageWithThread;
+  message_id: string;
   pinned_by: string;
   pinned_at: string;
 };
 type UnreadCountPayload = { channel_id: string; unread_count: number; has_mention: boolean };
 export type SystemMessageCreatedPayload = { channel_id: string; message: SystemMessage };
-type AckPayload = { type: WsEventType; success: boolean; message?: string };
+export type TypingUsersPayload = { channel_id: string; thread_id?: string; user_ids: string[] };
+export type ChannelActivityPayload = {
+  channel_id: string;
+  latest_message_id: string;
+  latest_message_at: string;
+  unread_count: number;
+  mention_count: number;
+  has_mention: boolean;
+};
+export type ThreadReplyCreatedPayload = {
+  channel_id: string;
+  thread_id: string;
+  message: MessageWithThread;
+};
+export type ThreadUpdatedPayload = {
+  channel_id: string;
+  thread_id: string;
+  reply_count: number;
+  last_reply_at?: string;
+  last_reply_user_id?: string;
+  participant_user_ids: string[];
+};
+export type ThreadUnreadPayload = { channel_id: string; thread_id: string; unread_count: number };
+export type ReadReceiptPayload = { channel_id: string; user_id: string; last_read_at: string };
+export type DraftUpdatedPayload = {
+  channel_id: string;
+  parent_id?: string;
+  body: string;
+  attachment_ids: string[];
+  deleted: boolean;
+  updated_at: string;
+};
+export type ReminderDuePayload = {
+  reminder_id: string;
+  message_id: string;
+  channel_id: string;
+  remind_at: string;
+  system_message: SystemMessage;
+};
+export type PollUpdatedPayload = {
+  channel_id: string;
+  message_id: string;
+  poll_id: string;
+  closes_at?: string;
+  voter_count: number;
+  options: {
+    id: string;
+    vote_count: number;
+    voter_ids?: string[];
+  }[];
+};
+export type EphemeralMessagePayload = { channel_id: string; message: EphemeralMessage };
+type AckPayload = {
+  type: WsEventType;
+  success: boolean;
+  code?: string;
+  message?: string;
+  message_id?: string;
+  client_msg_id?: string;
+};
 type ErrorPayload = { code: string; message: string };
 
 // クライアント→サーバーメッセージ
@@ -51,17 +153,35 @@ export type ClientToServerMessage =
   | { type: "join_channel"; payload: JoinChannelPayload }
   | { type: "leave_channel"; payload: LeaveChannelPayload }
   | { type: "post_message"; payload: PostMessagePayload }
+  | { type: "edit_message"; payload: EditMessagePayload }
+  | { type: "delete_message"; payload: DeleteMessagePayload }
+  | { type: "add_reaction"; payload: AddReactionPayload }
   | { type: "typing"; payload: TypingPayload }
-  | { type: "update_read_state"; payload: UpdateReadStatePayload };
+  | { type: "update_read_state"; payload: UpdateReadStatePayload }
+  | { type: "auth"; payload: AuthPayload }
+  | { type: "reauth"; payload: AuthPayload }
+  | { type: "join_thread"; payload: ThreadSubscriptionPayload }
+  | { type: "leave_thread"; payload: ThreadSubscriptionPayload };
 
 export type WsEventPayloadMap = {
   new_message: NewMessagePayload;
   message_updated: MessageUpdatedPayload;
   message_deleted: MessageDeletedPayload;
+  reaction_added: ReactionAddedPayload;
   unread_count: UnreadCountPayload;
   pin_created: PinPayload;
   pin_deleted: PinPayload;
   system_message_created: SystemMessageCreatedPayload;
+  typing_users: TypingUsersPayload;
+  channel_activity: ChannelActivityPayload;
+  thread_reply_created: ThreadReplyCreatedPayload;
+  thread_updated: ThreadUpdatedPayload;
+  thread_unread: ThreadUnreadPayload;
+  read_receipt: ReadReceiptPayload;
+  draft_updated: DraftUpdatedPayload;
+  reminder_due: ReminderDuePayload;
+  poll_updated: PollUpdatedPayload;
+  ephemeral_message: EphemeralMessagePayload;
   ack: AckPayload;
   error: ErrorPayload;
 };